@@ -21,14 +21,17 @@ var _ = math.Inf
 type IntegrationKind int32
 
 const (
-	IntegrationKind_HTTP IntegrationKind = 0
+	IntegrationKind_HTTP     IntegrationKind = 0
+	IntegrationKind_INFLUXDB IntegrationKind = 1
 )
 
 var IntegrationKind_name = map[int32]string{
 	0: "HTTP",
+	1: "INFLUXDB",
 }
 var IntegrationKind_value = map[string]int32{
-	"HTTP": 0,
+	"HTTP":     0,
+	"INFLUXDB": 1,
 }
 
 func (x IntegrationKind) String() string {
@@ -51,6 +54,24 @@ type CreateApplicationRequest struct {
 	PayloadEncoderScript string `protobuf:"bytes,17,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
 	// Payload decoder script.
 	PayloadDecoderScript string `protobuf:"bytes,18,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Tags (key / value) of the application.
+	Tags map[string]string `protobuf:"bytes,19,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Answer the clock synchronization requests (AppTimeReq) of the devices.
+	ClockSyncEnabled bool `protobuf:"varint,20,opt,name=clockSyncEnabled" json:"clockSyncEnabled,omitempty"`
+	// Interval (in seconds) after which the devices are forced to
+	// re-synchronize their clock (0 = disabled).
+	ClockSyncResyncInterval uint32 `protobuf:"varint,21,opt,name=clockSyncResyncInterval" json:"clockSyncResyncInterval,omitempty"`
+	// Max interval (in seconds) between two uplinks before an inactive alert
+	// is raised (0 = disabled).
+	AlertUplinkInterval uint32 `protobuf:"varint,22,opt,name=alertUplinkInterval" json:"alertUplinkInterval,omitempty"`
+	// Battery level (1 - 254) below which a low battery alert is raised
+	// (0 = disabled).
+	AlertBatteryThreshold uint32 `protobuf:"varint,23,opt,name=alertBatteryThreshold" json:"alertBatteryThreshold,omitempty"`
+	// Link margin (in dB) below which a low margin alert is raised (only
+	// used when alertMarginEnabled is set).
+	AlertMarginThreshold int32 `protobuf:"varint,24,opt,name=alertMarginThreshold" json:"alertMarginThreshold,omitempty"`
+	// Enable the low margin alert.
+	AlertMarginEnabled bool `protobuf:"varint,25,opt,name=alertMarginEnabled" json:"alertMarginEnabled,omitempty"`
 }
 
 func (m *CreateApplicationRequest) Reset()                    { *m = CreateApplicationRequest{} }
@@ -107,6 +128,55 @@ func (m *CreateApplicationRequest) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *CreateApplicationRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *CreateApplicationRequest) GetClockSyncEnabled() bool {
+	if m != nil {
+		return m.ClockSyncEnabled
+	}
+	return false
+}
+
+func (m *CreateApplicationRequest) GetClockSyncResyncInterval() uint32 {
+	if m != nil {
+		return m.ClockSyncResyncInterval
+	}
+	return 0
+}
+
+func (m *CreateApplicationRequest) GetAlertUplinkInterval() uint32 {
+	if m != nil {
+		return m.AlertUplinkInterval
+	}
+	return 0
+}
+
+func (m *CreateApplicationRequest) GetAlertBatteryThreshold() uint32 {
+	if m != nil {
+		return m.AlertBatteryThreshold
+	}
+	return 0
+}
+
+func (m *CreateApplicationRequest) GetAlertMarginThreshold() int32 {
+	if m != nil {
+		return m.AlertMarginThreshold
+	}
+	return 0
+}
+
+func (m *CreateApplicationRequest) GetAlertMarginEnabled() bool {
+	if m != nil {
+		return m.AlertMarginEnabled
+	}
+	return false
+}
+
 type CreateApplicationResponse struct {
 	// ID of the application that was created.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -158,6 +228,24 @@ type GetApplicationResponse struct {
 	PayloadEncoderScript string `protobuf:"bytes,17,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
 	// Payload decoder script.
 	PayloadDecoderScript string `protobuf:"bytes,18,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Tags (key / value) of the application.
+	Tags map[string]string `protobuf:"bytes,19,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Answer the clock synchronization requests (AppTimeReq) of the devices.
+	ClockSyncEnabled bool `protobuf:"varint,20,opt,name=clockSyncEnabled" json:"clockSyncEnabled,omitempty"`
+	// Interval (in seconds) after which the devices are forced to
+	// re-synchronize their clock (0 = disabled).
+	ClockSyncResyncInterval uint32 `protobuf:"varint,21,opt,name=clockSyncResyncInterval" json:"clockSyncResyncInterval,omitempty"`
+	// Max interval (in seconds) between two uplinks before an inactive alert
+	// is raised (0 = disabled).
+	AlertUplinkInterval uint32 `protobuf:"varint,22,opt,name=alertUplinkInterval" json:"alertUplinkInterval,omitempty"`
+	// Battery level (1 - 254) below which a low battery alert is raised
+	// (0 = disabled).
+	AlertBatteryThreshold uint32 `protobuf:"varint,23,opt,name=alertBatteryThreshold" json:"alertBatteryThreshold,omitempty"`
+	// Link margin (in dB) below which a low margin alert is raised (only
+	// used when alertMarginEnabled is set).
+	AlertMarginThreshold int32 `protobuf:"varint,24,opt,name=alertMarginThreshold" json:"alertMarginThreshold,omitempty"`
+	// Enable the low margin alert.
+	AlertMarginEnabled bool `protobuf:"varint,25,opt,name=alertMarginEnabled" json:"alertMarginEnabled,omitempty"`
 }
 
 func (m *GetApplicationResponse) Reset()                    { *m = GetApplicationResponse{} }
@@ -221,6 +309,55 @@ func (m *GetApplicationResponse) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *GetApplicationResponse) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *GetApplicationResponse) GetClockSyncEnabled() bool {
+	if m != nil {
+		return m.ClockSyncEnabled
+	}
+	return false
+}
+
+func (m *GetApplicationResponse) GetClockSyncResyncInterval() uint32 {
+	if m != nil {
+		return m.ClockSyncResyncInterval
+	}
+	return 0
+}
+
+func (m *GetApplicationResponse) GetAlertUplinkInterval() uint32 {
+	if m != nil {
+		return m.AlertUplinkInterval
+	}
+	return 0
+}
+
+func (m *GetApplicationResponse) GetAlertBatteryThreshold() uint32 {
+	if m != nil {
+		return m.AlertBatteryThreshold
+	}
+	return 0
+}
+
+func (m *GetApplicationResponse) GetAlertMarginThreshold() int32 {
+	if m != nil {
+		return m.AlertMarginThreshold
+	}
+	return 0
+}
+
+func (m *GetApplicationResponse) GetAlertMarginEnabled() bool {
+	if m != nil {
+		return m.AlertMarginEnabled
+	}
+	return false
+}
+
 type UpdateApplicationRequest struct {
 	// ID of the application to update.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -236,6 +373,24 @@ type UpdateApplicationRequest struct {
 	PayloadEncoderScript string `protobuf:"bytes,17,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
 	// Payload decoder script.
 	PayloadDecoderScript string `protobuf:"bytes,18,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Tags (key / value) of the application.
+	Tags map[string]string `protobuf:"bytes,19,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Answer the clock synchronization requests (AppTimeReq) of the devices.
+	ClockSyncEnabled bool `protobuf:"varint,20,opt,name=clockSyncEnabled" json:"clockSyncEnabled,omitempty"`
+	// Interval (in seconds) after which the devices are forced to
+	// re-synchronize their clock (0 = disabled).
+	ClockSyncResyncInterval uint32 `protobuf:"varint,21,opt,name=clockSyncResyncInterval" json:"clockSyncResyncInterval,omitempty"`
+	// Max interval (in seconds) between two uplinks before an inactive alert
+	// is raised (0 = disabled).
+	AlertUplinkInterval uint32 `protobuf:"varint,22,opt,name=alertUplinkInterval" json:"alertUplinkInterval,omitempty"`
+	// Battery level (1 - 254) below which a low battery alert is raised
+	// (0 = disabled).
+	AlertBatteryThreshold uint32 `protobuf:"varint,23,opt,name=alertBatteryThreshold" json:"alertBatteryThreshold,omitempty"`
+	// Link margin (in dB) below which a low margin alert is raised (only
+	// used when alertMarginEnabled is set).
+	AlertMarginThreshold int32 `protobuf:"varint,24,opt,name=alertMarginThreshold" json:"alertMarginThreshold,omitempty"`
+	// Enable the low margin alert.
+	AlertMarginEnabled bool `protobuf:"varint,25,opt,name=alertMarginEnabled" json:"alertMarginEnabled,omitempty"`
 }
 
 func (m *UpdateApplicationRequest) Reset()                    { *m = UpdateApplicationRequest{} }
@@ -292,6 +447,55 @@ func (m *UpdateApplicationRequest) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *UpdateApplicationRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *UpdateApplicationRequest) GetClockSyncEnabled() bool {
+	if m != nil {
+		return m.ClockSyncEnabled
+	}
+	return false
+}
+
+func (m *UpdateApplicationRequest) GetClockSyncResyncInterval() uint32 {
+	if m != nil {
+		return m.ClockSyncResyncInterval
+	}
+	return 0
+}
+
+func (m *UpdateApplicationRequest) GetAlertUplinkInterval() uint32 {
+	if m != nil {
+		return m.AlertUplinkInterval
+	}
+	return 0
+}
+
+func (m *UpdateApplicationRequest) GetAlertBatteryThreshold() uint32 {
+	if m != nil {
+		return m.AlertBatteryThreshold
+	}
+	return 0
+}
+
+func (m *UpdateApplicationRequest) GetAlertMarginThreshold() int32 {
+	if m != nil {
+		return m.AlertMarginThreshold
+	}
+	return 0
+}
+
+func (m *UpdateApplicationRequest) GetAlertMarginEnabled() bool {
+	if m != nil {
+		return m.AlertMarginEnabled
+	}
+	return false
+}
+
 type UpdateApplicationResponse struct {
 }
 
@@ -332,6 +536,9 @@ type ListApplicationRequest struct {
 	Offset int64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
 	// ID of the organization to filter on.
 	OrganizationID int64 `protobuf:"varint,3,opt,name=organizationID" json:"organizationID,omitempty"`
+	// Tag selector, e.g. "site=berlin,floor!=3". Supported are key=value,
+	// key!=value, key (tag exists) and !key (tag does not exist).
+	TagSelector string `protobuf:"bytes,4,opt,name=tagSelector" json:"tagSelector,omitempty"`
 }
 
 func (m *ListApplicationRequest) Reset()                    { *m = ListApplicationRequest{} }
@@ -360,6 +567,13 @@ func (m *ListApplicationRequest) GetOrganizationID() int64 {
 	return 0
 }
 
+func (m *ListApplicationRequest) GetTagSelector() string {
+	if m != nil {
+		return m.TagSelector
+	}
+	return ""
+}
+
 type ApplicationListItem struct {
 	// ID of the application.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -373,6 +587,8 @@ type ApplicationListItem struct {
 	ServiceProfileID string `protobuf:"bytes,15,opt,name=serviceProfileID" json:"serviceProfileID,omitempty"`
 	// Service-profile name.
 	ServiceProfileName string `protobuf:"bytes,16,opt,name=serviceProfileName" json:"serviceProfileName,omitempty"`
+	// Tags (key / value) of the application.
+	Tags map[string]string `protobuf:"bytes,17,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *ApplicationListItem) Reset()                    { *m = ApplicationListItem{} }
@@ -422,6 +638,13 @@ func (m *ApplicationListItem) GetServiceProfileName() string {
 	return ""
 }
 
+func (m *ApplicationListItem) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type ListApplicationResponse struct {
 	// Total number of applications available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
@@ -495,6 +718,25 @@ type HTTPIntegration struct {
 	AckNotificationURL string `protobuf:"bytes,5,opt,name=ackNotificationURL" json:"ackNotificationURL,omitempty"`
 	// The URL to call for error notifications.
 	ErrorNotificationURL string `protobuf:"bytes,6,opt,name=errorNotificationURL" json:"errorNotificationURL,omitempty"`
+	// Max number of delivery attempts before a failed delivery is moved to the dead letters (0 = default of 10).
+	MaxAttempts uint32 `protobuf:"varint,7,opt,name=maxAttempts" json:"maxAttempts,omitempty"`
+	// Max age (in seconds) of a failed delivery before it is moved to the dead letters (0 = default of 24 hours).
+	MaxAge uint32 `protobuf:"varint,8,opt,name=maxAge" json:"maxAge,omitempty"`
+	// Secret for signing the requests using HMAC-SHA256 (optional, min. 16 characters).
+	SigningSecret string `protobuf:"bytes,9,opt,name=signingSecret" json:"signingSecret,omitempty"`
+	// CA certificate (PEM) for verifying the server certificate (optional).
+	CaCert string `protobuf:"bytes,10,opt,name=caCert" json:"caCert,omitempty"`
+	// Client certificate (PEM) for client certificate authentication (optional).
+	TlsCert string `protobuf:"bytes,11,opt,name=tlsCert" json:"tlsCert,omitempty"`
+	// Client key (PEM) for client certificate authentication (optional).
+	TlsKey string `protobuf:"bytes,12,opt,name=tlsKey" json:"tlsKey,omitempty"`
+	// Payload marshaler: json (default), protobuf or protobuf_json.
+	Marshaler string `protobuf:"bytes,13,opt,name=marshaler" json:"marshaler,omitempty"`
+	// The URL to call for alert notifications.
+	AlertNotificationURL string `protobuf:"bytes,14,opt,name=alertNotificationURL" json:"alertNotificationURL,omitempty"`
+	// Remove the stored signing secret (on update).
+	// As the signing secret is not returned, an empty signingSecret keeps the stored secret.
+	ClearSigningSecret bool `protobuf:"varint,15,opt,name=clearSigningSecret" json:"clearSigningSecret,omitempty"`
 }
 
 func (m *HTTPIntegration) Reset()                    { *m = HTTPIntegration{} }
@@ -544,6 +786,69 @@ func (m *HTTPIntegration) GetErrorNotificationURL() string {
 	return ""
 }
 
+func (m *HTTPIntegration) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *HTTPIntegration) GetMaxAge() uint32 {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *HTTPIntegration) GetSigningSecret() string {
+	if m != nil {
+		return m.SigningSecret
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetCaCert() string {
+	if m != nil {
+		return m.CaCert
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetTlsCert() string {
+	if m != nil {
+		return m.TlsCert
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetTlsKey() string {
+	if m != nil {
+		return m.TlsKey
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetMarshaler() string {
+	if m != nil {
+		return m.Marshaler
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetAlertNotificationURL() string {
+	if m != nil {
+		return m.AlertNotificationURL
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetClearSigningSecret() bool {
+	if m != nil {
+		return m.ClearSigningSecret
+	}
+	return false
+}
+
 type GetHTTPIntegrationRequest struct {
 	// The id of the application.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -561,121 +866,723 @@ func (m *GetHTTPIntegrationRequest) GetId() int64 {
 	return 0
 }
 
-type DeleteIntegrationRequest struct {
+type GetHTTPIntegrationStatusRequest struct {
 	// The id of the application.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 }
 
-func (m *DeleteIntegrationRequest) Reset()                    { *m = DeleteIntegrationRequest{} }
-func (m *DeleteIntegrationRequest) String() string            { return proto.CompactTextString(m) }
-func (*DeleteIntegrationRequest) ProtoMessage()               {}
-func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
+func (m *GetHTTPIntegrationStatusRequest) Reset()         { *m = GetHTTPIntegrationStatusRequest{} }
+func (m *GetHTTPIntegrationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHTTPIntegrationStatusRequest) ProtoMessage()    {}
+func (*GetHTTPIntegrationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{15}
+}
 
-func (m *DeleteIntegrationRequest) GetId() int64 {
+func (m *GetHTTPIntegrationStatusRequest) GetId() int64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-type ListIntegrationRequest struct {
-	// The id of the application.
-	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+type GetHTTPIntegrationStatusResponse struct {
+	// Timestamp of the last successful delivery.
+	LastSuccessAt string `protobuf:"bytes,1,opt,name=lastSuccessAt" json:"lastSuccessAt,omitempty"`
+	// Timestamp of the last failed delivery.
+	LastFailureAt string `protobuf:"bytes,2,opt,name=lastFailureAt" json:"lastFailureAt,omitempty"`
+	// Error of the last failed delivery.
+	LastError string `protobuf:"bytes,3,opt,name=lastError" json:"lastError,omitempty"`
+	// Number of consecutive failed deliveries.
+	FailureCount uint32 `protobuf:"varint,4,opt,name=failureCount" json:"failureCount,omitempty"`
+	// Number of deliveries queued for retry.
+	QueueSize uint32 `protobuf:"varint,5,opt,name=queueSize" json:"queueSize,omitempty"`
+	// Number of dead letters.
+	DeadLetterCount uint32 `protobuf:"varint,6,opt,name=deadLetterCount" json:"deadLetterCount,omitempty"`
 }
 
-func (m *ListIntegrationRequest) Reset()                    { *m = ListIntegrationRequest{} }
-func (m *ListIntegrationRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListIntegrationRequest) ProtoMessage()               {}
-func (*ListIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
+func (m *GetHTTPIntegrationStatusResponse) Reset()         { *m = GetHTTPIntegrationStatusResponse{} }
+func (m *GetHTTPIntegrationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHTTPIntegrationStatusResponse) ProtoMessage()    {}
+func (*GetHTTPIntegrationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{16}
+}
 
-func (m *ListIntegrationRequest) GetId() int64 {
+func (m *GetHTTPIntegrationStatusResponse) GetLastSuccessAt() string {
 	if m != nil {
-		return m.Id
+		return m.LastSuccessAt
 	}
-	return 0
+	return ""
 }
 
-type ListIntegrationResponse struct {
-	// The integration kinds associated with the application.
-	Kinds []IntegrationKind `protobuf:"varint,1,rep,packed,name=kinds,enum=api.IntegrationKind" json:"kinds,omitempty"`
+func (m *GetHTTPIntegrationStatusResponse) GetLastFailureAt() string {
+	if m != nil {
+		return m.LastFailureAt
+	}
+	return ""
 }
 
-func (m *ListIntegrationResponse) Reset()                    { *m = ListIntegrationResponse{} }
-func (m *ListIntegrationResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListIntegrationResponse) ProtoMessage()               {}
-func (*ListIntegrationResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{17} }
-
-func (m *ListIntegrationResponse) GetKinds() []IntegrationKind {
+func (m *GetHTTPIntegrationStatusResponse) GetLastError() string {
 	if m != nil {
-		return m.Kinds
+		return m.LastError
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
-	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
-	proto.RegisterType((*GetApplicationRequest)(nil), "api.GetApplicationRequest")
-	proto.RegisterType((*GetApplicationResponse)(nil), "api.GetApplicationResponse")
-	proto.RegisterType((*UpdateApplicationRequest)(nil), "api.UpdateApplicationRequest")
-	proto.RegisterType((*UpdateApplicationResponse)(nil), "api.UpdateApplicationResponse")
-	proto.RegisterType((*DeleteApplicationRequest)(nil), "api.DeleteApplicationRequest")
-	proto.RegisterType((*DeleteApplicationResponse)(nil), "api.DeleteApplicationResponse")
-	proto.RegisterType((*ListApplicationRequest)(nil), "api.ListApplicationRequest")
-	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
-	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
-	proto.RegisterType((*EmptyResponse)(nil), "api.EmptyResponse")
-	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
-	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
-	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
-	proto.RegisterType((*DeleteIntegrationRequest)(nil), "api.DeleteIntegrationRequest")
-	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
-	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
-	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
+func (m *GetHTTPIntegrationStatusResponse) GetFailureCount() uint32 {
+	if m != nil {
+		return m.FailureCount
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetHTTPIntegrationStatusResponse) GetQueueSize() uint32 {
+	if m != nil {
+		return m.QueueSize
+	}
+	return 0
+}
 
-// Client API for Application service
+func (m *GetHTTPIntegrationStatusResponse) GetDeadLetterCount() uint32 {
+	if m != nil {
+		return m.DeadLetterCount
+	}
+	return 0
+}
 
-type ApplicationClient interface {
-	// Create creates the given application.
-	Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
-	// Get returns the requested application.
-	Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
-	// Update updates the given application.
-	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*UpdateApplicationResponse, error)
-	// Delete deletes the given application.
-	Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error)
-	// List lists the available applications.
-	List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error)
-	// CreateHTTPIntegration creates an HTTP application-integration.
-	CreateHTTPIntegration(ctx context.Context, in *HTTPIntegration, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// GetHTTPIntegration returns the HTTP application-itegration.
-	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*HTTPIntegration, error)
-	// UpdateHTTPIntegration updates the HTTP application-integration.
-	UpdateHTTPIntegration(ctx context.Context, in *HTTPIntegration, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// DeleteIntegration deletes the application-integration of the given type.
-	DeleteHTTPIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
-	// ListIntegrations lists all configured integrations.
-	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+type ListHTTPIntegrationDeadLettersRequest struct {
+	// The id of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Max number of dead letters to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
 }
 
-type applicationClient struct {
-	cc *grpc.ClientConn
+func (m *ListHTTPIntegrationDeadLettersRequest) Reset()         { *m = ListHTTPIntegrationDeadLettersRequest{} }
+func (m *ListHTTPIntegrationDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListHTTPIntegrationDeadLettersRequest) ProtoMessage()    {}
+func (*ListHTTPIntegrationDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{17}
 }
 
-func NewApplicationClient(cc *grpc.ClientConn) ApplicationClient {
-	return &applicationClient{cc}
+func (m *ListHTTPIntegrationDeadLettersRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (c *applicationClient) Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error) {
+func (m *ListHTTPIntegrationDeadLettersRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListHTTPIntegrationDeadLettersRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListHTTPIntegrationDeadLettersResponse struct {
+	// Total number of dead letters available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	// Dead letters within this result-set.
+	Result []*HTTPIntegrationDeadLetter `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListHTTPIntegrationDeadLettersResponse) Reset() {
+	*m = ListHTTPIntegrationDeadLettersResponse{}
+}
+func (m *ListHTTPIntegrationDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListHTTPIntegrationDeadLettersResponse) ProtoMessage()    {}
+func (*ListHTTPIntegrationDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{18}
+}
+
+func (m *ListHTTPIntegrationDeadLettersResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListHTTPIntegrationDeadLettersResponse) GetResult() []*HTTPIntegrationDeadLetter {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type HTTPIntegrationDeadLetter struct {
+	// ID of the dead letter.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Timestamp of the first delivery attempt.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp of the last delivery attempt.
+	FailedAt string `protobuf:"bytes,3,opt,name=failedAt" json:"failedAt,omitempty"`
+	// Event type (up, join, ack or error).
+	Event string `protobuf:"bytes,4,opt,name=event" json:"event,omitempty"`
+	// Number of delivery attempts.
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts" json:"attempts,omitempty"`
+	// Error of the last delivery attempt.
+	LastError string `protobuf:"bytes,6,opt,name=lastError" json:"lastError,omitempty"`
+	// Payload as a JSON string (only set when JSON encoded).
+	PayloadJSON string `protobuf:"bytes,7,opt,name=payloadJSON" json:"payloadJSON,omitempty"`
+	// Payload as posted to the endpoint.
+	Payload []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *HTTPIntegrationDeadLetter) Reset()                    { *m = HTTPIntegrationDeadLetter{} }
+func (m *HTTPIntegrationDeadLetter) String() string            { return proto.CompactTextString(m) }
+func (*HTTPIntegrationDeadLetter) ProtoMessage()               {}
+func (*HTTPIntegrationDeadLetter) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{19} }
+
+func (m *HTTPIntegrationDeadLetter) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HTTPIntegrationDeadLetter) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *HTTPIntegrationDeadLetter) GetFailedAt() string {
+	if m != nil {
+		return m.FailedAt
+	}
+	return ""
+}
+
+func (m *HTTPIntegrationDeadLetter) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *HTTPIntegrationDeadLetter) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *HTTPIntegrationDeadLetter) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *HTTPIntegrationDeadLetter) GetPayloadJSON() string {
+	if m != nil {
+		return m.PayloadJSON
+	}
+	return ""
+}
+
+func (m *HTTPIntegrationDeadLetter) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type ReplayHTTPIntegrationDeadLetterRequest struct {
+	// The id of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// ID of the dead letter.
+	DeadLetterID int64 `protobuf:"varint,2,opt,name=deadLetterID" json:"deadLetterID,omitempty"`
+}
+
+func (m *ReplayHTTPIntegrationDeadLetterRequest) Reset() {
+	*m = ReplayHTTPIntegrationDeadLetterRequest{}
+}
+func (m *ReplayHTTPIntegrationDeadLetterRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayHTTPIntegrationDeadLetterRequest) ProtoMessage()    {}
+func (*ReplayHTTPIntegrationDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{20}
+}
+
+func (m *ReplayHTTPIntegrationDeadLetterRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReplayHTTPIntegrationDeadLetterRequest) GetDeadLetterID() int64 {
+	if m != nil {
+		return m.DeadLetterID
+	}
+	return 0
+}
+
+type InfluxDBIntegration struct {
+	// The id of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// InfluxDB API write endpoint (e.g. http://localhost:8086/write).
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint" json:"endpoint,omitempty"`
+	// InfluxDB database name.
+	Db string `protobuf:"bytes,3,opt,name=db" json:"db,omitempty"`
+	// InfluxDB username.
+	Username string `protobuf:"bytes,4,opt,name=username" json:"username,omitempty"`
+	// InfluxDB password.
+	// This field is not returned by Get, an empty password keeps the stored password on update.
+	Password string `protobuf:"bytes,5,opt,name=password" json:"password,omitempty"`
+	// InfluxDB retention policy name.
+	RetentionPolicyName string `protobuf:"bytes,6,opt,name=retentionPolicyName" json:"retentionPolicyName,omitempty"`
+	// InfluxDB timestamp precision (ns, u, ms, s, m or h).
+	Precision string `protobuf:"bytes,7,opt,name=precision" json:"precision,omitempty"`
+	// Remove the stored password (on update).
+	ClearPassword bool `protobuf:"varint,8,opt,name=clearPassword" json:"clearPassword,omitempty"`
+}
+
+func (m *InfluxDBIntegration) Reset()                    { *m = InfluxDBIntegration{} }
+func (m *InfluxDBIntegration) String() string            { return proto.CompactTextString(m) }
+func (*InfluxDBIntegration) ProtoMessage()               {}
+func (*InfluxDBIntegration) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{21} }
+
+func (m *InfluxDBIntegration) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *InfluxDBIntegration) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetDb() string {
+	if m != nil {
+		return m.Db
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetRetentionPolicyName() string {
+	if m != nil {
+		return m.RetentionPolicyName
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetPrecision() string {
+	if m != nil {
+		return m.Precision
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetClearPassword() bool {
+	if m != nil {
+		return m.ClearPassword
+	}
+	return false
+}
+
+type GetInfluxDBIntegrationRequest struct {
+	// The id of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetInfluxDBIntegrationRequest) Reset()                    { *m = GetInfluxDBIntegrationRequest{} }
+func (m *GetInfluxDBIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInfluxDBIntegrationRequest) ProtoMessage()               {}
+func (*GetInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{22} }
+
+func (m *GetInfluxDBIntegrationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteIntegrationRequest struct {
+	// The id of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteIntegrationRequest) Reset()                    { *m = DeleteIntegrationRequest{} }
+func (m *DeleteIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteIntegrationRequest) ProtoMessage()               {}
+func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{23} }
+
+func (m *DeleteIntegrationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListIntegrationRequest struct {
+	// The id of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *ListIntegrationRequest) Reset()                    { *m = ListIntegrationRequest{} }
+func (m *ListIntegrationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListIntegrationRequest) ProtoMessage()               {}
+func (*ListIntegrationRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{24} }
+
+func (m *ListIntegrationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListIntegrationResponse struct {
+	// The integration kinds associated with the application.
+	Kinds []IntegrationKind `protobuf:"varint,1,rep,packed,name=kinds,enum=api.IntegrationKind" json:"kinds,omitempty"`
+}
+
+func (m *ListIntegrationResponse) Reset()                    { *m = ListIntegrationResponse{} }
+func (m *ListIntegrationResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListIntegrationResponse) ProtoMessage()               {}
+func (*ListIntegrationResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{25} }
+
+func (m *ListIntegrationResponse) GetKinds() []IntegrationKind {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+type StreamApplicationEventsRequest struct {
+	// ID of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *StreamApplicationEventsRequest) Reset()                    { *m = StreamApplicationEventsRequest{} }
+func (m *StreamApplicationEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*StreamApplicationEventsRequest) ProtoMessage()               {}
+func (*StreamApplicationEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{26} }
+
+func (m *StreamApplicationEventsRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type StreamApplicationEventsResponse struct {
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// ID of the event.
+	EventID int64 `protobuf:"varint,2,opt,name=eventID" json:"eventID,omitempty"`
+	// Timestamp of when the event was stored.
+	CreatedAt string `protobuf:"bytes,3,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Type of the event (uplink, join, ack, error or alert).
+	Type string `protobuf:"bytes,4,opt,name=type" json:"type,omitempty"`
+	// FPort of the uplink (uplink events only).
+	FPort uint32 `protobuf:"varint,5,opt,name=fPort" json:"fPort,omitempty"`
+	// Event payload as a JSON string (as sent to the integrations).
+	PayloadJSON string `protobuf:"bytes,6,opt,name=payloadJSON" json:"payloadJSON,omitempty"`
+}
+
+func (m *StreamApplicationEventsResponse) Reset()         { *m = StreamApplicationEventsResponse{} }
+func (m *StreamApplicationEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamApplicationEventsResponse) ProtoMessage()    {}
+func (*StreamApplicationEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{27}
+}
+
+func (m *StreamApplicationEventsResponse) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *StreamApplicationEventsResponse) GetEventID() int64 {
+	if m != nil {
+		return m.EventID
+	}
+	return 0
+}
+
+func (m *StreamApplicationEventsResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *StreamApplicationEventsResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StreamApplicationEventsResponse) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *StreamApplicationEventsResponse) GetPayloadJSON() string {
+	if m != nil {
+		return m.PayloadJSON
+	}
+	return ""
+}
+
+type TestPayloadCodecRequest struct {
+	// ID of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Payload codec.
+	PayloadCodec string `protobuf:"bytes,2,opt,name=payloadCodec" json:"payloadCodec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,3,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string `protobuf:"bytes,4,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// FPort of the payload.
+	FPort uint32 `protobuf:"varint,5,opt,name=fPort" json:"fPort,omitempty"`
+	// Bytes to decode (base64 encoded when using the REST api).
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// HEX encoded bytes to decode (alternative to data).
+	HexData string `protobuf:"bytes,7,opt,name=hexData" json:"hexData,omitempty"`
+	// JSON object to encode.
+	JsonObject string `protobuf:"bytes,8,opt,name=jsonObject" json:"jsonObject,omitempty"`
+}
+
+func (m *TestPayloadCodecRequest) Reset()                    { *m = TestPayloadCodecRequest{} }
+func (m *TestPayloadCodecRequest) String() string            { return proto.CompactTextString(m) }
+func (*TestPayloadCodecRequest) ProtoMessage()               {}
+func (*TestPayloadCodecRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{28} }
+
+func (m *TestPayloadCodecRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TestPayloadCodecRequest) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecRequest) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecRequest) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *TestPayloadCodecRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecRequest) GetHexData() string {
+	if m != nil {
+		return m.HexData
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecRequest) GetJsonObject() string {
+	if m != nil {
+		return m.JsonObject
+	}
+	return ""
+}
+
+type TestPayloadCodecResponse struct {
+	// Decoded object as JSON string (decode only).
+	JsonObject string `protobuf:"bytes,1,opt,name=jsonObject" json:"jsonObject,omitempty"`
+	// Encoded bytes (encode only, base64 encoded when using the REST api).
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// HEX encoded bytes (encode only).
+	HexData string `protobuf:"bytes,3,opt,name=hexData" json:"hexData,omitempty"`
+	// Execution time of the codec (in microseconds).
+	ExecutionTime int64 `protobuf:"varint,4,opt,name=executionTime" json:"executionTime,omitempty"`
+	// Error returned by the codec (including the line numbers of JavaScript errors).
+	Error string `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *TestPayloadCodecResponse) Reset()                    { *m = TestPayloadCodecResponse{} }
+func (m *TestPayloadCodecResponse) String() string            { return proto.CompactTextString(m) }
+func (*TestPayloadCodecResponse) ProtoMessage()               {}
+func (*TestPayloadCodecResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{29} }
+
+func (m *TestPayloadCodecResponse) GetJsonObject() string {
+	if m != nil {
+		return m.JsonObject
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TestPayloadCodecResponse) GetHexData() string {
+	if m != nil {
+		return m.HexData
+	}
+	return ""
+}
+
+func (m *TestPayloadCodecResponse) GetExecutionTime() int64 {
+	if m != nil {
+		return m.ExecutionTime
+	}
+	return 0
+}
+
+func (m *TestPayloadCodecResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
+	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
+	proto.RegisterType((*GetApplicationRequest)(nil), "api.GetApplicationRequest")
+	proto.RegisterType((*GetApplicationResponse)(nil), "api.GetApplicationResponse")
+	proto.RegisterType((*UpdateApplicationRequest)(nil), "api.UpdateApplicationRequest")
+	proto.RegisterType((*UpdateApplicationResponse)(nil), "api.UpdateApplicationResponse")
+	proto.RegisterType((*DeleteApplicationRequest)(nil), "api.DeleteApplicationRequest")
+	proto.RegisterType((*DeleteApplicationResponse)(nil), "api.DeleteApplicationResponse")
+	proto.RegisterType((*ListApplicationRequest)(nil), "api.ListApplicationRequest")
+	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
+	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
+	proto.RegisterType((*EmptyResponse)(nil), "api.EmptyResponse")
+	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
+	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
+	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
+	proto.RegisterType((*GetHTTPIntegrationStatusRequest)(nil), "api.GetHTTPIntegrationStatusRequest")
+	proto.RegisterType((*GetHTTPIntegrationStatusResponse)(nil), "api.GetHTTPIntegrationStatusResponse")
+	proto.RegisterType((*ListHTTPIntegrationDeadLettersRequest)(nil), "api.ListHTTPIntegrationDeadLettersRequest")
+	proto.RegisterType((*ListHTTPIntegrationDeadLettersResponse)(nil), "api.ListHTTPIntegrationDeadLettersResponse")
+	proto.RegisterType((*HTTPIntegrationDeadLetter)(nil), "api.HTTPIntegrationDeadLetter")
+	proto.RegisterType((*ReplayHTTPIntegrationDeadLetterRequest)(nil), "api.ReplayHTTPIntegrationDeadLetterRequest")
+	proto.RegisterType((*InfluxDBIntegration)(nil), "api.InfluxDBIntegration")
+	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
+	proto.RegisterType((*DeleteIntegrationRequest)(nil), "api.DeleteIntegrationRequest")
+	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
+	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
+	proto.RegisterType((*StreamApplicationEventsRequest)(nil), "api.StreamApplicationEventsRequest")
+	proto.RegisterType((*StreamApplicationEventsResponse)(nil), "api.StreamApplicationEventsResponse")
+	proto.RegisterType((*TestPayloadCodecRequest)(nil), "api.TestPayloadCodecRequest")
+	proto.RegisterType((*TestPayloadCodecResponse)(nil), "api.TestPayloadCodecResponse")
+	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Application service
+
+type ApplicationClient interface {
+	// Create creates the given application.
+	Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
+	// Get returns the requested application.
+	Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
+	// Update updates the given application.
+	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*UpdateApplicationResponse, error)
+	// Delete deletes the given application.
+	Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error)
+	// List lists the available applications.
+	List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error)
+	// CreateHTTPIntegration creates an HTTP application-integration.
+	CreateHTTPIntegration(ctx context.Context, in *HTTPIntegration, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// GetHTTPIntegration returns the HTTP application-itegration.
+	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*HTTPIntegration, error)
+	// UpdateHTTPIntegration updates the HTTP application-integration.
+	UpdateHTTPIntegration(ctx context.Context, in *HTTPIntegration, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// DeleteIntegration deletes the application-integration of the given type.
+	DeleteHTTPIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// GetHTTPIntegrationStatus returns the delivery status of the HTTP application-integration.
+	GetHTTPIntegrationStatus(ctx context.Context, in *GetHTTPIntegrationStatusRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationStatusResponse, error)
+	// ListHTTPIntegrationDeadLetters lists the HTTP application-integration deliveries which failed after exhausting the retry policy.
+	ListHTTPIntegrationDeadLetters(ctx context.Context, in *ListHTTPIntegrationDeadLettersRequest, opts ...grpc.CallOption) (*ListHTTPIntegrationDeadLettersResponse, error)
+	// ReplayHTTPIntegrationDeadLetter re-queues the given dead letter for delivery.
+	ReplayHTTPIntegrationDeadLetter(ctx context.Context, in *ReplayHTTPIntegrationDeadLetterRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// CreateInfluxDBIntegration creates an InfluxDB application-integration.
+	CreateInfluxDBIntegration(ctx context.Context, in *InfluxDBIntegration, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// GetInfluxDBIntegration returns the InfluxDB application-integration.
+	GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*InfluxDBIntegration, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
+	UpdateInfluxDBIntegration(ctx context.Context, in *InfluxDBIntegration, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
+	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ListIntegrations lists all configured integrations.
+	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+	// StreamEvents streams the events (uplinks, joins, acks and errors) of all the devices of the given application as they are received.
+	StreamEvents(ctx context.Context, in *StreamApplicationEventsRequest, opts ...grpc.CallOption) (Application_StreamEventsClient, error)
+	// TestPayloadCodec runs the given payload codec (without storing it) against the given bytes (decode) or JSON object (encode).
+	TestPayloadCodec(ctx context.Context, in *TestPayloadCodecRequest, opts ...grpc.CallOption) (*TestPayloadCodecResponse, error)
+}
+
+type applicationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationClient(cc *grpc.ClientConn) ApplicationClient {
+	return &applicationClient{cc}
+}
+
+func (c *applicationClient) Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error) {
 	out := new(CreateApplicationResponse)
 	err := grpc.Invoke(ctx, "/api.Application/Create", in, out, c.cc, opts...)
 	if err != nil {
@@ -756,6 +1663,69 @@ func (c *applicationClient) DeleteHTTPIntegration(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *applicationClient) GetHTTPIntegrationStatus(ctx context.Context, in *GetHTTPIntegrationStatusRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationStatusResponse, error) {
+	out := new(GetHTTPIntegrationStatusResponse)
+	err := grpc.Invoke(ctx, "/api.Application/GetHTTPIntegrationStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) ListHTTPIntegrationDeadLetters(ctx context.Context, in *ListHTTPIntegrationDeadLettersRequest, opts ...grpc.CallOption) (*ListHTTPIntegrationDeadLettersResponse, error) {
+	out := new(ListHTTPIntegrationDeadLettersResponse)
+	err := grpc.Invoke(ctx, "/api.Application/ListHTTPIntegrationDeadLetters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) ReplayHTTPIntegrationDeadLetter(ctx context.Context, in *ReplayHTTPIntegrationDeadLetterRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Application/ReplayHTTPIntegrationDeadLetter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) CreateInfluxDBIntegration(ctx context.Context, in *InfluxDBIntegration, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Application/CreateInfluxDBIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*InfluxDBIntegration, error) {
+	out := new(InfluxDBIntegration)
+	err := grpc.Invoke(ctx, "/api.Application/GetInfluxDBIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) UpdateInfluxDBIntegration(ctx context.Context, in *InfluxDBIntegration, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Application/UpdateInfluxDBIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) DeleteInfluxDBIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Application/DeleteInfluxDBIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationClient) ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error) {
 	out := new(ListIntegrationResponse)
 	err := grpc.Invoke(ctx, "/api.Application/ListIntegrations", in, out, c.cc, opts...)
@@ -765,6 +1735,47 @@ func (c *applicationClient) ListIntegrations(ctx context.Context, in *ListIntegr
 	return out, nil
 }
 
+func (c *applicationClient) StreamEvents(ctx context.Context, in *StreamApplicationEventsRequest, opts ...grpc.CallOption) (Application_StreamEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Application_serviceDesc.Streams[0], c.cc, "/api.Application/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Application_StreamEventsClient interface {
+	Recv() (*StreamApplicationEventsResponse, error)
+	grpc.ClientStream
+}
+
+type applicationStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationStreamEventsClient) Recv() (*StreamApplicationEventsResponse, error) {
+	m := new(StreamApplicationEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationClient) TestPayloadCodec(ctx context.Context, in *TestPayloadCodecRequest, opts ...grpc.CallOption) (*TestPayloadCodecResponse, error) {
+	out := new(TestPayloadCodecResponse)
+	err := grpc.Invoke(ctx, "/api.Application/TestPayloadCodec", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Application service
 
 type ApplicationServer interface {
@@ -786,8 +1797,26 @@ type ApplicationServer interface {
 	UpdateHTTPIntegration(context.Context, *HTTPIntegration) (*EmptyResponse, error)
 	// DeleteIntegration deletes the application-integration of the given type.
 	DeleteHTTPIntegration(context.Context, *DeleteIntegrationRequest) (*EmptyResponse, error)
+	// GetHTTPIntegrationStatus returns the delivery status of the HTTP application-integration.
+	GetHTTPIntegrationStatus(context.Context, *GetHTTPIntegrationStatusRequest) (*GetHTTPIntegrationStatusResponse, error)
+	// ListHTTPIntegrationDeadLetters lists the HTTP application-integration deliveries which failed after exhausting the retry policy.
+	ListHTTPIntegrationDeadLetters(context.Context, *ListHTTPIntegrationDeadLettersRequest) (*ListHTTPIntegrationDeadLettersResponse, error)
+	// ReplayHTTPIntegrationDeadLetter re-queues the given dead letter for delivery.
+	ReplayHTTPIntegrationDeadLetter(context.Context, *ReplayHTTPIntegrationDeadLetterRequest) (*EmptyResponse, error)
+	// CreateInfluxDBIntegration creates an InfluxDB application-integration.
+	CreateInfluxDBIntegration(context.Context, *InfluxDBIntegration) (*EmptyResponse, error)
+	// GetInfluxDBIntegration returns the InfluxDB application-integration.
+	GetInfluxDBIntegration(context.Context, *GetInfluxDBIntegrationRequest) (*InfluxDBIntegration, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
+	UpdateInfluxDBIntegration(context.Context, *InfluxDBIntegration) (*EmptyResponse, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
+	DeleteInfluxDBIntegration(context.Context, *DeleteIntegrationRequest) (*EmptyResponse, error)
 	// ListIntegrations lists all configured integrations.
 	ListIntegrations(context.Context, *ListIntegrationRequest) (*ListIntegrationResponse, error)
+	// StreamEvents streams the events (uplinks, joins, acks and errors) of all the devices of the given application as they are received.
+	StreamEvents(*StreamApplicationEventsRequest, Application_StreamEventsServer) error
+	// TestPayloadCodec runs the given payload codec (without storing it) against the given bytes (decode) or JSON object (encode).
+	TestPayloadCodec(context.Context, *TestPayloadCodecRequest) (*TestPayloadCodecResponse, error)
 }
 
 func RegisterApplicationServer(s *grpc.Server, srv ApplicationServer) {
@@ -956,6 +1985,132 @@ func _Application_DeleteHTTPIntegration_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Application_GetHTTPIntegrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHTTPIntegrationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).GetHTTPIntegrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/GetHTTPIntegrationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).GetHTTPIntegrationStatus(ctx, req.(*GetHTTPIntegrationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_ListHTTPIntegrationDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHTTPIntegrationDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).ListHTTPIntegrationDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/ListHTTPIntegrationDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).ListHTTPIntegrationDeadLetters(ctx, req.(*ListHTTPIntegrationDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_ReplayHTTPIntegrationDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayHTTPIntegrationDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).ReplayHTTPIntegrationDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/ReplayHTTPIntegrationDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).ReplayHTTPIntegrationDeadLetter(ctx, req.(*ReplayHTTPIntegrationDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_CreateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InfluxDBIntegration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).CreateInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/CreateInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).CreateInfluxDBIntegration(ctx, req.(*InfluxDBIntegration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_GetInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).GetInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/GetInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).GetInfluxDBIntegration(ctx, req.(*GetInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_UpdateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InfluxDBIntegration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).UpdateInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/UpdateInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).UpdateInfluxDBIntegration(ctx, req.(*InfluxDBIntegration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_DeleteInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).DeleteInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/DeleteInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).DeleteInfluxDBIntegration(ctx, req.(*DeleteIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Application_ListIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListIntegrationRequest)
 	if err := dec(in); err != nil {
@@ -974,6 +2129,45 @@ func _Application_ListIntegrations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Application_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamApplicationEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServer).StreamEvents(m, &applicationStreamEventsServer{stream})
+}
+
+type Application_StreamEventsServer interface {
+	Send(*StreamApplicationEventsResponse) error
+	grpc.ServerStream
+}
+
+type applicationStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationStreamEventsServer) Send(m *StreamApplicationEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Application_TestPayloadCodec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestPayloadCodecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).TestPayloadCodec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/TestPayloadCodec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).TestPayloadCodec(ctx, req.(*TestPayloadCodecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Application_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Application",
 	HandlerType: (*ApplicationServer)(nil),
@@ -1014,73 +2208,183 @@ var _Application_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteHTTPIntegration",
 			Handler:    _Application_DeleteHTTPIntegration_Handler,
 		},
+		{
+			MethodName: "GetHTTPIntegrationStatus",
+			Handler:    _Application_GetHTTPIntegrationStatus_Handler,
+		},
+		{
+			MethodName: "ListHTTPIntegrationDeadLetters",
+			Handler:    _Application_ListHTTPIntegrationDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayHTTPIntegrationDeadLetter",
+			Handler:    _Application_ReplayHTTPIntegrationDeadLetter_Handler,
+		},
+		{
+			MethodName: "CreateInfluxDBIntegration",
+			Handler:    _Application_CreateInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "GetInfluxDBIntegration",
+			Handler:    _Application_GetInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateInfluxDBIntegration",
+			Handler:    _Application_UpdateInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteInfluxDBIntegration",
+			Handler:    _Application_DeleteInfluxDBIntegration_Handler,
+		},
 		{
 			MethodName: "ListIntegrations",
 			Handler:    _Application_ListIntegrations_Handler,
 		},
+		{
+			MethodName: "TestPayloadCodec",
+			Handler:    _Application_TestPayloadCodec_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _Application_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "application.proto",
 }
 
 func init() { proto.RegisterFile("application.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x27, 0x76, 0x1b, 0x76, 0x5f, 0xa1, 0x49, 0xa7, 0x49, 0x70, 0x9d, 0x10, 0x05, 0x23, 0x20,
-	0xf2, 0x8a, 0xa4, 0xca, 0x72, 0xe2, 0x82, 0x50, 0x53, 0x75, 0x23, 0x56, 0xab, 0x95, 0xd9, 0xde,
-	0x10, 0xd2, 0x10, 0x4f, 0xb2, 0xb3, 0x71, 0x3c, 0xc6, 0x9e, 0x54, 0x6a, 0x81, 0x0b, 0x57, 0x8e,
-	0x5c, 0xf8, 0x18, 0x7c, 0x02, 0xbe, 0x04, 0x1f, 0x80, 0x0b, 0x77, 0xbe, 0x02, 0x9a, 0x3f, 0x69,
-	0x1c, 0x67, 0x2c, 0x05, 0xc1, 0x01, 0xc4, 0x2d, 0x33, 0xef, 0xf7, 0xfe, 0xfe, 0xde, 0x7b, 0xe3,
-	0xc0, 0x09, 0x4e, 0x92, 0x88, 0x4e, 0x31, 0xa7, 0x2c, 0x1e, 0x24, 0x29, 0xe3, 0x0c, 0xd9, 0x38,
-	0xa1, 0x6e, 0x67, 0xce, 0xd8, 0x3c, 0x22, 0x43, 0x9c, 0xd0, 0x21, 0x8e, 0x63, 0xc6, 0x25, 0x22,
-	0x53, 0x10, 0xef, 0x67, 0x0b, 0x9c, 0x8b, 0x94, 0x60, 0x4e, 0x3e, 0xdd, 0xa8, 0x07, 0xe4, 0xeb,
-	0x15, 0xc9, 0x38, 0x42, 0x70, 0x10, 0xe3, 0x25, 0x71, 0x2a, 0xbd, 0x4a, 0xff, 0x61, 0x20, 0x7f,
-	0xa3, 0x1e, 0x1c, 0x85, 0x24, 0x9b, 0xa6, 0x34, 0x11, 0x48, 0xc7, 0x92, 0xa2, 0xfc, 0x15, 0x7a,
-	0x1f, 0x8e, 0x59, 0x3a, 0xc7, 0x31, 0xbd, 0x93, 0xc6, 0x26, 0x63, 0xe7, 0xb8, 0x57, 0xe9, 0xdb,
-	0x41, 0xe1, 0x16, 0xf9, 0x50, 0xcf, 0x48, 0x7a, 0x43, 0xa7, 0xe4, 0x79, 0xca, 0x66, 0x34, 0x22,
-	0x93, 0xb1, 0x53, 0x93, 0xe6, 0x76, 0xee, 0x91, 0x07, 0x6f, 0x24, 0xf8, 0x36, 0x62, 0x38, 0xbc,
-	0x60, 0x21, 0x99, 0x3a, 0x75, 0x89, 0xdb, 0xba, 0x43, 0x23, 0x68, 0xe8, 0xf3, 0x65, 0x3c, 0x65,
-	0x21, 0x49, 0x3f, 0x97, 0x21, 0x39, 0x27, 0x12, 0x6b, 0x94, 0xe5, 0x74, 0xc6, 0x24, 0xaf, 0x83,
-	0xb6, 0x74, 0xb6, 0x64, 0xde, 0x23, 0x38, 0x33, 0x54, 0x2c, 0x4b, 0x58, 0x9c, 0x11, 0x74, 0x0c,
-	0x16, 0x0d, 0x65, 0xc1, 0xec, 0xc0, 0xa2, 0xa1, 0xf7, 0x01, 0x34, 0xaf, 0x08, 0x37, 0xd4, 0xb6,
-	0x08, 0xfc, 0xc5, 0x82, 0x56, 0x11, 0x69, 0xb6, 0x79, 0x4f, 0x8b, 0x55, 0x4e, 0x8b, 0xfd, 0xff,
-	0xa3, 0xe5, 0x27, 0x0b, 0x9c, 0xeb, 0x24, 0x34, 0x77, 0xf2, 0x3f, 0x53, 0xc2, 0xff, 0x6a, 0x69,
-	0xda, 0x70, 0x66, 0xa8, 0x8c, 0xea, 0x2e, 0xcf, 0x07, 0x67, 0x4c, 0x22, 0xb2, 0x4f, 0xd9, 0x84,
-	0x21, 0x03, 0x56, 0x1b, 0x8a, 0xa1, 0xf5, 0x94, 0x66, 0xa6, 0x5e, 0x6f, 0xc0, 0x61, 0x44, 0x97,
-	0x94, 0x6b, 0x4b, 0xea, 0x80, 0x5a, 0x50, 0x65, 0xb3, 0x59, 0x46, 0xb8, 0x64, 0xc1, 0x0e, 0xf4,
-	0xc9, 0xd0, 0xa8, 0xb6, 0xa9, 0x51, 0xbd, 0xdf, 0x2a, 0x70, 0x9a, 0x73, 0x26, 0x7c, 0x4f, 0x38,
-	0x59, 0xfe, 0x8b, 0xc7, 0x65, 0x00, 0x68, 0xfb, 0xee, 0x99, 0x88, 0x4b, 0x75, 0x86, 0x41, 0xe2,
-	0x2d, 0xe0, 0xad, 0x9d, 0x8a, 0xea, 0x9d, 0xd0, 0x05, 0xe0, 0x8c, 0xe3, 0xe8, 0x82, 0xad, 0xe2,
-	0x75, 0x5d, 0x73, 0x37, 0xe8, 0x1c, 0xaa, 0x29, 0xc9, 0x56, 0x91, 0x28, 0xae, 0xdd, 0x3f, 0x1a,
-	0x39, 0x03, 0x9c, 0xd0, 0x81, 0xa1, 0x5c, 0x81, 0xc6, 0x79, 0x35, 0x78, 0xf3, 0x72, 0x99, 0xf0,
-	0xdb, 0x7b, 0x3e, 0x3f, 0x81, 0xe6, 0x93, 0x17, 0x2f, 0x9e, 0x4f, 0x62, 0x4e, 0xe6, 0xa9, 0xd4,
-	0x79, 0x42, 0x70, 0x48, 0x52, 0x54, 0x07, 0x7b, 0x41, 0x6e, 0xf5, 0xab, 0x20, 0x7e, 0x0a, 0x82,
-	0x6f, 0x70, 0xb4, 0x5a, 0xd7, 0x58, 0x1d, 0xbc, 0x1f, 0x2c, 0xa8, 0x15, 0x2c, 0xec, 0x90, 0xf3,
-	0x11, 0xbc, 0xfe, 0x52, 0x5a, 0xcd, 0x74, 0xa0, 0xae, 0x0c, 0xd4, 0xe8, 0x38, 0x58, 0x43, 0x51,
-	0x07, 0x1e, 0x86, 0x98, 0xe3, 0xeb, 0xe4, 0x3a, 0x78, 0xaa, 0xc9, 0xdb, 0x5c, 0xa0, 0x73, 0x38,
-	0x7d, 0xc5, 0x68, 0xfc, 0x8c, 0x71, 0x3a, 0xd3, 0xd9, 0x0a, 0xdc, 0x81, 0xc4, 0x99, 0x44, 0x82,
-	0x18, 0x3c, 0x5d, 0x14, 0x15, 0x0e, 0x15, 0x31, 0xbb, 0x12, 0x31, 0x84, 0x24, 0x4d, 0x59, 0x5a,
-	0xd4, 0xa8, 0xaa, 0x21, 0x34, 0xc9, 0xc4, 0xb3, 0x71, 0x45, 0x78, 0x21, 0xb1, 0xb2, 0x41, 0xbb,
-	0x1f, 0xca, 0x3d, 0xb0, 0x7d, 0x35, 0x77, 0x7b, 0x20, 0x2f, 0x55, 0x3f, 0x6d, 0x21, 0x75, 0x3f,
-	0xf9, 0x70, 0xb8, 0xa0, 0x71, 0x98, 0x39, 0x95, 0x9e, 0xdd, 0x3f, 0x1e, 0x35, 0x24, 0x0b, 0x39,
-	0xe0, 0x67, 0x34, 0x0e, 0x03, 0x05, 0xf1, 0xdb, 0x50, 0x2b, 0x48, 0xd0, 0x03, 0x38, 0x10, 0x99,
-	0xd5, 0x5f, 0x1b, 0xfd, 0xf1, 0x00, 0x8e, 0x72, 0x6d, 0x86, 0x08, 0x54, 0xd5, 0x6b, 0x89, 0xde,
-	0x96, 0x36, 0xcb, 0x3e, 0x36, 0xdc, 0x6e, 0x99, 0x58, 0xb7, 0x63, 0xe7, 0xfb, 0x5f, 0x7f, 0xff,
-	0xd1, 0x6a, 0x79, 0x27, 0xea, 0x4b, 0x66, 0x83, 0xc8, 0x3e, 0xae, 0xf8, 0xe8, 0x4b, 0xb0, 0xaf,
-	0x08, 0x47, 0xaa, 0x7b, 0x8c, 0x2f, 0xae, 0xdb, 0x36, 0xca, 0xb4, 0xf5, 0xae, 0xb4, 0xee, 0xa0,
-	0xd6, 0x8e, 0xf5, 0xe1, 0x37, 0x34, 0xfc, 0x0e, 0xbd, 0x82, 0xaa, 0x5a, 0xa1, 0x3a, 0x8d, 0xb2,
-	0x97, 0x46, 0xa7, 0x51, 0xbe, 0x6e, 0xdf, 0x91, 0x8e, 0xda, 0x6e, 0x89, 0x23, 0x91, 0xcb, 0x1c,
-	0xaa, 0x8a, 0x7c, 0xed, 0xab, 0x6c, 0x3d, 0x6b, 0x5f, 0xe5, 0x1b, 0x59, 0x27, 0xe5, 0x97, 0x25,
-	0xf5, 0x05, 0x1c, 0x88, 0x7e, 0x40, 0xaa, 0x32, 0xe6, 0xe5, 0xed, 0x76, 0xcc, 0x42, 0xed, 0xe2,
-	0x4c, 0xba, 0x38, 0x45, 0xbb, 0xac, 0xa0, 0x1b, 0x68, 0x2a, 0x36, 0x8b, 0x3b, 0xa0, 0x61, 0x1a,
-	0x71, 0x17, 0xc9, 0xdb, 0xed, 0x15, 0xf4, 0x58, 0x5a, 0xff, 0xd0, 0xeb, 0x9b, 0x13, 0x18, 0xd2,
-	0x8d, 0x7e, 0x36, 0x7c, 0xc9, 0x79, 0x22, 0xca, 0xf7, 0x2d, 0xa0, 0xdd, 0x41, 0x43, 0xdd, 0x35,
-	0xfb, 0xe6, 0x09, 0x74, 0x8d, 0x41, 0x79, 0xe7, 0x32, 0x00, 0x1f, 0xed, 0x1d, 0x80, 0xc8, 0x5a,
-	0x91, 0xff, 0xb7, 0xb3, 0x76, 0xff, 0x62, 0xd6, 0x4d, 0xd5, 0x08, 0x45, 0xbf, 0xf9, 0x1e, 0x32,
-	0xe4, 0x6d, 0x0a, 0x40, 0x67, 0xed, 0xef, 0x9f, 0xf5, 0x1d, 0xd4, 0x0b, 0x9b, 0x25, 0xcb, 0x75,
-	0x95, 0xc1, 0x6d, 0xc7, 0x2c, 0xd4, 0x01, 0x3c, 0x92, 0x01, 0xbc, 0x87, 0xde, 0xdd, 0x23, 0x80,
-	0xaf, 0xaa, 0xf2, 0x9f, 0xcc, 0xe3, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x18, 0x63, 0xc2,
-	0x01, 0x0d, 0x00, 0x00,
+	// 2048 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0xa7, 0x67, 0xc6, 0x13, 0xfb, 0xd9, 0x8e, 0x9d, 0xf2, 0xbf, 0x76, 0xc7, 0x71, 0x66, 0x7b,
+	0x9d, 0xec, 0x30, 0xd9, 0xf5, 0x78, 0xb3, 0x61, 0xb3, 0x04, 0x21, 0x94, 0xc4, 0xde, 0xac, 0x49,
+	0xc8, 0x5a, 0x3d, 0x36, 0xe2, 0xb0, 0x42, 0x2a, 0x77, 0x97, 0xc7, 0x1d, 0xb7, 0xbb, 0x7b, 0xbb,
+	0x6a, 0x4c, 0x26, 0x4b, 0x24, 0xb4, 0xe2, 0x82, 0xc4, 0x01, 0x89, 0x13, 0x37, 0x0e, 0x7c, 0x05,
+	0x04, 0x42, 0x42, 0xe2, 0x23, 0x20, 0x71, 0xe1, 0x03, 0xec, 0x17, 0xe0, 0xc6, 0x0d, 0x54, 0x7f,
+	0xa6, 0xa7, 0xbb, 0xa7, 0xda, 0x19, 0x87, 0x95, 0x16, 0xc1, 0xde, 0xe6, 0xfd, 0xab, 0xfa, 0xbd,
+	0x57, 0xef, 0xbd, 0x7a, 0xd5, 0x36, 0x5c, 0xc1, 0x71, 0x1c, 0xf8, 0x2e, 0x66, 0x7e, 0x14, 0x6e,
+	0xc6, 0x49, 0xc4, 0x22, 0x54, 0xc5, 0xb1, 0x6f, 0xad, 0x75, 0xa3, 0xa8, 0x1b, 0x90, 0x36, 0x8e,
+	0xfd, 0x36, 0x0e, 0xc3, 0x88, 0x09, 0x0d, 0x2a, 0x55, 0xec, 0xbf, 0x4f, 0x80, 0xf9, 0x30, 0x21,
+	0x98, 0x91, 0xfb, 0x43, 0x73, 0x87, 0x7c, 0xda, 0x23, 0x94, 0x21, 0x04, 0xb5, 0x10, 0x9f, 0x12,
+	0xd3, 0x68, 0x18, 0xcd, 0x29, 0x47, 0xfc, 0x46, 0x0d, 0x98, 0xf6, 0x08, 0x75, 0x13, 0x3f, 0xe6,
+	0x9a, 0x66, 0x45, 0x88, 0xb2, 0x2c, 0x74, 0x13, 0x2e, 0x47, 0x49, 0x17, 0x87, 0xfe, 0x0b, 0xb1,
+	0xd8, 0xee, 0xb6, 0x79, 0xb9, 0x61, 0x34, 0xab, 0x4e, 0x81, 0x8b, 0x5a, 0x30, 0x4f, 0x49, 0x72,
+	0xe6, 0xbb, 0x64, 0x2f, 0x89, 0x8e, 0xfc, 0x80, 0xec, 0x6e, 0x9b, 0x73, 0x62, 0xb9, 0x11, 0x3e,
+	0xb2, 0x61, 0x26, 0xc6, 0xfd, 0x20, 0xc2, 0xde, 0xc3, 0xc8, 0x23, 0xae, 0x39, 0x2f, 0xf4, 0x72,
+	0x3c, 0x74, 0x1b, 0x16, 0x15, 0xbd, 0x13, 0xba, 0x91, 0x47, 0x92, 0x8e, 0x80, 0x64, 0x5e, 0x11,
+	0xba, 0x5a, 0x59, 0xc6, 0x66, 0x9b, 0x64, 0x6d, 0x50, 0xce, 0x26, 0x27, 0x43, 0xdf, 0x81, 0x1a,
+	0xc3, 0x5d, 0x6a, 0x2e, 0x34, 0xaa, 0xcd, 0xe9, 0xdb, 0x6f, 0x6d, 0xe2, 0xd8, 0xdf, 0x2c, 0x0b,
+	0xe1, 0xe6, 0x3e, 0xee, 0xd2, 0x9d, 0x90, 0x25, 0x7d, 0x47, 0x18, 0x71, 0xa7, 0xdd, 0x20, 0x72,
+	0x4f, 0x3a, 0xfd, 0xd0, 0xdd, 0x09, 0xf1, 0x61, 0x40, 0x3c, 0x73, 0xb1, 0x61, 0x34, 0x27, 0x9d,
+	0x11, 0x3e, 0xfa, 0x00, 0x56, 0x52, 0x9e, 0x43, 0x68, 0x3f, 0x74, 0x77, 0x43, 0x46, 0x92, 0x33,
+	0x1c, 0x98, 0x4b, 0x0d, 0xa3, 0x39, 0xeb, 0x94, 0x89, 0xd1, 0x16, 0x2c, 0xe0, 0x80, 0x24, 0xec,
+	0x20, 0x0e, 0xfc, 0xf0, 0x24, 0xb5, 0x5a, 0x16, 0x56, 0x3a, 0x11, 0xba, 0x03, 0x4b, 0x82, 0xfd,
+	0x00, 0x33, 0x46, 0x92, 0xfe, 0xfe, 0x71, 0x42, 0xe8, 0x71, 0x14, 0x78, 0xe6, 0x8a, 0xb0, 0xd1,
+	0x0b, 0x79, 0xf8, 0x84, 0xe0, 0x07, 0x38, 0xe9, 0xfa, 0xe1, 0xd0, 0xc8, 0x6c, 0x18, 0xcd, 0x09,
+	0x47, 0x2b, 0x43, 0x9b, 0x80, 0x32, 0xfc, 0x41, 0x0c, 0x56, 0x45, 0x0c, 0x34, 0x12, 0xeb, 0x2e,
+	0x4c, 0xa5, 0x41, 0x44, 0xf3, 0x50, 0x3d, 0x21, 0x7d, 0x95, 0x90, 0xfc, 0x27, 0x5a, 0x84, 0x89,
+	0x33, 0x1c, 0xf4, 0x88, 0xca, 0x44, 0x49, 0xdc, 0xab, 0x7c, 0x60, 0xd8, 0xb7, 0x60, 0x55, 0x73,
+	0x2c, 0x34, 0x8e, 0x42, 0x4a, 0xd0, 0x65, 0xa8, 0xf8, 0x9e, 0x58, 0xa7, 0xea, 0x54, 0x7c, 0xcf,
+	0x7e, 0x0b, 0x96, 0x1e, 0x11, 0xa6, 0xa9, 0x81, 0xa2, 0xe2, 0x17, 0x13, 0xb0, 0x5c, 0xd4, 0xd4,
+	0xaf, 0x99, 0x96, 0x4f, 0xa5, 0xbc, 0x7c, 0xaa, 0xff, 0x7f, 0xe5, 0xf3, 0xed, 0x5c, 0xf9, 0xdc,
+	0x10, 0xe5, 0xa3, 0x0f, 0xe8, 0xd7, 0xc5, 0xf3, 0xd5, 0x15, 0xcf, 0x5f, 0x26, 0xc0, 0x3c, 0x88,
+	0x3d, 0xfd, 0xbd, 0xf0, 0xe5, 0x24, 0xfa, 0xff, 0x52, 0xff, 0x2f, 0x0b, 0xd5, 0xd7, 0x29, 0xfc,
+	0xd5, 0xa5, 0xf0, 0x55, 0x58, 0xd5, 0x1c, 0x8b, 0x6c, 0x2d, 0x76, 0x0b, 0xcc, 0x6d, 0x12, 0x90,
+	0x71, 0xd2, 0x9b, 0x2f, 0xa4, 0xd1, 0x55, 0x0b, 0xfd, 0xca, 0x80, 0xe5, 0x27, 0x3e, 0xd5, 0x5d,
+	0x1d, 0x8b, 0x30, 0x11, 0xf8, 0xa7, 0x3e, 0x53, 0x4b, 0x49, 0x02, 0x2d, 0x43, 0x3d, 0x3a, 0x3a,
+	0xa2, 0x84, 0x09, 0xc4, 0x55, 0x47, 0x51, 0x9a, 0xbe, 0x5f, 0xd5, 0xf6, 0xfd, 0x06, 0x4c, 0x33,
+	0xdc, 0xed, 0x90, 0x80, 0xb8, 0x2c, 0x4a, 0xcc, 0x9a, 0x2c, 0xac, 0x0c, 0xcb, 0xfe, 0x6b, 0x05,
+	0x16, 0x32, 0x70, 0x38, 0xba, 0x5d, 0x46, 0x4e, 0xff, 0x8b, 0xef, 0xa7, 0x4d, 0x40, 0x79, 0xde,
+	0x53, 0x8e, 0x4b, 0x16, 0xb9, 0x46, 0x82, 0xde, 0x57, 0x25, 0x78, 0x45, 0x94, 0xa0, 0x2d, 0x4a,
+	0x50, 0xe3, 0x71, 0xb1, 0xfa, 0x5e, 0x3f, 0x97, 0x4e, 0x60, 0x65, 0xe4, 0x90, 0xd5, 0xad, 0xbf,
+	0x0e, 0xc0, 0x22, 0x86, 0x83, 0x87, 0x51, 0x2f, 0x1c, 0x1c, 0x75, 0x86, 0x83, 0xb6, 0xa0, 0x9e,
+	0x10, 0xda, 0x0b, 0xf8, 0x79, 0x73, 0xb4, 0x66, 0x19, 0x5a, 0x47, 0xe9, 0xd9, 0x73, 0x30, 0xbb,
+	0x73, 0x1a, 0xb3, 0x7e, 0x9a, 0x63, 0xdf, 0x83, 0xa5, 0x8f, 0xf6, 0xf7, 0xf7, 0x78, 0xb1, 0x76,
+	0x13, 0x61, 0xf3, 0x11, 0xc1, 0x1e, 0x49, 0xc6, 0x75, 0xc1, 0xfe, 0x43, 0x0d, 0xe6, 0x0a, 0x2b,
+	0x8c, 0x64, 0xc3, 0x1d, 0xb8, 0x74, 0x2c, 0x56, 0xa5, 0x0a, 0xa8, 0x25, 0x80, 0x6a, 0x37, 0x76,
+	0x06, 0xaa, 0x68, 0x0d, 0xa6, 0x3c, 0xcc, 0xf0, 0x41, 0x7c, 0xe0, 0x3c, 0x51, 0xd9, 0x32, 0x64,
+	0xf0, 0x3e, 0xf4, 0x2c, 0xf2, 0xc3, 0xa7, 0x11, 0xf3, 0x8f, 0x94, 0xb7, 0x5c, 0x4f, 0xe6, 0xac,
+	0x4e, 0x24, 0xba, 0x83, 0x7b, 0x52, 0x34, 0x98, 0x90, 0x99, 0x30, 0x2a, 0xe1, 0x1d, 0x88, 0x24,
+	0x49, 0x94, 0x14, 0x2d, 0xea, 0xb2, 0x81, 0xeb, 0x64, 0x3c, 0xc7, 0x4f, 0xf1, 0xf3, 0xfb, 0x8c,
+	0x91, 0xd3, 0x98, 0x51, 0xf3, 0x92, 0xe8, 0x70, 0x59, 0x16, 0xaf, 0x51, 0x4e, 0x76, 0x89, 0x39,
+	0x29, 0x84, 0x8a, 0x42, 0x1b, 0x30, 0x4b, 0xfd, 0x6e, 0xe8, 0x87, 0xdd, 0x0e, 0x71, 0x13, 0xc2,
+	0xcc, 0x29, 0xb1, 0x4d, 0x9e, 0xc9, 0xad, 0x5d, 0xfc, 0x90, 0x24, 0xcc, 0x04, 0x21, 0x56, 0x14,
+	0x32, 0xe1, 0x12, 0x0b, 0xa8, 0x10, 0x4c, 0x0b, 0xc1, 0x80, 0xe4, 0x16, 0x2c, 0xa0, 0x8f, 0x49,
+	0xdf, 0x9c, 0x91, 0x16, 0x92, 0xe2, 0xd1, 0x3d, 0xc5, 0x09, 0x3d, 0xe6, 0x6d, 0xd1, 0x9c, 0x95,
+	0xd1, 0x4d, 0x19, 0x69, 0xf7, 0x2d, 0xfa, 0x7e, 0x59, 0xfa, 0xae, 0x93, 0xf1, 0xf8, 0xba, 0x01,
+	0xc1, 0x49, 0x27, 0xe7, 0xc6, 0x9c, 0xec, 0xbe, 0xa3, 0x12, 0x3e, 0x44, 0x3f, 0x22, 0xac, 0x90,
+	0x04, 0x65, 0x8d, 0xf2, 0x5d, 0xb8, 0x3e, 0xaa, 0xdc, 0x61, 0x98, 0xf5, 0x68, 0x99, 0xc9, 0x3f,
+	0x0d, 0x68, 0x94, 0xdb, 0xa8, 0x12, 0xdb, 0x80, 0xd9, 0x00, 0x53, 0xd6, 0xe9, 0xb9, 0x2e, 0xa1,
+	0xf4, 0x3e, 0x53, 0x09, 0x9f, 0x67, 0x0e, 0xb4, 0x3e, 0xc4, 0x7e, 0xd0, 0x4b, 0xc8, 0x7d, 0xa6,
+	0x4a, 0x20, 0xcf, 0xe4, 0x21, 0xe5, 0x8c, 0x1d, 0x9e, 0x18, 0x83, 0x84, 0x4d, 0x19, 0x7c, 0xce,
+	0x38, 0x92, 0xaa, 0xb2, 0x9c, 0x6b, 0xe2, 0xf8, 0x73, 0x3c, 0xbe, 0xc2, 0xa7, 0x3d, 0xd2, 0x23,
+	0x1d, 0xff, 0x05, 0x11, 0x99, 0x39, 0xeb, 0x0c, 0x19, 0xa8, 0x09, 0x73, 0x1e, 0xc1, 0xde, 0x13,
+	0xc2, 0xaf, 0x4a, 0xb9, 0x48, 0x5d, 0xe8, 0x14, 0xd9, 0x36, 0x81, 0x1b, 0xbc, 0xf4, 0x0b, 0xae,
+	0x6f, 0xa7, 0x5a, 0x65, 0x31, 0x1b, 0xde, 0x2b, 0x15, 0xfd, 0xbd, 0x52, 0xcd, 0xde, 0x2b, 0xf6,
+	0xcf, 0x0c, 0xb8, 0xf9, 0xaa, 0x7d, 0xc6, 0x6c, 0x65, 0xef, 0x17, 0x5a, 0xd9, 0xba, 0xae, 0x43,
+	0x0c, 0x17, 0x4e, 0x1b, 0xda, 0x3f, 0x0c, 0x58, 0x2d, 0xd5, 0x1a, 0x71, 0x6f, 0x0d, 0xa6, 0x5c,
+	0xf1, 0x6e, 0xf3, 0xd2, 0x33, 0x1c, 0x32, 0x90, 0x05, 0x93, 0xfc, 0x34, 0x84, 0x50, 0x1e, 0x5f,
+	0x4a, 0xf3, 0xc0, 0x90, 0x33, 0xa2, 0x8e, 0x6d, 0xca, 0x91, 0x04, 0xb7, 0xc0, 0x83, 0x5a, 0x97,
+	0xc7, 0x95, 0xd2, 0xf9, 0x6c, 0xa8, 0x17, 0xb3, 0xa1, 0x01, 0xd3, 0x6a, 0x02, 0xfc, 0x7e, 0xe7,
+	0xe3, 0xa7, 0xa2, 0x51, 0x4c, 0x39, 0x59, 0x16, 0x2f, 0x69, 0x45, 0x8a, 0x4e, 0x31, 0xe3, 0x0c,
+	0x48, 0xfb, 0x13, 0xb8, 0xe9, 0x90, 0x38, 0xc0, 0xfd, 0xf2, 0xf0, 0x94, 0x1c, 0xaf, 0x0d, 0x33,
+	0xc3, 0x54, 0xd9, 0xdd, 0x56, 0xa7, 0x9c, 0xe3, 0xd9, 0xff, 0x32, 0x60, 0x61, 0x37, 0x3c, 0x0a,
+	0x7a, 0xcf, 0xb7, 0x1f, 0x9c, 0xd7, 0xd4, 0x2d, 0x98, 0x24, 0xa1, 0x17, 0x47, 0x7e, 0x38, 0x08,
+	0x65, 0x4a, 0x73, 0x5d, 0xef, 0x50, 0xc5, 0xb0, 0xe2, 0x1d, 0x72, 0xdd, 0x1e, 0x25, 0x89, 0x18,
+	0x09, 0x64, 0x00, 0x53, 0x9a, 0xcb, 0x62, 0x4c, 0xe9, 0x4f, 0xa2, 0xc4, 0x53, 0xcd, 0x38, 0xa5,
+	0x79, 0x93, 0x4f, 0x08, 0x23, 0x21, 0x07, 0xb0, 0x17, 0x05, 0xbe, 0xdb, 0x17, 0xb7, 0xb7, 0x8c,
+	0xa6, 0x4e, 0xc4, 0xa3, 0x1e, 0x27, 0xc4, 0xf5, 0x29, 0x1f, 0x31, 0x64, 0x54, 0x87, 0x0c, 0x5e,
+	0xc7, 0xa2, 0x11, 0xed, 0x0d, 0x36, 0x9c, 0x14, 0xdd, 0x29, 0xcf, 0xb4, 0xdb, 0x70, 0xed, 0x11,
+	0x61, 0x9a, 0x18, 0x94, 0x75, 0x9a, 0x74, 0xe2, 0x1b, 0x43, 0xb7, 0x29, 0x67, 0xba, 0x31, 0x34,
+	0x77, 0x60, 0x65, 0x44, 0x53, 0x55, 0x53, 0x0b, 0x26, 0x4e, 0xfc, 0xd0, 0xa3, 0xa6, 0xd1, 0xa8,
+	0x36, 0x2f, 0xdf, 0x5e, 0x14, 0xc5, 0x92, 0x51, 0x7c, 0xec, 0x87, 0x9e, 0x23, 0x55, 0xec, 0x2d,
+	0x58, 0xef, 0xb0, 0x84, 0xe0, 0xd3, 0xcc, 0x5c, 0xb0, 0xc3, 0xb3, 0xb7, 0xb4, 0x71, 0xfe, 0xd9,
+	0x80, 0xeb, 0xa5, 0x26, 0x0a, 0xc1, 0x32, 0xd4, 0x3d, 0x72, 0xb6, 0x73, 0xb0, 0xab, 0x1a, 0xa6,
+	0xa2, 0x78, 0xd6, 0x8a, 0xd2, 0x48, 0x93, 0x6b, 0x40, 0xe6, 0x6b, 0xaf, 0x5a, 0xac, 0x3d, 0x04,
+	0x35, 0xd6, 0x8f, 0x07, 0xd9, 0x21, 0x7e, 0xf3, 0x9a, 0x3b, 0xda, 0x8b, 0x12, 0xa6, 0x4a, 0x4b,
+	0x12, 0xc5, 0xca, 0xa9, 0x8f, 0x54, 0x8e, 0xfd, 0x9b, 0x0a, 0xac, 0xec, 0x13, 0xca, 0xf6, 0x32,
+	0x4f, 0xb8, 0x73, 0x2a, 0x22, 0xf7, 0xfa, 0xab, 0x5c, 0xe0, 0xf5, 0x57, 0x7d, 0x8d, 0xd7, 0x5f,
+	0xed, 0x9c, 0xd7, 0x9f, 0xde, 0x5f, 0x04, 0x35, 0x0f, 0x33, 0x2c, 0x1c, 0x9d, 0x71, 0xc4, 0x6f,
+	0x1e, 0xe5, 0x63, 0xf2, 0x7c, 0x9b, 0xb3, 0x65, 0x8e, 0x0f, 0x48, 0xde, 0x67, 0x9f, 0xd1, 0x28,
+	0xfc, 0xf8, 0xf0, 0x19, 0x71, 0x99, 0x48, 0xef, 0x29, 0x27, 0xc3, 0xb1, 0x7f, 0x67, 0x80, 0x39,
+	0x1a, 0x9b, 0x61, 0x93, 0xce, 0x18, 0x1b, 0x45, 0xe3, 0x14, 0x4a, 0x45, 0x0f, 0xa5, 0x9a, 0x87,
+	0xb2, 0x01, 0xb3, 0xe4, 0x39, 0x71, 0x7b, 0x3c, 0x7b, 0xf6, 0x7d, 0x55, 0xf9, 0x55, 0x27, 0xcf,
+	0x14, 0x8d, 0x55, 0xb4, 0xc8, 0x09, 0xd5, 0x58, 0x39, 0xd1, 0xfa, 0x26, 0xcc, 0x15, 0xd2, 0x19,
+	0x4d, 0x42, 0x8d, 0xf7, 0xbb, 0xf9, 0x6f, 0xa0, 0x19, 0x98, 0xdc, 0x7d, 0xfa, 0xe1, 0x93, 0x83,
+	0x1f, 0x6d, 0x3f, 0x98, 0x37, 0x6e, 0xff, 0x7e, 0x01, 0xa6, 0x33, 0x79, 0x8a, 0x08, 0xd4, 0xe5,
+	0xb7, 0x39, 0x74, 0xed, 0xdc, 0xef, 0xa7, 0xd6, 0x7a, 0x99, 0x58, 0x8d, 0xc6, 0x6b, 0x9f, 0xff,
+	0xed, 0x8b, 0x5f, 0x57, 0x96, 0xed, 0x2b, 0xf2, 0xfb, 0xf6, 0x50, 0x83, 0xde, 0x33, 0x5a, 0xe8,
+	0xc7, 0x50, 0x7d, 0x44, 0x18, 0xb2, 0xb4, 0x1f, 0x99, 0xe4, 0x06, 0x57, 0xcf, 0xf9, 0x00, 0x65,
+	0xaf, 0x8b, 0xd5, 0x4d, 0xb4, 0x3c, 0xb2, 0x7a, 0xfb, 0x33, 0xdf, 0x7b, 0x89, 0x9e, 0x41, 0x5d,
+	0x3e, 0x31, 0x95, 0x1b, 0x65, 0x9f, 0x01, 0xac, 0xf5, 0x32, 0xb1, 0xda, 0xe8, 0x0d, 0xb1, 0xd1,
+	0x55, 0xab, 0x64, 0x23, 0xee, 0x4b, 0x17, 0xea, 0xb2, 0x7f, 0xa9, 0xbd, 0xca, 0x9e, 0xaf, 0xd6,
+	0x7a, 0x99, 0x38, 0xef, 0x54, 0xab, 0xcc, 0xa9, 0x4f, 0xa0, 0xc6, 0x5b, 0x1a, 0x92, 0x91, 0xd1,
+	0xbf, 0x6d, 0xad, 0x35, 0xbd, 0x50, 0x6d, 0xb1, 0x2a, 0xb6, 0x58, 0x40, 0xa3, 0xa7, 0x82, 0xce,
+	0x60, 0x49, 0x9e, 0x66, 0xf1, 0x3d, 0xb2, 0xa8, 0x1b, 0x26, 0x2c, 0x24, 0xb8, 0xf9, 0xe7, 0xd0,
+	0x7b, 0x62, 0xf5, 0x77, 0xec, 0xa6, 0xde, 0x81, 0xb6, 0x3f, 0xb4, 0xa7, 0xed, 0x63, 0xc6, 0x62,
+	0x1e, 0xbe, 0x9f, 0x02, 0x1a, 0x9d, 0x33, 0xd1, 0xfa, 0xe0, 0xf4, 0xf5, 0x13, 0xae, 0xa5, 0x05,
+	0x65, 0x6f, 0x09, 0x00, 0x2d, 0x34, 0x36, 0x00, 0xee, 0xb5, 0x3c, 0xfc, 0xff, 0xd8, 0x6b, 0xeb,
+	0x82, 0x5e, 0x2f, 0xc9, 0x44, 0x28, 0xee, 0x9b, 0xcd, 0x21, 0x8d, 0xdf, 0x3a, 0x00, 0xca, 0xeb,
+	0xd6, 0xf8, 0x5e, 0xff, 0xd6, 0x00, 0xb3, 0x6c, 0xb8, 0x47, 0x1b, 0x25, 0xa1, 0xcf, 0xbd, 0x17,
+	0xac, 0x1b, 0xaf, 0xd0, 0x52, 0xd8, 0xee, 0x0a, 0x6c, 0xef, 0xa2, 0xf6, 0xb8, 0xd8, 0xda, 0x54,
+	0xa2, 0xf8, 0xa3, 0x01, 0xeb, 0xe7, 0x4f, 0xc7, 0xa8, 0x95, 0xa6, 0xfa, 0x2b, 0x47, 0x75, 0xeb,
+	0xd6, 0x58, 0xba, 0x0a, 0xf4, 0x77, 0x05, 0xe8, 0xbb, 0xe8, 0x5b, 0x63, 0x83, 0xe6, 0x33, 0xe0,
+	0x3b, 0x81, 0xc2, 0xf5, 0x27, 0x03, 0xae, 0xbf, 0x62, 0xc4, 0x44, 0x12, 0xcf, 0x78, 0x83, 0xa8,
+	0xf6, 0xd0, 0x7f, 0x28, 0x30, 0xee, 0xd9, 0x8f, 0x5f, 0x0b, 0x63, 0xfb, 0xb3, 0xec, 0xd4, 0xfa,
+	0xb2, 0x9d, 0x08, 0x20, 0x3c, 0x31, 0x3f, 0x37, 0x06, 0x7f, 0x9d, 0xd1, 0x8d, 0xb1, 0xa6, 0x9a,
+	0x95, 0x46, 0x24, 0x5a, 0x8c, 0xea, 0xf0, 0xed, 0xb7, 0xc7, 0xc1, 0xe8, 0x8b, 0x45, 0xbd, 0x43,
+	0x0e, 0xe2, 0x97, 0x86, 0xf8, 0x5b, 0x8e, 0x0e, 0x81, 0x3d, 0xc8, 0xbb, 0xf2, 0x09, 0xd3, 0x2a,
+	0x45, 0x69, 0xdf, 0x11, 0x88, 0x36, 0xd1, 0x85, 0x10, 0x89, 0x98, 0xc8, 0x2e, 0xf1, 0xa5, 0xc5,
+	0xc4, 0xba, 0x70, 0x4c, 0x7e, 0x6e, 0x0c, 0xbe, 0x76, 0xea, 0x40, 0xbc, 0x46, 0xdb, 0x50, 0xb1,
+	0x68, 0x5d, 0x2c, 0x16, 0x2f, 0x60, 0xbe, 0x30, 0x57, 0xd3, 0xcc, 0x85, 0xa4, 0xd9, 0x7a, 0x4d,
+	0x2f, 0x54, 0x20, 0x6e, 0x09, 0x10, 0x37, 0xd0, 0x9b, 0x63, 0x80, 0x40, 0xbf, 0x30, 0x60, 0x46,
+	0x8e, 0xd6, 0x72, 0x9e, 0x46, 0x6f, 0x8a, 0xb5, 0xcf, 0x1f, 0xd0, 0xad, 0x8d, 0xf3, 0x95, 0x14,
+	0x90, 0xb7, 0x05, 0x90, 0x9b, 0x68, 0xa3, 0x04, 0x88, 0x18, 0xc4, 0x69, 0x9b, 0x8a, 0x65, 0xb6,
+	0x0c, 0xf4, 0x12, 0xe6, 0x8b, 0x93, 0x20, 0x92, 0xae, 0x96, 0x0c, 0xcf, 0xd6, 0xb5, 0x12, 0x69,
+	0x1e, 0x80, 0xfd, 0x46, 0x09, 0x00, 0x3e, 0xeb, 0xba, 0x6d, 0x46, 0x28, 0xbb, 0x67, 0xb4, 0x0e,
+	0xeb, 0xe2, 0xbf, 0x04, 0xde, 0xfb, 0xf7, 0x00, 0x08, 0xe3, 0x96, 0xc4, 0x5d, 0x20, 0x00, 0x00,
 }
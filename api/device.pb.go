@@ -16,6 +16,10 @@ It is generated from these files:
 	networkServer.proto
 	serviceProfile.proto
 	deviceProfile.proto
+	integration.proto
+	multicastGroup.proto
+	scheduledDownlink.proto
+	fuotaSession.proto
 
 It has these top-level messages:
 	DeviceKeys
@@ -23,6 +27,7 @@ It has these top-level messages:
 	CreateDeviceResponse
 	GetDeviceRequest
 	GetDeviceResponse
+	DeviceLocation
 	DeleteDeviceRequest
 	DeleteDeviceResponse
 	ListDeviceByApplicationIDRequest
@@ -50,6 +55,17 @@ It has these top-level messages:
 	DataRate
 	RXInfo
 	TXInfo
+	ListDeviceEventsRequest
+	ListDeviceEventsResponse
+	DeviceEvent
+	StreamDeviceEventsRequest
+	ListDeviceAlertsRequest
+	ListDeviceAlertsResponse
+	DeviceAlert
+	ImportDevicesRequest
+	ImportDevicesResponse
+	ExportDevicesRequest
+	ExportDevicesResponse
 	CreateApplicationRequest
 	CreateApplicationResponse
 	GetApplicationRequest
@@ -65,9 +81,21 @@ It has these top-level messages:
 	HTTPIntegrationHeader
 	HTTPIntegration
 	GetHTTPIntegrationRequest
+	GetHTTPIntegrationStatusRequest
+	GetHTTPIntegrationStatusResponse
+	ListHTTPIntegrationDeadLettersRequest
+	ListHTTPIntegrationDeadLettersResponse
+	HTTPIntegrationDeadLetter
+	ReplayHTTPIntegrationDeadLetterRequest
+	InfluxDBIntegration
+	GetInfluxDBIntegrationRequest
 	DeleteIntegrationRequest
 	ListIntegrationRequest
 	ListIntegrationResponse
+	StreamApplicationEventsRequest
+	StreamApplicationEventsResponse
+	TestPayloadCodecRequest
+	TestPayloadCodecResponse
 	EnqueueDeviceQueueItemRequest
 	EnqueueDeviceQueueItemResponse
 	FlushDeviceQueueRequest
@@ -75,6 +103,10 @@ It has these top-level messages:
 	DeviceQueueItem
 	ListDeviceQueueItemsRequest
 	ListDeviceQueueItemsResponse
+	GetDownlinkStatusRequest
+	GetDownlinkStatusResponse
+	ListDownlinksRequest
+	ListDownlinksResponse
 	OrganizationLink
 	ProfileRequest
 	ProfileResponse
@@ -132,6 +164,15 @@ It has these top-level messages:
 	PingRX
 	GetLastPingRequest
 	GetLastPingResponse
+	ListGatewayPingsRequest
+	GatewayPingItem
+	ListGatewayPingsResponse
+	GetGatewayCoverageRequest
+	GetGatewayCoverageResponse
+	GatewayCoverageFeature
+	GatewayCoverageGeometry
+	GatewayCoverageProperties
+	GatewayCoverageRX
 	ListOrganizationRequest
 	OrganizationRequest
 	GetOrganizationResponse
@@ -146,6 +187,7 @@ It has these top-level messages:
 	GetOrganizationUserRequest
 	GetOrganizationUserResponse
 	ListOrganizationUsersResponse
+	OrganizationHTTPIntegration
 	ServiceProfile
 	DeviceProfile
 	CreateNetworkServerRequest
@@ -180,6 +222,60 @@ It has these top-level messages:
 	ListDeviceProfileRequest
 	DeviceProfileMeta
 	ListDeviceProfileResponse
+	UplinkRXInfo
+	UplinkTXInfo
+	UplinkDeviceStatus
+	DataUpPayload
+	JoinNotification
+	ACKNotification
+	ErrorNotification
+	AlertNotification
+	GatewayStatusNotification
+	DataDownPayload
+	CreateMulticastGroupRequest
+	CreateMulticastGroupResponse
+	GetMulticastGroupRequest
+	GetMulticastGroupResponse
+	UpdateMulticastGroupRequest
+	UpdateMulticastGroupResponse
+	DeleteMulticastGroupRequest
+	DeleteMulticastGroupResponse
+	ListMulticastGroupRequest
+	MulticastGroupListItem
+	ListMulticastGroupResponse
+	AddDeviceToMulticastGroupRequest
+	AddDeviceToMulticastGroupResponse
+	RemoveDeviceFromMulticastGroupRequest
+	RemoveDeviceFromMulticastGroupResponse
+	ListMulticastGroupDevicesRequest
+	MulticastGroupDeviceListItem
+	ListMulticastGroupDevicesResponse
+	EnqueueMulticastQueueItemRequest
+	EnqueueMulticastQueueItemResponse
+	ScheduledDownlink
+	CreateScheduledDownlinkRequest
+	CreateScheduledDownlinkResponse
+	GetScheduledDownlinkRequest
+	GetScheduledDownlinkResponse
+	UpdateScheduledDownlinkRequest
+	UpdateScheduledDownlinkResponse
+	DeleteScheduledDownlinkRequest
+	DeleteScheduledDownlinkResponse
+	ListScheduledDownlinkRequest
+	ListScheduledDownlinkResponse
+	FUOTASession
+	CreateFUOTASessionRequest
+	CreateFUOTASessionResponse
+	GetFUOTASessionRequest
+	GetFUOTASessionResponse
+	CancelFUOTASessionRequest
+	CancelFUOTASessionResponse
+	ListFUOTASessionRequest
+	FUOTASessionListItem
+	ListFUOTASessionResponse
+	ListFUOTASessionDevicesRequest
+	FUOTASessionDevice
+	ListFUOTASessionDevicesResponse
 */
 package api
 
@@ -232,6 +328,8 @@ type CreateDeviceRequest struct {
 	Description string `protobuf:"bytes,14,opt,name=description" json:"description,omitempty"`
 	// DeviceProfileID attached to the device.
 	DeviceProfileID string `protobuf:"bytes,18,opt,name=deviceProfileID" json:"deviceProfileID,omitempty"`
+	// Tags (key / value) of the device.
+	Tags map[string]string `protobuf:"bytes,19,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *CreateDeviceRequest) Reset()                    { *m = CreateDeviceRequest{} }
@@ -274,6 +372,13 @@ func (m *CreateDeviceRequest) GetDeviceProfileID() string {
 	return ""
 }
 
+func (m *CreateDeviceRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type CreateDeviceResponse struct {
 }
 
@@ -323,6 +428,10 @@ type GetDeviceResponse struct {
 	// The last time the application-server received any data from the device,
 	// or an empty string when the device never sent any data.
 	LastSeenAt string `protobuf:"bytes,21,opt,name=lastSeenAt" json:"lastSeenAt,omitempty"`
+	// Tags (key / value) of the device.
+	Tags map[string]string `protobuf:"bytes,22,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Last resolved location of the device (only set when available).
+	Location *DeviceLocation `protobuf:"bytes,23,opt,name=location" json:"location,omitempty"`
 }
 
 func (m *GetDeviceResponse) Reset()                    { *m = GetDeviceResponse{} }
@@ -386,6 +495,84 @@ func (m *GetDeviceResponse) GetLastSeenAt() string {
 	return ""
 }
 
+func (m *GetDeviceResponse) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *GetDeviceResponse) GetLocation() *DeviceLocation {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+// DeviceLocation contains the location of the device, as resolved from the
+// RX meta-data of the gateways receiving its uplinks.
+type DeviceLocation struct {
+	// Latitude.
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude" json:"latitude,omitempty"`
+	// Longitude.
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude" json:"longitude,omitempty"`
+	// Altitude.
+	Altitude float64 `protobuf:"fixed64,3,opt,name=altitude" json:"altitude,omitempty"`
+	// Source of the location (RSSI or TDOA).
+	Source string `protobuf:"bytes,4,opt,name=source" json:"source,omitempty"`
+	// Estimated accuracy (in meters).
+	Accuracy float64 `protobuf:"fixed64,5,opt,name=accuracy" json:"accuracy,omitempty"`
+	// Time on which the location was resolved.
+	UpdatedAt string `protobuf:"bytes,6,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *DeviceLocation) Reset()                    { *m = DeviceLocation{} }
+func (m *DeviceLocation) String() string            { return proto.CompactTextString(m) }
+func (*DeviceLocation) ProtoMessage()               {}
+func (*DeviceLocation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *DeviceLocation) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *DeviceLocation) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *DeviceLocation) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+func (m *DeviceLocation) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *DeviceLocation) GetAccuracy() float64 {
+	if m != nil {
+		return m.Accuracy
+	}
+	return 0
+}
+
+func (m *DeviceLocation) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
 type DeleteDeviceRequest struct {
 	// Hex encoded DevEUI of the device.
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -394,7 +581,7 @@ type DeleteDeviceRequest struct {
 func (m *DeleteDeviceRequest) Reset()                    { *m = DeleteDeviceRequest{} }
 func (m *DeleteDeviceRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteDeviceRequest) ProtoMessage()               {}
-func (*DeleteDeviceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*DeleteDeviceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *DeleteDeviceRequest) GetDevEUI() string {
 	if m != nil {
@@ -409,7 +596,7 @@ type DeleteDeviceResponse struct {
 func (m *DeleteDeviceResponse) Reset()                    { *m = DeleteDeviceResponse{} }
 func (m *DeleteDeviceResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteDeviceResponse) ProtoMessage()               {}
-func (*DeleteDeviceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*DeleteDeviceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 type ListDeviceByApplicationIDRequest struct {
 	// ID of the application for which to list the devices.
@@ -420,13 +607,16 @@ type ListDeviceByApplicationIDRequest struct {
 	Offset int64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
 	// Search against name or DevEUI
 	Search string `protobuf:"bytes,4,opt,name=search" json:"search,omitempty"`
+	// Tag selector, e.g. "site=berlin,floor!=3". Supported are key=value,
+	// key!=value, key (tag exists) and !key (tag does not exist).
+	TagSelector string `protobuf:"bytes,5,opt,name=tagSelector" json:"tagSelector,omitempty"`
 }
 
 func (m *ListDeviceByApplicationIDRequest) Reset()         { *m = ListDeviceByApplicationIDRequest{} }
 func (m *ListDeviceByApplicationIDRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDeviceByApplicationIDRequest) ProtoMessage()    {}
 func (*ListDeviceByApplicationIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{7}
+	return fileDescriptor0, []int{8}
 }
 
 func (m *ListDeviceByApplicationIDRequest) GetApplicationID() int64 {
@@ -457,6 +647,13 @@ func (m *ListDeviceByApplicationIDRequest) GetSearch() string {
 	return ""
 }
 
+func (m *ListDeviceByApplicationIDRequest) GetTagSelector() string {
+	if m != nil {
+		return m.TagSelector
+	}
+	return ""
+}
+
 type DeviceListItem struct {
 	// Hex encoded DevEUI.
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -483,12 +680,14 @@ type DeviceListItem struct {
 	// The last time the application-server received any data from the device,
 	// or an empty string when the device never sent any data.
 	LastSeenAt string `protobuf:"bytes,22,opt,name=lastSeenAt" json:"lastSeenAt,omitempty"`
+	// Tags (key / value) of the device.
+	Tags map[string]string `protobuf:"bytes,23,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *DeviceListItem) Reset()                    { *m = DeviceListItem{} }
 func (m *DeviceListItem) String() string            { return proto.CompactTextString(m) }
 func (*DeviceListItem) ProtoMessage()               {}
-func (*DeviceListItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*DeviceListItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *DeviceListItem) GetDevEUI() string {
 	if m != nil {
@@ -553,6 +752,13 @@ func (m *DeviceListItem) GetLastSeenAt() string {
 	return ""
 }
 
+func (m *DeviceListItem) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type ListDeviceResponse struct {
 	// Total number of devices available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
@@ -563,7 +769,7 @@ type ListDeviceResponse struct {
 func (m *ListDeviceResponse) Reset()                    { *m = ListDeviceResponse{} }
 func (m *ListDeviceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListDeviceResponse) ProtoMessage()               {}
-func (*ListDeviceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*ListDeviceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *ListDeviceResponse) GetTotalCount() int64 {
 	if m != nil {
@@ -590,12 +796,14 @@ type UpdateDeviceRequest struct {
 	Description string `protobuf:"bytes,14,opt,name=description" json:"description,omitempty"`
 	// DeviceProfileID attached to the device.
 	DeviceProfileID string `protobuf:"bytes,18,opt,name=deviceProfileID" json:"deviceProfileID,omitempty"`
+	// Tags (key / value) of the device.
+	Tags map[string]string `protobuf:"bytes,19,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *UpdateDeviceRequest) Reset()                    { *m = UpdateDeviceRequest{} }
 func (m *UpdateDeviceRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateDeviceRequest) ProtoMessage()               {}
-func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*UpdateDeviceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *UpdateDeviceRequest) GetDevEUI() string {
 	if m != nil {
@@ -632,13 +840,20 @@ func (m *UpdateDeviceRequest) GetDeviceProfileID() string {
 	return ""
 }
 
+func (m *UpdateDeviceRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type UpdateDeviceResponse struct {
 }
 
 func (m *UpdateDeviceResponse) Reset()                    { *m = UpdateDeviceResponse{} }
 func (m *UpdateDeviceResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateDeviceResponse) ProtoMessage()               {}
-func (*UpdateDeviceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*UpdateDeviceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 type CreateDeviceKeysRequest struct {
 	// Hex encoded DevEUI of the device.
@@ -649,7 +864,7 @@ type CreateDeviceKeysRequest struct {
 func (m *CreateDeviceKeysRequest) Reset()                    { *m = CreateDeviceKeysRequest{} }
 func (m *CreateDeviceKeysRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateDeviceKeysRequest) ProtoMessage()               {}
-func (*CreateDeviceKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*CreateDeviceKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *CreateDeviceKeysRequest) GetDevEUI() string {
 	if m != nil {
@@ -671,7 +886,7 @@ type CreateDeviceKeysResponse struct {
 func (m *CreateDeviceKeysResponse) Reset()                    { *m = CreateDeviceKeysResponse{} }
 func (m *CreateDeviceKeysResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateDeviceKeysResponse) ProtoMessage()               {}
-func (*CreateDeviceKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*CreateDeviceKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 type GetDeviceKeysRequest struct {
 	// Hex encoded DevEUI of the device.
@@ -681,7 +896,7 @@ type GetDeviceKeysRequest struct {
 func (m *GetDeviceKeysRequest) Reset()                    { *m = GetDeviceKeysRequest{} }
 func (m *GetDeviceKeysRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetDeviceKeysRequest) ProtoMessage()               {}
-func (*GetDeviceKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*GetDeviceKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *GetDeviceKeysRequest) GetDevEUI() string {
 	if m != nil {
@@ -697,7 +912,7 @@ type GetDeviceKeysResponse struct {
 func (m *GetDeviceKeysResponse) Reset()                    { *m = GetDeviceKeysResponse{} }
 func (m *GetDeviceKeysResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetDeviceKeysResponse) ProtoMessage()               {}
-func (*GetDeviceKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*GetDeviceKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *GetDeviceKeysResponse) GetDeviceKeys() *DeviceKeys {
 	if m != nil {
@@ -715,7 +930,7 @@ type UpdateDeviceKeysRequest struct {
 func (m *UpdateDeviceKeysRequest) Reset()                    { *m = UpdateDeviceKeysRequest{} }
 func (m *UpdateDeviceKeysRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateDeviceKeysRequest) ProtoMessage()               {}
-func (*UpdateDeviceKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*UpdateDeviceKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *UpdateDeviceKeysRequest) GetDevEUI() string {
 	if m != nil {
@@ -737,7 +952,7 @@ type UpdateDeviceKeysResponse struct {
 func (m *UpdateDeviceKeysResponse) Reset()                    { *m = UpdateDeviceKeysResponse{} }
 func (m *UpdateDeviceKeysResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateDeviceKeysResponse) ProtoMessage()               {}
-func (*UpdateDeviceKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*UpdateDeviceKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 type DeleteDeviceKeysRequest struct {
 	// Hex encoded DevEUI of the device.
@@ -747,7 +962,7 @@ type DeleteDeviceKeysRequest struct {
 func (m *DeleteDeviceKeysRequest) Reset()                    { *m = DeleteDeviceKeysRequest{} }
 func (m *DeleteDeviceKeysRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteDeviceKeysRequest) ProtoMessage()               {}
-func (*DeleteDeviceKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*DeleteDeviceKeysRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *DeleteDeviceKeysRequest) GetDevEUI() string {
 	if m != nil {
@@ -762,7 +977,7 @@ type DeleteDeviceKeysResponse struct {
 func (m *DeleteDeviceKeysResponse) Reset()                    { *m = DeleteDeviceKeysResponse{} }
 func (m *DeleteDeviceKeysResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteDeviceKeysResponse) ProtoMessage()               {}
-func (*DeleteDeviceKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*DeleteDeviceKeysResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 type ActivateDeviceRequest struct {
 	// Hex encoded DevEUI of the device to activate.
@@ -784,7 +999,7 @@ type ActivateDeviceRequest struct {
 func (m *ActivateDeviceRequest) Reset()                    { *m = ActivateDeviceRequest{} }
 func (m *ActivateDeviceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ActivateDeviceRequest) ProtoMessage()               {}
-func (*ActivateDeviceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*ActivateDeviceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *ActivateDeviceRequest) GetDevEUI() string {
 	if m != nil {
@@ -841,7 +1056,7 @@ type ActivateDeviceResponse struct {
 func (m *ActivateDeviceResponse) Reset()                    { *m = ActivateDeviceResponse{} }
 func (m *ActivateDeviceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ActivateDeviceResponse) ProtoMessage()               {}
-func (*ActivateDeviceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*ActivateDeviceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 type GetDeviceActivationRequest struct {
 	// Hex encoded DevEUI of the device.
@@ -851,7 +1066,7 @@ type GetDeviceActivationRequest struct {
 func (m *GetDeviceActivationRequest) Reset()                    { *m = GetDeviceActivationRequest{} }
 func (m *GetDeviceActivationRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetDeviceActivationRequest) ProtoMessage()               {}
-func (*GetDeviceActivationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*GetDeviceActivationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *GetDeviceActivationRequest) GetDevEUI() string {
 	if m != nil {
@@ -878,7 +1093,7 @@ type GetDeviceActivationResponse struct {
 func (m *GetDeviceActivationResponse) Reset()                    { *m = GetDeviceActivationResponse{} }
 func (m *GetDeviceActivationResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetDeviceActivationResponse) ProtoMessage()               {}
-func (*GetDeviceActivationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*GetDeviceActivationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *GetDeviceActivationResponse) GetDevAddr() string {
 	if m != nil {
@@ -930,7 +1145,7 @@ type GetRandomDevAddrRequest struct {
 func (m *GetRandomDevAddrRequest) Reset()                    { *m = GetRandomDevAddrRequest{} }
 func (m *GetRandomDevAddrRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRandomDevAddrRequest) ProtoMessage()               {}
-func (*GetRandomDevAddrRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*GetRandomDevAddrRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *GetRandomDevAddrRequest) GetDevEUI() string {
 	if m != nil {
@@ -947,7 +1162,7 @@ type GetRandomDevAddrResponse struct {
 func (m *GetRandomDevAddrResponse) Reset()                    { *m = GetRandomDevAddrResponse{} }
 func (m *GetRandomDevAddrResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetRandomDevAddrResponse) ProtoMessage()               {}
-func (*GetRandomDevAddrResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*GetRandomDevAddrResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *GetRandomDevAddrResponse) GetDevAddr() string {
 	if m != nil {
@@ -968,7 +1183,7 @@ type GetFrameLogsRequest struct {
 func (m *GetFrameLogsRequest) Reset()                    { *m = GetFrameLogsRequest{} }
 func (m *GetFrameLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetFrameLogsRequest) ProtoMessage()               {}
-func (*GetFrameLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*GetFrameLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *GetFrameLogsRequest) GetDevEUI() string {
 	if m != nil {
@@ -1001,7 +1216,7 @@ type GetFrameLogsResponse struct {
 func (m *GetFrameLogsResponse) Reset()                    { *m = GetFrameLogsResponse{} }
 func (m *GetFrameLogsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetFrameLogsResponse) ProtoMessage()               {}
-func (*GetFrameLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*GetFrameLogsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *GetFrameLogsResponse) GetTotalCount() int32 {
 	if m != nil {
@@ -1031,7 +1246,7 @@ type FrameLog struct {
 func (m *FrameLog) Reset()                    { *m = FrameLog{} }
 func (m *FrameLog) String() string            { return proto.CompactTextString(m) }
 func (*FrameLog) ProtoMessage()               {}
-func (*FrameLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*FrameLog) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *FrameLog) GetCreatedAt() string {
 	if m != nil {
@@ -1071,7 +1286,7 @@ type DataRate struct {
 func (m *DataRate) Reset()                    { *m = DataRate{} }
 func (m *DataRate) String() string            { return proto.CompactTextString(m) }
 func (*DataRate) ProtoMessage()               {}
-func (*DataRate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*DataRate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *DataRate) GetModulation() string {
 	if m != nil {
@@ -1125,7 +1340,7 @@ type RXInfo struct {
 func (m *RXInfo) Reset()                    { *m = RXInfo{} }
 func (m *RXInfo) String() string            { return proto.CompactTextString(m) }
 func (*RXInfo) ProtoMessage()               {}
-func (*RXInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*RXInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *RXInfo) GetChannel() int32 {
 	if m != nil {
@@ -1210,7 +1425,7 @@ type TXInfo struct {
 func (m *TXInfo) Reset()                    { *m = TXInfo{} }
 func (m *TXInfo) String() string            { return proto.CompactTextString(m) }
 func (*TXInfo) ProtoMessage()               {}
-func (*TXInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*TXInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *TXInfo) GetCodeRate() string {
 	if m != nil {
@@ -1261,12 +1476,480 @@ func (m *TXInfo) GetDataRate() *DataRate {
 	return nil
 }
 
+type ListDeviceEventsRequest struct {
+	// Hex encoded DevEUI.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Max number of events to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset of the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	// Only return events created at or after this timestamp (RFC3339, optional).
+	StartTime string `protobuf:"bytes,4,opt,name=startTime" json:"startTime,omitempty"`
+	// Only return events created before this timestamp (RFC3339, optional).
+	EndTime string `protobuf:"bytes,5,opt,name=endTime" json:"endTime,omitempty"`
+	// Only return uplink events received on this FPort (optional).
+	FPort uint32 `protobuf:"varint,6,opt,name=fPort" json:"fPort,omitempty"`
+	// Only return events of this type: uplink, join, ack, error or alert (optional).
+	Type string `protobuf:"bytes,7,opt,name=type" json:"type,omitempty"`
+}
+
+func (m *ListDeviceEventsRequest) Reset()                    { *m = ListDeviceEventsRequest{} }
+func (m *ListDeviceEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDeviceEventsRequest) ProtoMessage()               {}
+func (*ListDeviceEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *ListDeviceEventsRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ListDeviceEventsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceEventsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListDeviceEventsRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *ListDeviceEventsRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *ListDeviceEventsRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *ListDeviceEventsRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type ListDeviceEventsResponse struct {
+	// Total number of events available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	// Events within this result-set.
+	Result []*DeviceEvent `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListDeviceEventsResponse) Reset()                    { *m = ListDeviceEventsResponse{} }
+func (m *ListDeviceEventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDeviceEventsResponse) ProtoMessage()               {}
+func (*ListDeviceEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ListDeviceEventsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceEventsResponse) GetResult() []*DeviceEvent {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeviceEvent struct {
+	// ID of the event.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Timestamp of when the event was stored.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Type of the event (uplink, join, ack, error or alert).
+	Type string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// FPort of the uplink (uplink events only).
+	FPort uint32 `protobuf:"varint,4,opt,name=fPort" json:"fPort,omitempty"`
+	// Event payload as a JSON string (as sent to the integrations).
+	PayloadJSON string `protobuf:"bytes,5,opt,name=payloadJSON" json:"payloadJSON,omitempty"`
+}
+
+func (m *DeviceEvent) Reset()                    { *m = DeviceEvent{} }
+func (m *DeviceEvent) String() string            { return proto.CompactTextString(m) }
+func (*DeviceEvent) ProtoMessage()               {}
+func (*DeviceEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *DeviceEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeviceEvent) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *DeviceEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DeviceEvent) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DeviceEvent) GetPayloadJSON() string {
+	if m != nil {
+		return m.PayloadJSON
+	}
+	return ""
+}
+
+type StreamDeviceEventsRequest struct {
+	// Hex encoded DevEUI.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *StreamDeviceEventsRequest) Reset()                    { *m = StreamDeviceEventsRequest{} }
+func (m *StreamDeviceEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*StreamDeviceEventsRequest) ProtoMessage()               {}
+func (*StreamDeviceEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *StreamDeviceEventsRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type ListDeviceAlertsRequest struct {
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,1,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Only return the alerts of this hex encoded DevEUI (optional).
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Only return alerts in this state: OPEN or RESOLVED (optional).
+	State string `protobuf:"bytes,3,opt,name=state" json:"state,omitempty"`
+	// Max number of alerts to return in the result-set.
+	Limit int64 `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+	// Offset of the result-set (for pagination).
+	Offset int64 `protobuf:"varint,5,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListDeviceAlertsRequest) Reset()                    { *m = ListDeviceAlertsRequest{} }
+func (m *ListDeviceAlertsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDeviceAlertsRequest) ProtoMessage()               {}
+func (*ListDeviceAlertsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *ListDeviceAlertsRequest) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *ListDeviceAlertsRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ListDeviceAlertsRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ListDeviceAlertsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceAlertsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListDeviceAlertsResponse struct {
+	// Total number of alerts available within the result-set.
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	// Alerts within this result-set.
+	Result []*DeviceAlert `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListDeviceAlertsResponse) Reset()                    { *m = ListDeviceAlertsResponse{} }
+func (m *ListDeviceAlertsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDeviceAlertsResponse) ProtoMessage()               {}
+func (*ListDeviceAlertsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *ListDeviceAlertsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDeviceAlertsResponse) GetResult() []*DeviceAlert {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeviceAlert struct {
+	// ID of the alert.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Type of the alert: INACTIVE, LOW_BATTERY or LOW_MARGIN.
+	Type string `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`
+	// State of the alert: OPEN or RESOLVED.
+	State string `protobuf:"bytes,4,opt,name=state" json:"state,omitempty"`
+	// Description of the condition which raised the alert.
+	Message string `protobuf:"bytes,5,opt,name=message" json:"message,omitempty"`
+	// Timestamp of when the alert was raised.
+	CreatedAt string `protobuf:"bytes,6,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp of when the alert was resolved (RESOLVED alerts only).
+	ResolvedAt string `protobuf:"bytes,7,opt,name=resolvedAt" json:"resolvedAt,omitempty"`
+}
+
+func (m *DeviceAlert) Reset()                    { *m = DeviceAlert{} }
+func (m *DeviceAlert) String() string            { return proto.CompactTextString(m) }
+func (*DeviceAlert) ProtoMessage()               {}
+func (*DeviceAlert) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *DeviceAlert) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeviceAlert) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *DeviceAlert) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DeviceAlert) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *DeviceAlert) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *DeviceAlert) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *DeviceAlert) GetResolvedAt() string {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return ""
+}
+
+// ImportDevicesRequest is streamed by the client. The applicationID,
+// format, dryRun and partial fields are read from the first message, the
+// data of all the messages is concatenated.
+type ImportDevicesRequest struct {
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,1,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Format of the data (CSV or JSON).
+	Format string `protobuf:"bytes,2,opt,name=format" json:"format,omitempty"`
+	// Chunk of the devices to import. CSV data must start with a header
+	// row, JSON data must be an array of objects. The columns / keys are:
+	// devEUI, name, description, deviceProfileID, appKey, devAddr, appSKey,
+	// nwkSKey, fCntUp, fCntDown, skipFCntCheck and tags.
+	Data string `protobuf:"bytes,3,opt,name=data" json:"data,omitempty"`
+	// Only validate the devices, without importing them.
+	DryRun bool `protobuf:"varint,4,opt,name=dryRun" json:"dryRun,omitempty"`
+	// Import each device separately, a device failing to import does not
+	// abort the import of the other devices. By default, no devices are
+	// imported when one of the devices fails to import.
+	Partial bool `protobuf:"varint,5,opt,name=partial" json:"partial,omitempty"`
+}
+
+func (m *ImportDevicesRequest) Reset()                    { *m = ImportDevicesRequest{} }
+func (m *ImportDevicesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportDevicesRequest) ProtoMessage()               {}
+func (*ImportDevicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *ImportDevicesRequest) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *ImportDevicesRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ImportDevicesRequest) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *ImportDevicesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ImportDevicesRequest) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
+type ImportDevicesResponse struct {
+	// Row number (starting at 1, the CSV header excluded).
+	Row uint32 `protobuf:"varint,1,opt,name=row" json:"row,omitempty"`
+	// Hex encoded DevEUI (when valid).
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Status of the row: VALID (dry-run), IMPORTED, INVALID, FAILED or
+	// SKIPPED (not imported because of an error in an other row).
+	Status string `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+	// Error (when the status is INVALID or FAILED).
+	Error string `protobuf:"bytes,4,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ImportDevicesResponse) Reset()                    { *m = ImportDevicesResponse{} }
+func (m *ImportDevicesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImportDevicesResponse) ProtoMessage()               {}
+func (*ImportDevicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *ImportDevicesResponse) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportDevicesResponse) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ImportDevicesResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ImportDevicesResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ExportDevicesRequest struct {
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,1,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Format of the data (CSV or JSON).
+	Format string `protobuf:"bytes,2,opt,name=format" json:"format,omitempty"`
+}
+
+func (m *ExportDevicesRequest) Reset()                    { *m = ExportDevicesRequest{} }
+func (m *ExportDevicesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportDevicesRequest) ProtoMessage()               {}
+func (*ExportDevicesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *ExportDevicesRequest) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *ExportDevicesRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type ExportDevicesResponse struct {
+	// Chunk of the exported data. The concatenated chunks form the complete
+	// CSV or JSON document.
+	Data string `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
+}
+
+func (m *ExportDevicesResponse) Reset()                    { *m = ExportDevicesResponse{} }
+func (m *ExportDevicesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportDevicesResponse) ProtoMessage()               {}
+func (*ExportDevicesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *ExportDevicesResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DeviceKeys)(nil), "api.DeviceKeys")
 	proto.RegisterType((*CreateDeviceRequest)(nil), "api.CreateDeviceRequest")
 	proto.RegisterType((*CreateDeviceResponse)(nil), "api.CreateDeviceResponse")
 	proto.RegisterType((*GetDeviceRequest)(nil), "api.GetDeviceRequest")
 	proto.RegisterType((*GetDeviceResponse)(nil), "api.GetDeviceResponse")
+	proto.RegisterType((*DeviceLocation)(nil), "api.DeviceLocation")
 	proto.RegisterType((*DeleteDeviceRequest)(nil), "api.DeleteDeviceRequest")
 	proto.RegisterType((*DeleteDeviceResponse)(nil), "api.DeleteDeviceResponse")
 	proto.RegisterType((*ListDeviceByApplicationIDRequest)(nil), "api.ListDeviceByApplicationIDRequest")
@@ -1294,6 +1977,17 @@ func init() {
 	proto.RegisterType((*DataRate)(nil), "api.DataRate")
 	proto.RegisterType((*RXInfo)(nil), "api.RXInfo")
 	proto.RegisterType((*TXInfo)(nil), "api.TXInfo")
+	proto.RegisterType((*ListDeviceEventsRequest)(nil), "api.ListDeviceEventsRequest")
+	proto.RegisterType((*ListDeviceEventsResponse)(nil), "api.ListDeviceEventsResponse")
+	proto.RegisterType((*DeviceEvent)(nil), "api.DeviceEvent")
+	proto.RegisterType((*StreamDeviceEventsRequest)(nil), "api.StreamDeviceEventsRequest")
+	proto.RegisterType((*ListDeviceAlertsRequest)(nil), "api.ListDeviceAlertsRequest")
+	proto.RegisterType((*ListDeviceAlertsResponse)(nil), "api.ListDeviceAlertsResponse")
+	proto.RegisterType((*DeviceAlert)(nil), "api.DeviceAlert")
+	proto.RegisterType((*ImportDevicesRequest)(nil), "api.ImportDevicesRequest")
+	proto.RegisterType((*ImportDevicesResponse)(nil), "api.ImportDevicesResponse")
+	proto.RegisterType((*ExportDevicesRequest)(nil), "api.ExportDevicesRequest")
+	proto.RegisterType((*ExportDevicesResponse)(nil), "api.ExportDevicesResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1333,6 +2027,16 @@ type DeviceClient interface {
 	GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error)
 	// GetFrameLogs returns the uplink / downlink frame log for the given DevEUI.
 	GetFrameLogs(ctx context.Context, in *GetFrameLogsRequest, opts ...grpc.CallOption) (*GetFrameLogsResponse, error)
+	// ListEvents returns the stored events (uplinks, joins, acks, errors and alerts) for the given DevEUI, sorted by the most recent event first.
+	ListEvents(ctx context.Context, in *ListDeviceEventsRequest, opts ...grpc.CallOption) (*ListDeviceEventsResponse, error)
+	// StreamEvents streams the events (uplinks, joins, acks, errors and alerts) for the given DevEUI as they are received.
+	StreamEvents(ctx context.Context, in *StreamDeviceEventsRequest, opts ...grpc.CallOption) (Device_StreamEventsClient, error)
+	// ListAlerts returns the alerts (inactive, low battery and low margin) raised for the devices of the given application, sorted by the most recent alert first.
+	ListAlerts(ctx context.Context, in *ListDeviceAlertsRequest, opts ...grpc.CallOption) (*ListDeviceAlertsResponse, error)
+	// ImportDevices imports the given devices (CSV or JSON) into the application, including their keys (OTAA) or activation (ABP). The data can be streamed in chunks. All rows are validated first and the import is only performed when all rows are valid. Unless partial is set, the devices are imported all-or-nothing. A result is returned for every row.
+	ImportDevices(ctx context.Context, opts ...grpc.CallOption) (Device_ImportDevicesClient, error)
+	// ExportDevices exports the devices of the given application (CSV or JSON), including their keys (OTAA) or activation (ABP).
+	ExportDevices(ctx context.Context, in *ExportDevicesRequest, opts ...grpc.CallOption) (Device_ExportDevicesClient, error)
 }
 
 type deviceClient struct {
@@ -1460,6 +2164,119 @@ func (c *deviceClient) GetFrameLogs(ctx context.Context, in *GetFrameLogsRequest
 	return out, nil
 }
 
+func (c *deviceClient) ListEvents(ctx context.Context, in *ListDeviceEventsRequest, opts ...grpc.CallOption) (*ListDeviceEventsResponse, error) {
+	out := new(ListDeviceEventsResponse)
+	err := grpc.Invoke(ctx, "/api.Device/ListEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) StreamEvents(ctx context.Context, in *StreamDeviceEventsRequest, opts ...grpc.CallOption) (Device_StreamEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Device_serviceDesc.Streams[0], c.cc, "/api.Device/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Device_StreamEventsClient interface {
+	Recv() (*DeviceEvent, error)
+	grpc.ClientStream
+}
+
+type deviceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceStreamEventsClient) Recv() (*DeviceEvent, error) {
+	m := new(DeviceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceClient) ListAlerts(ctx context.Context, in *ListDeviceAlertsRequest, opts ...grpc.CallOption) (*ListDeviceAlertsResponse, error) {
+	out := new(ListDeviceAlertsResponse)
+	err := grpc.Invoke(ctx, "/api.Device/ListAlerts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceClient) ImportDevices(ctx context.Context, opts ...grpc.CallOption) (Device_ImportDevicesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Device_serviceDesc.Streams[1], c.cc, "/api.Device/ImportDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceImportDevicesClient{stream}
+	return x, nil
+}
+
+type Device_ImportDevicesClient interface {
+	Send(*ImportDevicesRequest) error
+	Recv() (*ImportDevicesResponse, error)
+	grpc.ClientStream
+}
+
+type deviceImportDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceImportDevicesClient) Send(m *ImportDevicesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deviceImportDevicesClient) Recv() (*ImportDevicesResponse, error) {
+	m := new(ImportDevicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceClient) ExportDevices(ctx context.Context, in *ExportDevicesRequest, opts ...grpc.CallOption) (Device_ExportDevicesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Device_serviceDesc.Streams[2], c.cc, "/api.Device/ExportDevices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceExportDevicesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Device_ExportDevicesClient interface {
+	Recv() (*ExportDevicesResponse, error)
+	grpc.ClientStream
+}
+
+type deviceExportDevicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceExportDevicesClient) Recv() (*ExportDevicesResponse, error) {
+	m := new(ExportDevicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Device service
 
 type DeviceServer interface {
@@ -1489,6 +2306,16 @@ type DeviceServer interface {
 	GetRandomDevAddr(context.Context, *GetRandomDevAddrRequest) (*GetRandomDevAddrResponse, error)
 	// GetFrameLogs returns the uplink / downlink frame log for the given DevEUI.
 	GetFrameLogs(context.Context, *GetFrameLogsRequest) (*GetFrameLogsResponse, error)
+	// ListEvents returns the stored events (uplinks, joins, acks, errors and alerts) for the given DevEUI, sorted by the most recent event first.
+	ListEvents(context.Context, *ListDeviceEventsRequest) (*ListDeviceEventsResponse, error)
+	// StreamEvents streams the events (uplinks, joins, acks, errors and alerts) for the given DevEUI as they are received.
+	StreamEvents(*StreamDeviceEventsRequest, Device_StreamEventsServer) error
+	// ListAlerts returns the alerts (inactive, low battery and low margin) raised for the devices of the given application, sorted by the most recent alert first.
+	ListAlerts(context.Context, *ListDeviceAlertsRequest) (*ListDeviceAlertsResponse, error)
+	// ImportDevices imports the given devices (CSV or JSON) into the application, including their keys (OTAA) or activation (ABP). The data can be streamed in chunks. All rows are validated first and the import is only performed when all rows are valid. Unless partial is set, the devices are imported all-or-nothing. A result is returned for every row.
+	ImportDevices(Device_ImportDevicesServer) error
+	// ExportDevices exports the devices of the given application (CSV or JSON), including their keys (OTAA) or activation (ABP).
+	ExportDevices(*ExportDevicesRequest, Device_ExportDevicesServer) error
 }
 
 func RegisterDeviceServer(s *grpc.Server, srv DeviceServer) {
@@ -1729,6 +2556,110 @@ func _Device_GetFrameLogs_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Device_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Device/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).ListEvents(ctx, req.(*ListDeviceEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDeviceEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceServer).StreamEvents(m, &deviceStreamEventsServer{stream})
+}
+
+type Device_StreamEventsServer interface {
+	Send(*DeviceEvent) error
+	grpc.ServerStream
+}
+
+type deviceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceStreamEventsServer) Send(m *DeviceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Device_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServer).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Device/ListAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServer).ListAlerts(ctx, req.(*ListDeviceAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Device_ImportDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeviceServer).ImportDevices(&deviceImportDevicesServer{stream})
+}
+
+type Device_ImportDevicesServer interface {
+	Send(*ImportDevicesResponse) error
+	Recv() (*ImportDevicesRequest, error)
+	grpc.ServerStream
+}
+
+type deviceImportDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceImportDevicesServer) Send(m *ImportDevicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deviceImportDevicesServer) Recv() (*ImportDevicesRequest, error) {
+	m := new(ImportDevicesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Device_ExportDevices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDevicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceServer).ExportDevices(m, &deviceExportDevicesServer{stream})
+}
+
+type Device_ExportDevicesServer interface {
+	Send(*ExportDevicesResponse) error
+	grpc.ServerStream
+}
+
+type deviceExportDevicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceExportDevicesServer) Send(m *ExportDevicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Device_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Device",
 	HandlerType: (*DeviceServer)(nil),
@@ -1785,104 +2716,171 @@ var _Device_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFrameLogs",
 			Handler:    _Device_GetFrameLogs_Handler,
 		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _Device_ListEvents_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _Device_ListAlerts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _Device_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportDevices",
+			Handler:       _Device_ImportDevices_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportDevices",
+			Handler:       _Device_ExportDevices_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "device.proto",
 }
 
 func init() { proto.RegisterFile("device.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x72, 0x1b, 0x45,
-	0x10, 0xae, 0x95, 0x6c, 0x59, 0x6e, 0x5b, 0xf9, 0x19, 0xd9, 0xd6, 0x66, 0xe3, 0x38, 0xaa, 0xcd,
-	0x4f, 0xc9, 0x0e, 0xd8, 0x24, 0xe4, 0xc4, 0xcd, 0xb1, 0x88, 0x31, 0x09, 0x21, 0xb5, 0x22, 0x40,
-	0x15, 0x50, 0xd4, 0x78, 0x77, 0x64, 0x6f, 0x79, 0xff, 0xd8, 0x1d, 0x3b, 0xa8, 0x42, 0x8a, 0x2a,
-	0x0e, 0x14, 0x27, 0x0e, 0xf0, 0x02, 0xbc, 0x43, 0x9e, 0x81, 0x27, 0xa0, 0x28, 0x6e, 0x9c, 0x78,
-	0x09, 0x6e, 0xd4, 0xf4, 0xcc, 0x4a, 0xbb, 0xd2, 0xae, 0x25, 0x0e, 0x54, 0x85, 0x93, 0x35, 0x3d,
-	0xbd, 0xfd, 0xf5, 0xd7, 0xdd, 0xd3, 0xd3, 0x63, 0x58, 0x76, 0xd8, 0x99, 0x6b, 0xb3, 0xed, 0x28,
-	0x0e, 0x79, 0x48, 0xaa, 0x34, 0x72, 0x8d, 0xf5, 0xa3, 0x30, 0x3c, 0xf2, 0xd8, 0x0e, 0x8d, 0xdc,
-	0x1d, 0x1a, 0x04, 0x21, 0xa7, 0xdc, 0x0d, 0x83, 0x44, 0xaa, 0x98, 0x37, 0x01, 0xba, 0xf8, 0xc9,
-	0x23, 0x36, 0x48, 0xc8, 0x1a, 0xd4, 0x68, 0x14, 0x3d, 0x62, 0x03, 0x5d, 0x6b, 0x6b, 0x9d, 0x45,
-	0x4b, 0xad, 0xcc, 0x57, 0x1a, 0x34, 0xf7, 0x62, 0x46, 0x39, 0x93, 0xca, 0x16, 0xfb, 0xea, 0x94,
-	0x25, 0x5c, 0xe8, 0x3b, 0xec, 0xec, 0xdd, 0x67, 0x07, 0xa9, 0xbe, 0x5c, 0x11, 0x02, 0x73, 0x01,
-	0xf5, 0x99, 0xbe, 0x88, 0x52, 0xfc, 0x4d, 0x6e, 0x42, 0x83, 0x46, 0x91, 0xe7, 0xda, 0x88, 0x7f,
-	0xd0, 0xd5, 0x1b, 0x6d, 0xad, 0x53, 0xb5, 0xf2, 0x42, 0xd2, 0x86, 0x25, 0x87, 0x25, 0x76, 0xec,
-	0x46, 0x42, 0xa0, 0x5f, 0x40, 0x03, 0x59, 0x11, 0xe9, 0xc0, 0x45, 0x49, 0xf2, 0x69, 0x1c, 0xf6,
-	0x5d, 0x8f, 0x1d, 0x74, 0x75, 0x82, 0x5a, 0xe3, 0x62, 0x73, 0x0d, 0x56, 0xf2, 0x4e, 0x27, 0x51,
-	0x18, 0x24, 0xcc, 0xdc, 0x82, 0x4b, 0xfb, 0x8c, 0xcf, 0xc4, 0xc4, 0x7c, 0x55, 0x81, 0xcb, 0x19,
-	0x65, 0x69, 0xe1, 0xf5, 0xe6, 0x4d, 0xde, 0x82, 0xa6, 0x14, 0xf5, 0x38, 0xe5, 0xa7, 0xc9, 0x03,
-	0xca, 0x39, 0x8b, 0x07, 0x7a, 0xb3, 0xad, 0x75, 0x1a, 0x56, 0xd1, 0x16, 0xd9, 0x06, 0x92, 0x15,
-	0x7f, 0x40, 0xe3, 0x23, 0x37, 0xd0, 0x57, 0xda, 0x5a, 0x67, 0xde, 0x2a, 0xd8, 0x21, 0x1b, 0x00,
-	0x1e, 0x4d, 0x78, 0x8f, 0xb1, 0x60, 0x97, 0xeb, 0xab, 0xe8, 0x46, 0x46, 0x62, 0xbe, 0x09, 0xcd,
-	0x2e, 0xf3, 0xd8, 0x8c, 0xe5, 0x22, 0x12, 0x95, 0x57, 0x57, 0x89, 0xfa, 0x51, 0x83, 0xf6, 0x63,
-	0x37, 0x51, 0xd1, 0x7f, 0x30, 0xd8, 0xcd, 0x86, 0x2c, 0x35, 0x3a, 0x11, 0xdf, 0x6a, 0x51, 0x7c,
-	0x57, 0x60, 0xde, 0x73, 0x7d, 0x97, 0x23, 0x72, 0xd5, 0x92, 0x0b, 0xe1, 0x50, 0xd8, 0xef, 0x27,
-	0x8c, 0xeb, 0x15, 0x14, 0xab, 0x95, 0x90, 0x27, 0x8c, 0xc6, 0xf6, 0xb1, 0x3e, 0x27, 0x1d, 0x95,
-	0x2b, 0xf3, 0xcf, 0x0a, 0x5c, 0x90, 0xce, 0x08, 0xb7, 0x0e, 0x38, 0xf3, 0x5f, 0xf3, 0x52, 0x78,
-	0x03, 0x2e, 0xe7, 0x44, 0x4f, 0x84, 0x4b, 0x4d, 0xd4, 0x9d, 0xdc, 0x28, 0x2b, 0x9c, 0x95, 0x7f,
-	0x5b, 0x38, 0xab, 0x33, 0x16, 0xce, 0xda, 0x44, 0xe1, 0x50, 0x20, 0xa3, 0x84, 0x0f, 0x8f, 0xdb,
-	0x06, 0x00, 0x0f, 0x39, 0xf5, 0xf6, 0xc2, 0xd3, 0x20, 0xcd, 0x60, 0x46, 0x42, 0xee, 0x40, 0x2d,
-	0x66, 0xc9, 0xa9, 0x27, 0xd2, 0x58, 0xed, 0x2c, 0xdd, 0x6b, 0x6e, 0xd3, 0xc8, 0xdd, 0xce, 0x27,
-	0xca, 0x52, 0x2a, 0xd8, 0xcb, 0x9e, 0x45, 0xce, 0xff, 0xaf, 0x97, 0xe5, 0x9d, 0x56, 0x47, 0xe4,
-	0x10, 0x5a, 0xd9, 0x1e, 0x27, 0xba, 0xf8, 0x34, 0x42, 0x3b, 0x00, 0xce, 0x50, 0x19, 0x0b, 0x7f,
-	0xe9, 0xde, 0xc5, 0x4c, 0xc4, 0xd0, 0x46, 0x46, 0xc5, 0x34, 0x40, 0x9f, 0xc4, 0x50, 0xf8, 0xdb,
-	0xb0, 0x32, 0x6c, 0x8f, 0x33, 0x80, 0x9b, 0xef, 0xc1, 0xea, 0x98, 0xbe, 0xca, 0x71, 0xde, 0x2b,
-	0x6d, 0xba, 0x57, 0x87, 0xd0, 0xca, 0x46, 0xe4, 0xbf, 0x62, 0x3e, 0x89, 0xa1, 0x98, 0xdf, 0x85,
-	0x56, 0xb6, 0x69, 0xcd, 0x42, 0xde, 0x00, 0x7d, 0xf2, 0x13, 0x65, 0xee, 0x0f, 0x0d, 0x56, 0x77,
-	0x6d, 0xee, 0x9e, 0xcd, 0x5c, 0x98, 0x3a, 0x2c, 0x38, 0xec, 0x6c, 0xd7, 0x71, 0x62, 0xa4, 0xb2,
-	0x68, 0xa5, 0x4b, 0xb1, 0x43, 0xa3, 0xa8, 0x27, 0xee, 0xf1, 0xaa, 0xdc, 0x51, 0x4b, 0xb1, 0x13,
-	0x3c, 0x3f, 0xc1, 0x1d, 0xd9, 0xd9, 0xd2, 0xa5, 0x40, 0xe9, 0xef, 0x05, 0xfc, 0x59, 0xa4, 0xcf,
-	0xe3, 0x71, 0x57, 0x2b, 0x62, 0x40, 0x5d, 0xfc, 0xea, 0x86, 0xcf, 0x03, 0xbd, 0x86, 0x3b, 0xc3,
-	0xb5, 0x38, 0x06, 0xc9, 0x89, 0x1b, 0x3d, 0xdc, 0x0b, 0xf8, 0xde, 0x31, 0xb3, 0x4f, 0xf4, 0x85,
-	0xb6, 0xd6, 0xa9, 0x5b, 0x79, 0xa1, 0xa9, 0xc3, 0xda, 0x38, 0x31, 0xc5, 0xf9, 0x3e, 0x18, 0xc3,
-	0x62, 0x50, 0x2a, 0x6e, 0x18, 0x4c, 0x8b, 0xe2, 0xaf, 0x1a, 0x5c, 0x2d, 0xfc, 0x4c, 0x55, 0x52,
-	0x26, 0x2e, 0x5a, 0x69, 0x5c, 0x2a, 0xa5, 0x71, 0xa9, 0x96, 0xc5, 0x65, 0xae, 0x34, 0x2e, 0xf3,
-	0xd3, 0xe2, 0x52, 0x2b, 0x8a, 0xcb, 0x5d, 0x68, 0xed, 0x33, 0x6e, 0xd1, 0xc0, 0x09, 0xfd, 0xae,
-	0xf4, 0x70, 0x1a, 0xf5, 0xfb, 0xa0, 0x4f, 0x7e, 0x32, 0x8d, 0xb6, 0xf9, 0x19, 0x34, 0xf7, 0x19,
-	0x7f, 0x18, 0x53, 0x9f, 0x3d, 0x0e, 0x8f, 0xa6, 0x9e, 0x92, 0xe1, 0x55, 0x59, 0x29, 0xbe, 0x2a,
-	0xab, 0xd9, 0xab, 0xd2, 0xfc, 0x02, 0x1b, 0x40, 0xc6, 0x78, 0x69, 0xcf, 0x9e, 0xcf, 0xf5, 0xec,
-	0x5b, 0x63, 0x3d, 0xbb, 0x81, 0xe7, 0x30, 0xb5, 0x33, 0xec, 0xd6, 0xbf, 0x68, 0x50, 0x4f, 0x85,
-	0x64, 0x1d, 0x16, 0x6d, 0x6c, 0x44, 0xce, 0x2e, 0x57, 0x4e, 0x8f, 0x04, 0x64, 0x13, 0x16, 0xe3,
-	0xaf, 0x0f, 0x82, 0x7e, 0xd8, 0x63, 0xa9, 0xd1, 0x25, 0x34, 0x6a, 0x7d, 0x2a, 0xa4, 0xd6, 0x68,
-	0x97, 0xdc, 0x80, 0x1a, 0xc7, 0x05, 0x92, 0x49, 0xf5, 0x3e, 0x92, 0x7a, 0x6a, 0x8b, 0xdc, 0x86,
-	0x0b, 0xd1, 0xf1, 0xe0, 0x29, 0x1d, 0x78, 0x21, 0x75, 0xde, 0xef, 0x7d, 0xf8, 0x44, 0x1d, 0x99,
-	0x31, 0xa9, 0xf9, 0xbd, 0x06, 0xf5, 0x2e, 0xe5, 0xd4, 0xa2, 0x1c, 0x69, 0xfb, 0xa1, 0x73, 0xea,
-	0x61, 0x49, 0x2a, 0x1f, 0x33, 0x12, 0x41, 0xe1, 0x90, 0x06, 0xce, 0x27, 0xae, 0xc3, 0x8f, 0x31,
-	0xc0, 0x0d, 0x6b, 0x24, 0x20, 0x26, 0x2c, 0x27, 0x51, 0xcc, 0xa8, 0xf3, 0x90, 0xda, 0x3c, 0x8c,
-	0xd1, 0xbb, 0x86, 0x95, 0x93, 0x89, 0x3c, 0x1f, 0xba, 0x3c, 0xa6, 0x9c, 0xa9, 0x8a, 0x4c, 0x97,
-	0xe6, 0xdf, 0x1a, 0xd4, 0x24, 0x57, 0xa1, 0x64, 0x1f, 0xd3, 0x20, 0x60, 0x9e, 0x0a, 0x7d, 0xba,
-	0x14, 0x75, 0x6b, 0x87, 0x0e, 0x13, 0xce, 0xaa, 0x43, 0x30, 0x5c, 0x0b, 0xe7, 0xfa, 0xb1, 0xa8,
-	0x8e, 0xc0, 0x1e, 0xa8, 0x34, 0x8f, 0x04, 0xc2, 0xa6, 0x17, 0x5a, 0xb4, 0xf7, 0xc4, 0x42, 0x60,
-	0xcd, 0x4a, 0x97, 0xe2, 0x8a, 0x8c, 0x93, 0xc4, 0xc5, 0x73, 0x30, 0x6f, 0xe1, 0x6f, 0x21, 0xe3,
-	0xae, 0xcf, 0xb0, 0xf4, 0x17, 0x2d, 0xfc, 0x2d, 0xec, 0x8b, 0xbf, 0x09, 0xa7, 0x7e, 0x84, 0xbd,
-	0xa2, 0x61, 0x8d, 0x04, 0x64, 0x13, 0xea, 0x8e, 0x0a, 0xa3, 0x5e, 0xc7, 0xb4, 0xc8, 0x9a, 0x48,
-	0x63, 0x6b, 0x0d, 0xb7, 0xc9, 0x25, 0xa8, 0xfa, 0xd4, 0x56, 0x57, 0xb2, 0xf8, 0x69, 0xfe, 0xae,
-	0x41, 0x4d, 0xe6, 0x2f, 0xc7, 0x50, 0x3b, 0x8f, 0x61, 0x65, 0x9c, 0x61, 0x1b, 0x96, 0x5c, 0xdf,
-	0x67, 0x8e, 0x4b, 0x39, 0xf3, 0x64, 0x04, 0xea, 0x56, 0x56, 0x94, 0x02, 0xcf, 0x0d, 0x81, 0xc5,
-	0x69, 0x89, 0xc2, 0xe7, 0x2c, 0x56, 0xe4, 0xe5, 0x22, 0xcf, 0xb4, 0x76, 0x1e, 0xd3, 0x85, 0x73,
-	0x99, 0xde, 0xfb, 0x69, 0x09, 0x6a, 0xb2, 0xd3, 0x91, 0x8f, 0xa1, 0x26, 0xaf, 0x61, 0xa2, 0xa3,
-	0x76, 0xc1, 0x83, 0xcc, 0xb8, 0x52, 0xb0, 0xa3, 0x9a, 0x6d, 0xeb, 0xbb, 0xdf, 0xfe, 0xfa, 0xb9,
-	0x72, 0xd9, 0x5c, 0xc6, 0x97, 0xa0, 0xbc, 0xe4, 0x92, 0x77, 0xb4, 0x2d, 0xd2, 0x83, 0xea, 0x3e,
-	0xe3, 0x64, 0x15, 0x3f, 0x1d, 0x7f, 0x18, 0x19, 0x6b, 0xe3, 0x62, 0x65, 0xee, 0x1a, 0x9a, 0x6b,
-	0x91, 0xd5, 0xac, 0xb9, 0x9d, 0x17, 0xb2, 0x87, 0xbc, 0x24, 0x9f, 0x0b, 0xb7, 0xc5, 0x55, 0xa7,
-	0x9c, 0x2d, 0x78, 0x0e, 0x28, 0x67, 0x0b, 0x27, 0x7f, 0x65, 0x7d, 0xab, 0xc4, 0xfa, 0x0f, 0x1a,
-	0x34, 0xc5, 0x60, 0x37, 0xf6, 0x24, 0x20, 0xb7, 0xd0, 0xe2, 0xb4, 0x27, 0x83, 0xd1, 0x1a, 0x53,
-	0x1b, 0xdd, 0xe9, 0x08, 0x7b, 0x87, 0x6c, 0xca, 0xd7, 0xf2, 0xe8, 0xdb, 0x64, 0xe7, 0x45, 0x6e,
-	0xb6, 0x7b, 0x99, 0xfa, 0x44, 0xbe, 0x84, 0x9a, 0x1c, 0x11, 0x14, 0xd1, 0x82, 0xd1, 0x52, 0x11,
-	0x2d, 0x9c, 0xdf, 0xda, 0x88, 0x68, 0x18, 0xc5, 0x44, 0x45, 0x7a, 0x22, 0x00, 0x99, 0x4f, 0x7c,
-	0xa1, 0xaf, 0x4f, 0x24, 0x38, 0x33, 0x78, 0x18, 0xd7, 0x4a, 0x76, 0x15, 0xd8, 0x2d, 0x04, 0xbb,
-	0x6e, 0x1a, 0x85, 0x60, 0x3b, 0x27, 0x6c, 0x80, 0x05, 0xe1, 0xc0, 0xc2, 0x3e, 0xe3, 0x08, 0x77,
-	0x25, 0x9f, 0xfd, 0x2c, 0x96, 0x51, 0xb4, 0xa5, 0x80, 0x4c, 0x04, 0x5a, 0x27, 0xe7, 0x00, 0x09,
-	0x5e, 0x32, 0x22, 0x19, 0x5e, 0x25, 0x03, 0x9d, 0xe2, 0x55, 0x3a, 0x8a, 0x29, 0x5e, 0xc6, 0x14,
-	0x5e, 0x3e, 0x80, 0x2c, 0xb6, 0x0c, 0x62, 0xc9, 0x08, 0xa7, 0x10, 0x4b, 0xa7, 0x35, 0x45, 0x70,
-	0xeb, 0x3c, 0x82, 0x01, 0xd4, 0xd3, 0xb9, 0x87, 0xc8, 0x60, 0x15, 0xce, 0x77, 0xc6, 0xd5, 0xc2,
-	0x3d, 0x05, 0xb4, 0x89, 0x40, 0x37, 0xcc, 0x8d, 0x62, 0x20, 0xaa, 0xbe, 0x12, 0xf4, 0xbe, 0x81,
-	0xc6, 0x3e, 0xe3, 0xa3, 0x81, 0x88, 0x5c, 0xcf, 0x67, 0x68, 0x62, 0xc2, 0x32, 0xda, 0xe5, 0x0a,
-	0x0a, 0xbe, 0x83, 0xf0, 0x26, 0x69, 0x9f, 0x0b, 0x2f, 0xc0, 0xbe, 0xc5, 0x7f, 0xaa, 0xe4, 0x46,
-	0x13, 0x15, 0xe2, 0x92, 0x21, 0x47, 0x85, 0xb8, 0x6c, 0x9e, 0x31, 0xb7, 0x11, 0xba, 0x63, 0xde,
-	0x2e, 0x86, 0x3e, 0x1a, 0x07, 0x73, 0x61, 0x39, 0x3b, 0x88, 0xa8, 0xe3, 0x58, 0x30, 0xf8, 0x18,
-	0x57, 0x0a, 0x76, 0x14, 0xe8, 0x4d, 0x04, 0xdd, 0x20, 0xeb, 0xc5, 0xa0, 0x7d, 0xf1, 0x41, 0x72,
-	0x58, 0xc3, 0xff, 0x9d, 0xbd, 0xfd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xed, 0x24, 0x44, 0xfc,
-	0x6e, 0x13, 0x00, 0x00,
+	// 2114 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x41, 0x6f, 0xe4, 0x48,
+	0x15, 0x96, 0xbb, 0x93, 0x4e, 0xe7, 0x25, 0x3d, 0x93, 0xa9, 0x4e, 0xd2, 0x8e, 0x37, 0xc9, 0xb6,
+	0x3c, 0x33, 0xab, 0x9e, 0x0c, 0x24, 0x99, 0xec, 0x08, 0x56, 0x73, 0xcb, 0x26, 0x99, 0x10, 0x76,
+	0x18, 0x46, 0xce, 0x0c, 0x20, 0x01, 0x42, 0x95, 0x76, 0xa5, 0x63, 0xe2, 0xb6, 0x8d, 0x5d, 0x9d,
+	0x49, 0x6b, 0x58, 0x21, 0xed, 0x01, 0xb8, 0x73, 0x83, 0x0b, 0x07, 0x7e, 0x00, 0x37, 0xb8, 0x72,
+	0xe0, 0xc2, 0x75, 0x85, 0xb8, 0x23, 0xfe, 0x04, 0x37, 0x54, 0xaf, 0xca, 0x6e, 0xdb, 0x6d, 0xa7,
+	0x7b, 0x50, 0x90, 0x96, 0x53, 0xfa, 0xbd, 0x57, 0xf5, 0xbe, 0xfa, 0xde, 0x7b, 0x55, 0xf5, 0xca,
+	0x81, 0x45, 0x9b, 0x5d, 0x39, 0x5d, 0xb6, 0x1d, 0x84, 0x3e, 0xf7, 0x49, 0x95, 0x06, 0x8e, 0xb1,
+	0xde, 0xf3, 0xfd, 0x9e, 0xcb, 0x76, 0x68, 0xe0, 0xec, 0x50, 0xcf, 0xf3, 0x39, 0xe5, 0x8e, 0xef,
+	0x45, 0x72, 0x88, 0xf9, 0x00, 0xe0, 0x10, 0xa7, 0x7c, 0xc6, 0x86, 0x11, 0x59, 0x85, 0x1a, 0x0d,
+	0x82, 0xcf, 0xd8, 0x50, 0xd7, 0xda, 0x5a, 0x67, 0xde, 0x52, 0x92, 0xf9, 0x87, 0x0a, 0x34, 0x0f,
+	0x42, 0x46, 0x39, 0x93, 0x83, 0x2d, 0xf6, 0xb3, 0x01, 0x8b, 0xb8, 0x18, 0x6f, 0xb3, 0xab, 0xa3,
+	0x37, 0x27, 0xf1, 0x78, 0x29, 0x11, 0x02, 0x33, 0x1e, 0xed, 0x33, 0x7d, 0x1e, 0xb5, 0xf8, 0x9b,
+	0x3c, 0x80, 0x06, 0x0d, 0x02, 0xd7, 0xe9, 0x22, 0xfe, 0xc9, 0xa1, 0xde, 0x68, 0x6b, 0x9d, 0xaa,
+	0x95, 0x55, 0x92, 0x36, 0x2c, 0xd8, 0x2c, 0xea, 0x86, 0x4e, 0x20, 0x14, 0xfa, 0x1d, 0x74, 0x90,
+	0x56, 0x91, 0x0e, 0xdc, 0x95, 0x24, 0x5f, 0x85, 0xfe, 0xb9, 0xe3, 0xb2, 0x93, 0x43, 0x9d, 0xe0,
+	0xa8, 0xbc, 0x9a, 0x7c, 0x03, 0x66, 0x38, 0xed, 0x45, 0x7a, 0xb3, 0x5d, 0xed, 0x2c, 0xec, 0x99,
+	0xdb, 0x34, 0x70, 0xb6, 0x0b, 0x58, 0x6c, 0xbf, 0xa6, 0xbd, 0xe8, 0xc8, 0xe3, 0xe1, 0xd0, 0xc2,
+	0xf1, 0xc6, 0x37, 0x61, 0x3e, 0x51, 0x91, 0x25, 0xa8, 0x5e, 0x26, 0xf1, 0x10, 0x3f, 0xc9, 0x32,
+	0xcc, 0x5e, 0x51, 0x77, 0xc0, 0xf4, 0x0a, 0xea, 0xa4, 0xf0, 0xac, 0xf2, 0x89, 0x66, 0xae, 0xc2,
+	0x72, 0xd6, 0x7f, 0x14, 0xf8, 0x5e, 0xc4, 0xcc, 0x2d, 0x58, 0x3a, 0x66, 0x7c, 0xaa, 0xd0, 0x99,
+	0x5f, 0x56, 0xe1, 0x5e, 0x6a, 0xb0, 0xf4, 0xf0, 0x15, 0x0f, 0xf4, 0x2e, 0x34, 0xa5, 0xea, 0x94,
+	0x53, 0x3e, 0x88, 0x3e, 0xa5, 0x9c, 0xb3, 0x70, 0xa8, 0x37, 0xdb, 0x5a, 0xa7, 0x61, 0x15, 0x99,
+	0xc8, 0x36, 0x90, 0xb4, 0xfa, 0x3b, 0x34, 0xec, 0x39, 0x9e, 0xbe, 0xdc, 0xd6, 0x3a, 0xb3, 0x56,
+	0x81, 0x85, 0x6c, 0x02, 0xb8, 0x34, 0xe2, 0xa7, 0x8c, 0x79, 0xfb, 0x5c, 0x5f, 0xc1, 0x65, 0xa4,
+	0x34, 0xe4, 0xa9, 0x4a, 0xf5, 0x2a, 0xa6, 0xba, 0x8d, 0xa9, 0x1e, 0x8b, 0x62, 0x3e, 0xd1, 0x64,
+	0x07, 0xea, 0xae, 0x2f, 0x23, 0xa2, 0xb7, 0xda, 0x5a, 0x67, 0x61, 0xaf, 0x89, 0x33, 0xe5, 0xb4,
+	0x17, 0xca, 0x64, 0x25, 0x83, 0xfe, 0xfb, 0xca, 0xf8, 0xb3, 0x06, 0x77, 0xb2, 0x5e, 0x89, 0x01,
+	0x75, 0x97, 0x72, 0x87, 0x0f, 0x6c, 0x86, 0x3e, 0x34, 0x2b, 0x91, 0xc9, 0x3a, 0xcc, 0xbb, 0xbe,
+	0xd7, 0x93, 0xc6, 0x0a, 0x1a, 0x47, 0x0a, 0x31, 0x93, 0xba, 0x6a, 0x66, 0x55, 0xce, 0x8c, 0x65,
+	0x51, 0x28, 0x91, 0x3f, 0x08, 0xbb, 0x4c, 0x9f, 0x91, 0x85, 0x22, 0x25, 0x9c, 0xd3, 0xed, 0x0e,
+	0x42, 0xda, 0x1d, 0xea, 0xb3, 0x6a, 0x8e, 0x92, 0x05, 0xda, 0x20, 0xb0, 0x29, 0x67, 0xf6, 0x3e,
+	0xd7, 0x6b, 0x38, 0x6d, 0xa4, 0x30, 0xbf, 0x0e, 0xcd, 0x43, 0xe6, 0xb2, 0x29, 0xb7, 0xbe, 0xd8,
+	0x03, 0xd9, 0xe1, 0x6a, 0x0f, 0xfc, 0x51, 0x83, 0xf6, 0x0b, 0x27, 0x52, 0x29, 0xf9, 0x74, 0xb8,
+	0x9f, 0xae, 0xc6, 0xd8, 0xe9, 0x58, 0xe9, 0x56, 0x8b, 0x4a, 0x77, 0x19, 0x66, 0x5d, 0xa7, 0xef,
+	0x70, 0x44, 0xae, 0x5a, 0x52, 0x10, 0x0b, 0xf2, 0xcf, 0xcf, 0x23, 0xc6, 0x31, 0x60, 0x55, 0x4b,
+	0x49, 0x18, 0x11, 0x46, 0xc3, 0xee, 0x45, 0x12, 0x11, 0x94, 0xc4, 0x06, 0xe0, 0xb4, 0x77, 0xca,
+	0x5c, 0xd6, 0xe5, 0x7e, 0x88, 0x41, 0x99, 0xb7, 0xd2, 0x2a, 0xf3, 0x6f, 0xd5, 0x24, 0x69, 0x4e,
+	0xc4, 0x4f, 0x38, 0xeb, 0x7f, 0xc5, 0xf7, 0xe1, 0xd7, 0xe0, 0x5e, 0x46, 0xf5, 0x52, 0x2c, 0xa9,
+	0x89, 0x63, 0xc7, 0x0d, 0x65, 0xbb, 0x76, 0xf9, 0x7d, 0x77, 0xed, 0xca, 0x94, 0xbb, 0x76, 0x75,
+	0x6c, 0xd7, 0x3e, 0x51, 0xbb, 0xb6, 0x85, 0xbb, 0x76, 0x23, 0xbd, 0xf7, 0x54, 0xc0, 0x6f, 0xef,
+	0x6c, 0xa6, 0x40, 0x46, 0xe5, 0x97, 0x9c, 0xab, 0x9b, 0x00, 0xdc, 0xe7, 0xd4, 0x3d, 0xf0, 0x07,
+	0x5e, 0x5c, 0x4f, 0x29, 0x0d, 0x79, 0x0c, 0xb5, 0x90, 0x45, 0x03, 0x57, 0x14, 0x55, 0x35, 0x7f,
+	0x3e, 0xa8, 0x35, 0x5a, 0x6a, 0x08, 0xde, 0x92, 0x6f, 0x70, 0xdf, 0xfc, 0xbf, 0xdf, 0x92, 0x05,
+	0x2c, 0x6e, 0xf5, 0x96, 0xcc, 0xfa, 0x57, 0x27, 0xc4, 0x19, 0xb4, 0xd2, 0xb7, 0xa7, 0x68, 0x48,
+	0x26, 0x45, 0x70, 0x07, 0xc0, 0x4e, 0x06, 0x23, 0xd2, 0xc2, 0xde, 0xdd, 0x54, 0x8a, 0xd0, 0x47,
+	0x6a, 0x88, 0x69, 0x80, 0x3e, 0x8e, 0xa1, 0xf0, 0xb7, 0x61, 0x39, 0xb9, 0x32, 0xa6, 0x00, 0x37,
+	0xbf, 0x05, 0x2b, 0xb9, 0xf1, 0xaa, 0xa8, 0xb2, 0xab, 0xd2, 0x26, 0xaf, 0xea, 0x0c, 0x5a, 0xe9,
+	0x88, 0xfc, 0xaf, 0x98, 0x8f, 0x63, 0x28, 0xe6, 0x4f, 0xa0, 0x95, 0x3e, 0xb3, 0xa7, 0x21, 0x6f,
+	0x80, 0x3e, 0x3e, 0x45, 0xb9, 0xfb, 0x87, 0x06, 0x2b, 0xfb, 0x5d, 0xee, 0x5c, 0x4d, 0xbd, 0x13,
+	0x74, 0x98, 0xb3, 0xd9, 0xd5, 0xbe, 0x6d, 0x87, 0xaa, 0x5c, 0x62, 0x51, 0x58, 0x68, 0x10, 0x9c,
+	0x8a, 0x96, 0xb4, 0x2a, 0x2d, 0x4a, 0x14, 0x16, 0xef, 0xed, 0x25, 0x5a, 0xe4, 0xc1, 0x1e, 0x8b,
+	0x02, 0xe5, 0xfc, 0xc0, 0xe3, 0x6f, 0x02, 0x3c, 0xd4, 0x1b, 0x96, 0x92, 0xc4, 0x1d, 0x28, 0x7e,
+	0x1d, 0xfa, 0x6f, 0x3d, 0xbc, 0xe6, 0x1a, 0x56, 0x22, 0x8b, 0x7d, 0x17, 0x5d, 0x3a, 0xc1, 0xf3,
+	0x03, 0x8f, 0x1f, 0x5c, 0xb0, 0xee, 0xa5, 0x3e, 0xd7, 0xd6, 0x3a, 0x75, 0x2b, 0xab, 0x34, 0x75,
+	0x58, 0xcd, 0x13, 0x53, 0x9c, 0x9f, 0x82, 0x91, 0x14, 0x83, 0x1a, 0x22, 0x5a, 0x87, 0x09, 0x51,
+	0xfc, 0xab, 0x06, 0x1f, 0x14, 0x4e, 0x53, 0x95, 0x94, 0x8a, 0x8b, 0x56, 0x1a, 0x97, 0x4a, 0x69,
+	0x5c, 0xaa, 0x65, 0x71, 0x99, 0x29, 0x8d, 0xcb, 0xec, 0xa4, 0xb8, 0xd4, 0x8a, 0xe2, 0xf2, 0x04,
+	0x5a, 0xc7, 0x8c, 0x5b, 0xd4, 0xb3, 0xfd, 0xfe, 0xa1, 0x5c, 0xe1, 0x24, 0xea, 0x4f, 0x41, 0x1f,
+	0x9f, 0x32, 0x89, 0xb6, 0xf9, 0x43, 0x68, 0x1e, 0x33, 0xfe, 0x3c, 0xa4, 0x7d, 0xf6, 0xc2, 0xef,
+	0x4d, 0xdc, 0x25, 0x49, 0xa7, 0x50, 0x29, 0xee, 0x14, 0xaa, 0xe9, 0x4e, 0xc1, 0xfc, 0x31, 0x2c,
+	0x67, 0x9d, 0x97, 0x5e, 0x12, 0xb3, 0x99, 0x4b, 0xe2, 0x61, 0xee, 0x92, 0x68, 0xe0, 0x3e, 0x8c,
+	0xfd, 0x24, 0xd7, 0xc3, 0xef, 0x35, 0xa8, 0xc7, 0x4a, 0xd1, 0x73, 0x75, 0x43, 0xa6, 0x7a, 0x2e,
+	0xb9, 0xe8, 0x91, 0x82, 0x3c, 0x82, 0xf9, 0xf0, 0xfa, 0xc4, 0x3b, 0xf7, 0x4f, 0x59, 0xec, 0x74,
+	0x01, 0x9d, 0x5a, 0x3f, 0x10, 0x5a, 0x6b, 0x64, 0x25, 0xf7, 0xa1, 0xc6, 0x51, 0x40, 0x32, 0xf1,
+	0xb8, 0xd7, 0x72, 0x9c, 0x32, 0x91, 0x8f, 0xe0, 0x4e, 0x70, 0x31, 0x7c, 0x45, 0x87, 0xae, 0x4f,
+	0xed, 0x6f, 0x9f, 0x7e, 0xf7, 0xa5, 0xda, 0x32, 0x39, 0xad, 0xf9, 0x4b, 0x0d, 0xea, 0x87, 0x94,
+	0x53, 0x8b, 0x72, 0xa4, 0xdd, 0xf7, 0xed, 0x81, 0x2b, 0xfb, 0x63, 0xb9, 0xc6, 0x94, 0x46, 0x50,
+	0x38, 0xa3, 0x9e, 0xfd, 0x7d, 0xc7, 0xe6, 0x17, 0x18, 0xe0, 0x86, 0x35, 0x52, 0x10, 0x13, 0x16,
+	0xa3, 0x20, 0x64, 0xd4, 0x7e, 0x4e, 0xb1, 0xbf, 0xaa, 0xe2, 0x80, 0x8c, 0x4e, 0xe4, 0xf9, 0xcc,
+	0xe1, 0x21, 0xe5, 0x4c, 0x55, 0x64, 0x2c, 0x9a, 0xff, 0xd6, 0xa0, 0x26, 0xb9, 0x8a, 0x41, 0xdd,
+	0x0b, 0xea, 0x79, 0xcc, 0x55, 0xa1, 0x8f, 0x45, 0x51, 0xb7, 0x5d, 0xdf, 0x66, 0x62, 0xb1, 0x6a,
+	0x13, 0x24, 0xb2, 0x58, 0xdc, 0x79, 0x28, 0xaa, 0xc3, 0xeb, 0x0e, 0x55, 0x9a, 0x47, 0x0a, 0xe1,
+	0xd3, 0xf5, 0x2d, 0x7a, 0xfa, 0xd2, 0x42, 0x60, 0xcd, 0x8a, 0x45, 0x71, 0x27, 0x87, 0x51, 0xe4,
+	0xe0, 0x3e, 0x98, 0xb5, 0xf0, 0xb7, 0xd0, 0x71, 0xa7, 0xcf, 0x54, 0x6b, 0x8c, 0xbf, 0x85, 0x7f,
+	0xf1, 0x37, 0xe2, 0xb4, 0x1f, 0xe0, 0x59, 0xd1, 0xb0, 0x46, 0x0a, 0xf2, 0x08, 0xea, 0xb6, 0x0a,
+	0xa3, 0x5e, 0x6f, 0x6b, 0x49, 0x4d, 0xc4, 0xb1, 0xb5, 0x12, 0xb3, 0xb8, 0x39, 0xfb, 0xb4, 0xab,
+	0x7a, 0x00, 0xf1, 0xd3, 0xfc, 0xbb, 0x06, 0x35, 0x99, 0xbf, 0x0c, 0x43, 0xed, 0x26, 0x86, 0x95,
+	0x3c, 0xc3, 0x36, 0x2c, 0x38, 0xfd, 0x3e, 0xb3, 0x1d, 0xca, 0x99, 0x2b, 0x23, 0x50, 0xb7, 0xd2,
+	0xaa, 0x18, 0x78, 0x26, 0x01, 0x16, 0xbb, 0x25, 0xf0, 0xdf, 0xb2, 0x50, 0x91, 0x97, 0x42, 0x96,
+	0x69, 0xed, 0x26, 0xa6, 0x73, 0x37, 0x32, 0x35, 0xff, 0xa2, 0x41, 0x6b, 0xd4, 0x82, 0x1d, 0x5d,
+	0x31, 0x8f, 0xdf, 0xee, 0x06, 0x16, 0x4b, 0x8d, 0x38, 0x0d, 0xf9, 0x6b, 0x91, 0x2d, 0x49, 0x6c,
+	0xa4, 0x10, 0x49, 0x67, 0x9e, 0x8d, 0x36, 0xd9, 0xec, 0xc7, 0xa2, 0x40, 0x39, 0x7f, 0xe5, 0x87,
+	0x5c, 0xd1, 0x93, 0x02, 0xa6, 0x7d, 0x18, 0x48, 0x5a, 0x22, 0xed, 0xc3, 0x80, 0x99, 0x36, 0xe8,
+	0xe3, 0x14, 0xa6, 0xec, 0x25, 0x3b, 0xb9, 0x63, 0x62, 0x29, 0x75, 0x5d, 0xa3, 0xab, 0xe4, 0xa4,
+	0xf8, 0x95, 0x06, 0x0b, 0x29, 0x3d, 0xb9, 0x03, 0x15, 0xc7, 0x56, 0x1e, 0x2b, 0x8e, 0x9d, 0x3d,
+	0x3c, 0x2a, 0xf9, 0xc3, 0x23, 0x5e, 0x77, 0x75, 0xb4, 0xee, 0x11, 0xc3, 0x99, 0x34, 0xc3, 0x36,
+	0x2c, 0x04, 0xa9, 0x33, 0x41, 0x3d, 0x81, 0x52, 0x2a, 0xf3, 0x63, 0x58, 0x3b, 0xe5, 0x21, 0xa3,
+	0xfd, 0xf7, 0x48, 0x9a, 0xf9, 0xbb, 0x4c, 0xa2, 0xf7, 0x5d, 0x16, 0x8e, 0xe6, 0x8c, 0xf5, 0xb7,
+	0x5a, 0x51, 0x7f, 0x3b, 0xf2, 0x5c, 0xc9, 0x97, 0x43, 0xc4, 0x29, 0x8f, 0xb9, 0x49, 0x61, 0x54,
+	0x24, 0x33, 0xc5, 0x45, 0x32, 0x9b, 0x39, 0xe5, 0x33, 0x29, 0x8c, 0x17, 0x77, 0x0b, 0x29, 0x44,
+	0x57, 0x49, 0x0a, 0xff, 0x94, 0xa4, 0x10, 0xf5, 0xa9, 0x14, 0xce, 0x63, 0x0a, 0xcb, 0x18, 0x96,
+	0x24, 0x4f, 0xb2, 0x9e, 0x49, 0xb3, 0xd6, 0x61, 0xae, 0xcf, 0xa2, 0x88, 0xf6, 0x92, 0x72, 0x56,
+	0x62, 0xb6, 0x3c, 0x6a, 0xf9, 0xf2, 0xd8, 0x04, 0x08, 0x59, 0xe4, 0xbb, 0x57, 0x68, 0x96, 0xc5,
+	0x9d, 0xd2, 0x98, 0xbf, 0xd5, 0x60, 0xf9, 0xa4, 0x1f, 0xf8, 0xa1, 0x0a, 0xd1, 0xfb, 0xa7, 0xee,
+	0xdc, 0x0f, 0xfb, 0x34, 0x2e, 0x4c, 0x25, 0x09, 0x62, 0xe2, 0x24, 0x88, 0x89, 0x89, 0xdf, 0x18,
+	0x84, 0x70, 0x68, 0x0d, 0x3c, 0x64, 0x56, 0xb7, 0x94, 0x24, 0xa8, 0x05, 0x34, 0xe4, 0x0e, 0x75,
+	0x91, 0x5a, 0xdd, 0x8a, 0x45, 0xd3, 0x87, 0x95, 0xdc, 0xda, 0x54, 0xe6, 0x96, 0xa0, 0x1a, 0xfa,
+	0x6f, 0x71, 0x49, 0x0d, 0x4b, 0xfc, 0x2c, 0x8d, 0xb0, 0xf8, 0x1e, 0x80, 0x8f, 0x54, 0xb5, 0x14,
+	0x25, 0x89, 0x28, 0xb3, 0x30, 0xf4, 0xc3, 0x38, 0xca, 0x28, 0x98, 0xaf, 0x61, 0xf9, 0xe8, 0xfa,
+	0xb6, 0x83, 0x61, 0x3e, 0x86, 0x95, 0xa3, 0xeb, 0x22, 0x1a, 0x71, 0x94, 0xb4, 0x51, 0x94, 0xf6,
+	0xfe, 0x79, 0x17, 0x6a, 0x72, 0x1c, 0xf9, 0x1e, 0xd4, 0xe4, 0xf3, 0x85, 0xe8, 0x65, 0x5f, 0x33,
+	0x8d, 0xb5, 0x02, 0x8b, 0x6a, 0x52, 0x5b, 0x5f, 0x7c, 0xf9, 0xaf, 0xdf, 0x54, 0xee, 0x99, 0x8b,
+	0xf8, 0x31, 0x58, 0x3e, 0x0e, 0xa2, 0x67, 0xda, 0x16, 0x39, 0x85, 0xea, 0x31, 0xe3, 0x64, 0x25,
+	0xff, 0xdd, 0x4c, 0x7a, 0x5c, 0x2d, 0xfe, 0x9c, 0x66, 0x6e, 0xa0, 0xbb, 0x16, 0x59, 0x49, 0xbb,
+	0xdb, 0x79, 0x27, 0xe3, 0xfc, 0x39, 0xf9, 0x11, 0xd4, 0xe4, 0x13, 0x41, 0x2d, 0xb6, 0xe0, 0x2b,
+	0x92, 0xb1, 0x56, 0x60, 0xc9, 0x7a, 0xdf, 0x2a, 0xf1, 0xfe, 0x6b, 0x0d, 0x9a, 0x62, 0x1f, 0xe7,
+	0xbe, 0x24, 0x91, 0x87, 0xe8, 0x71, 0xd2, 0x97, 0x26, 0xa3, 0x95, 0x1b, 0x36, 0x7a, 0x0b, 0x21,
+	0xec, 0x63, 0xf2, 0x08, 0x61, 0x53, 0xe9, 0x8c, 0x76, 0xde, 0x65, 0x92, 0xfb, 0x79, 0xbc, 0x26,
+	0xf2, 0x13, 0xa8, 0xc9, 0xa7, 0x95, 0x22, 0x5a, 0xf0, 0x7a, 0x36, 0xd6, 0x0a, 0x2c, 0x0a, 0xb1,
+	0x8d, 0x88, 0x86, 0x51, 0x4c, 0x54, 0xa4, 0x27, 0x00, 0x90, 0xf9, 0xc4, 0x8f, 0xf4, 0xeb, 0x63,
+	0x09, 0x4e, 0x3d, 0xd8, 0x8c, 0x8d, 0x12, 0xab, 0x02, 0x7b, 0x88, 0x60, 0x1f, 0x9a, 0x46, 0x21,
+	0xd8, 0xce, 0x25, 0x1b, 0x62, 0x41, 0xd8, 0x30, 0x77, 0xcc, 0x38, 0xc2, 0xad, 0x65, 0xb3, 0x9f,
+	0xc6, 0x32, 0x8a, 0x4c, 0x0a, 0xc8, 0x44, 0xa0, 0x75, 0x72, 0x03, 0x90, 0xe0, 0x25, 0x23, 0x92,
+	0xe2, 0x55, 0xf2, 0x10, 0x36, 0x36, 0x4a, 0xac, 0x59, 0x5e, 0xc6, 0x04, 0x5e, 0x7d, 0x00, 0x59,
+	0x6c, 0x29, 0xc4, 0x92, 0xa7, 0xaf, 0xb1, 0x51, 0x62, 0xcd, 0x12, 0xdc, 0xba, 0x89, 0xa0, 0x07,
+	0xf5, 0xf8, 0xbd, 0x48, 0x64, 0xb0, 0x0a, 0xdf, 0xc5, 0xc6, 0x07, 0x85, 0x36, 0x05, 0xf4, 0x08,
+	0x81, 0xee, 0x9b, 0x9b, 0xc5, 0x40, 0x54, 0xcd, 0x12, 0xf4, 0x7e, 0x0e, 0x8d, 0x63, 0xc6, 0x47,
+	0x0f, 0x49, 0xf2, 0x61, 0x36, 0x43, 0x63, 0x2f, 0x53, 0xa3, 0x5d, 0x3e, 0x40, 0xc1, 0x77, 0x10,
+	0xde, 0x24, 0xed, 0x1b, 0xe1, 0x05, 0xd8, 0x2f, 0x60, 0x29, 0xff, 0xa4, 0x53, 0x21, 0x2e, 0x79,
+	0x1c, 0x1a, 0x1b, 0x25, 0x56, 0x05, 0xbd, 0x8d, 0xd0, 0x1d, 0xf3, 0xa3, 0x62, 0xe8, 0x5e, 0x1e,
+	0xcc, 0x81, 0xc5, 0xf4, 0x03, 0x4e, 0x6d, 0xc7, 0x82, 0x07, 0xa3, 0xb1, 0x56, 0x60, 0x51, 0xa0,
+	0x0f, 0x10, 0x74, 0x93, 0xac, 0x17, 0x83, 0x9e, 0x8b, 0x09, 0x11, 0xf1, 0x01, 0xc4, 0xe1, 0x21,
+	0x1b, 0x22, 0xc5, 0xb2, 0xa4, 0xb9, 0x35, 0x36, 0x4a, 0xac, 0xd3, 0x01, 0x32, 0x09, 0xe1, 0xc3,
+	0xa2, 0xec, 0xc4, 0x14, 0xe4, 0x26, 0x3a, 0x2d, 0x6d, 0xce, 0x8c, 0xb1, 0xee, 0xd2, 0x7c, 0x8c,
+	0x38, 0x0f, 0xc9, 0xfd, 0x9b, 0x70, 0x76, 0x22, 0xf4, 0xb8, 0xab, 0x91, 0x77, 0x92, 0xa1, 0xec,
+	0x90, 0xc6, 0x18, 0x66, 0xba, 0x3a, 0x63, 0xa3, 0xc4, 0xaa, 0x18, 0xee, 0x22, 0xf2, 0x16, 0xe9,
+	0x4c, 0x3e, 0x53, 0xa9, 0x84, 0xfb, 0x29, 0x34, 0x32, 0xf7, 0xbc, 0x3a, 0x85, 0x8a, 0xfa, 0x12,
+	0xc3, 0x28, 0x32, 0x29, 0xe4, 0x4d, 0x44, 0xd6, 0xcd, 0x66, 0x86, 0xb3, 0x83, 0x63, 0x9f, 0x69,
+	0x5b, 0x1d, 0x6d, 0x57, 0x23, 0x5f, 0x68, 0xd0, 0x38, 0xba, 0x1e, 0x07, 0x3b, 0xba, 0x2e, 0x05,
+	0x2b, 0xbc, 0xbc, 0xcd, 0x4f, 0x10, 0x6c, 0x8f, 0xec, 0x4e, 0x7d, 0x75, 0xec, 0x30, 0x74, 0xb4,
+	0xab, 0x9d, 0xd5, 0xf0, 0xdf, 0xb1, 0x1f, 0xff, 0x67, 0x00, 0xb2, 0xfe, 0x81, 0xa3, 0xc1, 0x1d,
+	0x00, 0x00,
 }
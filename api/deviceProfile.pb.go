@@ -26,6 +26,24 @@ type CreateDeviceProfileRequest struct {
 	OrganizationID int64 `protobuf:"varint,3,opt,name=organizationID" json:"organizationID,omitempty"`
 	// Network-server id of the device-profile.
 	NetworkServerID int64 `protobuf:"varint,4,opt,name=networkServerID" json:"networkServerID,omitempty"`
+	// Payload codec (when empty, the codec of the application is used).
+	PayloadCodec string `protobuf:"bytes,5,opt,name=payloadCodec" json:"payloadCodec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,6,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string `protobuf:"bytes,7,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Max interval (in seconds) between two uplinks before an inactive alert
+	// is raised (when 0, the rule of the application is used).
+	AlertUplinkInterval uint32 `protobuf:"varint,8,opt,name=alertUplinkInterval" json:"alertUplinkInterval,omitempty"`
+	// Battery level (1 - 254) below which a low battery alert is raised
+	// (when 0, the rule of the application is used).
+	AlertBatteryThreshold uint32 `protobuf:"varint,9,opt,name=alertBatteryThreshold" json:"alertBatteryThreshold,omitempty"`
+	// Link margin (in dB) below which a low margin alert is raised (only
+	// used when alertMarginEnabled is set).
+	AlertMarginThreshold int32 `protobuf:"varint,10,opt,name=alertMarginThreshold" json:"alertMarginThreshold,omitempty"`
+	// Use the alertMarginThreshold of the device-profile (when not set, the
+	// rule of the application is used).
+	AlertMarginEnabled bool `protobuf:"varint,11,opt,name=alertMarginEnabled" json:"alertMarginEnabled,omitempty"`
 }
 
 func (m *CreateDeviceProfileRequest) Reset()                    { *m = CreateDeviceProfileRequest{} }
@@ -61,6 +79,55 @@ func (m *CreateDeviceProfileRequest) GetNetworkServerID() int64 {
 	return 0
 }
 
+func (m *CreateDeviceProfileRequest) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *CreateDeviceProfileRequest) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *CreateDeviceProfileRequest) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+func (m *CreateDeviceProfileRequest) GetAlertUplinkInterval() uint32 {
+	if m != nil {
+		return m.AlertUplinkInterval
+	}
+	return 0
+}
+
+func (m *CreateDeviceProfileRequest) GetAlertBatteryThreshold() uint32 {
+	if m != nil {
+		return m.AlertBatteryThreshold
+	}
+	return 0
+}
+
+func (m *CreateDeviceProfileRequest) GetAlertMarginThreshold() int32 {
+	if m != nil {
+		return m.AlertMarginThreshold
+	}
+	return 0
+}
+
+func (m *CreateDeviceProfileRequest) GetAlertMarginEnabled() bool {
+	if m != nil {
+		return m.AlertMarginEnabled
+	}
+	return false
+}
+
 type CreateDeviceProfileResponse struct {
 	// ID of the device-profile.
 	DeviceProfileID string `protobuf:"bytes,1,opt,name=deviceProfileID" json:"deviceProfileID,omitempty"`
@@ -107,6 +174,24 @@ type GetDeviceProfileResponse struct {
 	CreatedAt string `protobuf:"bytes,5,opt,name=createdAt" json:"createdAt,omitempty"`
 	// Timestamp when the record was last updated.
 	UpdatedAt string `protobuf:"bytes,6,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// Payload codec (when empty, the codec of the application is used).
+	PayloadCodec string `protobuf:"bytes,7,opt,name=payloadCodec" json:"payloadCodec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,8,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string `protobuf:"bytes,9,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Max interval (in seconds) between two uplinks before an inactive alert
+	// is raised (when 0, the rule of the application is used).
+	AlertUplinkInterval uint32 `protobuf:"varint,10,opt,name=alertUplinkInterval" json:"alertUplinkInterval,omitempty"`
+	// Battery level (1 - 254) below which a low battery alert is raised
+	// (when 0, the rule of the application is used).
+	AlertBatteryThreshold uint32 `protobuf:"varint,11,opt,name=alertBatteryThreshold" json:"alertBatteryThreshold,omitempty"`
+	// Link margin (in dB) below which a low margin alert is raised (only
+	// used when alertMarginEnabled is set).
+	AlertMarginThreshold int32 `protobuf:"varint,12,opt,name=alertMarginThreshold" json:"alertMarginThreshold,omitempty"`
+	// Use the alertMarginThreshold of the device-profile (when not set, the
+	// rule of the application is used).
+	AlertMarginEnabled bool `protobuf:"varint,13,opt,name=alertMarginEnabled" json:"alertMarginEnabled,omitempty"`
 }
 
 func (m *GetDeviceProfileResponse) Reset()                    { *m = GetDeviceProfileResponse{} }
@@ -156,10 +241,77 @@ func (m *GetDeviceProfileResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (m *GetDeviceProfileResponse) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *GetDeviceProfileResponse) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *GetDeviceProfileResponse) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+func (m *GetDeviceProfileResponse) GetAlertUplinkInterval() uint32 {
+	if m != nil {
+		return m.AlertUplinkInterval
+	}
+	return 0
+}
+
+func (m *GetDeviceProfileResponse) GetAlertBatteryThreshold() uint32 {
+	if m != nil {
+		return m.AlertBatteryThreshold
+	}
+	return 0
+}
+
+func (m *GetDeviceProfileResponse) GetAlertMarginThreshold() int32 {
+	if m != nil {
+		return m.AlertMarginThreshold
+	}
+	return 0
+}
+
+func (m *GetDeviceProfileResponse) GetAlertMarginEnabled() bool {
+	if m != nil {
+		return m.AlertMarginEnabled
+	}
+	return false
+}
+
 type UpdateDeviceProfileRequest struct {
 	DeviceProfile *DeviceProfile `protobuf:"bytes,1,opt,name=deviceProfile" json:"deviceProfile,omitempty"`
 	// Name of the device-profile.
 	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Payload codec (when empty, the codec of the application is used).
+	PayloadCodec string `protobuf:"bytes,3,opt,name=payloadCodec" json:"payloadCodec,omitempty"`
+	// Payload encoder script.
+	PayloadEncoderScript string `protobuf:"bytes,4,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
+	// Payload decoder script.
+	PayloadDecoderScript string `protobuf:"bytes,5,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Max interval (in seconds) between two uplinks before an inactive alert
+	// is raised (when 0, the rule of the application is used).
+	AlertUplinkInterval uint32 `protobuf:"varint,6,opt,name=alertUplinkInterval" json:"alertUplinkInterval,omitempty"`
+	// Battery level (1 - 254) below which a low battery alert is raised
+	// (when 0, the rule of the application is used).
+	AlertBatteryThreshold uint32 `protobuf:"varint,7,opt,name=alertBatteryThreshold" json:"alertBatteryThreshold,omitempty"`
+	// Link margin (in dB) below which a low margin alert is raised (only
+	// used when alertMarginEnabled is set).
+	AlertMarginThreshold int32 `protobuf:"varint,8,opt,name=alertMarginThreshold" json:"alertMarginThreshold,omitempty"`
+	// Use the alertMarginThreshold of the device-profile (when not set, the
+	// rule of the application is used).
+	AlertMarginEnabled bool `protobuf:"varint,9,opt,name=alertMarginEnabled" json:"alertMarginEnabled,omitempty"`
 }
 
 func (m *UpdateDeviceProfileRequest) Reset()                    { *m = UpdateDeviceProfileRequest{} }
@@ -181,6 +333,55 @@ func (m *UpdateDeviceProfileRequest) GetName() string {
 	return ""
 }
 
+func (m *UpdateDeviceProfileRequest) GetPayloadCodec() string {
+	if m != nil {
+		return m.PayloadCodec
+	}
+	return ""
+}
+
+func (m *UpdateDeviceProfileRequest) GetPayloadEncoderScript() string {
+	if m != nil {
+		return m.PayloadEncoderScript
+	}
+	return ""
+}
+
+func (m *UpdateDeviceProfileRequest) GetPayloadDecoderScript() string {
+	if m != nil {
+		return m.PayloadDecoderScript
+	}
+	return ""
+}
+
+func (m *UpdateDeviceProfileRequest) GetAlertUplinkInterval() uint32 {
+	if m != nil {
+		return m.AlertUplinkInterval
+	}
+	return 0
+}
+
+func (m *UpdateDeviceProfileRequest) GetAlertBatteryThreshold() uint32 {
+	if m != nil {
+		return m.AlertBatteryThreshold
+	}
+	return 0
+}
+
+func (m *UpdateDeviceProfileRequest) GetAlertMarginThreshold() int32 {
+	if m != nil {
+		return m.AlertMarginThreshold
+	}
+	return 0
+}
+
+func (m *UpdateDeviceProfileRequest) GetAlertMarginEnabled() bool {
+	if m != nil {
+		return m.AlertMarginEnabled
+	}
+	return false
+}
+
 type UpdateDeviceProfileResponse struct {
 }
 
@@ -576,42 +777,53 @@ var _DeviceProfileService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("deviceProfile.proto", fileDescriptor10) }
 
 var fileDescriptor10 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x67, 0x36, 0xdd, 0x40, 0xdf, 0xb2, 0x2b, 0x8e, 0xa5, 0x66, 0xd3, 0x76, 0xb7, 0x04, 0x59,
-	0xc2, 0x82, 0x29, 0x54, 0x0f, 0xea, 0x45, 0xa4, 0xc5, 0x52, 0x50, 0x90, 0x88, 0x1f, 0x60, 0x6c,
-	0x5f, 0xcb, 0xb8, 0xd9, 0x4c, 0x4c, 0xa6, 0x2b, 0xb8, 0x78, 0xf1, 0xec, 0x4d, 0xf0, 0xd3, 0xf8,
-	0x2d, 0xfc, 0x00, 0x5e, 0xbc, 0xf8, 0x05, 0x3c, 0x4b, 0x66, 0xa6, 0xba, 0xe9, 0x26, 0x4b, 0x2e,
-	0x82, 0x7b, 0xeb, 0xbc, 0xf7, 0xf2, 0x7e, 0xbf, 0xf7, 0x7b, 0x7f, 0x0a, 0xb7, 0xe6, 0x78, 0xc6,
-	0x67, 0xf8, 0x22, 0x15, 0x0b, 0x1e, 0x61, 0x90, 0xa4, 0x42, 0x0a, 0x6a, 0xb1, 0x84, 0xbb, 0xdd,
-	0xa5, 0x10, 0xcb, 0x08, 0x07, 0x2c, 0xe1, 0x03, 0x16, 0xc7, 0x42, 0x32, 0xc9, 0x45, 0x9c, 0xe9,
-	0x10, 0x77, 0x2f, 0xd1, 0x5f, 0x98, 0xb7, 0xf7, 0x95, 0x80, 0x3b, 0x4a, 0x91, 0x49, 0x1c, 0x5f,
-	0x4c, 0x18, 0xe2, 0xdb, 0x15, 0x66, 0x92, 0x3e, 0x80, 0xdd, 0x02, 0x90, 0x43, 0xfa, 0xc4, 0xdf,
-	0x19, 0xd2, 0x80, 0x25, 0x3c, 0x28, 0x7e, 0x51, 0x0c, 0xa4, 0x14, 0x1a, 0x31, 0x3b, 0x45, 0x67,
-	0xab, 0x4f, 0xfc, 0x66, 0xa8, 0x7e, 0xd3, 0x23, 0xd8, 0x13, 0xe9, 0x92, 0xc5, 0xfc, 0xbd, 0xe2,
-	0x34, 0x1d, 0x3b, 0x56, 0x9f, 0xf8, 0x56, 0xb8, 0x61, 0xa5, 0x3e, 0xdc, 0x88, 0x51, 0xbe, 0x13,
-	0xe9, 0xc9, 0x4b, 0x4c, 0xcf, 0x30, 0x9d, 0x8e, 0x9d, 0x86, 0x0a, 0xdc, 0x34, 0x7b, 0x13, 0xe8,
-	0x94, 0xb2, 0xcf, 0x12, 0x11, 0x67, 0x98, 0x27, 0x2a, 0xb0, 0x9a, 0x8e, 0x55, 0x01, 0xcd, 0x70,
-	0xd3, 0xec, 0x8d, 0xe0, 0xf6, 0x04, 0x65, 0xa9, 0x06, 0xf5, 0x93, 0xfc, 0x22, 0xe0, 0x5c, 0xce,
-	0x62, 0xb8, 0xfc, 0xe7, 0x52, 0xd2, 0x2e, 0x34, 0x67, 0x4a, 0xca, 0xf9, 0x13, 0xe9, 0x6c, 0x2b,
-	0xa8, 0xbf, 0x86, 0xdc, 0xbb, 0x4a, 0xe6, 0xc6, 0x6b, 0x6b, 0xef, 0x1f, 0x83, 0xf7, 0x06, 0xdc,
-	0x57, 0xea, 0xf1, 0xef, 0x87, 0xc8, 0xeb, 0x41, 0xa7, 0x14, 0x4b, 0xcb, 0xec, 0x3d, 0x05, 0x77,
-	0x8c, 0x11, 0x56, 0x50, 0xa9, 0xdf, 0xcb, 0x1e, 0x74, 0x4a, 0xf3, 0x18, 0x98, 0x2f, 0x04, 0x9c,
-	0x67, 0x3c, 0x2b, 0x9f, 0x98, 0x16, 0x6c, 0x47, 0xfc, 0x94, 0x4b, 0x95, 0xdb, 0x0a, 0xf5, 0x83,
-	0xb6, 0xc1, 0x16, 0x8b, 0x45, 0x86, 0x52, 0x95, 0x63, 0x85, 0xe6, 0x55, 0xbb, 0x95, 0x77, 0x60,
-	0x97, 0x25, 0x49, 0xc4, 0x67, 0xeb, 0x30, 0xdd, 0xc8, 0xa2, 0xd1, 0xfb, 0x4e, 0xe0, 0x66, 0x81,
-	0xd4, 0x73, 0x94, 0xac, 0x7e, 0xdd, 0xd7, 0x60, 0xd8, 0x4e, 0x60, 0xbf, 0x44, 0x79, 0xb3, 0x65,
-	0x07, 0x00, 0x52, 0x48, 0x16, 0x8d, 0xc4, 0x2a, 0x5e, 0xeb, 0x7f, 0xc1, 0x42, 0x03, 0xb0, 0x53,
-	0xcc, 0x56, 0x51, 0xde, 0x04, 0xcb, 0xdf, 0x19, 0xb6, 0x2f, 0x0f, 0x61, 0x2e, 0x58, 0x68, 0xa2,
-	0x86, 0x3f, 0x1b, 0xd0, 0x2a, 0x78, 0xf3, 0x12, 0xf8, 0x0c, 0x69, 0x04, 0xb6, 0xbe, 0x3c, 0xf4,
-	0x50, 0xa5, 0xa8, 0x3e, 0xa2, 0x6e, 0xbf, 0x3a, 0xc0, 0x4c, 0xd3, 0xe1, 0xc7, 0x6f, 0x3f, 0x3e,
-	0x6f, 0xed, 0x7b, 0x2d, 0x75, 0xb5, 0x75, 0x4b, 0xee, 0xae, 0x2f, 0xf5, 0x23, 0x72, 0x4c, 0x53,
-	0xb0, 0x26, 0x28, 0x69, 0x57, 0x65, 0xaa, 0x38, 0x54, 0x6e, 0xaf, 0xc2, 0x6b, 0x40, 0x02, 0x05,
-	0xe2, 0xd3, 0xa3, 0x32, 0x90, 0xc1, 0xf9, 0xc6, 0x20, 0x7c, 0xa0, 0x9f, 0x08, 0xd8, 0x7a, 0xd3,
-	0x4c, 0x89, 0xd5, 0x2b, 0x6e, 0x4a, 0xbc, 0x6a, 0x2f, 0x1f, 0x2b, 0xf4, 0x87, 0xee, 0xfd, 0x1a,
-	0xe8, 0xc1, 0x26, 0x97, 0x5c, 0x82, 0x73, 0xb0, 0xf5, 0x42, 0x1a, 0x36, 0xd5, 0x5b, 0x6e, 0xd8,
-	0x5c, 0xb5, 0xbe, 0x46, 0x8b, 0xe3, 0xba, 0x5a, 0xcc, 0xa0, 0x91, 0xcf, 0x1c, 0xd5, 0x12, 0x57,
-	0x2d, 0xbe, 0x7b, 0x50, 0xe5, 0x36, 0xb0, 0x5d, 0x05, 0xdb, 0xa6, 0xa5, 0x7d, 0x7e, 0x6d, 0xab,
-	0xbf, 0xe4, 0x7b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x63, 0xb6, 0x83, 0xdc, 0x07, 0x00,
-	0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x96, 0x71, 0x62, 0x92, 0x13, 0xc2, 0xd5, 0x1d, 0x72, 0xb9, 0xc6, 0x04, 0xb0, 0xac, 0x2b,
+	0x64, 0x21, 0xdd, 0x70, 0x95, 0xcb, 0xa2, 0xed, 0xa6, 0x6a, 0x13, 0x8a, 0x22, 0x15, 0xa9, 0x32,
+	0xe5, 0x01, 0x06, 0xfb, 0x10, 0x2c, 0x8c, 0xc7, 0x1d, 0x4f, 0xa8, 0x28, 0xea, 0xa6, 0xeb, 0xee,
+	0x5a, 0xf5, 0x81, 0xfa, 0x00, 0xdd, 0xf4, 0x01, 0xba, 0xe9, 0xa6, 0x6f, 0x51, 0x79, 0x6c, 0x44,
+	0x1c, 0x6c, 0x29, 0x49, 0xa5, 0x8a, 0x9d, 0xe7, 0x7c, 0xe7, 0x67, 0x7c, 0xbe, 0x6f, 0xce, 0x0c,
+	0xac, 0x78, 0x78, 0xe9, 0xbb, 0xf8, 0x82, 0xb3, 0x53, 0x3f, 0xc0, 0x4e, 0xc4, 0x99, 0x60, 0x44,
+	0xa5, 0x91, 0x6f, 0xb4, 0x87, 0x8c, 0x0d, 0x03, 0xdc, 0xa5, 0x91, 0xbf, 0x4b, 0xc3, 0x90, 0x09,
+	0x2a, 0x7c, 0x16, 0xc6, 0xa9, 0x8b, 0xb1, 0x1c, 0xa5, 0x11, 0xd9, 0xda, 0xfa, 0x58, 0x01, 0xa3,
+	0xc7, 0x91, 0x0a, 0xec, 0x8f, 0x27, 0x74, 0xf0, 0xd5, 0x08, 0x63, 0x41, 0x1e, 0x40, 0x33, 0x57,
+	0x48, 0x57, 0x4c, 0xc5, 0x6e, 0x74, 0x49, 0x87, 0x46, 0x7e, 0x27, 0x1f, 0x91, 0x77, 0x24, 0x04,
+	0x2a, 0x21, 0xbd, 0x40, 0x7d, 0xc1, 0x54, 0xec, 0xba, 0x23, 0xbf, 0xc9, 0x36, 0x2c, 0x33, 0x3e,
+	0xa4, 0xa1, 0xff, 0x46, 0xee, 0x69, 0xd0, 0xd7, 0x55, 0x53, 0xb1, 0x55, 0x67, 0xc2, 0x4a, 0x6c,
+	0xf8, 0x23, 0x44, 0xf1, 0x9a, 0xf1, 0xf3, 0x23, 0xe4, 0x97, 0xc8, 0x07, 0x7d, 0xbd, 0x22, 0x1d,
+	0x27, 0xcd, 0xc4, 0x82, 0xa5, 0x88, 0x5e, 0x05, 0x8c, 0x7a, 0x3d, 0xe6, 0xa1, 0xab, 0x57, 0x65,
+	0xb5, 0x9c, 0x8d, 0x74, 0xa1, 0x95, 0xad, 0xf7, 0x43, 0x97, 0x79, 0xc8, 0x8f, 0x5c, 0xee, 0x47,
+	0x42, 0xd7, 0xa4, 0x6f, 0x21, 0x36, 0x16, 0xd3, 0xc7, 0xf1, 0x98, 0xc5, 0x5c, 0x4c, 0x0e, 0x23,
+	0xff, 0xc1, 0x0a, 0x0d, 0x90, 0x8b, 0xe3, 0x28, 0xf0, 0xc3, 0xf3, 0x41, 0x28, 0x90, 0x5f, 0xd2,
+	0x40, 0xaf, 0x99, 0x8a, 0xdd, 0x74, 0x8a, 0x20, 0xb2, 0x07, 0x7f, 0x49, 0xf3, 0x53, 0x2a, 0x04,
+	0xf2, 0xab, 0x97, 0x67, 0x1c, 0xe3, 0x33, 0x16, 0x78, 0x7a, 0x5d, 0xc6, 0x14, 0x83, 0xc9, 0xde,
+	0x24, 0x70, 0x48, 0xf9, 0xd0, 0x0f, 0x6f, 0x83, 0xc0, 0x54, 0xec, 0xaa, 0x53, 0x88, 0x91, 0x0e,
+	0x90, 0x31, 0xfb, 0x7e, 0x48, 0x4f, 0x02, 0xf4, 0xf4, 0x86, 0xa9, 0xd8, 0x35, 0xa7, 0x00, 0xb1,
+	0x0e, 0x60, 0xbd, 0x50, 0x15, 0x71, 0xc4, 0xc2, 0x18, 0x13, 0x82, 0x72, 0x6c, 0x0f, 0xfa, 0x52,
+	0x18, 0x75, 0x67, 0xd2, 0x6c, 0xf5, 0xe0, 0xef, 0x03, 0x14, 0x85, 0xda, 0x9a, 0x3e, 0xc9, 0x97,
+	0x0a, 0xe8, 0x77, 0xb3, 0x64, 0x7b, 0xb9, 0xef, 0x12, 0x6d, 0x43, 0xdd, 0x95, 0xad, 0xf4, 0x9e,
+	0x88, 0x4c, 0x9f, 0xb7, 0x86, 0x04, 0x1d, 0x45, 0x5e, 0x86, 0xa6, 0x8a, 0xbc, 0x35, 0xdc, 0x91,
+	0xf7, 0xe2, 0x0c, 0xf2, 0xae, 0xcd, 0x21, 0xef, 0xfa, 0xec, 0xf2, 0x86, 0x39, 0xe4, 0xdd, 0x98,
+	0x47, 0xde, 0x4b, 0x33, 0xcb, 0xbb, 0x59, 0x2a, 0xef, 0xcf, 0x2a, 0x18, 0xc7, 0xb2, 0xcb, 0xbf,
+	0x61, 0xea, 0x4d, 0x92, 0xa8, 0xce, 0x40, 0x62, 0x65, 0x0e, 0x12, 0xab, 0xb3, 0x93, 0xa8, 0xcd,
+	0x41, 0xe2, 0xe2, 0x3c, 0x24, 0xd6, 0x66, 0x26, 0xb1, 0x5e, 0x4a, 0xe2, 0x06, 0xac, 0x17, 0x72,
+	0x98, 0xce, 0x05, 0xeb, 0x19, 0x18, 0x7d, 0x0c, 0x50, 0xe0, 0x2f, 0x0e, 0x9f, 0x0d, 0x58, 0x2f,
+	0xcc, 0x93, 0x95, 0xf9, 0xa4, 0x80, 0xfe, 0xdc, 0x8f, 0x8b, 0x47, 0x5c, 0x0b, 0xaa, 0x81, 0x7f,
+	0xe1, 0x0b, 0x99, 0x5b, 0x75, 0xd2, 0x05, 0x59, 0x05, 0x8d, 0x9d, 0x9e, 0xc6, 0x28, 0xa4, 0x4c,
+	0x54, 0x27, 0x5b, 0x4d, 0x3d, 0x7b, 0xfe, 0x81, 0x26, 0x8d, 0xa2, 0xc0, 0x77, 0x6f, 0xdc, 0xd2,
+	0xc9, 0x93, 0x37, 0x5a, 0xdf, 0x14, 0xf8, 0x33, 0xb7, 0xa9, 0x43, 0x14, 0x74, 0xfa, 0xff, 0xbe,
+	0xff, 0xd3, 0xd1, 0x3a, 0x87, 0xb5, 0x82, 0xce, 0x67, 0xd7, 0xc2, 0x26, 0x80, 0x60, 0x82, 0x06,
+	0x3d, 0x36, 0x0a, 0x6f, 0xfa, 0x3f, 0x66, 0x21, 0x1d, 0xd0, 0x38, 0xc6, 0xa3, 0x20, 0x21, 0x41,
+	0xb5, 0x1b, 0xdd, 0xd5, 0xbb, 0x87, 0x3b, 0x69, 0x98, 0x93, 0x79, 0x75, 0x7f, 0x54, 0xa0, 0x95,
+	0x43, 0x93, 0x5f, 0xf0, 0x5d, 0x24, 0x01, 0x68, 0xe9, 0x55, 0x49, 0xb6, 0x64, 0x8a, 0xf2, 0xd7,
+	0x94, 0x61, 0x96, 0x3b, 0x64, 0x6a, 0xda, 0x7a, 0xf7, 0xf5, 0xfb, 0x87, 0x85, 0x35, 0xab, 0x25,
+	0x9f, 0x6f, 0x29, 0x25, 0xff, 0xde, 0x3c, 0xd9, 0x1e, 0x29, 0x3b, 0x84, 0x83, 0x7a, 0x80, 0x82,
+	0xb4, 0x65, 0xa6, 0x92, 0x9b, 0xd5, 0xd8, 0x28, 0x41, 0xb3, 0x22, 0x1d, 0x59, 0xc4, 0x26, 0xdb,
+	0x45, 0x45, 0x76, 0xaf, 0x27, 0x84, 0xf0, 0x96, 0xbc, 0x57, 0x40, 0x4b, 0x4f, 0x5a, 0xf6, 0x8b,
+	0xe5, 0xa3, 0xd3, 0x30, 0xcb, 0x1d, 0xb2, 0xea, 0x8f, 0x65, 0xf5, 0x87, 0xc6, 0xde, 0x14, 0xd5,
+	0x3b, 0x93, 0x7b, 0x49, 0x5a, 0x70, 0x0d, 0x5a, 0x7a, 0x20, 0xb3, 0xdd, 0x94, 0x9f, 0x72, 0xc3,
+	0x2c, 0x77, 0xc8, 0xf7, 0x62, 0x67, 0xda, 0x5e, 0xb8, 0x50, 0x49, 0x34, 0x47, 0xd2, 0x16, 0x97,
+	0x1d, 0x7c, 0x63, 0xb3, 0x0c, 0xce, 0xca, 0xb6, 0x65, 0xd9, 0x55, 0x52, 0xc8, 0xf3, 0x89, 0x26,
+	0xdf, 0xe6, 0xff, 0xff, 0x1c, 0x00, 0x5c, 0xcf, 0xe6, 0xb5, 0xe5, 0x0b, 0x00, 0x00,
 }
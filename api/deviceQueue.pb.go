@@ -18,6 +18,42 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type DownlinkStatus int32
+
+const (
+	// The item has been added to the device-queue.
+	DownlinkStatus_QUEUED DownlinkStatus = 0
+	// The item has been sent by the network-server.
+	DownlinkStatus_SENT DownlinkStatus = 1
+	// The (confirmed) item has been acknowledged by the device.
+	DownlinkStatus_ACKED DownlinkStatus = 2
+	// The (confirmed) item has not been acknowledged by the device.
+	DownlinkStatus_NACKED DownlinkStatus = 3
+	// The item was flushed from the device-queue or no acknowledgement
+	// was received within the configured timeout.
+	DownlinkStatus_EXPIRED DownlinkStatus = 4
+)
+
+var DownlinkStatus_name = map[int32]string{
+	0: "QUEUED",
+	1: "SENT",
+	2: "ACKED",
+	3: "NACKED",
+	4: "EXPIRED",
+}
+var DownlinkStatus_value = map[string]int32{
+	"QUEUED":  0,
+	"SENT":    1,
+	"ACKED":   2,
+	"NACKED":  3,
+	"EXPIRED": 4,
+}
+
+func (x DownlinkStatus) String() string {
+	return proto.EnumName(DownlinkStatus_name, int32(x))
+}
+func (DownlinkStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+
 type EnqueueDeviceQueueItemRequest struct {
 	// Hex encoded DevEUI of the node.
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -81,6 +117,8 @@ func (m *EnqueueDeviceQueueItemRequest) GetJsonObject() string {
 }
 
 type EnqueueDeviceQueueItemResponse struct {
+	// FCnt of the enqueued item.
+	FCnt uint32 `protobuf:"varint,1,opt,name=fCnt" json:"fCnt,omitempty"`
 }
 
 func (m *EnqueueDeviceQueueItemResponse) Reset()                    { *m = EnqueueDeviceQueueItemResponse{} }
@@ -88,6 +126,13 @@ func (m *EnqueueDeviceQueueItemResponse) String() string            { return pro
 func (*EnqueueDeviceQueueItemResponse) ProtoMessage()               {}
 func (*EnqueueDeviceQueueItemResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
 
+func (m *EnqueueDeviceQueueItemResponse) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
 type FlushDeviceQueueRequest struct {
 	// Hex encoded DevEUI of the node.
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -208,6 +253,190 @@ func (m *ListDeviceQueueItemsResponse) GetItems() []*DeviceQueueItem {
 	return nil
 }
 
+type GetDownlinkStatusRequest struct {
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// FCnt of the downlink.
+	FCnt uint32 `protobuf:"varint,2,opt,name=fCnt" json:"fCnt,omitempty"`
+}
+
+func (m *GetDownlinkStatusRequest) Reset()                    { *m = GetDownlinkStatusRequest{} }
+func (m *GetDownlinkStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDownlinkStatusRequest) ProtoMessage()               {}
+func (*GetDownlinkStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{7} }
+
+func (m *GetDownlinkStatusRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *GetDownlinkStatusRequest) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+type GetDownlinkStatusResponse struct {
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Random reference (used on ack notification).
+	Reference string `protobuf:"bytes,2,opt,name=reference" json:"reference,omitempty"`
+	// FCnt of the downlink.
+	FCnt uint32 `protobuf:"varint,3,opt,name=fCnt" json:"fCnt,omitempty"`
+	// FPort used.
+	FPort uint32 `protobuf:"varint,4,opt,name=fPort" json:"fPort,omitempty"`
+	// Is an ACK required from the device.
+	Confirmed bool `protobuf:"varint,5,opt,name=confirmed" json:"confirmed,omitempty"`
+	// Status of the downlink.
+	Status DownlinkStatus `protobuf:"varint,6,opt,name=status,enum=api.DownlinkStatus" json:"status,omitempty"`
+	// Timestamp when the downlink was queued.
+	QueuedAt string `protobuf:"bytes,7,opt,name=queuedAt" json:"queuedAt,omitempty"`
+	// Timestamp when the downlink was sent (empty when not sent).
+	SentAt string `protobuf:"bytes,8,opt,name=sentAt" json:"sentAt,omitempty"`
+	// Timestamp when the (n)ack was received (empty when not received).
+	AckedAt string `protobuf:"bytes,9,opt,name=ackedAt" json:"ackedAt,omitempty"`
+	// Timestamp when the downlink expired (empty when not expired).
+	ExpiredAt string `protobuf:"bytes,10,opt,name=expiredAt" json:"expiredAt,omitempty"`
+}
+
+func (m *GetDownlinkStatusResponse) Reset()                    { *m = GetDownlinkStatusResponse{} }
+func (m *GetDownlinkStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDownlinkStatusResponse) ProtoMessage()               {}
+func (*GetDownlinkStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{8} }
+
+func (m *GetDownlinkStatusResponse) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *GetDownlinkStatusResponse) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *GetDownlinkStatusResponse) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *GetDownlinkStatusResponse) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *GetDownlinkStatusResponse) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *GetDownlinkStatusResponse) GetStatus() DownlinkStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DownlinkStatus_QUEUED
+}
+
+func (m *GetDownlinkStatusResponse) GetQueuedAt() string {
+	if m != nil {
+		return m.QueuedAt
+	}
+	return ""
+}
+
+func (m *GetDownlinkStatusResponse) GetSentAt() string {
+	if m != nil {
+		return m.SentAt
+	}
+	return ""
+}
+
+func (m *GetDownlinkStatusResponse) GetAckedAt() string {
+	if m != nil {
+		return m.AckedAt
+	}
+	return ""
+}
+
+func (m *GetDownlinkStatusResponse) GetExpiredAt() string {
+	if m != nil {
+		return m.ExpiredAt
+	}
+	return ""
+}
+
+type ListDownlinksRequest struct {
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListDownlinksRequest) Reset()                    { *m = ListDownlinksRequest{} }
+func (m *ListDownlinksRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinksRequest) ProtoMessage()               {}
+func (*ListDownlinksRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{9} }
+
+func (m *ListDownlinksRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *ListDownlinksRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDownlinksRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListDownlinksResponse struct {
+	// Total number of downlinks.
+	TotalCount int64                        `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*GetDownlinkStatusResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListDownlinksResponse) Reset()                    { *m = ListDownlinksResponse{} }
+func (m *ListDownlinksResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinksResponse) ProtoMessage()               {}
+func (*ListDownlinksResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{10} }
+
+func (m *ListDownlinksResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDownlinksResponse) GetResult() []*GetDownlinkStatusResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EnqueueDeviceQueueItemRequest)(nil), "api.EnqueueDeviceQueueItemRequest")
 	proto.RegisterType((*EnqueueDeviceQueueItemResponse)(nil), "api.EnqueueDeviceQueueItemResponse")
@@ -216,6 +445,11 @@ func init() {
 	proto.RegisterType((*DeviceQueueItem)(nil), "api.DeviceQueueItem")
 	proto.RegisterType((*ListDeviceQueueItemsRequest)(nil), "api.ListDeviceQueueItemsRequest")
 	proto.RegisterType((*ListDeviceQueueItemsResponse)(nil), "api.ListDeviceQueueItemsResponse")
+	proto.RegisterType((*GetDownlinkStatusRequest)(nil), "api.GetDownlinkStatusRequest")
+	proto.RegisterType((*GetDownlinkStatusResponse)(nil), "api.GetDownlinkStatusResponse")
+	proto.RegisterType((*ListDownlinksRequest)(nil), "api.ListDownlinksRequest")
+	proto.RegisterType((*ListDownlinksResponse)(nil), "api.ListDownlinksResponse")
+	proto.RegisterEnum("api.DownlinkStatus", DownlinkStatus_name, DownlinkStatus_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -235,6 +469,11 @@ type DeviceQueueClient interface {
 	Flush(ctx context.Context, in *FlushDeviceQueueRequest, opts ...grpc.CallOption) (*FlushDeviceQueueResponse, error)
 	// List lists the items in the device-queue.
 	List(ctx context.Context, in *ListDeviceQueueItemsRequest, opts ...grpc.CallOption) (*ListDeviceQueueItemsResponse, error)
+	// GetDownlinkStatus returns the status of the downlink matching the
+	// given frame-counter.
+	GetDownlinkStatus(ctx context.Context, in *GetDownlinkStatusRequest, opts ...grpc.CallOption) (*GetDownlinkStatusResponse, error)
+	// ListDownlinks lists the downlinks (and their status) of the device.
+	ListDownlinks(ctx context.Context, in *ListDownlinksRequest, opts ...grpc.CallOption) (*ListDownlinksResponse, error)
 }
 
 type deviceQueueClient struct {
@@ -272,6 +511,24 @@ func (c *deviceQueueClient) List(ctx context.Context, in *ListDeviceQueueItemsRe
 	return out, nil
 }
 
+func (c *deviceQueueClient) GetDownlinkStatus(ctx context.Context, in *GetDownlinkStatusRequest, opts ...grpc.CallOption) (*GetDownlinkStatusResponse, error) {
+	out := new(GetDownlinkStatusResponse)
+	err := grpc.Invoke(ctx, "/api.DeviceQueue/GetDownlinkStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceQueueClient) ListDownlinks(ctx context.Context, in *ListDownlinksRequest, opts ...grpc.CallOption) (*ListDownlinksResponse, error) {
+	out := new(ListDownlinksResponse)
+	err := grpc.Invoke(ctx, "/api.DeviceQueue/ListDownlinks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for DeviceQueue service
 
 type DeviceQueueServer interface {
@@ -281,6 +538,11 @@ type DeviceQueueServer interface {
 	Flush(context.Context, *FlushDeviceQueueRequest) (*FlushDeviceQueueResponse, error)
 	// List lists the items in the device-queue.
 	List(context.Context, *ListDeviceQueueItemsRequest) (*ListDeviceQueueItemsResponse, error)
+	// GetDownlinkStatus returns the status of the downlink matching the
+	// given frame-counter.
+	GetDownlinkStatus(context.Context, *GetDownlinkStatusRequest) (*GetDownlinkStatusResponse, error)
+	// ListDownlinks lists the downlinks (and their status) of the device.
+	ListDownlinks(context.Context, *ListDownlinksRequest) (*ListDownlinksResponse, error)
 }
 
 func RegisterDeviceQueueServer(s *grpc.Server, srv DeviceQueueServer) {
@@ -341,6 +603,42 @@ func _DeviceQueue_List_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceQueue_GetDownlinkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownlinkStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceQueueServer).GetDownlinkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceQueue/GetDownlinkStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceQueueServer).GetDownlinkStatus(ctx, req.(*GetDownlinkStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceQueue_ListDownlinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownlinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceQueueServer).ListDownlinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceQueue/ListDownlinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceQueueServer).ListDownlinks(ctx, req.(*ListDownlinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeviceQueue_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DeviceQueue",
 	HandlerType: (*DeviceQueueServer)(nil),
@@ -357,6 +655,14 @@ var _DeviceQueue_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _DeviceQueue_List_Handler,
 		},
+		{
+			MethodName: "GetDownlinkStatus",
+			Handler:    _DeviceQueue_GetDownlinkStatus_Handler,
+		},
+		{
+			MethodName: "ListDownlinks",
+			Handler:    _DeviceQueue_ListDownlinks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deviceQueue.proto",
@@ -365,32 +671,51 @@ var _DeviceQueue_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("deviceQueue.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x51, 0x6e, 0xd4, 0x30,
-	0x10, 0x95, 0x77, 0xb3, 0xdb, 0x76, 0x0a, 0x42, 0x8c, 0x2a, 0xb0, 0xd2, 0x6c, 0x65, 0x52, 0x09,
-	0x45, 0xfb, 0xb1, 0x11, 0x45, 0xfc, 0xf0, 0x0b, 0x45, 0x2a, 0x42, 0x02, 0x22, 0x71, 0x80, 0x34,
-	0x99, 0x14, 0x57, 0xad, 0x9d, 0xc6, 0x4e, 0x3f, 0x40, 0xfc, 0x70, 0x05, 0x8e, 0xc0, 0x39, 0x38,
-	0x05, 0x57, 0x40, 0x9c, 0x03, 0xc5, 0x89, 0xb4, 0x61, 0xdb, 0xa4, 0x7f, 0xf6, 0xcc, 0x7b, 0xf3,
-	0x3c, 0x6f, 0xc6, 0xf0, 0x30, 0xa7, 0x6b, 0x99, 0xd1, 0xc7, 0x9a, 0x6a, 0x5a, 0x95, 0x95, 0xb6,
-	0x1a, 0xa7, 0x69, 0x29, 0xfd, 0xe0, 0x4c, 0xeb, 0xb3, 0x0b, 0x8a, 0xd3, 0x52, 0xc6, 0xa9, 0x52,
-	0xda, 0xa6, 0x56, 0x6a, 0x65, 0x5a, 0x48, 0xf8, 0x8b, 0xc1, 0xe2, 0x58, 0x5d, 0x35, 0xa4, 0xd7,
-	0x6b, 0xfe, 0x89, 0xa5, 0xcb, 0x84, 0xae, 0x6a, 0x32, 0x16, 0x1f, 0xc1, 0x3c, 0xa7, 0xeb, 0xe3,
-	0x4f, 0x27, 0x9c, 0x09, 0x16, 0xed, 0x24, 0xdd, 0x0d, 0x03, 0xd8, 0xa9, 0xa8, 0xa0, 0x8a, 0x54,
-	0x46, 0x7c, 0xe2, 0x52, 0xeb, 0x40, 0x93, 0xcd, 0xb4, 0x2a, 0x64, 0x75, 0x49, 0x39, 0x9f, 0x0a,
-	0x16, 0x6d, 0x27, 0xeb, 0x00, 0xee, 0xc1, 0xac, 0xf8, 0xa0, 0x2b, 0xcb, 0x3d, 0xc1, 0xa2, 0xfb,
-	0x49, 0x7b, 0x41, 0x04, 0x2f, 0x4f, 0x6d, 0xca, 0x67, 0x82, 0x45, 0xf7, 0x12, 0x77, 0xc6, 0x03,
-	0x80, 0x73, 0xa3, 0xd5, 0xfb, 0xd3, 0x73, 0xca, 0x2c, 0x9f, 0x3b, 0x99, 0x5e, 0x24, 0x14, 0x70,
-	0x30, 0xf4, 0x7c, 0x53, 0x6a, 0x65, 0x28, 0x7c, 0x06, 0x8f, 0xdf, 0x5c, 0xd4, 0xe6, 0x73, 0x2f,
-	0x7f, 0x47, 0x6b, 0xa1, 0x0f, 0xfc, 0x26, 0xa5, 0x2b, 0xf7, 0x93, 0xc1, 0x83, 0x0d, 0xa9, 0x5e,
-	0x9d, 0xc9, 0xb0, 0x45, 0xd3, 0x51, 0x8b, 0xbc, 0x41, 0x8b, 0xe6, 0xb7, 0x59, 0xb4, 0xd5, 0xb3,
-	0x08, 0xc1, 0x2b, 0x5e, 0x29, 0xcb, 0xb7, 0x1d, 0xd0, 0x9d, 0xc3, 0x17, 0xb0, 0xff, 0x4e, 0x1a,
-	0xbb, 0xf1, 0x50, 0x73, 0x57, 0xe3, 0x6f, 0x21, 0xb8, 0x9d, 0xd6, 0x36, 0x8f, 0x4b, 0x98, 0xc9,
-	0x26, 0xc0, 0x99, 0x98, 0x46, 0xbb, 0x47, 0x7b, 0xab, 0xb4, 0x94, 0xab, 0x4d, 0xe3, 0x5b, 0xc8,
-	0xd1, 0xdf, 0x09, 0xec, 0xf6, 0x52, 0xf8, 0x05, 0xb6, 0xba, 0x49, 0x61, 0xe8, 0x78, 0xa3, 0x6b,
-	0xe7, 0x1f, 0x8e, 0x62, 0xba, 0x61, 0x3c, 0xfd, 0xfe, 0xfb, 0xcf, 0x8f, 0x89, 0x08, 0xf7, 0xdd,
-	0x76, 0xb7, 0x1f, 0xc0, 0xc4, 0x5f, 0xdb, 0x6e, 0xbe, 0xc5, 0x8e, 0xfb, 0x92, 0x2d, 0x51, 0xc2,
-	0xcc, 0x0d, 0x14, 0x03, 0x57, 0x75, 0x60, 0x1f, 0xfc, 0xc5, 0x40, 0xb6, 0x53, 0x3b, 0x74, 0x6a,
-	0x8b, 0xe5, 0x98, 0x1a, 0x96, 0xe0, 0x35, 0x16, 0xa2, 0x70, 0xb5, 0x46, 0x86, 0xe0, 0x3f, 0x19,
-	0x41, 0xfc, 0xaf, 0x88, 0x63, 0x8a, 0xa7, 0x73, 0xf7, 0x93, 0x9f, 0xff, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0xbf, 0xca, 0x4f, 0x78, 0x01, 0x04, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4e, 0xdb, 0x4c,
+	0x10, 0xff, 0x1c, 0x27, 0x4e, 0x32, 0x7c, 0xf0, 0x85, 0xfd, 0xd2, 0xd6, 0x98, 0x24, 0x35, 0x46,
+	0xa2, 0x51, 0x2a, 0x25, 0x2a, 0xfd, 0x73, 0xe8, 0x0d, 0x11, 0x53, 0x41, 0x2b, 0x0a, 0xa6, 0x48,
+	0x3d, 0xf4, 0x62, 0x92, 0x0d, 0x35, 0x04, 0xaf, 0xf1, 0xae, 0x69, 0x55, 0xc4, 0x85, 0x57, 0xe8,
+	0x23, 0xf4, 0x0d, 0x7a, 0xef, 0x53, 0xf4, 0xda, 0x63, 0x1f, 0xa4, 0xf2, 0xee, 0x42, 0x9c, 0x10,
+	0x3b, 0x52, 0x6f, 0x9e, 0xdd, 0x99, 0xf9, 0xcd, 0xfc, 0x7e, 0xb3, 0x63, 0x58, 0xec, 0xe3, 0x0b,
+	0xaf, 0x87, 0xf7, 0x23, 0x1c, 0xe1, 0x76, 0x10, 0x12, 0x46, 0x90, 0xea, 0x06, 0x9e, 0x51, 0x3b,
+	0x26, 0xe4, 0x78, 0x88, 0x3b, 0x6e, 0xe0, 0x75, 0x5c, 0xdf, 0x27, 0xcc, 0x65, 0x1e, 0xf1, 0xa9,
+	0x70, 0xb1, 0x7e, 0x28, 0x50, 0xb7, 0xfd, 0xf3, 0x38, 0xa8, 0x3b, 0x8a, 0xdf, 0x66, 0xf8, 0xcc,
+	0xc1, 0xe7, 0x11, 0xa6, 0x0c, 0xdd, 0x07, 0xad, 0x8f, 0x2f, 0xec, 0xc3, 0x6d, 0x5d, 0x31, 0x95,
+	0x66, 0xd9, 0x91, 0x16, 0xaa, 0x41, 0x39, 0xc4, 0x03, 0x1c, 0x62, 0xbf, 0x87, 0xf5, 0x1c, 0xbf,
+	0x1a, 0x1d, 0xc4, 0xb7, 0x3d, 0xe2, 0x0f, 0xbc, 0xf0, 0x0c, 0xf7, 0x75, 0xd5, 0x54, 0x9a, 0x25,
+	0x67, 0x74, 0x80, 0xaa, 0x50, 0x18, 0xec, 0x91, 0x90, 0xe9, 0x79, 0x53, 0x69, 0xce, 0x3b, 0xc2,
+	0x40, 0x08, 0xf2, 0x7d, 0x97, 0xb9, 0x7a, 0xc1, 0x54, 0x9a, 0xff, 0x3a, 0xfc, 0x1b, 0x35, 0x00,
+	0x4e, 0x28, 0xf1, 0xdf, 0x1e, 0x9d, 0xe0, 0x1e, 0xd3, 0x35, 0x0e, 0x93, 0x38, 0xb1, 0x9e, 0x41,
+	0x23, 0xad, 0x7c, 0x1a, 0x10, 0x9f, 0xe2, 0x38, 0xeb, 0x60, 0xd3, 0x67, 0xbc, 0xfa, 0x79, 0x87,
+	0x7f, 0x5b, 0x4f, 0xe0, 0xc1, 0xd6, 0x30, 0xa2, 0x1f, 0x13, 0x31, 0x33, 0xda, 0xb5, 0x0c, 0xd0,
+	0xef, 0x86, 0x08, 0x08, 0xeb, 0x9b, 0x02, 0xff, 0x4d, 0xc0, 0x27, 0xf2, 0xe4, 0xd2, 0x69, 0x53,
+	0x33, 0x69, 0xcb, 0xa7, 0xd2, 0xa6, 0x4d, 0xa3, 0xad, 0x98, 0xa0, 0xed, 0xa6, 0xe9, 0x52, 0xa2,
+	0xe9, 0xe7, 0xb0, 0xfc, 0xc6, 0xa3, 0x6c, 0xa2, 0x50, 0x3a, 0xab, 0xf1, 0x1d, 0xa8, 0x4d, 0x0f,
+	0x93, 0xfc, 0xb6, 0xa0, 0xe0, 0xc5, 0x07, 0xba, 0x62, 0xaa, 0xcd, 0xb9, 0xf5, 0x6a, 0xdb, 0x0d,
+	0xbc, 0xf6, 0xa4, 0x18, 0xc2, 0xc5, 0xda, 0x02, 0xfd, 0x15, 0x66, 0x5d, 0xf2, 0xc9, 0x1f, 0x7a,
+	0xfe, 0xe9, 0x01, 0x73, 0x59, 0x34, 0x0b, 0xff, 0xb6, 0x95, 0x5c, 0xa2, 0x95, 0xef, 0x39, 0x58,
+	0x9a, 0x92, 0x48, 0x56, 0xf4, 0x77, 0x13, 0x7b, 0x83, 0xa3, 0x8e, 0x70, 0x52, 0xe6, 0x74, 0x4c,
+	0xa4, 0xc2, 0xa4, 0x48, 0x8f, 0x41, 0xa3, 0xbc, 0x1e, 0xae, 0xd2, 0xc2, 0xfa, 0xff, 0x82, 0x90,
+	0xf1, 0x52, 0xa5, 0x0b, 0x32, 0xa0, 0xc4, 0x87, 0xb7, 0xbf, 0xc1, 0xb8, 0x7e, 0x65, 0xe7, 0xd6,
+	0x8e, 0xdb, 0xa0, 0xd8, 0x67, 0x1b, 0x42, 0xc5, 0xb2, 0x23, 0x2d, 0xa4, 0x43, 0xd1, 0xed, 0x9d,
+	0xf2, 0x90, 0x32, 0xbf, 0xb8, 0x31, 0xe3, 0xc2, 0xf0, 0xe7, 0xc0, 0x0b, 0xf9, 0x1d, 0x88, 0x06,
+	0x6f, 0x0f, 0xac, 0x0f, 0x50, 0xe5, 0x42, 0xca, 0x4a, 0x66, 0x12, 0x5f, 0x85, 0xc2, 0xd0, 0x3b,
+	0xf3, 0x04, 0xf3, 0xaa, 0x23, 0x8c, 0xd8, 0x9b, 0x0c, 0x06, 0x14, 0x0b, 0xa2, 0x54, 0x47, 0x5a,
+	0x16, 0x81, 0x7b, 0x13, 0xd9, 0xa5, 0x1a, 0x0d, 0x00, 0x46, 0x98, 0x3b, 0xdc, 0x24, 0x91, 0x7c,
+	0x85, 0xaa, 0x93, 0x38, 0x41, 0x2f, 0x40, 0x0b, 0x31, 0x8d, 0x86, 0x31, 0x4e, 0x3c, 0x40, 0x0d,
+	0xce, 0x57, 0xaa, 0xba, 0x8e, 0xf4, 0x6e, 0xed, 0xc0, 0xc2, 0xb8, 0x07, 0x02, 0xd0, 0xf6, 0x0f,
+	0xed, 0x43, 0xbb, 0x5b, 0xf9, 0x07, 0x95, 0x20, 0x7f, 0x60, 0xef, 0xbe, 0xab, 0x28, 0xa8, 0x0c,
+	0x85, 0x8d, 0xcd, 0xd7, 0x76, 0xb7, 0x92, 0x8b, 0x1d, 0x76, 0xc5, 0xb7, 0x8a, 0xe6, 0xa0, 0x68,
+	0xbf, 0xdf, 0xdb, 0x76, 0xec, 0x6e, 0x25, 0xbf, 0xfe, 0x2b, 0x0f, 0x73, 0x89, 0x91, 0x45, 0x5f,
+	0xa0, 0x28, 0xb7, 0x0a, 0xb2, 0x78, 0x39, 0x99, 0x2b, 0xd2, 0x58, 0xcd, 0xf4, 0x91, 0x4b, 0x62,
+	0xed, 0xfa, 0xe7, 0xef, 0xaf, 0x39, 0xd3, 0x5a, 0xe6, 0x9b, 0x58, 0x2c, 0x6b, 0xda, 0xb9, 0x14,
+	0x64, 0x5f, 0x75, 0x78, 0xec, 0x4b, 0xa5, 0x85, 0x3c, 0x28, 0xf0, 0x45, 0x83, 0x6a, 0x3c, 0x6b,
+	0xca, 0x9e, 0x32, 0xea, 0x29, 0xb7, 0x12, 0x6d, 0x95, 0xa3, 0xd5, 0x5b, 0x59, 0x68, 0x28, 0x80,
+	0x7c, 0xac, 0x19, 0x32, 0x79, 0xae, 0x8c, 0xe5, 0x60, 0xac, 0x64, 0x78, 0x8c, 0x23, 0xa2, 0x4c,
+	0xc4, 0x6b, 0x05, 0x16, 0xef, 0x48, 0x8b, 0xea, 0x69, 0x92, 0x0b, 0xf0, 0x19, 0x13, 0x61, 0xb5,
+	0x39, 0x72, 0x13, 0xad, 0x4d, 0x47, 0xee, 0xcb, 0x28, 0xda, 0xb9, 0x8c, 0x1f, 0xf5, 0x15, 0x22,
+	0x30, 0x3f, 0x36, 0xaa, 0x68, 0x69, 0xd4, 0xdd, 0xc4, 0xe3, 0x30, 0x8c, 0x69, 0x57, 0x12, 0xf7,
+	0x11, 0xc7, 0x5d, 0x41, 0x0f, 0x67, 0xe0, 0x1e, 0x69, 0xfc, 0x5f, 0xfb, 0xf4, 0xcf, 0x00, 0x96,
+	0x96, 0x93, 0xe2, 0xa3, 0x07, 0x00, 0x00,
 }
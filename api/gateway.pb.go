@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
+import google_protobuf "github.com/golang/protobuf/ptypes/struct"
 
 import (
 	context "golang.org/x/net/context"
@@ -62,6 +63,19 @@ type CreateGatewayRequest struct {
 	Ping bool `protobuf:"varint,9,opt,name=ping" json:"ping,omitempty"`
 	// ID of the network-server to which the gateway is connected.
 	NetworkServerID int64 `protobuf:"varint,10,opt,name=networkServerID" json:"networkServerID,omitempty"`
+	// Tags (key / value) of the gateway.
+	Tags map[string]string `protobuf:"bytes,11,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Ping interval in seconds (0 = use the default interval).
+	PingInterval uint32 `protobuf:"varint,12,opt,name=pingInterval" json:"pingInterval,omitempty"`
+	// Ping frequency in Hz (0 = use the default frequency).
+	PingFrequency uint32 `protobuf:"varint,13,opt,name=pingFrequency" json:"pingFrequency,omitempty"`
+	// Ping data-rate (only used when customPingDR is set).
+	PingDR uint32 `protobuf:"varint,14,opt,name=pingDR" json:"pingDR,omitempty"`
+	// Use the pingDR data-rate instead of the default data-rate.
+	CustomPingDR bool `protobuf:"varint,15,opt,name=customPingDR" json:"customPingDR,omitempty"`
+	// The gateway reports fine (GPS synchronized) timestamps, which are used
+	// for TDOA geolocation.
+	FineTimestamp bool `protobuf:"varint,16,opt,name=fineTimestamp" json:"fineTimestamp,omitempty"`
 }
 
 func (m *CreateGatewayRequest) Reset()                    { *m = CreateGatewayRequest{} }
@@ -139,6 +153,48 @@ func (m *CreateGatewayRequest) GetNetworkServerID() int64 {
 	return 0
 }
 
+func (m *CreateGatewayRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *CreateGatewayRequest) GetPingInterval() uint32 {
+	if m != nil {
+		return m.PingInterval
+	}
+	return 0
+}
+
+func (m *CreateGatewayRequest) GetPingFrequency() uint32 {
+	if m != nil {
+		return m.PingFrequency
+	}
+	return 0
+}
+
+func (m *CreateGatewayRequest) GetPingDR() uint32 {
+	if m != nil {
+		return m.PingDR
+	}
+	return 0
+}
+
+func (m *CreateGatewayRequest) GetCustomPingDR() bool {
+	if m != nil {
+		return m.CustomPingDR
+	}
+	return false
+}
+
+func (m *CreateGatewayRequest) GetFineTimestamp() bool {
+	if m != nil {
+		return m.FineTimestamp
+	}
+	return false
+}
+
 type CreateGatewayResponse struct {
 }
 
@@ -193,6 +249,23 @@ type GetGatewayResponse struct {
 	Ping bool `protobuf:"varint,13,opt,name=ping" json:"ping,omitempty"`
 	// ID of the network-server to which the gateway is connected.
 	NetworkServerID int64 `protobuf:"varint,14,opt,name=networkServerID" json:"networkServerID,omitempty"`
+	// Tags (key / value) of the gateway.
+	Tags map[string]string `protobuf:"bytes,15,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Status of the gateway (UNKNOWN, ONLINE or OFFLINE).
+	Status string `protobuf:"bytes,16,opt,name=status" json:"status,omitempty"`
+	// Timestamp of the last status change.
+	StatusChangedAt string `protobuf:"bytes,17,opt,name=statusChangedAt" json:"statusChangedAt,omitempty"`
+	// Ping interval in seconds (0 = use the default interval).
+	PingInterval uint32 `protobuf:"varint,18,opt,name=pingInterval" json:"pingInterval,omitempty"`
+	// Ping frequency in Hz (0 = use the default frequency).
+	PingFrequency uint32 `protobuf:"varint,19,opt,name=pingFrequency" json:"pingFrequency,omitempty"`
+	// Ping data-rate (only used when customPingDR is set).
+	PingDR uint32 `protobuf:"varint,20,opt,name=pingDR" json:"pingDR,omitempty"`
+	// Use the pingDR data-rate instead of the default data-rate.
+	CustomPingDR bool `protobuf:"varint,21,opt,name=customPingDR" json:"customPingDR,omitempty"`
+	// The gateway reports fine (GPS synchronized) timestamps, which are used
+	// for TDOA geolocation.
+	FineTimestamp bool `protobuf:"varint,22,opt,name=fineTimestamp" json:"fineTimestamp,omitempty"`
 }
 
 func (m *GetGatewayResponse) Reset()                    { *m = GetGatewayResponse{} }
@@ -298,6 +371,62 @@ func (m *GetGatewayResponse) GetNetworkServerID() int64 {
 	return 0
 }
 
+func (m *GetGatewayResponse) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *GetGatewayResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetGatewayResponse) GetStatusChangedAt() string {
+	if m != nil {
+		return m.StatusChangedAt
+	}
+	return ""
+}
+
+func (m *GetGatewayResponse) GetPingInterval() uint32 {
+	if m != nil {
+		return m.PingInterval
+	}
+	return 0
+}
+
+func (m *GetGatewayResponse) GetPingFrequency() uint32 {
+	if m != nil {
+		return m.PingFrequency
+	}
+	return 0
+}
+
+func (m *GetGatewayResponse) GetPingDR() uint32 {
+	if m != nil {
+		return m.PingDR
+	}
+	return 0
+}
+
+func (m *GetGatewayResponse) GetCustomPingDR() bool {
+	if m != nil {
+		return m.CustomPingDR
+	}
+	return false
+}
+
+func (m *GetGatewayResponse) GetFineTimestamp() bool {
+	if m != nil {
+		return m.FineTimestamp
+	}
+	return false
+}
+
 type DeleteGatewayRequest struct {
 	// Hex encoded mac address.
 	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
@@ -365,6 +494,9 @@ type ListGatewayRequest struct {
 	// ID of the organization for which to filter on, when left blank the
 	// response will return all gateways to which the user has access to.
 	OrganizationID int64 `protobuf:"varint,3,opt,name=organizationID" json:"organizationID,omitempty"`
+	// Tag selector, e.g. "site=berlin,floor!=3". Supported are key=value,
+	// key!=value, key (tag exists) and !key (tag does not exist).
+	TagSelector string `protobuf:"bytes,4,opt,name=tagSelector" json:"tagSelector,omitempty"`
 }
 
 func (m *ListGatewayRequest) Reset()                    { *m = ListGatewayRequest{} }
@@ -393,6 +525,13 @@ func (m *ListGatewayRequest) GetOrganizationID() int64 {
 	return 0
 }
 
+func (m *ListGatewayRequest) GetTagSelector() string {
+	if m != nil {
+		return m.TagSelector
+	}
+	return ""
+}
+
 type ListGatewayItem struct {
 	// Hex encoded mac address.
 	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
@@ -408,6 +547,12 @@ type ListGatewayItem struct {
 	OrganizationID int64 `protobuf:"varint,6,opt,name=organizationID" json:"organizationID,omitempty"`
 	// ID of the network-server to which the gateway is connected.
 	NetworkServerID int64 `protobuf:"varint,7,opt,name=networkServerID" json:"networkServerID,omitempty"`
+	// Tags (key / value) of the gateway.
+	Tags map[string]string `protobuf:"bytes,8,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Status of the gateway (UNKNOWN, ONLINE or OFFLINE).
+	Status string `protobuf:"bytes,9,opt,name=status" json:"status,omitempty"`
+	// The timestamp of the most recent data from the gateway.
+	LastSeenAt string `protobuf:"bytes,10,opt,name=lastSeenAt" json:"lastSeenAt,omitempty"`
 }
 
 func (m *ListGatewayItem) Reset()                    { *m = ListGatewayItem{} }
@@ -464,6 +609,27 @@ func (m *ListGatewayItem) GetNetworkServerID() int64 {
 	return 0
 }
 
+func (m *ListGatewayItem) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListGatewayItem) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ListGatewayItem) GetLastSeenAt() string {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return ""
+}
+
 type ListGatewayResponse struct {
 	// Total number of nodes available within the result-set.
 	TotalCount int32 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
@@ -509,6 +675,19 @@ type UpdateGatewayRequest struct {
 	ChannelConfigurationID int64 `protobuf:"varint,8,opt,name=channelConfigurationID" json:"channelConfigurationID,omitempty"`
 	// Gateway sends out a periodic ping.
 	Ping bool `protobuf:"varint,9,opt,name=ping" json:"ping,omitempty"`
+	// Tags (key / value) of the gateway.
+	Tags map[string]string `protobuf:"bytes,10,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Ping interval in seconds (0 = use the default interval).
+	PingInterval uint32 `protobuf:"varint,11,opt,name=pingInterval" json:"pingInterval,omitempty"`
+	// Ping frequency in Hz (0 = use the default frequency).
+	PingFrequency uint32 `protobuf:"varint,12,opt,name=pingFrequency" json:"pingFrequency,omitempty"`
+	// Ping data-rate (only used when customPingDR is set).
+	PingDR uint32 `protobuf:"varint,13,opt,name=pingDR" json:"pingDR,omitempty"`
+	// Use the pingDR data-rate instead of the default data-rate.
+	CustomPingDR bool `protobuf:"varint,14,opt,name=customPingDR" json:"customPingDR,omitempty"`
+	// The gateway reports fine (GPS synchronized) timestamps, which are used
+	// for TDOA geolocation.
+	FineTimestamp bool `protobuf:"varint,15,opt,name=fineTimestamp" json:"fineTimestamp,omitempty"`
 }
 
 func (m *UpdateGatewayRequest) Reset()                    { *m = UpdateGatewayRequest{} }
@@ -579,6 +758,48 @@ func (m *UpdateGatewayRequest) GetPing() bool {
 	return false
 }
 
+func (m *UpdateGatewayRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *UpdateGatewayRequest) GetPingInterval() uint32 {
+	if m != nil {
+		return m.PingInterval
+	}
+	return 0
+}
+
+func (m *UpdateGatewayRequest) GetPingFrequency() uint32 {
+	if m != nil {
+		return m.PingFrequency
+	}
+	return 0
+}
+
+func (m *UpdateGatewayRequest) GetPingDR() uint32 {
+	if m != nil {
+		return m.PingDR
+	}
+	return 0
+}
+
+func (m *UpdateGatewayRequest) GetCustomPingDR() bool {
+	if m != nil {
+		return m.CustomPingDR
+	}
+	return false
+}
+
+func (m *UpdateGatewayRequest) GetFineTimestamp() bool {
+	if m != nil {
+		return m.FineTimestamp
+	}
+	return false
+}
+
 type UpdateGatewayResponse struct {
 }
 
@@ -1459,6 +1680,353 @@ func (m *GetLastPingResponse) GetPingRX() []*PingRX {
 	return nil
 }
 
+type ListGatewayPingsRequest struct {
+	// Hex encoded mac address of the gateway.
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	// Max number of pings to return in the result-set.
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int32 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListGatewayPingsRequest) Reset()                    { *m = ListGatewayPingsRequest{} }
+func (m *ListGatewayPingsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListGatewayPingsRequest) ProtoMessage()               {}
+func (*ListGatewayPingsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{38} }
+
+func (m *ListGatewayPingsRequest) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *ListGatewayPingsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListGatewayPingsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type GatewayPingItem struct {
+	// ID of the ping.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Created at.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Frequency.
+	Frequency uint32 `protobuf:"varint,3,opt,name=frequency" json:"frequency,omitempty"`
+	// Data-rate.
+	Dr uint32 `protobuf:"varint,4,opt,name=dr" json:"dr,omitempty"`
+	// Gateways and meta-data of reception.
+	PingRX []*PingRX `protobuf:"bytes,5,rep,name=pingRX" json:"pingRX,omitempty"`
+}
+
+func (m *GatewayPingItem) Reset()                    { *m = GatewayPingItem{} }
+func (m *GatewayPingItem) String() string            { return proto.CompactTextString(m) }
+func (*GatewayPingItem) ProtoMessage()               {}
+func (*GatewayPingItem) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{39} }
+
+func (m *GatewayPingItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GatewayPingItem) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GatewayPingItem) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *GatewayPingItem) GetDr() uint32 {
+	if m != nil {
+		return m.Dr
+	}
+	return 0
+}
+
+func (m *GatewayPingItem) GetPingRX() []*PingRX {
+	if m != nil {
+		return m.PingRX
+	}
+	return nil
+}
+
+type ListGatewayPingsResponse struct {
+	// Total number of pings available within the result-set.
+	TotalCount int32 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	// Pings within this result-set.
+	Result []*GatewayPingItem `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListGatewayPingsResponse) Reset()                    { *m = ListGatewayPingsResponse{} }
+func (m *ListGatewayPingsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListGatewayPingsResponse) ProtoMessage()               {}
+func (*ListGatewayPingsResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{40} }
+
+func (m *ListGatewayPingsResponse) GetTotalCount() int32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListGatewayPingsResponse) GetResult() []*GatewayPingItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type GetGatewayCoverageRequest struct {
+	// ID of the organization.
+	OrganizationID int64 `protobuf:"varint,1,opt,name=organizationID" json:"organizationID,omitempty"`
+	// Geohash precision (length) of the cells, 1 - 9 (default 7).
+	Precision uint32 `protobuf:"varint,2,opt,name=precision" json:"precision,omitempty"`
+}
+
+func (m *GetGatewayCoverageRequest) Reset()                    { *m = GetGatewayCoverageRequest{} }
+func (m *GetGatewayCoverageRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayCoverageRequest) ProtoMessage()               {}
+func (*GetGatewayCoverageRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{41} }
+
+func (m *GetGatewayCoverageRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *GetGatewayCoverageRequest) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+// GeoJSON FeatureCollection containing a feature per geohash cell.
+type GetGatewayCoverageResponse struct {
+	// GeoJSON type (FeatureCollection).
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// Features (cells).
+	Features []*GatewayCoverageFeature `protobuf:"bytes,2,rep,name=features" json:"features,omitempty"`
+}
+
+func (m *GetGatewayCoverageResponse) Reset()                    { *m = GetGatewayCoverageResponse{} }
+func (m *GetGatewayCoverageResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetGatewayCoverageResponse) ProtoMessage()               {}
+func (*GetGatewayCoverageResponse) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{42} }
+
+func (m *GetGatewayCoverageResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GetGatewayCoverageResponse) GetFeatures() []*GatewayCoverageFeature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+// GeoJSON Feature of a geohash cell.
+type GatewayCoverageFeature struct {
+	// GeoJSON type (Feature).
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// Outline of the cell.
+	Geometry *GatewayCoverageGeometry `protobuf:"bytes,2,opt,name=geometry" json:"geometry,omitempty"`
+	// Bounding box of the cell (west, south, east, north).
+	Bbox []float64 `protobuf:"fixed64,3,rep,packed,name=bbox" json:"bbox,omitempty"`
+	// Coverage within the cell.
+	Properties *GatewayCoverageProperties `protobuf:"bytes,4,opt,name=properties" json:"properties,omitempty"`
+}
+
+func (m *GatewayCoverageFeature) Reset()                    { *m = GatewayCoverageFeature{} }
+func (m *GatewayCoverageFeature) String() string            { return proto.CompactTextString(m) }
+func (*GatewayCoverageFeature) ProtoMessage()               {}
+func (*GatewayCoverageFeature) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{43} }
+
+func (m *GatewayCoverageFeature) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GatewayCoverageFeature) GetGeometry() *GatewayCoverageGeometry {
+	if m != nil {
+		return m.Geometry
+	}
+	return nil
+}
+
+func (m *GatewayCoverageFeature) GetBbox() []float64 {
+	if m != nil {
+		return m.Bbox
+	}
+	return nil
+}
+
+func (m *GatewayCoverageFeature) GetProperties() *GatewayCoverageProperties {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+// GeoJSON Polygon geometry.
+type GatewayCoverageGeometry struct {
+	// GeoJSON type (Polygon).
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// Linear rings of the polygon. A cell has a single ring with the
+	// [longitude, latitude] positions of its corners (counterclockwise,
+	// the first and last position are equal).
+	Coordinates *google_protobuf.ListValue `protobuf:"bytes,2,opt,name=coordinates" json:"coordinates,omitempty"`
+}
+
+func (m *GatewayCoverageGeometry) Reset()                    { *m = GatewayCoverageGeometry{} }
+func (m *GatewayCoverageGeometry) String() string            { return proto.CompactTextString(m) }
+func (*GatewayCoverageGeometry) ProtoMessage()               {}
+func (*GatewayCoverageGeometry) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{44} }
+
+func (m *GatewayCoverageGeometry) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GatewayCoverageGeometry) GetCoordinates() *google_protobuf.ListValue {
+	if m != nil {
+		return m.Coordinates
+	}
+	return nil
+}
+
+type GatewayCoverageProperties struct {
+	// Geohash of the cell.
+	Geohash string `protobuf:"bytes,1,opt,name=geohash" json:"geohash,omitempty"`
+	// Gateways receiving transmissions within the cell.
+	Gateways []*GatewayCoverageRX `protobuf:"bytes,2,rep,name=gateways" json:"gateways,omitempty"`
+}
+
+func (m *GatewayCoverageProperties) Reset()                    { *m = GatewayCoverageProperties{} }
+func (m *GatewayCoverageProperties) String() string            { return proto.CompactTextString(m) }
+func (*GatewayCoverageProperties) ProtoMessage()               {}
+func (*GatewayCoverageProperties) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{45} }
+
+func (m *GatewayCoverageProperties) GetGeohash() string {
+	if m != nil {
+		return m.Geohash
+	}
+	return ""
+}
+
+func (m *GatewayCoverageProperties) GetGateways() []*GatewayCoverageRX {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+type GatewayCoverageRX struct {
+	// Hex encoded mac address of the gateway.
+	Mac string `protobuf:"bytes,1,opt,name=mac" json:"mac,omitempty"`
+	// Number of receptions.
+	Count uint32 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	// Min. RSSI.
+	RssiMin int32 `protobuf:"varint,3,opt,name=rssiMin" json:"rssiMin,omitempty"`
+	// Average RSSI.
+	RssiAvg float64 `protobuf:"fixed64,4,opt,name=rssiAvg" json:"rssiAvg,omitempty"`
+	// Max. RSSI.
+	RssiMax int32 `protobuf:"varint,5,opt,name=rssiMax" json:"rssiMax,omitempty"`
+	// Min. LoRa SNR.
+	LoRaSNRMin float64 `protobuf:"fixed64,6,opt,name=loRaSNRMin" json:"loRaSNRMin,omitempty"`
+	// Average LoRa SNR.
+	LoRaSNRAvg float64 `protobuf:"fixed64,7,opt,name=loRaSNRAvg" json:"loRaSNRAvg,omitempty"`
+	// Max. LoRa SNR.
+	LoRaSNRMax float64 `protobuf:"fixed64,8,opt,name=loRaSNRMax" json:"loRaSNRMax,omitempty"`
+}
+
+func (m *GatewayCoverageRX) Reset()                    { *m = GatewayCoverageRX{} }
+func (m *GatewayCoverageRX) String() string            { return proto.CompactTextString(m) }
+func (*GatewayCoverageRX) ProtoMessage()               {}
+func (*GatewayCoverageRX) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{46} }
+
+func (m *GatewayCoverageRX) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *GatewayCoverageRX) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GatewayCoverageRX) GetRssiMin() int32 {
+	if m != nil {
+		return m.RssiMin
+	}
+	return 0
+}
+
+func (m *GatewayCoverageRX) GetRssiAvg() float64 {
+	if m != nil {
+		return m.RssiAvg
+	}
+	return 0
+}
+
+func (m *GatewayCoverageRX) GetRssiMax() int32 {
+	if m != nil {
+		return m.RssiMax
+	}
+	return 0
+}
+
+func (m *GatewayCoverageRX) GetLoRaSNRMin() float64 {
+	if m != nil {
+		return m.LoRaSNRMin
+	}
+	return 0
+}
+
+func (m *GatewayCoverageRX) GetLoRaSNRAvg() float64 {
+	if m != nil {
+		return m.LoRaSNRAvg
+	}
+	return 0
+}
+
+func (m *GatewayCoverageRX) GetLoRaSNRMax() float64 {
+	if m != nil {
+		return m.LoRaSNRMax
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CreateGatewayRequest)(nil), "api.CreateGatewayRequest")
 	proto.RegisterType((*CreateGatewayResponse)(nil), "api.CreateGatewayResponse")
@@ -1498,6 +2066,15 @@ func init() {
 	proto.RegisterType((*PingRX)(nil), "api.PingRX")
 	proto.RegisterType((*GetLastPingRequest)(nil), "api.GetLastPingRequest")
 	proto.RegisterType((*GetLastPingResponse)(nil), "api.GetLastPingResponse")
+	proto.RegisterType((*ListGatewayPingsRequest)(nil), "api.ListGatewayPingsRequest")
+	proto.RegisterType((*GatewayPingItem)(nil), "api.GatewayPingItem")
+	proto.RegisterType((*ListGatewayPingsResponse)(nil), "api.ListGatewayPingsResponse")
+	proto.RegisterType((*GetGatewayCoverageRequest)(nil), "api.GetGatewayCoverageRequest")
+	proto.RegisterType((*GetGatewayCoverageResponse)(nil), "api.GetGatewayCoverageResponse")
+	proto.RegisterType((*GatewayCoverageFeature)(nil), "api.GatewayCoverageFeature")
+	proto.RegisterType((*GatewayCoverageGeometry)(nil), "api.GatewayCoverageGeometry")
+	proto.RegisterType((*GatewayCoverageProperties)(nil), "api.GatewayCoverageProperties")
+	proto.RegisterType((*GatewayCoverageRX)(nil), "api.GatewayCoverageRX")
 	proto.RegisterEnum("api.Modulation", Modulation_name, Modulation_value)
 }
 
@@ -1532,6 +2109,9 @@ type GatewayClient interface {
 	// GetExtraChannelsForChannelConfigurationID returns the extra channels for
 	// the given channel-configuration id.
 	GetExtraChannelsForChannelConfigurationID(ctx context.Context, in *GetExtraChannelsForChannelConfigurationIDRequest, opts ...grpc.CallOption) (*GetExtraChannelsForChannelConfigurationIDResponse, error)
+	// GetCoverage returns the coverage of the gateways of an organization as
+	// GeoJSON feature collection, binned into geohash cells.
+	GetCoverage(ctx context.Context, in *GetGatewayCoverageRequest, opts ...grpc.CallOption) (*GetGatewayCoverageResponse, error)
 	// Create creates the given gateway.
 	Create(ctx context.Context, in *CreateGatewayRequest, opts ...grpc.CallOption) (*CreateGatewayResponse, error)
 	// Get returns the gateway for the requested mac address.
@@ -1549,6 +2129,9 @@ type GatewayClient interface {
 	GetStats(ctx context.Context, in *GetGatewayStatsRequest, opts ...grpc.CallOption) (*GetGatewayStatsResponse, error)
 	// GetLastPing returns the last emitted ping and gateways receiving this ping.
 	GetLastPing(ctx context.Context, in *GetLastPingRequest, opts ...grpc.CallOption) (*GetLastPingResponse, error)
+	// ListPings lists the pings sent by the gateway and the gateways
+	// receiving these pings, most recent first.
+	ListPings(ctx context.Context, in *ListGatewayPingsRequest, opts ...grpc.CallOption) (*ListGatewayPingsResponse, error)
 }
 
 type gatewayClient struct {
@@ -1640,6 +2223,15 @@ func (c *gatewayClient) GetExtraChannelsForChannelConfigurationID(ctx context.Co
 	return out, nil
 }
 
+func (c *gatewayClient) GetCoverage(ctx context.Context, in *GetGatewayCoverageRequest, opts ...grpc.CallOption) (*GetGatewayCoverageResponse, error) {
+	out := new(GetGatewayCoverageResponse)
+	err := grpc.Invoke(ctx, "/api.Gateway/GetCoverage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayClient) Create(ctx context.Context, in *CreateGatewayRequest, opts ...grpc.CallOption) (*CreateGatewayResponse, error) {
 	out := new(CreateGatewayResponse)
 	err := grpc.Invoke(ctx, "/api.Gateway/Create", in, out, c.cc, opts...)
@@ -1712,6 +2304,15 @@ func (c *gatewayClient) GetLastPing(ctx context.Context, in *GetLastPingRequest,
 	return out, nil
 }
 
+func (c *gatewayClient) ListPings(ctx context.Context, in *ListGatewayPingsRequest, opts ...grpc.CallOption) (*ListGatewayPingsResponse, error) {
+	out := new(ListGatewayPingsResponse)
+	err := grpc.Invoke(ctx, "/api.Gateway/ListPings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Gateway service
 
 type GatewayServer interface {
@@ -1735,6 +2336,9 @@ type GatewayServer interface {
 	// GetExtraChannelsForChannelConfigurationID returns the extra channels for
 	// the given channel-configuration id.
 	GetExtraChannelsForChannelConfigurationID(context.Context, *GetExtraChannelsForChannelConfigurationIDRequest) (*GetExtraChannelsForChannelConfigurationIDResponse, error)
+	// GetCoverage returns the coverage of the gateways of an organization as
+	// GeoJSON feature collection, binned into geohash cells.
+	GetCoverage(context.Context, *GetGatewayCoverageRequest) (*GetGatewayCoverageResponse, error)
 	// Create creates the given gateway.
 	Create(context.Context, *CreateGatewayRequest) (*CreateGatewayResponse, error)
 	// Get returns the gateway for the requested mac address.
@@ -1752,6 +2356,9 @@ type GatewayServer interface {
 	GetStats(context.Context, *GetGatewayStatsRequest) (*GetGatewayStatsResponse, error)
 	// GetLastPing returns the last emitted ping and gateways receiving this ping.
 	GetLastPing(context.Context, *GetLastPingRequest) (*GetLastPingResponse, error)
+	// ListPings lists the pings sent by the gateway and the gateways
+	// receiving these pings, most recent first.
+	ListPings(context.Context, *ListGatewayPingsRequest) (*ListGatewayPingsResponse, error)
 }
 
 func RegisterGatewayServer(s *grpc.Server, srv GatewayServer) {
@@ -1920,6 +2527,24 @@ func _Gateway_GetExtraChannelsForChannelConfigurationID_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_GetCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).GetCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Gateway/GetCoverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).GetCoverage(ctx, req.(*GetGatewayCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Gateway_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateGatewayRequest)
 	if err := dec(in); err != nil {
@@ -2064,6 +2689,24 @@ func _Gateway_GetLastPing_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_ListPings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayPingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).ListPings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Gateway/ListPings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).ListPings(ctx, req.(*ListGatewayPingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Gateway_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Gateway",
 	HandlerType: (*GatewayServer)(nil),
@@ -2104,6 +2747,10 @@ var _Gateway_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetExtraChannelsForChannelConfigurationID",
 			Handler:    _Gateway_GetExtraChannelsForChannelConfigurationID_Handler,
 		},
+		{
+			MethodName: "GetCoverage",
+			Handler:    _Gateway_GetCoverage_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _Gateway_Create_Handler,
@@ -2136,6 +2783,10 @@ var _Gateway_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLastPing",
 			Handler:    _Gateway_GetLastPing_Handler,
 		},
+		{
+			MethodName: "ListPings",
+			Handler:    _Gateway_ListPings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway.proto",
@@ -2144,113 +2795,154 @@ var _Gateway_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("gateway.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 1728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xdc, 0xd4,
-	0x16, 0x7f, 0x77, 0xbe, 0x32, 0x39, 0x69, 0xbe, 0x6e, 0xd2, 0x64, 0xe2, 0x8c, 0xd2, 0x89, 0x9b,
-	0xe6, 0x4d, 0xf3, 0xfa, 0x32, 0x7d, 0x6d, 0x5f, 0x85, 0x10, 0x42, 0xaa, 0x92, 0x36, 0xaa, 0x1a,
-	0x68, 0xe5, 0xb4, 0xe2, 0x43, 0x20, 0x74, 0x3b, 0xbe, 0x99, 0x98, 0x7a, 0xec, 0xc1, 0xbe, 0xd3,
-	0xb4, 0x54, 0x11, 0x52, 0x17, 0xec, 0xd8, 0x50, 0x09, 0x56, 0x80, 0xc4, 0x0a, 0xf1, 0x27, 0xf0,
-	0x0f, 0x20, 0xb1, 0x60, 0x01, 0x3b, 0xd6, 0x08, 0xfe, 0x0d, 0xe4, 0xeb, 0x6b, 0x8f, 0xed, 0xb9,
-	0xf6, 0x78, 0x28, 0x95, 0x58, 0xb0, 0x1b, 0x9f, 0xfb, 0xf5, 0x3b, 0xbf, 0xf3, 0x3b, 0xe7, 0x9e,
-	0xdc, 0xc0, 0x74, 0x87, 0x30, 0x7a, 0x4c, 0x1e, 0x6f, 0xf7, 0x1c, 0x9b, 0xd9, 0xb8, 0x48, 0x7a,
-	0x86, 0x52, 0xef, 0xd8, 0x76, 0xc7, 0xa4, 0x2d, 0xd2, 0x33, 0x5a, 0xc4, 0xb2, 0x6c, 0x46, 0x98,
-	0x61, 0x5b, 0xae, 0x3f, 0x45, 0xfd, 0xa9, 0x00, 0x8b, 0x3b, 0x0e, 0x25, 0x8c, 0xee, 0xf9, 0x4b,
-	0x35, 0xfa, 0x41, 0x9f, 0xba, 0x0c, 0xcf, 0x41, 0xb1, 0x4b, 0xda, 0x35, 0xd4, 0x40, 0xcd, 0x49,
-	0xcd, 0xfb, 0x89, 0x31, 0x94, 0x2c, 0xd2, 0xa5, 0xb5, 0x02, 0x37, 0xf1, 0xdf, 0xb8, 0x01, 0x53,
-	0x3a, 0x75, 0xdb, 0x8e, 0xd1, 0xf3, 0x36, 0xad, 0x15, 0xf9, 0x50, 0xd4, 0x84, 0x15, 0xa8, 0x9a,
-	0x84, 0x19, 0xac, 0xaf, 0xd3, 0x5a, 0xa9, 0x81, 0x9a, 0x48, 0x0b, 0xbf, 0x71, 0x1d, 0x26, 0x4d,
-	0xdb, 0xea, 0xf8, 0x83, 0x65, 0x3e, 0x38, 0x30, 0x78, 0x2b, 0x89, 0x29, 0x56, 0x56, 0xfc, 0x95,
-	0xc1, 0x37, 0xde, 0x84, 0x19, 0xdb, 0xe9, 0x10, 0xcb, 0xf8, 0x90, 0x7b, 0x73, 0x73, 0xb7, 0x36,
-	0xd1, 0x40, 0xcd, 0xa2, 0x96, 0xb0, 0xe2, 0xab, 0xb0, 0xd4, 0x3e, 0x22, 0x96, 0x45, 0xcd, 0x1d,
-	0xdb, 0x3a, 0x34, 0x3a, 0x7d, 0x27, 0x98, 0x5f, 0xe5, 0xf3, 0x53, 0x46, 0x3d, 0x5f, 0x7b, 0x86,
-	0xd5, 0xa9, 0x4d, 0x36, 0x50, 0xb3, 0xaa, 0xf1, 0xdf, 0xb8, 0x09, 0xb3, 0x16, 0x65, 0xc7, 0xb6,
-	0xf3, 0xe0, 0x80, 0x3a, 0x0f, 0xa9, 0x73, 0x73, 0xb7, 0x06, 0x7c, 0x93, 0xa4, 0x59, 0x5d, 0x86,
-	0xd3, 0x09, 0x4e, 0xdd, 0x9e, 0x6d, 0xb9, 0x54, 0x3d, 0x07, 0xf3, 0x7b, 0x94, 0x8d, 0x62, 0x5a,
-	0xfd, 0xb1, 0x08, 0x38, 0x3a, 0xcf, 0x5f, 0xfd, 0x37, 0x0f, 0x49, 0x1d, 0x26, 0xdb, 0xdc, 0x69,
-	0xfd, 0x1a, 0xe3, 0xd1, 0x98, 0xd4, 0x06, 0x06, 0x6f, 0xb4, 0xdf, 0xd3, 0xc5, 0x68, 0xd5, 0x1f,
-	0x0d, 0x0d, 0x1e, 0xe6, 0x43, 0xc3, 0x71, 0xd9, 0x01, 0xa5, 0xd6, 0x35, 0xc6, 0x59, 0x9f, 0xd4,
-	0xa2, 0x26, 0xbc, 0x06, 0x60, 0x92, 0x70, 0x02, 0xf0, 0x09, 0x11, 0x8b, 0x44, 0x10, 0x53, 0x63,
-	0x0a, 0xe2, 0x54, 0x2e, 0x41, 0x4c, 0x67, 0x0b, 0x62, 0x46, 0x2e, 0x88, 0x26, 0x2c, 0xee, 0x52,
-	0x93, 0x8e, 0x4e, 0x32, 0xb5, 0x05, 0xab, 0x7b, 0xd4, 0xa2, 0xce, 0x40, 0x3c, 0x77, 0xed, 0x07,
-	0xd4, 0x4a, 0x5f, 0x70, 0x05, 0xea, 0xf2, 0x05, 0x42, 0x34, 0x8b, 0x50, 0x66, 0x9e, 0x41, 0xac,
-	0xf1, 0x3f, 0x3c, 0x85, 0x26, 0x00, 0x09, 0x85, 0xbe, 0x0f, 0x78, 0xdf, 0x70, 0x93, 0x12, 0x5d,
-	0x84, 0xb2, 0x69, 0x74, 0x0d, 0xc6, 0x37, 0x29, 0x6b, 0xfe, 0x07, 0x5e, 0x82, 0x8a, 0x7d, 0x78,
-	0xe8, 0x52, 0xc6, 0xf5, 0x57, 0xd6, 0xc4, 0x97, 0x24, 0x16, 0x45, 0x59, 0x2c, 0xd4, 0xdf, 0x11,
-	0xcc, 0x46, 0x0e, 0xbb, 0xc9, 0x68, 0xf7, 0x2f, 0xd3, 0x78, 0x4c, 0x8d, 0xa5, 0x4c, 0x35, 0x96,
-	0x93, 0x6a, 0x1c, 0xc6, 0x5f, 0x91, 0x6a, 0x49, 0x12, 0xff, 0x09, 0x79, 0xfc, 0xdb, 0xb0, 0x10,
-	0x63, 0x55, 0xc4, 0x66, 0x0d, 0x80, 0xd9, 0x8c, 0x98, 0x3b, 0x76, 0xdf, 0x0a, 0xb8, 0x8d, 0x58,
-	0xf0, 0x05, 0xa8, 0x38, 0xd4, 0xed, 0x9b, 0x1e, 0xc1, 0xc5, 0xe6, 0xd4, 0xa5, 0xc5, 0x6d, 0xd2,
-	0x33, 0xb6, 0x13, 0x94, 0x69, 0x62, 0x8e, 0xfa, 0x4d, 0x01, 0x16, 0xef, 0x71, 0x27, 0xfe, 0x29,
-	0xe5, 0xd9, 0xa5, 0xdc, 0x93, 0x7f, 0x82, 0x29, 0x21, 0xff, 0xdf, 0x10, 0x9c, 0x12, 0xb6, 0x03,
-	0x46, 0x98, 0xeb, 0xf9, 0xc5, 0x8c, 0x2e, 0x75, 0x19, 0xe9, 0xf6, 0x04, 0x83, 0x03, 0x03, 0xbe,
-	0x00, 0xf3, 0xce, 0xa3, 0x3b, 0xa4, 0xfd, 0x80, 0x32, 0x57, 0xa3, 0x6d, 0x6a, 0x3c, 0xa4, 0xba,
-	0x48, 0x86, 0xe1, 0x01, 0x7c, 0x11, 0x16, 0x86, 0x8c, 0xb7, 0x6f, 0x71, 0xa6, 0xcb, 0x9a, 0x6c,
-	0xc8, 0xdb, 0x9f, 0x0d, 0xed, 0x5f, 0xf2, 0xf7, 0x1f, 0x1a, 0xc0, 0x5b, 0x30, 0x17, 0x1a, 0xaf,
-	0x77, 0x0d, 0xc6, 0xa8, 0xce, 0x43, 0x51, 0xd6, 0x86, 0xec, 0xea, 0xa7, 0x08, 0x96, 0x06, 0x57,
-	0x0c, 0xf7, 0x35, 0x5d, 0x2e, 0x0a, 0x54, 0x0d, 0x8b, 0x51, 0xe7, 0x21, 0x31, 0x85, 0x64, 0xc2,
-	0x6f, 0x2f, 0x7c, 0x2e, 0x23, 0x0e, 0xbb, 0x1b, 0xb2, 0xe4, 0x2b, 0x27, 0x61, 0xc5, 0x2a, 0x9c,
-	0xa2, 0x96, 0x3e, 0x98, 0xe5, 0xe7, 0x64, 0xcc, 0xa6, 0xee, 0xc2, 0xf2, 0x10, 0x26, 0x91, 0x2a,
-	0xe7, 0xc3, 0x54, 0x40, 0x3c, 0x15, 0xe6, 0x79, 0x2a, 0xc4, 0xa6, 0x06, 0x79, 0x70, 0x02, 0xeb,
-	0xfe, 0xed, 0xbb, 0x23, 0x11, 0x44, 0xe0, 0x64, 0x90, 0x01, 0x28, 0x92, 0x01, 0x0a, 0x54, 0x85,
-	0x86, 0x5c, 0x9e, 0x70, 0x65, 0x2d, 0xfc, 0x96, 0xe5, 0x7a, 0x51, 0x9e, 0xeb, 0x57, 0x40, 0xcd,
-	0x3a, 0x5e, 0xf8, 0x33, 0x03, 0x05, 0x43, 0xe7, 0xa7, 0x17, 0xb5, 0x82, 0xa1, 0xab, 0x6f, 0xc3,
-	0xda, 0x1e, 0x65, 0x59, 0x88, 0x13, 0x2b, 0x64, 0x88, 0x0a, 0x72, 0x44, 0xdf, 0x23, 0x38, 0x93,
-	0xba, 0xb9, 0x1c, 0x8f, 0xb4, 0x42, 0x44, 0xf9, 0x29, 0x26, 0xf8, 0x79, 0x9e, 0x7a, 0x2b, 0xf1,
-	0xa4, 0x22, 0xf7, 0xe4, 0x13, 0x04, 0xeb, 0x7e, 0xe2, 0x8e, 0xc3, 0xd4, 0xb8, 0xbe, 0x48, 0xf0,
-	0x94, 0xe4, 0x78, 0x36, 0x40, 0xcd, 0x82, 0x23, 0x8a, 0xca, 0xbb, 0xb0, 0xee, 0x5f, 0xb6, 0x2f,
-	0x26, 0xbc, 0x1b, 0xa0, 0x66, 0x6d, 0x2f, 0x40, 0xec, 0x43, 0xc3, 0xbb, 0x38, 0x64, 0x73, 0xc2,
-	0xcc, 0x97, 0x9c, 0x89, 0xe4, 0x67, 0x12, 0x58, 0xcf, 0xd8, 0x4d, 0x68, 0xea, 0x95, 0x44, 0xce,
-	0x6e, 0xf8, 0x39, 0x9b, 0xad, 0xc4, 0x30, 0x8d, 0xbf, 0x2d, 0xc0, 0x8a, 0x9f, 0x48, 0xd7, 0x1f,
-	0x31, 0x87, 0x88, 0x35, 0x01, 0xd4, 0xf4, 0xdb, 0x00, 0x65, 0xde, 0x06, 0x2d, 0x80, 0xae, 0xad,
-	0xf7, 0x4d, 0xfe, 0xcd, 0x19, 0x9d, 0xb9, 0x34, 0xcb, 0x71, 0xbd, 0x16, 0x9a, 0xb5, 0xc8, 0x14,
-	0x4f, 0xba, 0x87, 0x8e, 0x77, 0xa8, 0xd5, 0x7e, 0x2c, 0x4a, 0xf5, 0xc0, 0xe0, 0x8d, 0xde, 0x27,
-	0x96, 0xfe, 0x86, 0xa1, 0xb3, 0x23, 0x51, 0x98, 0x07, 0x06, 0x5c, 0x83, 0x89, 0xfb, 0x06, 0xd3,
-	0x08, 0xa3, 0xa2, 0x0e, 0x07, 0x9f, 0x78, 0x03, 0xa6, 0xdd, 0x9e, 0x43, 0x89, 0x7e, 0x83, 0xb4,
-	0x99, 0xed, 0xb8, 0xb5, 0x0a, 0xd7, 0x60, 0xdc, 0x38, 0x46, 0x83, 0x71, 0x01, 0x14, 0x19, 0x57,
-	0x29, 0xc5, 0xe6, 0xbb, 0x02, 0xac, 0xf8, 0xba, 0x95, 0x51, 0x9b, 0x54, 0x62, 0x3a, 0xd5, 0x85,
-	0x31, 0xa8, 0x2e, 0x8e, 0x49, 0x75, 0x29, 0x93, 0xea, 0x72, 0x06, 0xd5, 0x95, 0x11, 0x54, 0x4f,
-	0xe4, 0xa4, 0xba, 0x2a, 0xa7, 0xba, 0x0e, 0x8a, 0x8c, 0x3b, 0x91, 0x66, 0xf7, 0x60, 0xc5, 0x4f,
-	0xc6, 0x3c, 0xcc, 0xe6, 0xcf, 0xf1, 0x3a, 0x28, 0xb2, 0x6d, 0xc5, 0xa1, 0x3f, 0x14, 0xf8, 0xc5,
-	0x99, 0x27, 0xf6, 0x7f, 0x3a, 0x9a, 0xb1, 0x02, 0x5f, 0xcc, 0x2c, 0xf0, 0xa5, 0x64, 0x81, 0x8f,
-	0x2b, 0xa1, 0x3c, 0xa6, 0x12, 0x2a, 0x29, 0x4a, 0x38, 0xe6, 0x4a, 0x98, 0x18, 0x28, 0xe1, 0x38,
-	0xa9, 0x84, 0xea, 0x08, 0x25, 0x4c, 0x4a, 0x94, 0xa0, 0x9a, 0x70, 0x31, 0xc1, 0xa5, 0x7b, 0xc3,
-	0x76, 0x76, 0xa4, 0xac, 0x3c, 0x7f, 0x60, 0x0d, 0xf8, 0xdf, 0x18, 0xa7, 0x89, 0x98, 0x5e, 0x49,
-	0x14, 0xd6, 0x7a, 0x50, 0x58, 0x65, 0x0a, 0x08, 0x0b, 0xea, 0x97, 0x08, 0x2a, 0x77, 0x0c, 0xab,
-	0xa3, 0xbd, 0x29, 0xff, 0x8b, 0xc0, 0x71, 0x5d, 0x43, 0x34, 0xaf, 0xfc, 0xb7, 0xc7, 0xa4, 0x69,
-	0x3b, 0xe4, 0xe0, 0x75, 0x8d, 0x07, 0x1c, 0x69, 0xc1, 0xe7, 0x8b, 0xf9, 0x4b, 0x40, 0xdd, 0xe4,
-	0xaf, 0x1e, 0xfb, 0xc4, 0x65, 0x1c, 0x66, 0xea, 0x9f, 0xbc, 0x1f, 0x23, 0x58, 0x88, 0x4d, 0x14,
-	0xb4, 0xc4, 0x24, 0x8a, 0x24, 0x12, 0x1d, 0x68, 0xca, 0x73, 0x73, 0x3a, 0xaa, 0xa9, 0x19, 0x28,
-	0xe8, 0x0e, 0x77, 0x73, 0x5a, 0x2b, 0xe8, 0x0e, 0x3e, 0x0b, 0x95, 0x1e, 0xe7, 0xaa, 0x56, 0xe2,
-	0x14, 0x4f, 0x71, 0x8a, 0x7d, 0xfa, 0x34, 0x31, 0xb4, 0x75, 0x06, 0x60, 0x20, 0x60, 0x5c, 0x85,
-	0xd2, 0xfe, 0x6d, 0xed, 0xda, 0xdc, 0xbf, 0xf0, 0x04, 0x14, 0x6f, 0x1c, 0xdc, 0x9a, 0x43, 0x97,
-	0x9e, 0xcd, 0xc3, 0x84, 0xe8, 0x51, 0xf1, 0x17, 0x28, 0xa8, 0xd1, 0xb2, 0xe8, 0xe2, 0x4d, 0x7e,
-	0xc0, 0xc8, 0xc6, 0x55, 0xf9, 0xf7, 0xc8, 0x79, 0xa2, 0x28, 0x6c, 0x3f, 0xfd, 0xf9, 0xd7, 0x67,
-	0x85, 0xa6, 0x7a, 0x96, 0xbf, 0xfc, 0x89, 0x87, 0x41, 0xb7, 0x25, 0xd2, 0xbb, 0x1d, 0xbb, 0xb2,
-	0x5f, 0x46, 0x5b, 0xf8, 0x33, 0xc4, 0x8b, 0x88, 0x14, 0xdc, 0xd9, 0xec, 0x9b, 0xdb, 0x47, 0x96,
-	0xeb, 0x7a, 0x57, 0x2f, 0x72, 0x58, 0x5b, 0xb8, 0x99, 0x03, 0x56, 0xeb, 0x89, 0xa1, 0x9f, 0xe0,
-	0xaf, 0x51, 0x50, 0x71, 0x33, 0x88, 0x1b, 0xd9, 0x15, 0x0a, 0xe2, 0x72, 0xb4, 0x6b, 0x97, 0x39,
-	0xc2, 0xff, 0x2a, 0xb9, 0x11, 0x7a, 0xec, 0x7d, 0x85, 0x82, 0x0a, 0x9d, 0x01, 0x72, 0x64, 0x17,
-	0x28, 0x40, 0xe6, 0x68, 0xe7, 0x04, 0x8d, 0x5b, 0xf9, 0x69, 0xfc, 0x1c, 0xc1, 0x4a, 0x6a, 0xcf,
-	0x86, 0xcf, 0x85, 0x4f, 0x0b, 0x59, 0x1d, 0xa2, 0xb2, 0x39, 0x6a, 0x9a, 0x80, 0xf7, 0x1f, 0x0e,
-	0xef, 0x1c, 0xce, 0x23, 0x3e, 0x7c, 0x02, 0x78, 0xb8, 0x79, 0xc1, 0x6b, 0x11, 0xa1, 0x4b, 0x2e,
-	0x53, 0xe5, 0x4c, 0xea, 0xb8, 0xc0, 0xb0, 0xc9, 0x31, 0x34, 0xd4, 0xd5, 0x38, 0x06, 0xea, 0xcd,
-	0x0d, 0x7a, 0x7d, 0x2f, 0x74, 0x4f, 0x11, 0xe0, 0xe1, 0x1b, 0x5d, 0x9c, 0x9f, 0xda, 0x26, 0x89,
-	0xf3, 0x33, 0x5a, 0x01, 0xc1, 0x81, 0xd2, 0xc8, 0x38, 0x3f, 0xd4, 0xcf, 0x47, 0x80, 0x87, 0x2f,
-	0x78, 0x81, 0x21, 0xb5, 0xa1, 0x10, 0x18, 0x32, 0x3a, 0x83, 0x26, 0xc7, 0xa0, 0x6e, 0x8d, 0xc4,
-	0x80, 0x7f, 0x41, 0x70, 0x3e, 0xf7, 0x4d, 0x84, 0xff, 0x2f, 0xbb, 0x71, 0x46, 0xde, 0x93, 0xca,
-	0xd5, 0x71, 0x97, 0x09, 0x37, 0x5e, 0xe5, 0x6e, 0xbc, 0x84, 0xaf, 0xe6, 0x55, 0x7b, 0xdc, 0x43,
-	0xfc, 0x16, 0x54, 0x7c, 0xa1, 0xe0, 0x95, 0x88, 0x6a, 0xe2, 0xcf, 0x64, 0x8a, 0x22, 0x1b, 0x12,
-	0x00, 0x6a, 0x1c, 0x00, 0x56, 0xa7, 0x63, 0x00, 0xbc, 0xc0, 0x1d, 0x40, 0x71, 0x8f, 0x32, 0xbc,
-	0x14, 0x78, 0x96, 0xd8, 0x74, 0x79, 0xc8, 0x2e, 0x76, 0x5c, 0xe5, 0x3b, 0x9e, 0xc6, 0x0b, 0x71,
-	0x97, 0x9e, 0x74, 0x49, 0xfb, 0x04, 0xbf, 0x07, 0x15, 0x5f, 0x58, 0x02, 0xaf, 0xec, 0x59, 0x4f,
-	0xe0, 0x95, 0xbf, 0x63, 0xad, 0xf1, 0xdd, 0x6b, 0x8a, 0x6c, 0x77, 0x0f, 0xf5, 0x3b, 0x50, 0xf1,
-	0x55, 0x23, 0x0e, 0x90, 0xbd, 0x4e, 0x8b, 0x03, 0xe4, 0xef, 0xc4, 0x02, 0xfe, 0x96, 0x14, 0xfe,
-	0x31, 0x4c, 0x07, 0x6f, 0xd2, 0xfc, 0x31, 0x1a, 0x37, 0x04, 0x0b, 0xa9, 0x0f, 0xdb, 0xca, 0x7a,
-	0xc6, 0x0c, 0x71, 0xe4, 0x3a, 0x3f, 0x72, 0x55, 0x5d, 0x91, 0x1c, 0xd9, 0xe2, 0xcf, 0xda, 0xf8,
-	0x0e, 0x94, 0xbc, 0xda, 0x84, 0x97, 0x93, 0x0f, 0xa5, 0xc1, 0x31, 0xb5, 0xe1, 0x01, 0xb1, 0xfb,
-	0x69, 0xbe, 0xfb, 0x2c, 0x8e, 0x47, 0x18, 0x1f, 0x41, 0x75, 0x8f, 0x32, 0xff, 0x2d, 0x70, 0x35,
-	0x11, 0xcb, 0xe8, 0xab, 0x99, 0x52, 0x97, 0x0f, 0xc6, 0xb1, 0x63, 0x29, 0x76, 0x97, 0xef, 0x7e,
-	0x04, 0x53, 0x91, 0xa6, 0x06, 0x87, 0xc2, 0x49, 0xf4, 0x43, 0xc2, 0x05, 0x49, 0xff, 0x13, 0x14,
-	0x3c, 0xbc, 0x26, 0x3b, 0xc4, 0x6b, 0x59, 0xdc, 0x96, 0x49, 0x5c, 0x76, 0xbf, 0xc2, 0xff, 0xf5,
-	0x77, 0xf9, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x61, 0xbe, 0xae, 0x2e, 0x1c, 0x00, 0x00,
+	// 2373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4d, 0x6f, 0xdb, 0xc8,
+	0xb5, 0xd4, 0x97, 0xa5, 0xa7, 0xc8, 0x1f, 0x63, 0xc7, 0xa6, 0x69, 0xd5, 0x96, 0x99, 0xc4, 0xd1,
+	0xba, 0xa9, 0x9d, 0x7a, 0xb3, 0x49, 0xb0, 0x58, 0x2c, 0x10, 0xd8, 0xb1, 0x11, 0xac, 0xd3, 0x0d,
+	0xe8, 0x6c, 0x9b, 0x2d, 0x5a, 0x14, 0x63, 0x69, 0x2c, 0x13, 0x96, 0x48, 0x95, 0x1c, 0xf9, 0xa3,
+	0x8b, 0xa0, 0xc0, 0x1e, 0x7a, 0xda, 0x5e, 0x5a, 0xa0, 0x3d, 0xb5, 0x05, 0x7a, 0x2c, 0xfa, 0x0b,
+	0xf6, 0x07, 0xb4, 0x40, 0xaf, 0xbd, 0xf5, 0x5c, 0x14, 0xe8, 0x6d, 0x7f, 0x42, 0x31, 0x1f, 0xa4,
+	0x48, 0x6a, 0x48, 0xc9, 0x9b, 0x2c, 0xd0, 0x43, 0x6f, 0x9a, 0xf7, 0xde, 0xbc, 0xaf, 0x79, 0xef,
+	0xcd, 0x7b, 0x1c, 0x41, 0xad, 0x83, 0x29, 0xb9, 0xc0, 0x57, 0x5b, 0x7d, 0xcf, 0xa5, 0x2e, 0xca,
+	0xe3, 0xbe, 0x6d, 0xd4, 0x3b, 0xae, 0xdb, 0xe9, 0x92, 0x6d, 0xdc, 0xb7, 0xb7, 0xb1, 0xe3, 0xb8,
+	0x14, 0x53, 0xdb, 0x75, 0x7c, 0x41, 0x12, 0x62, 0xf9, 0xea, 0x78, 0x70, 0xb2, 0xed, 0x53, 0x6f,
+	0xd0, 0xa2, 0x02, 0x6b, 0x7e, 0x55, 0x80, 0x85, 0x5d, 0x8f, 0x60, 0x4a, 0x0e, 0x04, 0x63, 0x8b,
+	0xfc, 0x6c, 0x40, 0x7c, 0x8a, 0x66, 0x21, 0xdf, 0xc3, 0x2d, 0x5d, 0x6b, 0x68, 0xcd, 0x8a, 0xc5,
+	0x7e, 0x22, 0x04, 0x05, 0x07, 0xf7, 0x88, 0x9e, 0xe3, 0x20, 0xfe, 0x1b, 0x35, 0xa0, 0xda, 0x26,
+	0x7e, 0xcb, 0xb3, 0xfb, 0x4c, 0xa4, 0x9e, 0xe7, 0xa8, 0x28, 0x08, 0x19, 0x50, 0xee, 0x62, 0x6a,
+	0xd3, 0x41, 0x9b, 0xe8, 0x85, 0x86, 0xd6, 0xd4, 0xac, 0x70, 0x8d, 0xea, 0x50, 0xe9, 0xba, 0x4e,
+	0x47, 0x20, 0x8b, 0x1c, 0x39, 0x04, 0xb0, 0x9d, 0xb8, 0x2b, 0x77, 0x96, 0xc4, 0xce, 0x60, 0x8d,
+	0x36, 0x60, 0xda, 0xf5, 0x3a, 0xd8, 0xb1, 0x7f, 0xce, 0x6d, 0x7d, 0xb6, 0xa7, 0x4f, 0x35, 0xb4,
+	0x66, 0xde, 0x4a, 0x40, 0xd1, 0x43, 0x58, 0x6c, 0x9d, 0x62, 0xc7, 0x21, 0xdd, 0x5d, 0xd7, 0x39,
+	0xb1, 0x3b, 0x03, 0x2f, 0xa0, 0x2f, 0x73, 0xfa, 0x14, 0x2c, 0xb3, 0xb5, 0x6f, 0x3b, 0x1d, 0xbd,
+	0xd2, 0xd0, 0x9a, 0x65, 0x8b, 0xff, 0x46, 0x4d, 0x98, 0x71, 0x08, 0xbd, 0x70, 0xbd, 0xb3, 0x23,
+	0xe2, 0x9d, 0x13, 0xef, 0xd9, 0x9e, 0x0e, 0x9c, 0x49, 0x12, 0x8c, 0x1e, 0x41, 0x81, 0xe2, 0x8e,
+	0xaf, 0x57, 0x1b, 0xf9, 0x66, 0x75, 0xe7, 0xd6, 0x16, 0xee, 0xdb, 0x5b, 0x2a, 0x27, 0x6f, 0xbd,
+	0xc4, 0x1d, 0xff, 0xa9, 0x43, 0xbd, 0x2b, 0x8b, 0x6f, 0x40, 0x26, 0xdc, 0x60, 0xa2, 0x9e, 0x39,
+	0x94, 0x78, 0xe7, 0xb8, 0xab, 0xdf, 0x68, 0x68, 0xcd, 0x9a, 0x15, 0x83, 0xa1, 0xdb, 0x50, 0x63,
+	0xeb, 0x7d, 0x8f, 0xf1, 0x70, 0x5a, 0x57, 0x7a, 0x8d, 0x13, 0xc5, 0x81, 0x68, 0x11, 0x4a, 0x0c,
+	0xb0, 0x67, 0xe9, 0xd3, 0x1c, 0x2d, 0x57, 0x4c, 0x42, 0x6b, 0xe0, 0x53, 0xb7, 0xf7, 0x42, 0x60,
+	0x67, 0xb8, 0x81, 0x31, 0x18, 0x93, 0x70, 0x62, 0x3b, 0xe4, 0xa5, 0xdd, 0x23, 0x3e, 0xc5, 0xbd,
+	0xbe, 0x3e, 0xcb, 0x89, 0xe2, 0x40, 0xe3, 0x11, 0x54, 0x42, 0xf5, 0x59, 0xb4, 0x9c, 0x91, 0xab,
+	0x20, 0x5a, 0xce, 0xc8, 0x15, 0x5a, 0x80, 0xe2, 0x39, 0xee, 0x0e, 0x82, 0x70, 0x11, 0x8b, 0xf7,
+	0x73, 0x8f, 0x35, 0x73, 0x09, 0x6e, 0x26, 0x9c, 0xe1, 0xf7, 0x5d, 0xc7, 0x27, 0xe6, 0x1d, 0x98,
+	0x3b, 0x20, 0x74, 0x5c, 0x1c, 0x9a, 0x7f, 0x29, 0x01, 0x8a, 0xd2, 0x89, 0xdd, 0xff, 0xe3, 0x01,
+	0x5b, 0x87, 0x4a, 0x8b, 0x1b, 0xdd, 0x7e, 0x42, 0x79, 0xac, 0x56, 0xac, 0x21, 0x80, 0x61, 0x07,
+	0xfd, 0xb6, 0xc4, 0x96, 0x05, 0x36, 0x04, 0x30, 0x9d, 0x4f, 0x6c, 0xcf, 0xa7, 0x47, 0x84, 0x38,
+	0x4f, 0x28, 0x8f, 0xc9, 0x8a, 0x15, 0x05, 0xa1, 0x55, 0x80, 0x2e, 0x0e, 0x09, 0x80, 0x13, 0x44,
+	0x20, 0x8a, 0x74, 0xa9, 0x5e, 0x33, 0x5d, 0x6e, 0x4c, 0x94, 0x2e, 0xb5, 0xec, 0x74, 0x99, 0x56,
+	0xa7, 0xcb, 0x7b, 0x32, 0x5d, 0x66, 0x78, 0xba, 0xac, 0xf3, 0x74, 0x19, 0x3d, 0xe0, 0x91, 0x64,
+	0x59, 0x84, 0x92, 0x4f, 0x31, 0x1d, 0xf8, 0x3c, 0x3e, 0x2b, 0x96, 0x5c, 0x31, 0xc1, 0xe2, 0xd7,
+	0xee, 0x29, 0x76, 0x3a, 0xdc, 0xa5, 0x73, 0x9c, 0x20, 0x09, 0x1e, 0x49, 0x37, 0x34, 0x49, 0xba,
+	0xcd, 0x67, 0xa7, 0xdb, 0x42, 0x66, 0xba, 0xdd, 0x9c, 0x24, 0xdd, 0x16, 0xdf, 0x6a, 0xba, 0x35,
+	0x61, 0x61, 0x8f, 0x74, 0xc9, 0xf8, 0x02, 0x6f, 0x6e, 0xc3, 0xca, 0x01, 0x71, 0x88, 0x37, 0x4c,
+	0xcd, 0x97, 0xee, 0x19, 0x71, 0xd2, 0x37, 0x3c, 0x80, 0xba, 0x7a, 0x83, 0x4c, 0xc9, 0x05, 0x28,
+	0x52, 0x06, 0x90, 0x7b, 0xc4, 0x82, 0xe5, 0x7f, 0x42, 0x21, 0x99, 0xff, 0x5f, 0x68, 0x80, 0x0e,
+	0x6d, 0x3f, 0x59, 0x01, 0x16, 0xa0, 0xd8, 0xb5, 0x7b, 0x36, 0xe5, 0x5c, 0x8a, 0x96, 0x58, 0x30,
+	0x8f, 0xbb, 0x27, 0x27, 0x3e, 0xa1, 0xdc, 0xe2, 0xa2, 0x25, 0x57, 0x8a, 0x50, 0xcf, 0x2b, 0x43,
+	0xbd, 0x01, 0x55, 0x8a, 0x3b, 0x47, 0xa4, 0x4b, 0x5a, 0xd4, 0xf5, 0x78, 0xa6, 0x57, 0xac, 0x28,
+	0xc8, 0xfc, 0x22, 0x0f, 0x33, 0x11, 0x75, 0x9e, 0x51, 0xd2, 0x7b, 0x6b, 0x45, 0x26, 0x56, 0x0e,
+	0x0a, 0x99, 0xe5, 0xa0, 0x98, 0x2c, 0x07, 0xa3, 0x16, 0x96, 0x94, 0x16, 0x2a, 0x12, 0x70, 0x4a,
+	0x9d, 0x80, 0x3b, 0x32, 0x01, 0xcb, 0x3c, 0x01, 0x57, 0x79, 0x02, 0x26, 0x2c, 0xcf, 0xc8, 0xbe,
+	0x4a, 0x2c, 0xfb, 0xc6, 0x94, 0xa2, 0xaf, 0x1f, 0xc7, 0x2d, 0x98, 0x8f, 0x05, 0x87, 0x8c, 0xb1,
+	0x55, 0x00, 0xea, 0x52, 0xdc, 0xdd, 0x75, 0x07, 0x4e, 0x10, 0x22, 0x11, 0x08, 0xba, 0x07, 0x25,
+	0x8f, 0xf8, 0x83, 0x2e, 0x8b, 0x13, 0x66, 0xdd, 0x82, 0xca, 0x3a, 0x4b, 0xd2, 0x98, 0x7f, 0x2d,
+	0xc0, 0xc2, 0x27, 0xdc, 0xd3, 0xff, 0x6f, 0x87, 0xc6, 0xb4, 0x43, 0x41, 0x93, 0x03, 0x91, 0x26,
+	0x47, 0xe5, 0xba, 0xb1, 0x4d, 0x4e, 0x75, 0x92, 0xaa, 0x7b, 0x23, 0xbb, 0xea, 0xd6, 0x32, 0xab,
+	0xee, 0xf4, 0x24, 0x55, 0x77, 0xe6, 0x6d, 0x37, 0x39, 0x09, 0x67, 0xc8, 0x22, 0xf7, 0x6f, 0x0d,
+	0x6e, 0x48, 0xd8, 0x11, 0xc5, 0xd4, 0x67, 0xa7, 0x4e, 0x43, 0x25, 0x04, 0xef, 0x21, 0x00, 0xdd,
+	0x83, 0x39, 0xef, 0xf2, 0x05, 0x6e, 0x9d, 0x11, 0xea, 0x5b, 0xa4, 0x45, 0xec, 0x73, 0xd2, 0x96,
+	0x15, 0x6f, 0x14, 0x81, 0xee, 0xc3, 0xfc, 0x08, 0xf0, 0xe3, 0x8f, 0x78, 0x1c, 0x16, 0x2d, 0x15,
+	0x8a, 0xf1, 0xa7, 0x23, 0xfc, 0x0b, 0x82, 0xff, 0x08, 0x02, 0x6d, 0xc2, 0x6c, 0x08, 0x7c, 0xda,
+	0xb3, 0x29, 0x25, 0x6d, 0x1e, 0xa8, 0x45, 0x6b, 0x04, 0x6e, 0xfe, 0x5a, 0x83, 0xc5, 0xe1, 0x2d,
+	0xce, 0x6d, 0x4d, 0x4f, 0x26, 0x03, 0xca, 0x76, 0x10, 0x0f, 0xc2, 0x97, 0xe1, 0x9a, 0x05, 0xb7,
+	0x4f, 0xb1, 0x47, 0x87, 0x47, 0x25, 0xf2, 0x2a, 0x01, 0x65, 0xa7, 0x4e, 0x9c, 0xf6, 0x90, 0x4a,
+	0x94, 0xd5, 0x18, 0xcc, 0xdc, 0x83, 0xa5, 0x11, 0x9d, 0x64, 0x21, 0x79, 0x27, 0x2c, 0x14, 0x1a,
+	0x8f, 0xe8, 0x39, 0xd1, 0x87, 0x44, 0x49, 0x83, 0x2a, 0xf1, 0x1a, 0xd6, 0x45, 0x07, 0xbb, 0xab,
+	0x48, 0x97, 0xc0, 0xc8, 0xa0, 0x3e, 0x68, 0x91, 0xfa, 0x60, 0x40, 0x59, 0x66, 0x98, 0xcf, 0xcb,
+	0x51, 0xd1, 0x0a, 0xd7, 0xaa, 0x72, 0x9d, 0x57, 0x96, 0x6b, 0xf3, 0x01, 0x98, 0x59, 0xe2, 0xa5,
+	0x3d, 0xd3, 0x90, 0xb3, 0xdb, 0x5c, 0x7a, 0xde, 0xca, 0xd9, 0x6d, 0xf3, 0x47, 0xb0, 0x7a, 0x40,
+	0x68, 0x96, 0xc6, 0x89, 0x1d, 0x2a, 0x8d, 0x72, 0x6a, 0x8d, 0xfe, 0xa6, 0xc1, 0x5a, 0x2a, 0x73,
+	0xb5, 0x3e, 0xca, 0xfa, 0x19, 0xf5, 0x4f, 0x3e, 0xe1, 0x9f, 0x37, 0xb9, 0x32, 0x15, 0x96, 0x94,
+	0xd4, 0x96, 0xfc, 0x4a, 0x83, 0x75, 0x91, 0xb8, 0xd7, 0xf1, 0xd4, 0x75, 0x6d, 0x51, 0xe8, 0x53,
+	0x50, 0xeb, 0x73, 0x1b, 0xcc, 0x2c, 0x75, 0x64, 0x51, 0xf9, 0x09, 0xac, 0x8b, 0x96, 0xea, 0x9b,
+	0x39, 0xde, 0xdb, 0x60, 0x66, 0xb1, 0x97, 0x4a, 0x1c, 0x42, 0x83, 0x5d, 0xab, 0x2a, 0x9a, 0x30,
+	0xf3, 0x15, 0x32, 0x35, 0xb5, 0x4c, 0x0c, 0xeb, 0x19, 0xdc, 0x64, 0x4c, 0x7d, 0x90, 0xc8, 0xd9,
+	0xdb, 0xc1, 0xec, 0x90, 0xa5, 0x68, 0x98, 0xc6, 0x7f, 0xce, 0xc1, 0xb2, 0x48, 0xa4, 0xa7, 0x97,
+	0xd4, 0xc3, 0x72, 0x4f, 0xa0, 0x6a, 0xfa, 0x5d, 0xa9, 0x65, 0xde, 0x95, 0xdb, 0x00, 0x3d, 0xb7,
+	0x3d, 0xe8, 0xf2, 0x35, 0xf7, 0xe8, 0xf4, 0xce, 0x0c, 0xd7, 0xeb, 0x79, 0x08, 0xb6, 0x22, 0x24,
+	0x2c, 0x74, 0x4f, 0xc2, 0x7b, 0x4e, 0x94, 0xea, 0x21, 0x80, 0x61, 0x8f, 0xb1, 0xd3, 0xfe, 0xa1,
+	0xdd, 0xa6, 0xa7, 0xb2, 0x30, 0x0f, 0x01, 0x48, 0x87, 0xa9, 0x63, 0x9b, 0x5a, 0x98, 0x12, 0x59,
+	0x87, 0x83, 0x25, 0xbb, 0xdf, 0xfc, 0xbe, 0x47, 0x70, 0x7b, 0x1f, 0xb3, 0x6e, 0xd6, 0xd7, 0x4b,
+	0x3c, 0x06, 0xe3, 0xc0, 0xc9, 0x7b, 0x44, 0xf3, 0x1e, 0x18, 0x2a, 0x5f, 0xa5, 0x14, 0x9b, 0x2f,
+	0x73, 0xb0, 0x2c, 0xe2, 0x56, 0xe5, 0xda, 0x64, 0x24, 0xa6, 0xbb, 0x3a, 0x77, 0x0d, 0x57, 0xe7,
+	0xaf, 0xe9, 0xea, 0x42, 0xa6, 0xab, 0x8b, 0x19, 0xae, 0x2e, 0x8d, 0x71, 0xf5, 0xd4, 0x84, 0xae,
+	0x2e, 0xab, 0x5d, 0x5d, 0x07, 0x43, 0xe5, 0x3b, 0x99, 0x66, 0x9f, 0xc0, 0xb2, 0x48, 0xc6, 0x49,
+	0x3c, 0x3b, 0x79, 0x8e, 0xd7, 0xc1, 0x50, 0xb1, 0x95, 0x42, 0xff, 0x9e, 0xe3, 0x17, 0xe7, 0x24,
+	0x67, 0xff, 0xb5, 0x4f, 0x33, 0x56, 0xe0, 0xf3, 0x99, 0x05, 0xbe, 0x90, 0x2c, 0xf0, 0xf1, 0x48,
+	0x28, 0x5e, 0x33, 0x12, 0x4a, 0x29, 0x91, 0x70, 0xc1, 0x23, 0x61, 0x6a, 0x18, 0x09, 0x17, 0xc9,
+	0x48, 0x28, 0x8f, 0x89, 0x84, 0x8a, 0x22, 0x12, 0xcc, 0x2e, 0xdc, 0x4f, 0xf8, 0xd2, 0xdf, 0x77,
+	0xbd, 0x5d, 0xa5, 0x57, 0xde, 0xfc, 0x60, 0x6d, 0xf8, 0xde, 0x35, 0xa4, 0xc9, 0x33, 0x7d, 0x90,
+	0x28, 0xac, 0xf5, 0xa0, 0xb0, 0xaa, 0x22, 0x20, 0x2c, 0xa8, 0x7f, 0xd0, 0xa0, 0xc4, 0xda, 0x6b,
+	0xeb, 0x95, 0x7a, 0x5e, 0xf2, 0x7c, 0xdf, 0x96, 0xcd, 0x2b, 0xff, 0xcd, 0x3c, 0xd9, 0x75, 0x3d,
+	0x7c, 0xf4, 0x7d, 0x8b, 0x1f, 0xb8, 0x66, 0x05, 0xcb, 0x6f, 0x66, 0x4e, 0x32, 0x37, 0xf8, 0x97,
+	0xc3, 0x43, 0xec, 0x53, 0xae, 0x66, 0xea, 0x87, 0x8d, 0x5f, 0x6a, 0x30, 0x1f, 0x23, 0x94, 0x6e,
+	0x89, 0x85, 0xa8, 0xa6, 0x08, 0xd1, 0x61, 0x4c, 0xe5, 0xf8, 0x44, 0x32, 0x04, 0xb0, 0x13, 0x6c,
+	0x7b, 0xdc, 0xcc, 0x9a, 0x95, 0x6b, 0x7b, 0xe8, 0x96, 0x18, 0x5e, 0xac, 0x57, 0x7a, 0x81, 0xbb,
+	0xb8, 0xca, 0x5d, 0x2c, 0xdc, 0x67, 0x49, 0x94, 0xf9, 0x29, 0x2c, 0x45, 0x46, 0x55, 0x86, 0xcc,
+	0x68, 0xa2, 0xc3, 0x0f, 0x25, 0x39, 0xf5, 0x87, 0x92, 0x7c, 0xf4, 0x43, 0x09, 0xeb, 0xcf, 0x67,
+	0x22, 0x7c, 0xf9, 0xe7, 0x8d, 0x64, 0x94, 0xc5, 0xec, 0xcd, 0x65, 0xda, 0x9b, 0x57, 0xdb, 0x5b,
+	0x50, 0xd8, 0x5b, 0x4c, 0xb7, 0xf7, 0x14, 0xf4, 0x51, 0x7b, 0xdf, 0x68, 0xd2, 0x4f, 0x98, 0x18,
+	0xc6, 0x2a, 0x86, 0xe5, 0xe1, 0x24, 0xb0, 0xeb, 0x9e, 0x13, 0x0f, 0x77, 0x48, 0xe0, 0xdb, 0xd1,
+	0x79, 0x5a, 0x53, 0xce, 0xd3, 0x75, 0xa8, 0xf4, 0x3d, 0xd2, 0xb2, 0xfd, 0xe0, 0xaa, 0xaf, 0x59,
+	0x43, 0x80, 0x69, 0x83, 0xa1, 0x12, 0x21, 0xcd, 0x41, 0x50, 0xa0, 0x57, 0xfd, 0x70, 0x3e, 0x60,
+	0xbf, 0xd1, 0x23, 0x28, 0x9f, 0x10, 0x4c, 0x07, 0x1e, 0xf1, 0xa5, 0x11, 0x2b, 0x51, 0x23, 0x02,
+	0x1e, 0xfb, 0x82, 0xc6, 0x0a, 0x89, 0xcd, 0x2f, 0xd9, 0xb0, 0xa5, 0x24, 0x52, 0xca, 0x79, 0x0c,
+	0xe5, 0x0e, 0x71, 0x7b, 0x84, 0x7a, 0x22, 0x50, 0xc3, 0x04, 0x8f, 0xb3, 0x38, 0x90, 0x34, 0x56,
+	0x48, 0xcd, 0xb8, 0x1d, 0x1f, 0xbb, 0x97, 0xbc, 0xa3, 0xd5, 0x2c, 0xfe, 0x1b, 0x7d, 0x08, 0xd0,
+	0xf7, 0xdc, 0x3e, 0xf1, 0xa8, 0x4d, 0x7c, 0x7e, 0xe2, 0xc1, 0x47, 0xa4, 0x04, 0xbf, 0x17, 0x21,
+	0x95, 0x15, 0xd9, 0x61, 0x9e, 0xc1, 0x52, 0x8a, 0x60, 0xa5, 0xf2, 0x1f, 0x40, 0xb5, 0xe5, 0xba,
+	0x5e, 0xdb, 0x76, 0x30, 0xe5, 0x7e, 0x62, 0xf2, 0x8c, 0x2d, 0xf1, 0xcc, 0xb5, 0x15, 0x3c, 0x73,
+	0xf1, 0x4f, 0x3c, 0x3f, 0x60, 0xf3, 0xb8, 0x15, 0x25, 0x37, 0x6d, 0x58, 0x4e, 0xd5, 0x8a, 0xd5,
+	0xa3, 0x0e, 0x71, 0x4f, 0xb1, 0x7f, 0x2a, 0x25, 0x06, 0x4b, 0xb4, 0x03, 0x65, 0xf9, 0xf2, 0x16,
+	0x9c, 0xcc, 0xa2, 0xca, 0x42, 0xeb, 0x95, 0x15, 0xd2, 0x99, 0xff, 0xd1, 0x60, 0x6e, 0x04, 0xaf,
+	0xce, 0xdb, 0x16, 0x8f, 0x69, 0x11, 0x41, 0x62, 0xc1, 0x74, 0x61, 0x35, 0xf2, 0xb9, 0xed, 0xc8,
+	0xc4, 0x0d, 0x96, 0x01, 0xe6, 0xc9, 0x79, 0x47, 0x96, 0xc6, 0x60, 0x19, 0xee, 0xc1, 0x97, 0x41,
+	0x3b, 0x28, 0x97, 0xfc, 0xb3, 0x9c, 0x6b, 0xb1, 0xd2, 0xca, 0x18, 0x8a, 0xba, 0x18, 0x81, 0x44,
+	0xf0, 0x8c, 0xed, 0x54, 0x0c, 0xcf, 0x38, 0x47, 0xf6, 0xe3, 0x4b, 0xbd, 0x1c, 0xc3, 0x3f, 0xc7,
+	0x97, 0x9b, 0x6b, 0x00, 0xc3, 0x9b, 0x16, 0x95, 0xa1, 0x70, 0xf8, 0xb1, 0xf5, 0x64, 0xf6, 0x5b,
+	0x68, 0x0a, 0xf2, 0xfb, 0x47, 0x1f, 0xcd, 0x6a, 0x3b, 0x5f, 0x21, 0x98, 0x92, 0xce, 0x40, 0xbf,
+	0xd7, 0x82, 0x66, 0x52, 0x75, 0x0d, 0xa1, 0x8d, 0xc8, 0x83, 0x59, 0xc6, 0x40, 0x63, 0xdc, 0x1d,
+	0x4b, 0x27, 0xbb, 0x97, 0xad, 0xcf, 0xff, 0xf1, 0xaf, 0xdf, 0xe4, 0x9a, 0xe6, 0x2d, 0xfe, 0x44,
+	0x1a, 0x9c, 0xcf, 0xb6, 0xec, 0x43, 0x5a, 0xd1, 0x3d, 0xfe, 0xfb, 0xda, 0x26, 0xfa, 0xad, 0xc6,
+	0xbb, 0x1d, 0xa5, 0x72, 0xb7, 0xb2, 0x47, 0x0c, 0xa1, 0xd9, 0x44, 0x73, 0x88, 0x79, 0x9f, 0xab,
+	0xb5, 0x89, 0x9a, 0x13, 0xa8, 0xb5, 0xfd, 0x99, 0xdd, 0x7e, 0x8d, 0xfe, 0xa4, 0x05, 0xad, 0x61,
+	0x86, 0xe3, 0xc6, 0x8e, 0xaf, 0xc6, 0xdd, 0xb1, 0x74, 0x52, 0xc3, 0x77, 0xb9, 0x86, 0xdf, 0x35,
+	0x26, 0xd6, 0x90, 0x79, 0xef, 0x8f, 0x5a, 0xd0, 0x4a, 0x66, 0x28, 0x39, 0x76, 0x5c, 0x35, 0xee,
+	0x8e, 0xa5, 0x8b, 0xbb, 0x71, 0x73, 0x72, 0x37, 0xfe, 0x4e, 0x83, 0xe5, 0xd4, 0xe1, 0x12, 0xdd,
+	0x09, 0xbf, 0x10, 0x67, 0x8d, 0xb2, 0xc6, 0xc6, 0x38, 0x32, 0xa9, 0xde, 0x77, 0xb8, 0x7a, 0x77,
+	0xd0, 0x24, 0xc1, 0x87, 0x5e, 0x03, 0x1a, 0x9d, 0xb2, 0xd0, 0x6a, 0x24, 0xd0, 0x15, 0x5d, 0xbf,
+	0xb1, 0x96, 0x8a, 0x97, 0x3a, 0x6c, 0x70, 0x1d, 0x1a, 0xe6, 0x4a, 0x5c, 0x07, 0xc2, 0x68, 0xa5,
+	0x22, 0x3c, 0xf0, 0x3f, 0xd7, 0x00, 0x8d, 0x8e, 0x1e, 0x52, 0x7e, 0xea, 0x3c, 0x67, 0xac, 0xa5,
+	0xe2, 0xe3, 0x3e, 0x30, 0x1a, 0x19, 0xf2, 0xc3, 0xf8, 0xf9, 0x05, 0xa0, 0xd1, 0x49, 0x44, 0xea,
+	0x90, 0x3a, 0xf9, 0x18, 0x6b, 0xa9, 0x78, 0xa9, 0x43, 0x93, 0xeb, 0x60, 0x6e, 0x8e, 0xd5, 0x01,
+	0xfd, 0x53, 0x83, 0x77, 0x26, 0x6e, 0x99, 0xd1, 0x7b, 0xaa, 0xd6, 0x78, 0x6c, 0x43, 0x6f, 0x3c,
+	0xbc, 0xee, 0x36, 0x69, 0xc6, 0x87, 0xdc, 0x8c, 0xc7, 0xe8, 0xe1, 0xa4, 0xd1, 0x1e, 0xb7, 0x10,
+	0x39, 0x50, 0x65, 0x75, 0x49, 0xde, 0x47, 0xd2, 0xad, 0xa9, 0x9d, 0x90, 0xb1, 0x96, 0x8a, 0x97,
+	0xfa, 0xac, 0x72, 0x7d, 0x74, 0xb4, 0x98, 0xd0, 0x27, 0x10, 0xf0, 0x29, 0x94, 0x44, 0x60, 0xa2,
+	0xe5, 0xd4, 0xff, 0x41, 0x18, 0x86, 0x0a, 0x25, 0x05, 0xe8, 0x5c, 0x00, 0x32, 0x6b, 0x31, 0x01,
+	0x2c, 0x50, 0x8e, 0x20, 0x7f, 0x40, 0x28, 0x5a, 0x1c, 0x79, 0x30, 0x16, 0x4c, 0x97, 0x52, 0x1e,
+	0x92, 0xcd, 0x15, 0xce, 0xf1, 0x26, 0x9a, 0x8f, 0xab, 0xfc, 0x59, 0x0f, 0xb7, 0x5e, 0xa3, 0x9f,
+	0x42, 0x49, 0x04, 0xb2, 0xd4, 0x57, 0xf5, 0xa4, 0x61, 0x18, 0x2a, 0x54, 0xdc, 0x21, 0x86, 0x8a,
+	0x3b, 0xd3, 0xfa, 0xc7, 0x50, 0x12, 0x51, 0x2a, 0x05, 0xa8, 0x1e, 0x67, 0x0d, 0x43, 0x85, 0x8a,
+	0xab, 0xbf, 0xa9, 0x54, 0xff, 0x02, 0x6a, 0xc1, 0x93, 0x2c, 0x7f, 0x8b, 0x45, 0x0d, 0xe9, 0x85,
+	0xd4, 0x77, 0x5d, 0x63, 0x3d, 0x83, 0x42, 0x8a, 0x5c, 0xe7, 0x22, 0x57, 0xcc, 0x65, 0x85, 0xc8,
+	0x6d, 0xfe, 0xaa, 0x8b, 0x5e, 0x40, 0x81, 0xd5, 0x42, 0xb4, 0x94, 0x7c, 0x5f, 0x0b, 0xc4, 0xe8,
+	0xa3, 0x08, 0xc9, 0xfd, 0x26, 0xe7, 0x3e, 0x83, 0xe2, 0x27, 0x8c, 0x4e, 0xa1, 0x7c, 0x40, 0xa8,
+	0x78, 0x24, 0x59, 0x49, 0x9c, 0x65, 0xf4, 0x39, 0xc1, 0xa8, 0xab, 0x91, 0x71, 0xdd, 0x91, 0x52,
+	0x77, 0x9f, 0x73, 0x3f, 0x85, 0x6a, 0x64, 0xda, 0x43, 0x61, 0xe0, 0x24, 0x06, 0x45, 0x43, 0x1f,
+	0x45, 0xc4, 0x0b, 0x2c, 0x5a, 0x55, 0x09, 0x61, 0xb3, 0x8d, 0xbf, 0xcd, 0xde, 0x40, 0xd1, 0x19,
+	0x54, 0x0e, 0x6d, 0xb1, 0xd7, 0x47, 0xf5, 0xa4, 0x47, 0xa2, 0xf3, 0x9d, 0xf1, 0xed, 0x14, 0xec,
+	0x24, 0x66, 0x71, 0x89, 0xc7, 0x25, 0xde, 0x0b, 0xbf, 0xfb, 0xdf, 0x01, 0x00, 0xcc, 0x5a, 0x99,
+	0xf5, 0x34, 0x26, 0x00, 0x00,
 }
@@ -27,7 +27,11 @@ protoc  -I/usr/local/include -I. ${GOPATHLIST} --go_out=plugins=grpc:. \
     profiles.proto \
     networkServer.proto \
     serviceProfile.proto \
-    deviceProfile.proto
+    deviceProfile.proto \
+    integration.proto \
+    multicastGroup.proto \
+    scheduledDownlink.proto \
+    fuotaSession.proto
 
 # generate the JSON interface code
 protoc -I/usr/local/include -I. ${GOPATHLIST} --grpc-gateway_out=logtostderr=true:. \
@@ -41,7 +45,11 @@ protoc -I/usr/local/include -I. ${GOPATHLIST} --grpc-gateway_out=logtostderr=tru
     profiles.proto \
     networkServer.proto \
     serviceProfile.proto \
-    deviceProfile.proto
+    deviceProfile.proto \
+    integration.proto \
+    multicastGroup.proto \
+    scheduledDownlink.proto \
+    fuotaSession.proto
 
 # generate the swagger definitions
 protoc -I/usr/local/include -I. ${GOPATHLIST} --swagger_out=logtostderr=true:./swagger \
@@ -55,7 +63,11 @@ protoc -I/usr/local/include -I. ${GOPATHLIST} --swagger_out=logtostderr=true:./s
     profiles.proto \
     networkServer.proto \
     serviceProfile.proto \
-    deviceProfile.proto
+    deviceProfile.proto \
+    integration.proto \
+    multicastGroup.proto \
+    scheduledDownlink.proto \
+    fuotaSession.proto
 
 # merge the swagger code into one file
 go run swagger/main.go swagger > ../static/swagger/api.swagger.json
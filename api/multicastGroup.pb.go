@@ -0,0 +1,1101 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: multicastGroup.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type CreateMulticastGroupRequest struct {
+	// Name of the multicast-group.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// ID of the service-profile.
+	ServiceProfileID string `protobuf:"bytes,2,opt,name=serviceProfileID" json:"serviceProfileID,omitempty"`
+	// Hex encoded multicast address.
+	McAddr string `protobuf:"bytes,3,opt,name=mcAddr" json:"mcAddr,omitempty"`
+	// Hex encoded multicast network session key.
+	McNwkSKey string `protobuf:"bytes,4,opt,name=mcNwkSKey" json:"mcNwkSKey,omitempty"`
+	// Hex encoded multicast application session key.
+	McAppSKey string `protobuf:"bytes,5,opt,name=mcAppSKey" json:"mcAppSKey,omitempty"`
+}
+
+func (m *CreateMulticastGroupRequest) Reset()                    { *m = CreateMulticastGroupRequest{} }
+func (m *CreateMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateMulticastGroupRequest) ProtoMessage()               {}
+func (*CreateMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{0} }
+
+func (m *CreateMulticastGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetServiceProfileID() string {
+	if m != nil {
+		return m.ServiceProfileID
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetMcAddr() string {
+	if m != nil {
+		return m.McAddr
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetMcNwkSKey() string {
+	if m != nil {
+		return m.McNwkSKey
+	}
+	return ""
+}
+
+func (m *CreateMulticastGroupRequest) GetMcAppSKey() string {
+	if m != nil {
+		return m.McAppSKey
+	}
+	return ""
+}
+
+type CreateMulticastGroupResponse struct {
+	// ID of the multicast-group.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateMulticastGroupResponse) Reset()                    { *m = CreateMulticastGroupResponse{} }
+func (m *CreateMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateMulticastGroupResponse) ProtoMessage()               {}
+func (*CreateMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{1} }
+
+func (m *CreateMulticastGroupResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetMulticastGroupRequest struct {
+	// ID of the multicast-group.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetMulticastGroupRequest) Reset()                    { *m = GetMulticastGroupRequest{} }
+func (m *GetMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMulticastGroupRequest) ProtoMessage()               {}
+func (*GetMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{2} }
+
+func (m *GetMulticastGroupRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetMulticastGroupResponse struct {
+	// ID of the multicast-group.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Name of the multicast-group.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// ID of the service-profile.
+	ServiceProfileID string `protobuf:"bytes,3,opt,name=serviceProfileID" json:"serviceProfileID,omitempty"`
+	// Hex encoded multicast address.
+	McAddr string `protobuf:"bytes,4,opt,name=mcAddr" json:"mcAddr,omitempty"`
+	// Hex encoded multicast network session key.
+	McNwkSKey string `protobuf:"bytes,5,opt,name=mcNwkSKey" json:"mcNwkSKey,omitempty"`
+	// Hex encoded multicast application session key.
+	McAppSKey string `protobuf:"bytes,6,opt,name=mcAppSKey" json:"mcAppSKey,omitempty"`
+	// Timestamp when the record was created.
+	CreatedAt string `protobuf:"bytes,7,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp when the record was last updated.
+	UpdatedAt string `protobuf:"bytes,8,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// Frame-counter used for the next multicast downlink.
+	FCnt uint32 `protobuf:"varint,9,opt,name=fCnt" json:"fCnt,omitempty"`
+}
+
+func (m *GetMulticastGroupResponse) Reset()                    { *m = GetMulticastGroupResponse{} }
+func (m *GetMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMulticastGroupResponse) ProtoMessage()               {}
+func (*GetMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{3} }
+
+func (m *GetMulticastGroupResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetServiceProfileID() string {
+	if m != nil {
+		return m.ServiceProfileID
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetMcAddr() string {
+	if m != nil {
+		return m.McAddr
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetMcNwkSKey() string {
+	if m != nil {
+		return m.McNwkSKey
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetMcAppSKey() string {
+	if m != nil {
+		return m.McAppSKey
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+type UpdateMulticastGroupRequest struct {
+	// ID of the multicast-group.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Name of the multicast-group.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Hex encoded multicast address.
+	McAddr string `protobuf:"bytes,3,opt,name=mcAddr" json:"mcAddr,omitempty"`
+	// Hex encoded multicast network session key.
+	McNwkSKey string `protobuf:"bytes,4,opt,name=mcNwkSKey" json:"mcNwkSKey,omitempty"`
+	// Hex encoded multicast application session key.
+	McAppSKey string `protobuf:"bytes,5,opt,name=mcAppSKey" json:"mcAppSKey,omitempty"`
+}
+
+func (m *UpdateMulticastGroupRequest) Reset()                    { *m = UpdateMulticastGroupRequest{} }
+func (m *UpdateMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMulticastGroupRequest) ProtoMessage()               {}
+func (*UpdateMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{4} }
+
+func (m *UpdateMulticastGroupRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateMulticastGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateMulticastGroupRequest) GetMcAddr() string {
+	if m != nil {
+		return m.McAddr
+	}
+	return ""
+}
+
+func (m *UpdateMulticastGroupRequest) GetMcNwkSKey() string {
+	if m != nil {
+		return m.McNwkSKey
+	}
+	return ""
+}
+
+func (m *UpdateMulticastGroupRequest) GetMcAppSKey() string {
+	if m != nil {
+		return m.McAppSKey
+	}
+	return ""
+}
+
+type UpdateMulticastGroupResponse struct {
+}
+
+func (m *UpdateMulticastGroupResponse) Reset()                    { *m = UpdateMulticastGroupResponse{} }
+func (m *UpdateMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMulticastGroupResponse) ProtoMessage()               {}
+func (*UpdateMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{5} }
+
+type DeleteMulticastGroupRequest struct {
+	// ID of the multicast-group.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteMulticastGroupRequest) Reset()                    { *m = DeleteMulticastGroupRequest{} }
+func (m *DeleteMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMulticastGroupRequest) ProtoMessage()               {}
+func (*DeleteMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{6} }
+
+func (m *DeleteMulticastGroupRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type DeleteMulticastGroupResponse struct {
+}
+
+func (m *DeleteMulticastGroupResponse) Reset()                    { *m = DeleteMulticastGroupResponse{} }
+func (m *DeleteMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMulticastGroupResponse) ProtoMessage()               {}
+func (*DeleteMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{7} }
+
+type ListMulticastGroupRequest struct {
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset" json:"offset,omitempty"`
+	// Organization id to filter on (required for non-admin users).
+	OrganizationID int64 `protobuf:"varint,3,opt,name=organizationID" json:"organizationID,omitempty"`
+}
+
+func (m *ListMulticastGroupRequest) Reset()                    { *m = ListMulticastGroupRequest{} }
+func (m *ListMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListMulticastGroupRequest) ProtoMessage()               {}
+func (*ListMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{8} }
+
+func (m *ListMulticastGroupRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+type MulticastGroupListItem struct {
+	// ID of the multicast-group.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// Name of the multicast-group.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// ID of the service-profile.
+	ServiceProfileID string `protobuf:"bytes,3,opt,name=serviceProfileID" json:"serviceProfileID,omitempty"`
+	// Name of the service-profile.
+	ServiceProfileName string `protobuf:"bytes,4,opt,name=serviceProfileName" json:"serviceProfileName,omitempty"`
+	// Timestamp when the record was created.
+	CreatedAt string `protobuf:"bytes,5,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp when the record was last updated.
+	UpdatedAt string `protobuf:"bytes,6,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *MulticastGroupListItem) Reset()                    { *m = MulticastGroupListItem{} }
+func (m *MulticastGroupListItem) String() string            { return proto.CompactTextString(m) }
+func (*MulticastGroupListItem) ProtoMessage()               {}
+func (*MulticastGroupListItem) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{9} }
+
+func (m *MulticastGroupListItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MulticastGroupListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MulticastGroupListItem) GetServiceProfileID() string {
+	if m != nil {
+		return m.ServiceProfileID
+	}
+	return ""
+}
+
+func (m *MulticastGroupListItem) GetServiceProfileName() string {
+	if m != nil {
+		return m.ServiceProfileName
+	}
+	return ""
+}
+
+func (m *MulticastGroupListItem) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *MulticastGroupListItem) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ListMulticastGroupResponse struct {
+	// Total number of multicast-groups.
+	TotalCount int64                     `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*MulticastGroupListItem `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListMulticastGroupResponse) Reset()                    { *m = ListMulticastGroupResponse{} }
+func (m *ListMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListMulticastGroupResponse) ProtoMessage()               {}
+func (*ListMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{10} }
+
+func (m *ListMulticastGroupResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupResponse) GetResult() []*MulticastGroupListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type AddDeviceToMulticastGroupRequest struct {
+	// ID of the multicast-group.
+	MulticastGroupID string `protobuf:"bytes,1,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *AddDeviceToMulticastGroupRequest) Reset()         { *m = AddDeviceToMulticastGroupRequest{} }
+func (m *AddDeviceToMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceToMulticastGroupRequest) ProtoMessage()    {}
+func (*AddDeviceToMulticastGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{11}
+}
+
+func (m *AddDeviceToMulticastGroupRequest) GetMulticastGroupID() string {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return ""
+}
+
+func (m *AddDeviceToMulticastGroupRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type AddDeviceToMulticastGroupResponse struct {
+}
+
+func (m *AddDeviceToMulticastGroupResponse) Reset()         { *m = AddDeviceToMulticastGroupResponse{} }
+func (m *AddDeviceToMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceToMulticastGroupResponse) ProtoMessage()    {}
+func (*AddDeviceToMulticastGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{12}
+}
+
+type RemoveDeviceFromMulticastGroupRequest struct {
+	// ID of the multicast-group.
+	MulticastGroupID string `protobuf:"bytes,1,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *RemoveDeviceFromMulticastGroupRequest) Reset()         { *m = RemoveDeviceFromMulticastGroupRequest{} }
+func (m *RemoveDeviceFromMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceFromMulticastGroupRequest) ProtoMessage()    {}
+func (*RemoveDeviceFromMulticastGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{13}
+}
+
+func (m *RemoveDeviceFromMulticastGroupRequest) GetMulticastGroupID() string {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return ""
+}
+
+func (m *RemoveDeviceFromMulticastGroupRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type RemoveDeviceFromMulticastGroupResponse struct {
+}
+
+func (m *RemoveDeviceFromMulticastGroupResponse) Reset() {
+	*m = RemoveDeviceFromMulticastGroupResponse{}
+}
+func (m *RemoveDeviceFromMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceFromMulticastGroupResponse) ProtoMessage()    {}
+func (*RemoveDeviceFromMulticastGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{14}
+}
+
+type ListMulticastGroupDevicesRequest struct {
+	// ID of the multicast-group.
+	MulticastGroupID string `protobuf:"bytes,1,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListMulticastGroupDevicesRequest) Reset()         { *m = ListMulticastGroupDevicesRequest{} }
+func (m *ListMulticastGroupDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastGroupDevicesRequest) ProtoMessage()    {}
+func (*ListMulticastGroupDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{15}
+}
+
+func (m *ListMulticastGroupDevicesRequest) GetMulticastGroupID() string {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return ""
+}
+
+func (m *ListMulticastGroupDevicesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupDevicesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type MulticastGroupDeviceListItem struct {
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Name of the device.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,3,opt,name=applicationID" json:"applicationID,omitempty"`
+	// ID of the device-profile.
+	DeviceProfileID string `protobuf:"bytes,4,opt,name=deviceProfileID" json:"deviceProfileID,omitempty"`
+	// Name of the device-profile.
+	DeviceProfileName string `protobuf:"bytes,5,opt,name=deviceProfileName" json:"deviceProfileName,omitempty"`
+}
+
+func (m *MulticastGroupDeviceListItem) Reset()                    { *m = MulticastGroupDeviceListItem{} }
+func (m *MulticastGroupDeviceListItem) String() string            { return proto.CompactTextString(m) }
+func (*MulticastGroupDeviceListItem) ProtoMessage()               {}
+func (*MulticastGroupDeviceListItem) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{16} }
+
+func (m *MulticastGroupDeviceListItem) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *MulticastGroupDeviceListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MulticastGroupDeviceListItem) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *MulticastGroupDeviceListItem) GetDeviceProfileID() string {
+	if m != nil {
+		return m.DeviceProfileID
+	}
+	return ""
+}
+
+func (m *MulticastGroupDeviceListItem) GetDeviceProfileName() string {
+	if m != nil {
+		return m.DeviceProfileName
+	}
+	return ""
+}
+
+type ListMulticastGroupDevicesResponse struct {
+	// Total number of devices in the multicast-group.
+	TotalCount int64                           `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*MulticastGroupDeviceListItem `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListMulticastGroupDevicesResponse) Reset()         { *m = ListMulticastGroupDevicesResponse{} }
+func (m *ListMulticastGroupDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastGroupDevicesResponse) ProtoMessage()    {}
+func (*ListMulticastGroupDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{17}
+}
+
+func (m *ListMulticastGroupDevicesResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupDevicesResponse) GetResult() []*MulticastGroupDeviceListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type EnqueueMulticastQueueItemRequest struct {
+	// ID of the multicast-group.
+	MulticastGroupID string `protobuf:"bytes,1,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// FPort used (must be > 0).
+	FPort uint32 `protobuf:"varint,2,opt,name=fPort" json:"fPort,omitempty"`
+	// Base64 encoded data.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *EnqueueMulticastQueueItemRequest) Reset()         { *m = EnqueueMulticastQueueItemRequest{} }
+func (m *EnqueueMulticastQueueItemRequest) String() string { return proto.CompactTextString(m) }
+func (*EnqueueMulticastQueueItemRequest) ProtoMessage()    {}
+func (*EnqueueMulticastQueueItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{18}
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetMulticastGroupID() string {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return ""
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type EnqueueMulticastQueueItemResponse struct {
+	// Frame-counter of the enqueued payload.
+	FCnt uint32 `protobuf:"varint,1,opt,name=fCnt" json:"fCnt,omitempty"`
+}
+
+func (m *EnqueueMulticastQueueItemResponse) Reset()         { *m = EnqueueMulticastQueueItemResponse{} }
+func (m *EnqueueMulticastQueueItemResponse) String() string { return proto.CompactTextString(m) }
+func (*EnqueueMulticastQueueItemResponse) ProtoMessage()    {}
+func (*EnqueueMulticastQueueItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{19}
+}
+
+func (m *EnqueueMulticastQueueItemResponse) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CreateMulticastGroupRequest)(nil), "api.CreateMulticastGroupRequest")
+	proto.RegisterType((*CreateMulticastGroupResponse)(nil), "api.CreateMulticastGroupResponse")
+	proto.RegisterType((*GetMulticastGroupRequest)(nil), "api.GetMulticastGroupRequest")
+	proto.RegisterType((*GetMulticastGroupResponse)(nil), "api.GetMulticastGroupResponse")
+	proto.RegisterType((*UpdateMulticastGroupRequest)(nil), "api.UpdateMulticastGroupRequest")
+	proto.RegisterType((*UpdateMulticastGroupResponse)(nil), "api.UpdateMulticastGroupResponse")
+	proto.RegisterType((*DeleteMulticastGroupRequest)(nil), "api.DeleteMulticastGroupRequest")
+	proto.RegisterType((*DeleteMulticastGroupResponse)(nil), "api.DeleteMulticastGroupResponse")
+	proto.RegisterType((*ListMulticastGroupRequest)(nil), "api.ListMulticastGroupRequest")
+	proto.RegisterType((*MulticastGroupListItem)(nil), "api.MulticastGroupListItem")
+	proto.RegisterType((*ListMulticastGroupResponse)(nil), "api.ListMulticastGroupResponse")
+	proto.RegisterType((*AddDeviceToMulticastGroupRequest)(nil), "api.AddDeviceToMulticastGroupRequest")
+	proto.RegisterType((*AddDeviceToMulticastGroupResponse)(nil), "api.AddDeviceToMulticastGroupResponse")
+	proto.RegisterType((*RemoveDeviceFromMulticastGroupRequest)(nil), "api.RemoveDeviceFromMulticastGroupRequest")
+	proto.RegisterType((*RemoveDeviceFromMulticastGroupResponse)(nil), "api.RemoveDeviceFromMulticastGroupResponse")
+	proto.RegisterType((*ListMulticastGroupDevicesRequest)(nil), "api.ListMulticastGroupDevicesRequest")
+	proto.RegisterType((*MulticastGroupDeviceListItem)(nil), "api.MulticastGroupDeviceListItem")
+	proto.RegisterType((*ListMulticastGroupDevicesResponse)(nil), "api.ListMulticastGroupDevicesResponse")
+	proto.RegisterType((*EnqueueMulticastQueueItemRequest)(nil), "api.EnqueueMulticastQueueItemRequest")
+	proto.RegisterType((*EnqueueMulticastQueueItemResponse)(nil), "api.EnqueueMulticastQueueItemResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for MulticastGroupService service
+
+type MulticastGroupServiceClient interface {
+	// Create creates the given multicast-group.
+	Create(ctx context.Context, in *CreateMulticastGroupRequest, opts ...grpc.CallOption) (*CreateMulticastGroupResponse, error)
+	// Get returns the multicast-group matching the given id.
+	Get(ctx context.Context, in *GetMulticastGroupRequest, opts ...grpc.CallOption) (*GetMulticastGroupResponse, error)
+	// Update updates the given multicast-group.
+	Update(ctx context.Context, in *UpdateMulticastGroupRequest, opts ...grpc.CallOption) (*UpdateMulticastGroupResponse, error)
+	// Delete deletes the multicast-group matching the given id.
+	Delete(ctx context.Context, in *DeleteMulticastGroupRequest, opts ...grpc.CallOption) (*DeleteMulticastGroupResponse, error)
+	// List lists the available multicast-groups.
+	List(ctx context.Context, in *ListMulticastGroupRequest, opts ...grpc.CallOption) (*ListMulticastGroupResponse, error)
+	// AddDevice adds the given device to the multicast-group.
+	AddDevice(ctx context.Context, in *AddDeviceToMulticastGroupRequest, opts ...grpc.CallOption) (*AddDeviceToMulticastGroupResponse, error)
+	// RemoveDevice removes the given device from the multicast-group.
+	RemoveDevice(ctx context.Context, in *RemoveDeviceFromMulticastGroupRequest, opts ...grpc.CallOption) (*RemoveDeviceFromMulticastGroupResponse, error)
+	// ListDevices lists the devices of the multicast-group.
+	ListDevices(ctx context.Context, in *ListMulticastGroupDevicesRequest, opts ...grpc.CallOption) (*ListMulticastGroupDevicesResponse, error)
+	// Enqueue adds the given payload to the multicast-queue of the
+	// multicast-group. The payload is transmitted once, using the multicast
+	// address and session keys of the group.
+	Enqueue(ctx context.Context, in *EnqueueMulticastQueueItemRequest, opts ...grpc.CallOption) (*EnqueueMulticastQueueItemResponse, error)
+}
+
+type multicastGroupServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMulticastGroupServiceClient(cc *grpc.ClientConn) MulticastGroupServiceClient {
+	return &multicastGroupServiceClient{cc}
+}
+
+func (c *multicastGroupServiceClient) Create(ctx context.Context, in *CreateMulticastGroupRequest, opts ...grpc.CallOption) (*CreateMulticastGroupResponse, error) {
+	out := new(CreateMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) Get(ctx context.Context, in *GetMulticastGroupRequest, opts ...grpc.CallOption) (*GetMulticastGroupResponse, error) {
+	out := new(GetMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) Update(ctx context.Context, in *UpdateMulticastGroupRequest, opts ...grpc.CallOption) (*UpdateMulticastGroupResponse, error) {
+	out := new(UpdateMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) Delete(ctx context.Context, in *DeleteMulticastGroupRequest, opts ...grpc.CallOption) (*DeleteMulticastGroupResponse, error) {
+	out := new(DeleteMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) List(ctx context.Context, in *ListMulticastGroupRequest, opts ...grpc.CallOption) (*ListMulticastGroupResponse, error) {
+	out := new(ListMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) AddDevice(ctx context.Context, in *AddDeviceToMulticastGroupRequest, opts ...grpc.CallOption) (*AddDeviceToMulticastGroupResponse, error) {
+	out := new(AddDeviceToMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/AddDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) RemoveDevice(ctx context.Context, in *RemoveDeviceFromMulticastGroupRequest, opts ...grpc.CallOption) (*RemoveDeviceFromMulticastGroupResponse, error) {
+	out := new(RemoveDeviceFromMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/RemoveDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) ListDevices(ctx context.Context, in *ListMulticastGroupDevicesRequest, opts ...grpc.CallOption) (*ListMulticastGroupDevicesResponse, error) {
+	out := new(ListMulticastGroupDevicesResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/ListDevices", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) Enqueue(ctx context.Context, in *EnqueueMulticastQueueItemRequest, opts ...grpc.CallOption) (*EnqueueMulticastQueueItemResponse, error) {
+	out := new(EnqueueMulticastQueueItemResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Enqueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for MulticastGroupService service
+
+type MulticastGroupServiceServer interface {
+	// Create creates the given multicast-group.
+	Create(context.Context, *CreateMulticastGroupRequest) (*CreateMulticastGroupResponse, error)
+	// Get returns the multicast-group matching the given id.
+	Get(context.Context, *GetMulticastGroupRequest) (*GetMulticastGroupResponse, error)
+	// Update updates the given multicast-group.
+	Update(context.Context, *UpdateMulticastGroupRequest) (*UpdateMulticastGroupResponse, error)
+	// Delete deletes the multicast-group matching the given id.
+	Delete(context.Context, *DeleteMulticastGroupRequest) (*DeleteMulticastGroupResponse, error)
+	// List lists the available multicast-groups.
+	List(context.Context, *ListMulticastGroupRequest) (*ListMulticastGroupResponse, error)
+	// AddDevice adds the given device to the multicast-group.
+	AddDevice(context.Context, *AddDeviceToMulticastGroupRequest) (*AddDeviceToMulticastGroupResponse, error)
+	// RemoveDevice removes the given device from the multicast-group.
+	RemoveDevice(context.Context, *RemoveDeviceFromMulticastGroupRequest) (*RemoveDeviceFromMulticastGroupResponse, error)
+	// ListDevices lists the devices of the multicast-group.
+	ListDevices(context.Context, *ListMulticastGroupDevicesRequest) (*ListMulticastGroupDevicesResponse, error)
+	// Enqueue adds the given payload to the multicast-queue of the
+	// multicast-group. The payload is transmitted once, using the multicast
+	// address and session keys of the group.
+	Enqueue(context.Context, *EnqueueMulticastQueueItemRequest) (*EnqueueMulticastQueueItemResponse, error)
+}
+
+func RegisterMulticastGroupServiceServer(s *grpc.Server, srv MulticastGroupServiceServer) {
+	s.RegisterService(&_MulticastGroupService_serviceDesc, srv)
+}
+
+func _MulticastGroupService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Create(ctx, req.(*CreateMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Get(ctx, req.(*GetMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Update(ctx, req.(*UpdateMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Delete(ctx, req.(*DeleteMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).List(ctx, req.(*ListMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_AddDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDeviceToMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).AddDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/AddDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).AddDevice(ctx, req.(*AddDeviceToMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_RemoveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeviceFromMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).RemoveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/RemoveDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).RemoveDevice(ctx, req.(*RemoveDeviceFromMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMulticastGroupDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).ListDevices(ctx, req.(*ListMulticastGroupDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_Enqueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueMulticastQueueItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Enqueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Enqueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Enqueue(ctx, req.(*EnqueueMulticastQueueItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MulticastGroupService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.MulticastGroupService",
+	HandlerType: (*MulticastGroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _MulticastGroupService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _MulticastGroupService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _MulticastGroupService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _MulticastGroupService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _MulticastGroupService_List_Handler,
+		},
+		{
+			MethodName: "AddDevice",
+			Handler:    _MulticastGroupService_AddDevice_Handler,
+		},
+		{
+			MethodName: "RemoveDevice",
+			Handler:    _MulticastGroupService_RemoveDevice_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _MulticastGroupService_ListDevices_Handler,
+		},
+		{
+			MethodName: "Enqueue",
+			Handler:    _MulticastGroupService_Enqueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "multicastGroup.proto",
+}
+
+func init() { proto.RegisterFile("multicastGroup.proto", fileDescriptor12) }
+
+var fileDescriptor12 = []byte{
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x72, 0xdb, 0x44,
+	0x14, 0x1e, 0x59, 0x89, 0x8a, 0x4f, 0x9b, 0x52, 0x76, 0xda, 0xe2, 0x2a, 0x6e, 0x6a, 0x2f, 0xc4,
+	0xe3, 0x11, 0xd4, 0x66, 0x92, 0x61, 0x20, 0xed, 0x95, 0x27, 0x29, 0x99, 0x0c, 0xd0, 0x29, 0x2a,
+	0x7d, 0x00, 0x61, 0xad, 0x83, 0xa6, 0x92, 0x56, 0x96, 0x56, 0xe1, 0xa7, 0x84, 0x0b, 0x5e, 0x80,
+	0x8b, 0x0e, 0xf0, 0x0e, 0xdc, 0x71, 0xc9, 0x1b, 0x70, 0x0f, 0x8f, 0xc0, 0x83, 0x30, 0xfb, 0x13,
+	0x25, 0x92, 0xd7, 0x92, 0xdb, 0x21, 0x77, 0xde, 0x73, 0x4e, 0xf6, 0x3b, 0xe7, 0xdb, 0xef, 0x9c,
+	0xa3, 0xc0, 0xcd, 0x28, 0x0f, 0x59, 0x30, 0xf5, 0x32, 0x76, 0x98, 0xd2, 0x3c, 0x19, 0x25, 0x29,
+	0x65, 0x14, 0x99, 0x5e, 0x12, 0xd8, 0xdd, 0x63, 0x4a, 0x8f, 0x43, 0x32, 0xf6, 0x92, 0x60, 0xec,
+	0xc5, 0x31, 0x65, 0x1e, 0x0b, 0x68, 0x9c, 0xc9, 0x10, 0xfc, 0x87, 0x01, 0x9b, 0xfb, 0x29, 0xf1,
+	0x18, 0xf9, 0xbc, 0x74, 0x83, 0x4b, 0xe6, 0x39, 0xc9, 0x18, 0x42, 0xb0, 0x16, 0x7b, 0x11, 0xe9,
+	0x18, 0x3d, 0x63, 0xd8, 0x76, 0xc5, 0x6f, 0xe4, 0xc0, 0x8d, 0x8c, 0xa4, 0x27, 0xc1, 0x94, 0x3c,
+	0x49, 0xe9, 0x2c, 0x08, 0xc9, 0xd1, 0x41, 0xa7, 0x25, 0xfc, 0x0b, 0x76, 0x74, 0x1b, 0xac, 0x68,
+	0x3a, 0xf1, 0xfd, 0xb4, 0x63, 0x8a, 0x08, 0x75, 0x42, 0x5d, 0x68, 0x47, 0xd3, 0xc7, 0xdf, 0x3c,
+	0x7f, 0xfa, 0x29, 0xf9, 0xae, 0xb3, 0x26, 0x5c, 0xe7, 0x06, 0xe9, 0x9d, 0x24, 0x89, 0xf0, 0xae,
+	0x9f, 0x79, 0x95, 0x01, 0x8f, 0xa0, 0xab, 0x4f, 0x39, 0x4b, 0x68, 0x9c, 0x11, 0x74, 0x1d, 0x5a,
+	0x81, 0xaf, 0x32, 0x6e, 0x05, 0x3e, 0x76, 0xa0, 0x73, 0x48, 0x98, 0xbe, 0xbe, 0x6a, 0xec, 0xcf,
+	0x2d, 0xb8, 0xa3, 0x09, 0xd6, 0xdf, 0x5c, 0xb0, 0xd3, 0x6a, 0x60, 0xc7, 0x6c, 0x64, 0x67, 0x6d,
+	0x39, 0x3b, 0xeb, 0xb5, 0xec, 0x58, 0x15, 0x76, 0xb8, 0x77, 0x2a, 0xd8, 0xf1, 0x27, 0xac, 0x73,
+	0x45, 0x7a, 0x0b, 0x03, 0xf7, 0xe6, 0x89, 0xaf, 0xbc, 0x6f, 0x48, 0x6f, 0x61, 0xe0, 0xf5, 0xcc,
+	0xf6, 0x63, 0xd6, 0x69, 0xf7, 0x8c, 0xe1, 0x86, 0x2b, 0x7e, 0xe3, 0x5f, 0x0c, 0xd8, 0x7c, 0x26,
+	0x22, 0x56, 0x62, 0x50, 0xcb, 0xc9, 0x65, 0xa8, 0x60, 0x0b, 0xba, 0xfa, 0xb4, 0xe4, 0x5b, 0xe1,
+	0xfb, 0xb0, 0x79, 0x40, 0x42, 0xb2, 0x62, 0xda, 0xfc, 0x3a, 0x7d, 0xb8, 0xba, 0x6e, 0x0e, 0x77,
+	0x3e, 0x0b, 0xb2, 0x25, 0x2a, 0xba, 0x09, 0xeb, 0x61, 0x10, 0x05, 0x4c, 0xdc, 0x67, 0xba, 0xf2,
+	0xc0, 0xab, 0xa6, 0xb3, 0x59, 0x46, 0x98, 0xe0, 0xc2, 0x74, 0xd5, 0x09, 0x0d, 0xe0, 0x3a, 0x4d,
+	0x8f, 0xbd, 0x38, 0xf8, 0x5e, 0xb4, 0xa2, 0xd2, 0x87, 0xe9, 0x56, 0xac, 0xf8, 0x1f, 0x03, 0x6e,
+	0x97, 0xf1, 0x78, 0x06, 0x47, 0x8c, 0x44, 0xff, 0xbb, 0x10, 0x47, 0x80, 0xca, 0xb6, 0xc7, 0xfc,
+	0x36, 0xf9, 0x22, 0x1a, 0x4f, 0x59, 0x64, 0xeb, 0xb5, 0x22, 0xb3, 0x2a, 0x22, 0xc3, 0x73, 0xb0,
+	0x75, 0x4c, 0xaa, 0x16, 0xdb, 0x02, 0x60, 0x94, 0x79, 0xe1, 0x3e, 0xcd, 0xe3, 0x33, 0x3e, 0x2f,
+	0x58, 0xd0, 0x2e, 0x58, 0x29, 0xc9, 0xf2, 0x90, 0x93, 0x6a, 0x0e, 0xaf, 0xee, 0x6c, 0x8e, 0xbc,
+	0x24, 0x18, 0xe9, 0x69, 0x72, 0x55, 0x28, 0x9e, 0x41, 0x6f, 0xe2, 0xfb, 0x07, 0x84, 0x97, 0xf1,
+	0x25, 0xd5, 0xbf, 0xa1, 0x03, 0x37, 0xca, 0x43, 0xf4, 0xe8, 0x40, 0x11, 0xbc, 0x60, 0xe7, 0x2f,
+	0xeb, 0x93, 0x93, 0x47, 0xcf, 0x8e, 0x14, 0xe1, 0xea, 0x84, 0xdf, 0x81, 0x7e, 0x0d, 0x8e, 0x52,
+	0xd2, 0x73, 0xd8, 0x76, 0x49, 0x44, 0x4f, 0x88, 0x8c, 0xfb, 0x24, 0xa5, 0xd1, 0xe5, 0x65, 0x34,
+	0x84, 0x41, 0x13, 0x98, 0x4a, 0xeb, 0x07, 0xe8, 0x2d, 0x3e, 0x8b, 0xfc, 0xab, 0xec, 0x75, 0x32,
+	0x2a, 0x7a, 0xa2, 0xa5, 0xef, 0x09, 0xf3, 0x62, 0x4f, 0xe0, 0xbf, 0x0c, 0xe8, 0xea, 0xa0, 0x0b,
+	0xc5, 0x9f, 0x17, 0x68, 0x5c, 0x2c, 0x50, 0xab, 0xfc, 0x77, 0x61, 0xc3, 0x4b, 0x92, 0x30, 0x98,
+	0x96, 0xfb, 0xab, 0x6c, 0x44, 0x43, 0x78, 0xd3, 0x27, 0xe5, 0xf6, 0x90, 0x82, 0xaf, 0x9a, 0xd1,
+	0xfb, 0xf0, 0x96, 0x4f, 0x2a, 0x2d, 0xa0, 0x54, 0xbf, 0xe8, 0xc0, 0x3f, 0x42, 0xbf, 0x86, 0xc8,
+	0x15, 0x65, 0xbe, 0x57, 0x91, 0x79, 0x5f, 0x23, 0xf3, 0x32, 0x43, 0x85, 0xd8, 0xbf, 0x85, 0xde,
+	0xa3, 0x78, 0x9e, 0x93, 0xfc, 0x7c, 0x94, 0x7d, 0xc1, 0x4f, 0x22, 0xe8, 0xf5, 0x1e, 0x72, 0xf6,
+	0x84, 0xa6, 0xf2, 0x21, 0x37, 0x5c, 0x79, 0xe0, 0xbc, 0xfb, 0x1e, 0xf3, 0x04, 0xb5, 0xd7, 0x5c,
+	0xf1, 0x1b, 0x7f, 0x04, 0xfd, 0x1a, 0x64, 0x55, 0xf9, 0xd9, 0x8e, 0x31, 0xce, 0x77, 0xcc, 0xce,
+	0x9f, 0x6d, 0xb8, 0x55, 0xae, 0xed, 0xa9, 0x9c, 0x39, 0x88, 0x82, 0x25, 0x77, 0x3d, 0xea, 0x09,
+	0x06, 0x6a, 0xbe, 0x55, 0xec, 0x7e, 0x4d, 0x84, 0x12, 0x79, 0xef, 0xa7, 0xbf, 0xff, 0x7d, 0xd9,
+	0xb2, 0xf1, 0x2d, 0xf1, 0x39, 0x54, 0x94, 0x7a, 0xff, 0x98, 0x47, 0x65, 0x0f, 0x0c, 0x07, 0x7d,
+	0x0d, 0xe6, 0x21, 0x61, 0xe8, 0xae, 0xb8, 0x6b, 0xd9, 0x67, 0x83, 0xbd, 0xb5, 0xcc, 0xad, 0x70,
+	0xb0, 0xc0, 0xe9, 0x22, 0x5b, 0x8b, 0x33, 0x7e, 0x11, 0xf8, 0xa7, 0x88, 0x81, 0x25, 0x17, 0x98,
+	0x2a, 0xad, 0x66, 0xc9, 0xda, 0xfd, 0x9a, 0x08, 0x05, 0xb9, 0x2d, 0x20, 0xef, 0xd9, 0x35, 0x90,
+	0xbc, 0xbe, 0x39, 0x58, 0x72, 0xcf, 0x29, 0xd4, 0x9a, 0x1d, 0x69, 0xf7, 0x6b, 0x22, 0xca, 0x85,
+	0x3a, 0x75, 0x85, 0xce, 0x60, 0x8d, 0x8b, 0x14, 0x49, 0xd2, 0x96, 0x6e, 0x51, 0xfb, 0xde, 0x52,
+	0xbf, 0x02, 0xbb, 0x2b, 0xc0, 0xde, 0x46, 0xfa, 0xd7, 0x43, 0xbf, 0x19, 0xd0, 0x2e, 0xc6, 0x2f,
+	0xda, 0x16, 0xb7, 0x35, 0x8d, 0x7d, 0x7b, 0xd0, 0x14, 0xa6, 0xb0, 0x1f, 0x0a, 0xec, 0x0f, 0xf1,
+	0x07, 0x4b, 0x0a, 0xad, 0xb6, 0xcd, 0xe9, 0x58, 0x4e, 0x06, 0x21, 0xaa, 0xdf, 0x0d, 0xb8, 0x76,
+	0x71, 0x0c, 0x23, 0x47, 0xa0, 0xae, 0xb4, 0x06, 0xec, 0xf7, 0x56, 0x8a, 0x55, 0x69, 0x4e, 0x44,
+	0x9a, 0x0f, 0x9d, 0xbd, 0x57, 0x4d, 0x73, 0xfc, 0x42, 0x0e, 0xd4, 0x53, 0xf4, 0xab, 0x01, 0x57,
+	0xf9, 0x23, 0x48, 0xac, 0x4c, 0x11, 0xd9, 0xb4, 0x1b, 0xec, 0x41, 0x53, 0x98, 0xca, 0xf0, 0x63,
+	0x91, 0xe1, 0x0e, 0x7a, 0x65, 0x22, 0xd1, 0x4b, 0x03, 0xae, 0xa8, 0xf9, 0xa2, 0x92, 0x6a, 0x9a,
+	0x73, 0xf6, 0xa0, 0x29, 0x4c, 0x25, 0xb5, 0x27, 0x92, 0xda, 0xc5, 0xa3, 0x95, 0x93, 0x12, 0x37,
+	0x3e, 0x30, 0x9c, 0xaf, 0x2c, 0xf1, 0x8f, 0xd4, 0xee, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc2,
+	0x1b, 0xa4, 0xfe, 0x83, 0x0d, 0x00, 0x00,
+}
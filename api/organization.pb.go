@@ -468,6 +468,98 @@ func (m *ListOrganizationUsersResponse) GetResult() []*GetOrganizationUserRespon
 	return nil
 }
 
+// HTTP organization-integration, receiving the gateway notifications of
+// the organization.
+type OrganizationHTTPIntegration struct {
+	// The id of the organization.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The headers to use when making HTTP callbacks.
+	Headers []*HTTPIntegrationHeader `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty"`
+	// The URL to call for gateway status (online / offline) notifications.
+	GatewayStatusURL string `protobuf:"bytes,3,opt,name=gatewayStatusURL" json:"gatewayStatusURL,omitempty"`
+	// Secret for signing the requests using HMAC-SHA256 (optional, min. 16 characters).
+	SigningSecret string `protobuf:"bytes,4,opt,name=signingSecret" json:"signingSecret,omitempty"`
+	// CA certificate (PEM) for verifying the server certificate (optional).
+	CaCert string `protobuf:"bytes,5,opt,name=caCert" json:"caCert,omitempty"`
+	// TLS certificate (PEM) used for client-certificate authentication (optional).
+	TlsCert string `protobuf:"bytes,6,opt,name=tlsCert" json:"tlsCert,omitempty"`
+	// TLS key (PEM) used for client-certificate authentication (optional).
+	TlsKey string `protobuf:"bytes,7,opt,name=tlsKey" json:"tlsKey,omitempty"`
+	// Payload marshaler: json (default), protobuf or protobuf_json.
+	Marshaler string `protobuf:"bytes,8,opt,name=marshaler" json:"marshaler,omitempty"`
+	// Remove the stored signing secret (on update).
+	// As the signing secret is not returned, an empty signingSecret keeps the stored secret.
+	ClearSigningSecret bool `protobuf:"varint,9,opt,name=clearSigningSecret" json:"clearSigningSecret,omitempty"`
+}
+
+func (m *OrganizationHTTPIntegration) Reset()                    { *m = OrganizationHTTPIntegration{} }
+func (m *OrganizationHTTPIntegration) String() string            { return proto.CompactTextString(m) }
+func (*OrganizationHTTPIntegration) ProtoMessage()               {}
+func (*OrganizationHTTPIntegration) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{14} }
+
+func (m *OrganizationHTTPIntegration) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OrganizationHTTPIntegration) GetHeaders() []*HTTPIntegrationHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *OrganizationHTTPIntegration) GetGatewayStatusURL() string {
+	if m != nil {
+		return m.GatewayStatusURL
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetSigningSecret() string {
+	if m != nil {
+		return m.SigningSecret
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetCaCert() string {
+	if m != nil {
+		return m.CaCert
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetTlsCert() string {
+	if m != nil {
+		return m.TlsCert
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetTlsKey() string {
+	if m != nil {
+		return m.TlsKey
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetMarshaler() string {
+	if m != nil {
+		return m.Marshaler
+	}
+	return ""
+}
+
+func (m *OrganizationHTTPIntegration) GetClearSigningSecret() bool {
+	if m != nil {
+		return m.ClearSigningSecret
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ListOrganizationRequest)(nil), "api.ListOrganizationRequest")
 	proto.RegisterType((*OrganizationRequest)(nil), "api.OrganizationRequest")
@@ -483,6 +575,7 @@ func init() {
 	proto.RegisterType((*GetOrganizationUserRequest)(nil), "api.GetOrganizationUserRequest")
 	proto.RegisterType((*GetOrganizationUserResponse)(nil), "api.GetOrganizationUserResponse")
 	proto.RegisterType((*ListOrganizationUsersResponse)(nil), "api.ListOrganizationUsersResponse")
+	proto.RegisterType((*OrganizationHTTPIntegration)(nil), "api.OrganizationHTTPIntegration")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -516,6 +609,14 @@ type OrganizationClient interface {
 	UpdateUser(ctx context.Context, in *OrganizationUserRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
 	// Delete a user from an organization.
 	DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// CreateHTTPIntegration creates an HTTP organization-integration.
+	CreateHTTPIntegration(ctx context.Context, in *OrganizationHTTPIntegration, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// GetHTTPIntegration returns the HTTP organization-integration.
+	GetHTTPIntegration(ctx context.Context, in *OrganizationRequest, opts ...grpc.CallOption) (*OrganizationHTTPIntegration, error)
+	// UpdateHTTPIntegration updates the HTTP organization-integration.
+	UpdateHTTPIntegration(ctx context.Context, in *OrganizationHTTPIntegration, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// DeleteHTTPIntegration deletes the HTTP organization-integration.
+	DeleteHTTPIntegration(ctx context.Context, in *OrganizationRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
 }
 
 type organizationClient struct {
@@ -616,6 +717,42 @@ func (c *organizationClient) DeleteUser(ctx context.Context, in *DeleteOrganizat
 	return out, nil
 }
 
+func (c *organizationClient) CreateHTTPIntegration(ctx context.Context, in *OrganizationHTTPIntegration, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error) {
+	out := new(OrganizationEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/CreateHTTPIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) GetHTTPIntegration(ctx context.Context, in *OrganizationRequest, opts ...grpc.CallOption) (*OrganizationHTTPIntegration, error) {
+	out := new(OrganizationHTTPIntegration)
+	err := grpc.Invoke(ctx, "/api.Organization/GetHTTPIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) UpdateHTTPIntegration(ctx context.Context, in *OrganizationHTTPIntegration, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error) {
+	out := new(OrganizationEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/UpdateHTTPIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) DeleteHTTPIntegration(ctx context.Context, in *OrganizationRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error) {
+	out := new(OrganizationEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/DeleteHTTPIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Organization service
 
 type OrganizationServer interface {
@@ -639,6 +776,14 @@ type OrganizationServer interface {
 	UpdateUser(context.Context, *OrganizationUserRequest) (*OrganizationEmptyResponse, error)
 	// Delete a user from an organization.
 	DeleteUser(context.Context, *DeleteOrganizationUserRequest) (*OrganizationEmptyResponse, error)
+	// CreateHTTPIntegration creates an HTTP organization-integration.
+	CreateHTTPIntegration(context.Context, *OrganizationHTTPIntegration) (*OrganizationEmptyResponse, error)
+	// GetHTTPIntegration returns the HTTP organization-integration.
+	GetHTTPIntegration(context.Context, *OrganizationRequest) (*OrganizationHTTPIntegration, error)
+	// UpdateHTTPIntegration updates the HTTP organization-integration.
+	UpdateHTTPIntegration(context.Context, *OrganizationHTTPIntegration) (*OrganizationEmptyResponse, error)
+	// DeleteHTTPIntegration deletes the HTTP organization-integration.
+	DeleteHTTPIntegration(context.Context, *OrganizationRequest) (*OrganizationEmptyResponse, error)
 }
 
 func RegisterOrganizationServer(s *grpc.Server, srv OrganizationServer) {
@@ -825,6 +970,78 @@ func _Organization_DeleteUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organization_CreateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationHTTPIntegration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).CreateHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/CreateHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).CreateHTTPIntegration(ctx, req.(*OrganizationHTTPIntegration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_GetHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).GetHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/GetHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).GetHTTPIntegration(ctx, req.(*OrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_UpdateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationHTTPIntegration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).UpdateHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/UpdateHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).UpdateHTTPIntegration(ctx, req.(*OrganizationHTTPIntegration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_DeleteHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).DeleteHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/DeleteHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).DeleteHTTPIntegration(ctx, req.(*OrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Organization_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Organization",
 	HandlerType: (*OrganizationServer)(nil),
@@ -869,6 +1086,22 @@ var _Organization_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _Organization_DeleteUser_Handler,
 		},
+		{
+			MethodName: "CreateHTTPIntegration",
+			Handler:    _Organization_CreateHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "GetHTTPIntegration",
+			Handler:    _Organization_GetHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateHTTPIntegration",
+			Handler:    _Organization_UpdateHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteHTTPIntegration",
+			Handler:    _Organization_DeleteHTTPIntegration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organization.proto",
@@ -877,52 +1110,66 @@ var _Organization_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("organization.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xe3, 0xc4, 0x6d, 0xa6, 0x08, 0xa4, 0xa5, 0x6a, 0x1c, 0x37, 0x69, 0xc2, 0x4a, 0x45,
-	0x51, 0x41, 0x89, 0x28, 0x1c, 0x10, 0xb7, 0xaa, 0x45, 0x01, 0x09, 0x81, 0x64, 0xa9, 0x27, 0x10,
-	0xd5, 0x12, 0x6f, 0xdb, 0x95, 0x1c, 0xdb, 0xf5, 0x6e, 0x40, 0xa1, 0x54, 0x42, 0x5c, 0x39, 0xf2,
-	0x01, 0x7c, 0x0d, 0x5f, 0xc0, 0x2f, 0xf0, 0x15, 0x9c, 0x90, 0xd7, 0x9b, 0xe2, 0x3a, 0x5e, 0x27,
-	0xaa, 0xe0, 0xd6, 0xdd, 0x99, 0xcc, 0x7b, 0xf3, 0xf6, 0xcd, 0xb8, 0x80, 0xc2, 0xf8, 0x84, 0x04,
-	0xec, 0x23, 0x11, 0x2c, 0x0c, 0xfa, 0x51, 0x1c, 0x8a, 0x10, 0x99, 0x24, 0x62, 0x4e, 0xeb, 0x24,
-	0x0c, 0x4f, 0x7c, 0x3a, 0x20, 0x11, 0x1b, 0x90, 0x20, 0x08, 0x85, 0xcc, 0xe0, 0x69, 0x0a, 0x3e,
-	0x82, 0xc6, 0x0b, 0xc6, 0xc5, 0xab, 0xcc, 0x8f, 0x5d, 0x7a, 0x36, 0xa1, 0x5c, 0xa0, 0x75, 0xa8,
-	0xf9, 0x6c, 0xcc, 0x84, 0x6d, 0x74, 0x8d, 0x5e, 0xcd, 0x4d, 0x0f, 0x68, 0x03, 0xac, 0xf0, 0xf8,
-	0x98, 0x53, 0x61, 0x57, 0xe4, 0xb5, 0x3a, 0x25, 0xf7, 0x9c, 0x92, 0x78, 0x74, 0x6a, 0x9b, 0x5d,
-	0xa3, 0x57, 0x77, 0xd5, 0x09, 0x6f, 0xc3, 0xed, 0xa2, 0xe2, 0x37, 0xa1, 0xc2, 0x3c, 0x59, 0xd9,
-	0x74, 0x2b, 0xcc, 0xc3, 0x3f, 0x0c, 0x68, 0x0c, 0x69, 0x8e, 0x07, 0x8f, 0xc2, 0x80, 0xd3, 0x7c,
-	0x2e, 0x42, 0x50, 0x0d, 0xc8, 0x98, 0x4a, 0x02, 0x75, 0x57, 0xfe, 0x8d, 0xba, 0xb0, 0xe6, 0x31,
-	0x1e, 0xf9, 0x64, 0xfa, 0x32, 0x09, 0xa5, 0x1c, 0xb2, 0x57, 0xa8, 0x07, 0xb7, 0x46, 0x24, 0x78,
-	0x46, 0xde, 0xd3, 0x21, 0x11, 0xf4, 0x03, 0x99, 0x72, 0xbb, 0xda, 0x35, 0x7a, 0xab, 0x6e, 0xfe,
-	0x1a, 0xb5, 0xa0, 0x3e, 0x8a, 0x29, 0x11, 0xd4, 0xdb, 0x13, 0x76, 0x4d, 0x56, 0xfa, 0x7b, 0x91,
-	0x44, 0x27, 0x91, 0xa7, 0xa2, 0x56, 0x1a, 0xbd, 0xbc, 0xc0, 0xe7, 0xd0, 0xdc, 0x97, 0xa9, 0x45,
-	0x4d, 0xcf, 0x88, 0x1b, 0x7a, 0xe2, 0x95, 0xa5, 0x88, 0x9b, 0x85, 0xc4, 0xf1, 0x7d, 0x70, 0x8a,
-	0xc0, 0x8b, 0x65, 0xc4, 0x5f, 0x0d, 0x68, 0x1e, 0x4a, 0xe2, 0x4b, 0x3c, 0xd0, 0xff, 0x16, 0x1d,
-	0x47, 0x60, 0xcf, 0x1b, 0x51, 0x31, 0xdf, 0x02, 0x10, 0xa1, 0x20, 0xfe, 0x7e, 0x38, 0x09, 0x66,
-	0x76, 0xcc, 0xdc, 0xa0, 0x47, 0x60, 0xc5, 0x94, 0x4f, 0xfc, 0xc4, 0x93, 0x66, 0x6f, 0x6d, 0xb7,
-	0xd5, 0x27, 0x11, 0xeb, 0x6b, 0xec, 0xe4, 0xaa, 0x5c, 0xbc, 0x09, 0xcd, 0x6c, 0xfc, 0xe9, 0x38,
-	0x12, 0xd3, 0x59, 0x12, 0x7e, 0x0d, 0x8d, 0x6c, 0xf0, 0x90, 0xd3, 0x58, 0xa7, 0xcc, 0x06, 0x58,
-	0x13, 0x4e, 0xe3, 0xe7, 0x07, 0x52, 0x1b, 0xd3, 0x55, 0x27, 0x64, 0xc3, 0x0a, 0xe3, 0x7b, 0xde,
-	0x98, 0x05, 0xea, 0xbd, 0x66, 0x47, 0x3c, 0x84, 0xf6, 0x01, 0xf5, 0xe9, 0x55, 0xe1, 0xaf, 0x01,
-	0x81, 0xdf, 0x40, 0x2b, 0x2f, 0x5a, 0x52, 0x86, 0xeb, 0xea, 0x5c, 0x8e, 0x74, 0xa5, 0x78, 0xa4,
-	0xcd, 0xec, 0x48, 0xe3, 0x03, 0x70, 0x72, 0x1a, 0x5e, 0x87, 0xe3, 0x77, 0x03, 0x36, 0x0b, 0xcb,
-	0x68, 0xa6, 0xdb, 0x81, 0xd5, 0xe4, 0x97, 0x19, 0xb3, 0x5d, 0x9e, 0xf5, 0x92, 0x5e, 0x9d, 0xd9,
-	0x6a, 0xe9, 0xcc, 0xd6, 0xf2, 0x33, 0x3b, 0x85, 0xb6, 0x46, 0xc5, 0x25, 0xfd, 0xf7, 0x38, 0xe7,
-	0xbf, 0x6e, 0x91, 0xff, 0xb2, 0x4d, 0xcf, 0x3c, 0xb8, 0xfb, 0x7b, 0x15, 0x6e, 0x64, 0x93, 0xd0,
-	0x11, 0x54, 0x13, 0x2e, 0x28, 0xb5, 0xb0, 0x66, 0x35, 0x3b, 0x6d, 0x4d, 0x54, 0x99, 0xd7, 0xf9,
-	0xf2, 0xf3, 0xd7, 0xb7, 0xca, 0x3a, 0x42, 0x72, 0xe9, 0x67, 0x3f, 0x0c, 0x1c, 0xbd, 0x05, 0x73,
-	0x48, 0x05, 0xb2, 0x65, 0x85, 0xa2, 0xda, 0xa5, 0xc3, 0x83, 0x3b, 0xb2, 0x74, 0x13, 0x35, 0xe6,
-	0x4b, 0x0f, 0xce, 0x99, 0x77, 0x81, 0x4e, 0xc1, 0x4a, 0x77, 0x10, 0xda, 0x92, 0x85, 0xb4, 0xdb,
-	0xd0, 0xe9, 0x68, 0xe3, 0x0a, 0xab, 0x2d, 0xb1, 0x1a, 0xb8, 0xa0, 0x8d, 0x27, 0xc6, 0x0e, 0xf2,
-	0xc1, 0x4a, 0xd7, 0x97, 0x42, 0xd2, 0xee, 0x32, 0x67, 0x6b, 0xae, 0xd9, 0xab, 0xc3, 0x8e, 0x25,
-	0x50, 0xcb, 0xd1, 0x35, 0x95, 0xa0, 0x8d, 0xc0, 0x4a, 0x67, 0xb6, 0x44, 0xba, 0x45, 0x38, 0x4a,
-	0xbc, 0x1d, 0xad, 0x78, 0x53, 0xa8, 0x27, 0x8f, 0x2a, 0xdd, 0x87, 0xee, 0x14, 0x3e, 0x72, 0x76,
-	0xbe, 0x1d, 0x5c, 0x96, 0xa2, 0x40, 0xb7, 0x25, 0x68, 0x07, 0xb5, 0x35, 0xa0, 0x83, 0x89, 0x44,
-	0xfb, 0x04, 0x2b, 0x43, 0x2a, 0x91, 0x51, 0x47, 0x6f, 0xdf, 0x14, 0x76, 0xa1, 0xbf, 0x71, 0x5f,
-	0x82, 0xf6, 0xd0, 0xdd, 0x52, 0xd0, 0xc1, 0x79, 0xba, 0x23, 0x2e, 0xd0, 0x19, 0xac, 0xec, 0x79,
-	0x9e, 0x44, 0x6f, 0xcd, 0x89, 0x98, 0x85, 0x5e, 0x24, 0x71, 0x4f, 0x02, 0x63, 0x5c, 0xde, 0x6d,
-	0xf2, 0xa0, 0x17, 0x00, 0xa9, 0x63, 0xfe, 0x01, 0xea, 0x03, 0x89, 0x7a, 0xcf, 0x59, 0xb2, 0xdd,
-	0x04, 0xfe, 0xb3, 0x01, 0x90, 0x1a, 0x4a, 0xe2, 0xa7, 0x2f, 0x59, 0xfa, 0x55, 0x58, 0xc8, 0x42,
-	0x89, 0xbe, 0xb3, 0x24, 0x8b, 0x77, 0x96, 0xfc, 0x17, 0xf0, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x81, 0xc9, 0x5a, 0xec, 0x3b, 0x0a, 0x00, 0x00,
+	// 970 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0x93, 0x36, 0x69, 0xce, 0xb2, 0xfc, 0x0c, 0xbb, 0x1b, 0xd7, 0x4d, 0xda, 0x30, 0x62,
+	0x51, 0x08, 0x28, 0x11, 0x4b, 0x2f, 0x10, 0x77, 0x55, 0x8b, 0xd2, 0x15, 0x2b, 0x40, 0xee, 0xf6,
+	0x0a, 0xc4, 0x6a, 0x88, 0x67, 0x93, 0x91, 0x1c, 0xdb, 0xeb, 0x99, 0x80, 0x42, 0xa9, 0x84, 0x80,
+	0x3b, 0x2e, 0xb8, 0xe0, 0x01, 0x78, 0x00, 0x9e, 0x83, 0x4b, 0xae, 0x78, 0x05, 0x1e, 0x04, 0x79,
+	0x66, 0x9c, 0x75, 0x6c, 0x4f, 0xe2, 0x15, 0xdb, 0xbb, 0xcc, 0x39, 0xc7, 0xe7, 0xfb, 0xe6, 0x3b,
+	0x3f, 0xa3, 0x00, 0x0a, 0xe3, 0x29, 0x09, 0xd8, 0xf7, 0x44, 0xb0, 0x30, 0x18, 0x46, 0x71, 0x28,
+	0x42, 0x54, 0x27, 0x11, 0x73, 0x3a, 0xd3, 0x30, 0x9c, 0xfa, 0x74, 0x44, 0x22, 0x36, 0x22, 0x41,
+	0x10, 0x0a, 0x19, 0xc1, 0x55, 0x88, 0xf3, 0x06, 0x89, 0x22, 0x9f, 0x4d, 0x32, 0x5f, 0xe1, 0x27,
+	0xd0, 0x7e, 0xc4, 0xb8, 0xf8, 0x3c, 0x93, 0xcf, 0xa5, 0xcf, 0x16, 0x94, 0x0b, 0x74, 0x07, 0x76,
+	0x7d, 0x36, 0x67, 0xc2, 0xb6, 0x7a, 0x56, 0x7f, 0xd7, 0x55, 0x07, 0x74, 0x0f, 0x1a, 0xe1, 0xd3,
+	0xa7, 0x9c, 0x0a, 0xbb, 0x26, 0xcd, 0xfa, 0x94, 0xd8, 0x39, 0x25, 0xf1, 0x64, 0x66, 0xd7, 0x7b,
+	0x56, 0xbf, 0xe5, 0xea, 0x13, 0xbe, 0x0f, 0x6f, 0x96, 0x25, 0x7f, 0x15, 0x6a, 0xcc, 0x93, 0x99,
+	0xeb, 0x6e, 0x8d, 0x79, 0xf8, 0x2f, 0x0b, 0xda, 0x63, 0x9a, 0xe3, 0xc1, 0xa3, 0x30, 0xe0, 0x34,
+	0x1f, 0x8b, 0x10, 0xec, 0x04, 0x64, 0x4e, 0x25, 0x81, 0x96, 0x2b, 0x7f, 0xa3, 0x1e, 0xdc, 0xf2,
+	0x18, 0x8f, 0x7c, 0xb2, 0xfc, 0x2c, 0x71, 0x29, 0x0e, 0x59, 0x13, 0xea, 0xc3, 0x6b, 0x13, 0x12,
+	0x9c, 0x93, 0x6f, 0xe9, 0x98, 0x08, 0xfa, 0x1d, 0x59, 0x72, 0x7b, 0xa7, 0x67, 0xf5, 0xf7, 0xdc,
+	0xbc, 0x19, 0x75, 0xa0, 0x35, 0x89, 0x29, 0x11, 0xd4, 0x3b, 0x11, 0xf6, 0xae, 0xcc, 0xf4, 0xdc,
+	0x90, 0x78, 0x17, 0x91, 0xa7, 0xbd, 0x0d, 0xe5, 0x5d, 0x19, 0xf0, 0x15, 0xec, 0x9f, 0xca, 0xd0,
+	0xb2, 0x4b, 0xa7, 0xc4, 0x2d, 0x33, 0xf1, 0x5a, 0x25, 0xe2, 0xf5, 0x52, 0xe2, 0xf8, 0x7d, 0x70,
+	0xca, 0xc0, 0xcb, 0x65, 0xc4, 0xbf, 0x5a, 0xb0, 0x7f, 0x19, 0x79, 0x85, 0xf0, 0xd2, 0x02, 0xdd,
+	0xb4, 0xe8, 0x38, 0x02, 0xbb, 0xd8, 0x88, 0x9a, 0xf9, 0x21, 0x80, 0x08, 0x05, 0xf1, 0x4f, 0xc3,
+	0x45, 0x90, 0xb6, 0x63, 0xc6, 0x82, 0x8e, 0xa1, 0x11, 0x53, 0xbe, 0xf0, 0x93, 0x9e, 0xac, 0xf7,
+	0x6f, 0x3d, 0xe8, 0x0c, 0x49, 0xc4, 0x86, 0x86, 0x76, 0x72, 0x75, 0x2c, 0x3e, 0x80, 0xfd, 0xac,
+	0xff, 0x93, 0x79, 0x24, 0x96, 0x69, 0x10, 0xfe, 0x12, 0xda, 0x59, 0xe7, 0x25, 0xa7, 0xb1, 0x49,
+	0x99, 0x7b, 0xd0, 0x58, 0x70, 0x1a, 0x3f, 0x3c, 0x93, 0xda, 0xd4, 0x5d, 0x7d, 0x42, 0x36, 0x34,
+	0x19, 0x3f, 0xf1, 0xe6, 0x2c, 0xd0, 0xf5, 0x4a, 0x8f, 0x78, 0x0c, 0xdd, 0x33, 0xea, 0x53, 0x41,
+	0xff, 0x27, 0x04, 0xfe, 0x0a, 0x3a, 0x79, 0xd1, 0x92, 0x34, 0xdc, 0x94, 0x67, 0x35, 0xd2, 0xb5,
+	0xf2, 0x91, 0xae, 0x67, 0x47, 0x1a, 0x9f, 0x81, 0x33, 0xa6, 0x85, 0xe4, 0x2f, 0xca, 0xf1, 0x0f,
+	0x0b, 0x0e, 0x4a, 0xd3, 0x18, 0xa6, 0xdb, 0x81, 0xbd, 0xe4, 0xcb, 0x4c, 0xb3, 0xad, 0xce, 0x66,
+	0x49, 0xd7, 0x67, 0x76, 0x67, 0xe3, 0xcc, 0xee, 0xe6, 0x67, 0x76, 0x09, 0x5d, 0x83, 0x8a, 0x15,
+	0xfb, 0xef, 0xa3, 0x5c, 0xff, 0xf5, 0xca, 0xfa, 0x2f, 0x7b, 0xe9, 0x55, 0x0f, 0xfe, 0x5d, 0x83,
+	0x83, 0x6c, 0xd0, 0xf9, 0xe3, 0xc7, 0x5f, 0x3c, 0x0c, 0x04, 0x9d, 0xc6, 0xf2, 0x58, 0x10, 0xe7,
+	0x18, 0x9a, 0x33, 0x4a, 0x3c, 0x1a, 0x73, 0x0d, 0xe5, 0x48, 0xa8, 0xdc, 0x67, 0xe7, 0x32, 0xc4,
+	0x4d, 0x43, 0xd1, 0x00, 0x5e, 0x9f, 0xaa, 0x39, 0xbb, 0x10, 0x44, 0x2c, 0xf8, 0xa5, 0xfb, 0x48,
+	0x0f, 0x6b, 0xc1, 0x8e, 0xde, 0x86, 0xdb, 0x9c, 0x4d, 0x03, 0x16, 0x4c, 0x2f, 0xe8, 0x24, 0xa6,
+	0xa9, 0x98, 0xeb, 0xc6, 0xa4, 0xd8, 0x13, 0x72, 0x4a, 0xe3, 0x54, 0x4d, 0x7d, 0x4a, 0x0a, 0x24,
+	0x7c, 0x2e, 0x1d, 0x6a, 0x35, 0xa6, 0xc7, 0xe4, 0x0b, 0xe1, 0xf3, 0x4f, 0xe9, 0xd2, 0x6e, 0xaa,
+	0x2f, 0xd4, 0x29, 0x29, 0xcd, 0x9c, 0xc4, 0x7c, 0x46, 0x7c, 0x1a, 0xdb, 0x7b, 0xaa, 0x34, 0x2b,
+	0x03, 0x1a, 0x02, 0x9a, 0xf8, 0x94, 0xc4, 0x17, 0x6b, 0x94, 0x5a, 0xb2, 0xf6, 0x25, 0x9e, 0x07,
+	0x7f, 0xde, 0x86, 0x57, 0xb2, 0x7a, 0xa2, 0x27, 0xb0, 0x93, 0xd4, 0x16, 0xa9, 0x95, 0x60, 0x78,
+	0xea, 0x9c, 0xae, 0xc1, 0xab, 0x97, 0x81, 0xf3, 0xd3, 0x3f, 0xff, 0xfe, 0x5e, 0xbb, 0x83, 0x90,
+	0x7c, 0x57, 0xb3, 0x6f, 0x2f, 0x47, 0x5f, 0x43, 0x7d, 0x4c, 0x05, 0xb2, 0x65, 0x86, 0xb2, 0xdc,
+	0x1b, 0x97, 0x11, 0x3e, 0x92, 0xa9, 0xf7, 0x51, 0xbb, 0x98, 0x7a, 0x74, 0xc5, 0xbc, 0x6b, 0x34,
+	0x83, 0x86, 0xda, 0xe9, 0xe8, 0x50, 0x26, 0x32, 0xbe, 0x2e, 0xce, 0x91, 0xd1, 0xaf, 0xb1, 0xba,
+	0x12, 0xab, 0x8d, 0x4b, 0xae, 0xf1, 0xb1, 0x35, 0x40, 0x3e, 0x34, 0xd4, 0x73, 0xa0, 0x91, 0x8c,
+	0x6f, 0x83, 0x73, 0x58, 0xb8, 0xec, 0xfa, 0xf2, 0xc4, 0x12, 0xa8, 0xe3, 0x98, 0x2e, 0x95, 0xa0,
+	0x4d, 0xa0, 0xa1, 0x76, 0xe0, 0x06, 0xe9, 0xb6, 0xe1, 0x68, 0xf1, 0x06, 0x46, 0xf1, 0x96, 0xd0,
+	0x4a, 0x8a, 0x2a, 0xa7, 0x19, 0xbd, 0x55, 0x5a, 0xe4, 0xec, 0xbe, 0x74, 0xf0, 0xa6, 0x10, 0x0d,
+	0x7a, 0x5f, 0x82, 0x1e, 0xa1, 0xae, 0x01, 0x74, 0xb4, 0x90, 0x68, 0x3f, 0x40, 0x73, 0x4c, 0x25,
+	0x32, 0x3a, 0x32, 0xaf, 0x03, 0x05, 0xbb, 0x75, 0x5f, 0xe0, 0xa1, 0x04, 0xed, 0xa3, 0x77, 0x36,
+	0x82, 0x8e, 0xae, 0xd4, 0xce, 0xbd, 0x46, 0xcf, 0xa0, 0x79, 0xe2, 0x79, 0x12, 0xbd, 0x53, 0x10,
+	0x31, 0x0b, 0xbd, 0x4d, 0xe2, 0xbe, 0x04, 0xc6, 0x78, 0xf3, 0x6d, 0x93, 0x82, 0x5e, 0x03, 0xa8,
+	0x8e, 0x79, 0x09, 0xa8, 0x1f, 0x48, 0xd4, 0xf7, 0x9c, 0x8a, 0xd7, 0x4d, 0xe0, 0x7f, 0xb4, 0x00,
+	0x54, 0x43, 0x49, 0x7c, 0x55, 0xc9, 0x8d, 0xaf, 0xec, 0x56, 0x16, 0x5a, 0xf4, 0x41, 0x55, 0xd1,
+	0x7f, 0xb3, 0xe0, 0xae, 0x1a, 0xbf, 0xfc, 0x1a, 0xef, 0x15, 0x90, 0x72, 0x11, 0x5b, 0xb9, 0x1c,
+	0x4b, 0x2e, 0x43, 0xfc, 0xae, 0x89, 0x0b, 0x7b, 0x9e, 0x8c, 0x8f, 0x66, 0x42, 0x44, 0x89, 0x28,
+	0x3f, 0x5b, 0x80, 0xc6, 0x54, 0xe4, 0xe9, 0x98, 0x27, 0x6e, 0x2b, 0xd1, 0xb4, 0x34, 0xa8, 0x3a,
+	0x11, 0xa9, 0x8b, 0x6a, 0x8d, 0x1b, 0xd3, 0xc5, 0x79, 0x31, 0x5d, 0x7e, 0xb1, 0xe0, 0xae, 0xea,
+	0x8d, 0xea, 0xd2, 0x54, 0xec, 0xd9, 0x41, 0x75, 0x26, 0xdf, 0x34, 0xe4, 0x7f, 0xb0, 0x0f, 0xff,
+	0x1b, 0x00, 0xb7, 0xcd, 0xca, 0x01, 0xcf, 0x0d, 0x00, 0x00,
 }
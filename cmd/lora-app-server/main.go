@@ -34,11 +34,19 @@ import (
 	pb "github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/api"
 	"github.com/Frankz/lora-app-server/internal/api/auth"
+	"github.com/Frankz/lora-app-server/internal/clocksync"
 	"github.com/Frankz/lora-app-server/internal/common"
 	"github.com/Frankz/lora-app-server/internal/downlink"
+	"github.com/Frankz/lora-app-server/internal/eventlog"
+	"github.com/Frankz/lora-app-server/internal/fuota"
+	"github.com/Frankz/lora-app-server/internal/geolocation"
 	"github.com/Frankz/lora-app-server/internal/gwping"
+	"github.com/Frankz/lora-app-server/internal/gwstatus"
+	"github.com/Frankz/lora-app-server/internal/handler/httphandler"
+	"github.com/Frankz/lora-app-server/internal/handler/marshaler"
 	"github.com/Frankz/lora-app-server/internal/handler/mqtthandler"
 	"github.com/Frankz/lora-app-server/internal/handler/multihandler"
+	"github.com/Frankz/lora-app-server/internal/health"
 	"github.com/Frankz/lora-app-server/internal/migrations"
 	"github.com/Frankz/lora-app-server/internal/nsclient"
 	"github.com/Frankz/lora-app-server/internal/profilesmigrate"
@@ -112,7 +120,16 @@ func run(c *cli.Context) error {
 		setHashIterations,
 		setDisableAssignExistingUsers,
 		setPublicASSettings,
+		handleDeviceEventRetention,
+		setGeolocationResolver,
 		handleDataDownPayloads,
+		handleScheduledDownlinks,
+		handleDownlinkStatus,
+		handleFUOTASessions,
+		handleClockSync,
+		handleDeviceHealth,
+		handleGatewayStatus,
+		startHTTPIntegrationRetry,
 		startApplicationServerAPI,
 		startGatewayPing,
 		startJoinServerAPI,
@@ -174,7 +191,30 @@ func setRedisPool(c *cli.Context) error {
 }
 
 func setHandler(c *cli.Context) error {
-	h, err := mqtthandler.NewHandler(c.String("mqtt-server"), c.String("mqtt-username"), c.String("mqtt-password"), c.String("mqtt-ca-cert"), c.String("mqtt-tls-cert"), c.String("mqtt-tls-key"))
+	qos := c.Int("mqtt-qos")
+	if qos < 0 || qos > 2 {
+		return fmt.Errorf("invalid mqtt-qos %d, must be 0, 1 or 2", qos)
+	}
+
+	h, err := mqtthandler.NewHandler(mqtthandler.Config{
+		Server:                c.String("mqtt-server"),
+		Username:              c.String("mqtt-username"),
+		Password:              c.String("mqtt-password"),
+		CACert:                c.String("mqtt-ca-cert"),
+		TLSCert:               c.String("mqtt-tls-cert"),
+		TLSKey:                c.String("mqtt-tls-key"),
+		UplinkTopicTemplate:   c.String("mqtt-uplink-topic-template"),
+		JoinTopicTemplate:     c.String("mqtt-join-topic-template"),
+		ACKTopicTemplate:      c.String("mqtt-ack-topic-template"),
+		ErrorTopicTemplate:    c.String("mqtt-error-topic-template"),
+		AlertTopicTemplate:    c.String("mqtt-alert-topic-template"),
+		DownlinkTopicTemplate: c.String("mqtt-downlink-topic-template"),
+		QOS:                   uint8(qos),
+		Retain:                c.Bool("mqtt-retain"),
+		Marshaler:             marshaler.Type(c.String("mqtt-marshaler")),
+
+		GatewayStatusTopicTemplate: c.String("mqtt-gateway-status-topic-template"),
+	})
 	if err != nil {
 		return errors.Wrap(err, "setup mqtt handler error")
 	}
@@ -246,11 +286,77 @@ func setPublicASSettings(c *cli.Context) error {
 	return nil
 }
 
+func handleDeviceEventRetention(c *cli.Context) error {
+	common.DeviceEventMaxAge = c.Duration("device-event-max-age")
+	common.DeviceEventMaxCount = c.Int("device-event-max-count")
+	if common.DeviceEventMaxAge == 0 && common.DeviceEventMaxCount == 0 {
+		return nil
+	}
+	go eventlog.PruneLoop()
+	return nil
+}
+
+func setGeolocationResolver(c *cli.Context) error {
+	r, err := geolocation.NewResolver(strings.Split(c.String("geolocation-resolvers"), ","))
+	if err != nil {
+		return errors.Wrap(err, "setup geolocation resolver error")
+	}
+	common.GeolocationResolver = r
+	return nil
+}
+
 func handleDataDownPayloads(c *cli.Context) error {
 	go downlink.HandleDataDownPayloads()
 	return nil
 }
 
+func handleScheduledDownlinks(c *cli.Context) error {
+	go downlink.ScheduledDownlinkLoop()
+	return nil
+}
+
+func handleDownlinkStatus(c *cli.Context) error {
+	common.DownlinkACKTimeout = c.Duration("downlink-ack-timeout")
+	common.DownlinkMaxAge = c.Duration("downlink-max-age")
+	common.DownlinkMaxCount = c.Int("downlink-max-count")
+	go downlink.DownlinkStatusLoop()
+	return nil
+}
+
+func handleFUOTASessions(c *cli.Context) error {
+	go fuota.SessionLoop()
+	return nil
+}
+
+func handleClockSync(c *cli.Context) error {
+	go clocksync.ResyncLoop()
+	return nil
+}
+
+func handleDeviceHealth(c *cli.Context) error {
+	common.DeviceHealthCheckInterval = c.Duration("device-health-check-interval")
+	if common.DeviceHealthCheckInterval == 0 {
+		return nil
+	}
+	go health.CheckLoop()
+	return nil
+}
+
+func handleGatewayStatus(c *cli.Context) error {
+	common.GatewayOfflineTimeout = c.Duration("gateway-offline-timeout")
+	common.GatewayStatusCheckInterval = c.Duration("gateway-status-check-interval")
+	if common.GatewayOfflineTimeout == 0 || common.GatewayStatusCheckInterval == 0 {
+		return nil
+	}
+	go gwstatus.CheckLoop()
+	return nil
+}
+
+func startHTTPIntegrationRetry(c *cli.Context) error {
+	go httphandler.RetryLoop()
+	return nil
+}
+
 func startApplicationServerAPI(c *cli.Context) error {
 	log.WithFields(log.Fields{
 		"bind":     c.String("bind"),
@@ -275,6 +381,7 @@ func startGatewayPing(c *cli.Context) error {
 	common.GatewayPingFrequency = c.Int("gw-ping-frequency")
 	common.GatewayPingDR = c.Int("gw-ping-dr")
 	common.GatewayPingInterval = c.Duration("gw-ping-interval")
+	common.GatewayPingConcurrency = c.Int("gw-ping-concurrency")
 
 	if common.GatewayPingFrequency == 0 {
 		log.Fatalf("--gw-ping-frequency setting must be set")
@@ -350,6 +457,9 @@ func startClientAPI(ctx context.Context) func(*cli.Context) error {
 		pb.RegisterNetworkServerServer(clientAPIHandler, api.NewNetworkServerAPI(validator))
 		pb.RegisterServiceProfileServiceServer(clientAPIHandler, api.NewServiceProfileServiceAPI(validator))
 		pb.RegisterDeviceProfileServiceServer(clientAPIHandler, api.NewDeviceProfileServiceAPI(validator))
+		pb.RegisterMulticastGroupServiceServer(clientAPIHandler, api.NewMulticastGroupAPI(validator))
+		pb.RegisterScheduledDownlinkServiceServer(clientAPIHandler, api.NewScheduledDownlinkAPI(validator))
+		pb.RegisterFUOTASessionServiceServer(clientAPIHandler, api.NewFUOTASessionAPI(validator))
 
 		// setup the client http interface variable
 		// we need to start the gRPC service first, as it is used by the
@@ -519,6 +629,15 @@ func getJSONGateway(ctx context.Context, c *cli.Context) (http.Handler, error) {
 	if err := pb.RegisterDeviceProfileServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
 		return nil, errors.Wrap(err, "register device-profile handler error")
 	}
+	if err := pb.RegisterMulticastGroupServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register multicast-group handler error")
+	}
+	if err := pb.RegisterScheduledDownlinkServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register scheduled downlink handler error")
+	}
+	if err := pb.RegisterFUOTASessionServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register fuota session handler error")
+	}
 
 	return mux, nil
 }
@@ -610,6 +729,64 @@ func main() {
 			Usage:  "mqtt key file of certificate used by the gateway backend (optional)",
 			EnvVar: "MQTT_CERT_KEY",
 		},
+		cli.StringFlag{
+			Name:   "mqtt-uplink-topic-template",
+			Usage:  "mqtt topic template for uplink data (Go template, with .ApplicationID and .DevEUI)",
+			Value:  mqtthandler.DefaultUplinkTopicTemplate,
+			EnvVar: "MQTT_UPLINK_TOPIC_TEMPLATE",
+		},
+		cli.StringFlag{
+			Name:   "mqtt-join-topic-template",
+			Usage:  "mqtt topic template for join notifications (Go template, with .ApplicationID and .DevEUI)",
+			Value:  mqtthandler.DefaultJoinTopicTemplate,
+			EnvVar: "MQTT_JOIN_TOPIC_TEMPLATE",
+		},
+		cli.StringFlag{
+			Name:   "mqtt-ack-topic-template",
+			Usage:  "mqtt topic template for ack notifications (Go template, with .ApplicationID and .DevEUI)",
+			Value:  mqtthandler.DefaultACKTopicTemplate,
+			EnvVar: "MQTT_ACK_TOPIC_TEMPLATE",
+		},
+		cli.StringFlag{
+			Name:   "mqtt-error-topic-template",
+			Usage:  "mqtt topic template for error notifications (Go template, with .ApplicationID and .DevEUI)",
+			Value:  mqtthandler.DefaultErrorTopicTemplate,
+			EnvVar: "MQTT_ERROR_TOPIC_TEMPLATE",
+		},
+		cli.StringFlag{
+			Name:   "mqtt-alert-topic-template",
+			Usage:  "mqtt topic template for alert notifications (Go template, with .ApplicationID and .DevEUI)",
+			Value:  mqtthandler.DefaultAlertTopicTemplate,
+			EnvVar: "MQTT_ALERT_TOPIC_TEMPLATE",
+		},
+		cli.StringFlag{
+			Name:   "mqtt-gateway-status-topic-template",
+			Usage:  "mqtt topic template for gateway status notifications (Go template, with .OrganizationID and .MAC)",
+			Value:  mqtthandler.DefaultGatewayStatusTopicTemplate,
+			EnvVar: "MQTT_GATEWAY_STATUS_TOPIC_TEMPLATE",
+		},
+		cli.StringFlag{
+			Name:   "mqtt-downlink-topic-template",
+			Usage:  "mqtt topic template for downlink data (Go template, must contain .ApplicationID and .DevEUI)",
+			Value:  mqtthandler.DefaultDownlinkTopicTemplate,
+			EnvVar: "MQTT_DOWNLINK_TOPIC_TEMPLATE",
+		},
+		cli.IntFlag{
+			Name:   "mqtt-qos",
+			Usage:  "mqtt qos used for publishing uplink data and notifications (0, 1 or 2)",
+			EnvVar: "MQTT_QOS",
+		},
+		cli.BoolFlag{
+			Name:   "mqtt-retain",
+			Usage:  "publish uplink data and notifications as retained messages",
+			EnvVar: "MQTT_RETAIN",
+		},
+		cli.StringFlag{
+			Name:   "mqtt-marshaler",
+			Usage:  "mqtt payload marshaler (json, protobuf or protobuf_json)",
+			Value:  string(marshaler.JSON),
+			EnvVar: "MQTT_MARSHALER",
+		},
 		cli.StringFlag{
 			Name:   "as-public-server",
 			Usage:  "ip:port of the application-server api (used by LoRa Server to connect back to LoRa App Server)",
@@ -702,6 +879,65 @@ func main() {
 			Usage:  "the data-rate to use for transmitting the gateway ping",
 			EnvVar: "GW_PING_DR",
 		},
+		cli.IntFlag{
+			Name:   "gw-ping-concurrency",
+			Usage:  "the max. number of gateway pings sent concurrently (to non-overlapping gateways)",
+			EnvVar: "GW_PING_CONCURRENCY",
+			Value:  10,
+		},
+		cli.DurationFlag{
+			Name:   "device-event-max-age",
+			Usage:  "max age of the stored device events (uplinks, joins, acks and errors), 0 = no limit",
+			EnvVar: "DEVICE_EVENT_MAX_AGE",
+			Value:  time.Hour * 24 * 30,
+		},
+		cli.IntFlag{
+			Name:   "device-event-max-count",
+			Usage:  "max number of stored events per device, 0 = no limit",
+			EnvVar: "DEVICE_EVENT_MAX_COUNT",
+			Value:  1000,
+		},
+		cli.DurationFlag{
+			Name:   "downlink-ack-timeout",
+			Usage:  "duration after which a confirmed downlink without acknowledgement expires and an error notification is sent, 0 = disabled",
+			EnvVar: "DOWNLINK_ACK_TIMEOUT",
+			Value:  time.Hour,
+		},
+		cli.DurationFlag{
+			Name:   "downlink-max-age",
+			Usage:  "max age of the stored downlinks (delivery tracking), 0 = no limit",
+			EnvVar: "DOWNLINK_MAX_AGE",
+			Value:  time.Hour * 24 * 30,
+		},
+		cli.IntFlag{
+			Name:   "downlink-max-count",
+			Usage:  "max number of stored downlinks (delivery tracking) per device, pending downlinks are kept, 0 = no limit",
+			EnvVar: "DOWNLINK_MAX_COUNT",
+			Value:  1000,
+		},
+		cli.DurationFlag{
+			Name:   "device-health-check-interval",
+			Usage:  "interval in which the device alert rules (inactivity, battery and margin) are evaluated, 0 = disabled",
+			EnvVar: "DEVICE_HEALTH_CHECK_INTERVAL",
+			Value:  time.Minute,
+		},
+		cli.DurationFlag{
+			Name:   "gateway-offline-timeout",
+			Usage:  "duration after which a gateway which has not been seen is marked as offline, 0 = gateway status tracking disabled",
+			EnvVar: "GATEWAY_OFFLINE_TIMEOUT",
+			Value:  5 * time.Minute,
+		},
+		cli.DurationFlag{
+			Name:   "gateway-status-check-interval",
+			Usage:  "interval in which the gateway status is updated",
+			EnvVar: "GATEWAY_STATUS_CHECK_INTERVAL",
+			Value:  time.Minute,
+		},
+		cli.StringFlag{
+			Name:   "geolocation-resolvers",
+			Usage:  "comma separated list of geolocation resolvers (tdoa, rssi) used in order of preference for resolving the device location on uplink, leave blank to disable",
+			EnvVar: "GEOLOCATION_RESOLVERS",
+		},
 		cli.StringFlag{
 			Name:   "branding-header",
 			Usage:  "when set, this html is inserted into the header of the ui, before \"LoRa Server\"",
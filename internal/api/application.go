@@ -1,8 +1,11 @@
 package api
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"time"
 
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -11,8 +14,11 @@ import (
 	"github.com/Frankz/lora-app-server/internal/api/auth"
 	"github.com/Frankz/lora-app-server/internal/codec"
 	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/eventlog"
 	"github.com/Frankz/lora-app-server/internal/handler"
 	"github.com/Frankz/lora-app-server/internal/handler/httphandler"
+	"github.com/Frankz/lora-app-server/internal/handler/influxdbhandler"
+	"github.com/Frankz/lora-app-server/internal/handler/marshaler"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/jmoiron/sqlx"
 )
@@ -37,14 +43,24 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := validatePayloadCodec(codec.Type(req.PayloadCodec), req.PayloadEncoderScript, req.PayloadDecoderScript); err != nil {
+		return nil, err
+	}
+
 	app := storage.Application{
-		Name:                 req.Name,
-		Description:          req.Description,
-		OrganizationID:       req.OrganizationID,
-		ServiceProfileID:     req.ServiceProfileID,
-		PayloadCodec:         codec.Type(req.PayloadCodec),
-		PayloadEncoderScript: req.PayloadEncoderScript,
-		PayloadDecoderScript: req.PayloadDecoderScript,
+		Name:                    req.Name,
+		Description:             req.Description,
+		OrganizationID:          req.OrganizationID,
+		ServiceProfileID:        req.ServiceProfileID,
+		PayloadCodec:            codec.Type(req.PayloadCodec),
+		PayloadEncoderScript:    req.PayloadEncoderScript,
+		PayloadDecoderScript:    req.PayloadDecoderScript,
+		Tags:                    req.Tags,
+		ClockSyncEnabled:        req.ClockSyncEnabled,
+		ClockSyncResyncInterval: time.Duration(req.ClockSyncResyncInterval) * time.Second,
+		AlertUplinkInterval:     time.Duration(req.AlertUplinkInterval) * time.Second,
+		AlertBatteryThreshold:   int(req.AlertBatteryThreshold),
+		AlertMarginThreshold:    alertMarginThreshold(req.AlertMarginEnabled, req.AlertMarginThreshold),
 	}
 
 	if err := storage.CreateApplication(common.DB, &app); err != nil {
@@ -69,14 +85,24 @@ func (a *ApplicationAPI) Get(ctx context.Context, req *pb.GetApplicationRequest)
 		return nil, errToRPCError(err)
 	}
 	resp := pb.GetApplicationResponse{
-		Id:                   app.ID,
-		Name:                 app.Name,
-		Description:          app.Description,
-		OrganizationID:       app.OrganizationID,
-		ServiceProfileID:     app.ServiceProfileID,
-		PayloadCodec:         string(app.PayloadCodec),
-		PayloadEncoderScript: app.PayloadEncoderScript,
-		PayloadDecoderScript: app.PayloadDecoderScript,
+		Id:                      app.ID,
+		Name:                    app.Name,
+		Description:             app.Description,
+		OrganizationID:          app.OrganizationID,
+		ServiceProfileID:        app.ServiceProfileID,
+		PayloadCodec:            string(app.PayloadCodec),
+		PayloadEncoderScript:    app.PayloadEncoderScript,
+		PayloadDecoderScript:    app.PayloadDecoderScript,
+		Tags:                    app.Tags,
+		ClockSyncEnabled:        app.ClockSyncEnabled,
+		ClockSyncResyncInterval: uint32(app.ClockSyncResyncInterval / time.Second),
+		AlertUplinkInterval:     uint32(app.AlertUplinkInterval / time.Second),
+		AlertBatteryThreshold:   uint32(app.AlertBatteryThreshold),
+	}
+
+	if app.AlertMarginThreshold != nil {
+		resp.AlertMarginThreshold = int32(*app.AlertMarginThreshold)
+		resp.AlertMarginEnabled = true
 	}
 
 	return &resp, nil
@@ -90,6 +116,10 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := validatePayloadCodec(codec.Type(req.PayloadCodec), req.PayloadEncoderScript, req.PayloadDecoderScript); err != nil {
+		return nil, err
+	}
+
 	app, err := storage.GetApplication(common.DB, req.Id)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -102,6 +132,12 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 	app.PayloadCodec = codec.Type(req.PayloadCodec)
 	app.PayloadEncoderScript = req.PayloadEncoderScript
 	app.PayloadDecoderScript = req.PayloadDecoderScript
+	app.Tags = req.Tags
+	app.ClockSyncEnabled = req.ClockSyncEnabled
+	app.ClockSyncResyncInterval = time.Duration(req.ClockSyncResyncInterval) * time.Second
+	app.AlertUplinkInterval = time.Duration(req.AlertUplinkInterval) * time.Second
+	app.AlertBatteryThreshold = int(req.AlertBatteryThreshold)
+	app.AlertMarginThreshold = alertMarginThreshold(req.AlertMarginEnabled, req.AlertMarginThreshold)
 
 	err = storage.UpdateApplication(common.DB, app)
 	if err != nil {
@@ -151,45 +187,50 @@ func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationReques
 		return nil, errToRPCError(err)
 	}
 
+	selector, err := storage.ParseTagSelector(req.TagSelector)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "tagSelector: %s", err)
+	}
+
 	var count int
 	var apps []storage.ApplicationListItem
 
 	if req.OrganizationID == 0 {
 		if isAdmin {
-			apps, err = storage.GetApplications(common.DB, int(req.Limit), int(req.Offset))
+			apps, err = storage.GetApplications(common.DB, int(req.Limit), int(req.Offset), selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetApplicationCount(common.DB)
+			count, err = storage.GetApplicationCount(common.DB, selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 		} else {
-			apps, err = storage.GetApplicationsForUser(common.DB, username, 0, int(req.Limit), int(req.Offset))
+			apps, err = storage.GetApplicationsForUser(common.DB, username, 0, int(req.Limit), int(req.Offset), selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetApplicationCountForUser(common.DB, username, 0)
+			count, err = storage.GetApplicationCountForUser(common.DB, username, 0, selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 		}
 	} else {
 		if isAdmin {
-			apps, err = storage.GetApplicationsForOrganizationID(common.DB, req.OrganizationID, int(req.Limit), int(req.Offset))
+			apps, err = storage.GetApplicationsForOrganizationID(common.DB, req.OrganizationID, int(req.Limit), int(req.Offset), selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetApplicationCountForOrganizationID(common.DB, req.OrganizationID)
+			count, err = storage.GetApplicationCountForOrganizationID(common.DB, req.OrganizationID, selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 		} else {
-			apps, err = storage.GetApplicationsForUser(common.DB, username, req.OrganizationID, int(req.Limit), int(req.Offset))
+			apps, err = storage.GetApplicationsForUser(common.DB, username, req.OrganizationID, int(req.Limit), int(req.Offset), selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetApplicationCountForUser(common.DB, username, req.OrganizationID)
+			count, err = storage.GetApplicationCountForUser(common.DB, username, req.OrganizationID, selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
@@ -207,6 +248,7 @@ func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationReques
 			OrganizationID:     app.OrganizationID,
 			ServiceProfileID:   app.ServiceProfileID,
 			ServiceProfileName: app.ServiceProfileName,
+			Tags:               app.Tags,
 		}
 
 		resp.Result = append(resp.Result, &item)
@@ -234,6 +276,14 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.HTTPI
 		JoinNotificationURL:  in.JoinNotificationURL,
 		ACKNotificationURL:   in.AckNotificationURL,
 		ErrorNotificationURL: in.ErrorNotificationURL,
+		AlertNotificationURL: in.AlertNotificationURL,
+		MaxAttempts:          int(in.MaxAttempts),
+		MaxAge:               time.Duration(in.MaxAge) * time.Second,
+		SigningSecret:        in.SigningSecret,
+		CACert:               in.CaCert,
+		TLSCert:              in.TlsCert,
+		TLSKey:               in.TlsKey,
+		Marshaler:            marshaler.Type(in.Marshaler),
 	}
 	if err := conf.Validate(); err != nil {
 		return nil, errToRPCError(err)
@@ -273,6 +323,7 @@ func (a *ApplicationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetHTTPI
 	if err = json.Unmarshal(integration.Settings, &conf); err != nil {
 		return nil, errToRPCError(err)
 	}
+	conf = conf.Redacted()
 
 	var headers []*pb.HTTPIntegrationHeader
 	for k, v := range conf.Headers {
@@ -290,6 +341,14 @@ func (a *ApplicationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetHTTPI
 		JoinNotificationURL:  conf.JoinNotificationURL,
 		AckNotificationURL:   conf.ACKNotificationURL,
 		ErrorNotificationURL: conf.ErrorNotificationURL,
+		AlertNotificationURL: conf.AlertNotificationURL,
+		MaxAttempts:          uint32(conf.MaxAttempts),
+		MaxAge:               uint32(conf.MaxAge / time.Second),
+		SigningSecret:        conf.SigningSecret,
+		CaCert:               conf.CACert,
+		TlsCert:              conf.TLSCert,
+		TlsKey:               conf.TLSKey,
+		Marshaler:            string(conf.Marshaler),
 	}, nil
 }
 
@@ -317,7 +376,22 @@ func (a *ApplicationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.HTTPI
 		JoinNotificationURL:  in.JoinNotificationURL,
 		ACKNotificationURL:   in.AckNotificationURL,
 		ErrorNotificationURL: in.ErrorNotificationURL,
+		AlertNotificationURL: in.AlertNotificationURL,
+		MaxAttempts:          int(in.MaxAttempts),
+		MaxAge:               time.Duration(in.MaxAge) * time.Second,
+		SigningSecret:        in.SigningSecret,
+		CACert:               in.CaCert,
+		TLSCert:              in.TlsCert,
+		TLSKey:               in.TlsKey,
+		Marshaler:            marshaler.Type(in.Marshaler),
+	}
+
+	var stored httphandler.HandlerConfig
+	if err = json.Unmarshal(integration.Settings, &stored); err != nil {
+		return nil, errToRPCError(err)
 	}
+	conf.KeepSecrets(stored, in.ClearSigningSecret)
+
 	if err := conf.Validate(); err != nil {
 		return nil, errToRPCError(err)
 	}
@@ -355,6 +429,275 @@ func (a *ApplicationAPI) DeleteHTTPIntegration(ctx context.Context, in *pb.Delet
 	return &pb.EmptyResponse{}, nil
 }
 
+// GetHTTPIntegrationStatus returns the delivery status of the HTTP
+// application-integration.
+func (a *ApplicationAPI) GetHTTPIntegrationStatus(ctx context.Context, in *pb.GetHTTPIntegrationStatusRequest) (*pb.GetHTTPIntegrationStatusResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(common.DB, in.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	status, err := storage.GetIntegrationDeliveryStatus(common.DB, integration.ID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	queueSize, err := storage.GetIntegrationQueueItemCount(common.DB, integration.ID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	deadLetterCount, err := storage.GetIntegrationDeadLetterCount(common.DB, integration.ID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetHTTPIntegrationStatusResponse{
+		LastError:       status.LastError,
+		FailureCount:    uint32(status.FailureCount),
+		QueueSize:       uint32(queueSize),
+		DeadLetterCount: uint32(deadLetterCount),
+	}
+	if status.LastSuccessAt != nil {
+		resp.LastSuccessAt = status.LastSuccessAt.Format(time.RFC3339Nano)
+	}
+	if status.LastFailureAt != nil {
+		resp.LastFailureAt = status.LastFailureAt.Format(time.RFC3339Nano)
+	}
+
+	return &resp, nil
+}
+
+// ListHTTPIntegrationDeadLetters lists the HTTP application-integration
+// deliveries which failed after exhausting the retry policy.
+func (a *ApplicationAPI) ListHTTPIntegrationDeadLetters(ctx context.Context, in *pb.ListHTTPIntegrationDeadLettersRequest) (*pb.ListHTTPIntegrationDeadLettersResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(common.DB, in.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	dls, err := storage.GetIntegrationDeadLetters(common.DB, integration.ID, int(in.Limit), int(in.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	count, err := storage.GetIntegrationDeadLetterCount(common.DB, integration.ID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListHTTPIntegrationDeadLettersResponse{
+		TotalCount: int64(count),
+	}
+	for _, dl := range dls {
+		pbDL := pb.HTTPIntegrationDeadLetter{
+			Id:        dl.ID,
+			CreatedAt: dl.CreatedAt.Format(time.RFC3339Nano),
+			FailedAt:  dl.FailedAt.Format(time.RFC3339Nano),
+			Event:     dl.Event,
+			Attempts:  uint32(dl.Attempts),
+			LastError: dl.LastError,
+			Payload:   dl.Payload,
+		}
+		// the payload is binary when the protobuf marshaler is used
+		if json.Valid(dl.Payload) {
+			pbDL.PayloadJSON = string(dl.Payload)
+		}
+		resp.Result = append(resp.Result, &pbDL)
+	}
+
+	return &resp, nil
+}
+
+// ReplayHTTPIntegrationDeadLetter re-queues the given dead letter for
+// delivery.
+func (a *ApplicationAPI) ReplayHTTPIntegrationDeadLetter(ctx context.Context, in *pb.ReplayHTTPIntegrationDeadLetterRequest) (*pb.EmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(common.DB, in.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	err = storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		dl, err := storage.GetIntegrationDeadLetter(tx, in.DeadLetterID, true)
+		if err != nil {
+			return err
+		}
+
+		// make sure the dead letter belongs to the given application
+		if dl.IntegrationID != integration.ID {
+			return storage.ErrDoesNotExist
+		}
+
+		err = storage.CreateIntegrationQueueItem(tx, &storage.IntegrationQueueItem{
+			CreatedAt:     time.Now(),
+			IntegrationID: dl.IntegrationID,
+			Event:         dl.Event,
+			Payload:       dl.Payload,
+			RetryAfter:    time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return storage.DeleteIntegrationDeadLetter(tx, dl.ID)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+// CreateInfluxDBIntegration creates an InfluxDB application-integration.
+func (a *ApplicationAPI) CreateInfluxDBIntegration(ctx context.Context, in *pb.InfluxDBIntegration) (*pb.EmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	conf := influxdbhandler.HandlerConfig{
+		Endpoint:            in.Endpoint,
+		DB:                  in.Db,
+		Username:            in.Username,
+		Password:            in.Password,
+		RetentionPolicyName: in.RetentionPolicyName,
+		Precision:           in.Precision,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	confJSON, err := json.Marshal(conf)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.Integration{
+		ApplicationID: in.Id,
+		Kind:          handler.InfluxDBHandlerKind,
+		Settings:      confJSON,
+	}
+	if err = storage.CreateIntegration(common.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+// GetInfluxDBIntegration returns the InfluxDB application-integration.
+func (a *ApplicationAPI) GetInfluxDBIntegration(ctx context.Context, in *pb.GetInfluxDBIntegrationRequest) (*pb.InfluxDBIntegration, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(common.DB, in.Id, handler.InfluxDBHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var conf influxdbhandler.HandlerConfig
+	if err = json.Unmarshal(integration.Settings, &conf); err != nil {
+		return nil, errToRPCError(err)
+	}
+	conf = conf.Redacted()
+
+	return &pb.InfluxDBIntegration{
+		Id:                  integration.ApplicationID,
+		Endpoint:            conf.Endpoint,
+		Db:                  conf.DB,
+		Username:            conf.Username,
+		Password:            conf.Password,
+		RetentionPolicyName: conf.RetentionPolicyName,
+		Precision:           conf.Precision,
+	}, nil
+}
+
+// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
+func (a *ApplicationAPI) UpdateInfluxDBIntegration(ctx context.Context, in *pb.InfluxDBIntegration) (*pb.EmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(common.DB, in.Id, handler.InfluxDBHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	conf := influxdbhandler.HandlerConfig{
+		Endpoint:            in.Endpoint,
+		DB:                  in.Db,
+		Username:            in.Username,
+		Password:            in.Password,
+		RetentionPolicyName: in.RetentionPolicyName,
+		Precision:           in.Precision,
+	}
+
+	var stored influxdbhandler.HandlerConfig
+	if err = json.Unmarshal(integration.Settings, &stored); err != nil {
+		return nil, errToRPCError(err)
+	}
+	conf.KeepSecrets(stored, in.ClearPassword)
+
+	if err := conf.Validate(); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	confJSON, err := json.Marshal(conf)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	integration.Settings = confJSON
+
+	if err = storage.UpdateIntegration(common.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
+func (a *ApplicationAPI) DeleteInfluxDBIntegration(ctx context.Context, in *pb.DeleteIntegrationRequest) (*pb.EmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(common.DB, in.Id, handler.InfluxDBHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.DeleteIntegration(common.DB, integration.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
 // ListIntegrations lists all configured integrations.
 func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegrationRequest) (*pb.ListIntegrationResponse, error) {
 	if err := a.validator.Validate(ctx,
@@ -373,6 +716,8 @@ func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegr
 		switch integration.Kind {
 		case handler.HTTPHandlerKind:
 			out.Kinds = append(out.Kinds, pb.IntegrationKind_HTTP)
+		case handler.InfluxDBHandlerKind:
+			out.Kinds = append(out.Kinds, pb.IntegrationKind_INFLUXDB)
 		default:
 			return nil, grpc.Errorf(codes.Internal, "unknown integration kind: %s", integration.Kind)
 		}
@@ -380,3 +725,152 @@ func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegr
 
 	return &out, nil
 }
+
+// StreamEvents streams the events of all the devices of the given
+// application as they are received.
+func (a *ApplicationAPI) StreamEvents(req *pb.StreamApplicationEventsRequest, srv pb.Application_StreamEventsServer) error {
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateApplicationAccess(req.Id, auth.Read),
+	); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	events := make(chan eventlog.Event)
+	subErr := make(chan error, 1)
+	go func() {
+		subErr <- eventlog.GetEventsForApplication(srv.Context(), req.Id, events)
+	}()
+
+	for {
+		select {
+		case e := <-events:
+			resp := pb.StreamApplicationEventsResponse{
+				DevEUI:      e.DevEUI.String(),
+				EventID:     e.ID,
+				CreatedAt:   e.CreatedAt.Format(time.RFC3339Nano),
+				Type:        string(e.Type),
+				PayloadJSON: string(e.Payload),
+			}
+			if e.FPort != nil {
+				resp.FPort = uint32(*e.FPort)
+			}
+
+			if err := srv.Send(&resp); err != nil {
+				log.WithField("application_id", req.Id).Errorf("send event error: %s", err)
+				return err
+			}
+		case err := <-subErr:
+			if err != nil {
+				return errToRPCError(err)
+			}
+			return nil
+		}
+	}
+}
+
+// TestPayloadCodec runs the given payload codec against the given bytes
+// (decode) or JSON object (encode). Errors returned by the codec are
+// returned as part of the response.
+func (a *ApplicationAPI) TestPayloadCodec(ctx context.Context, req *pb.TestPayloadCodecRequest) (*pb.TestPayloadCodecResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 0 and 255")
+	}
+
+	data := req.Data
+	if req.HexData != "" {
+		var err error
+		data, err = hex.DecodeString(req.HexData)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "hexData: %s", err)
+		}
+	}
+
+	if (len(data) == 0) == (req.JsonObject == "") {
+		return nil, grpc.Errorf(codes.InvalidArgument, "either data, hexData or jsonObject must be set")
+	}
+
+	codecPL := codec.NewUncachedPayload(codec.Type(req.PayloadCodec), uint8(req.FPort), req.PayloadEncoderScript, req.PayloadDecoderScript)
+	if codecPL == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", req.PayloadCodec)
+	}
+
+	var resp pb.TestPayloadCodecResponse
+
+	// decode
+	if len(data) != 0 {
+		start := time.Now()
+		err := codecPL.UnmarshalBinary(data)
+		resp.ExecutionTime = int64(time.Since(start) / time.Microsecond)
+		if err != nil {
+			resp.Error = codec.ErrorDetails(err)
+			return &resp, nil
+		}
+
+		b, err := json.Marshal(codecPL)
+		if err != nil {
+			resp.Error = err.Error()
+			return &resp, nil
+		}
+		resp.JsonObject = string(b)
+
+		return &resp, nil
+	}
+
+	// encode
+	if err := json.Unmarshal([]byte(req.JsonObject), &codecPL); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "jsonObject: %s", err)
+	}
+
+	start := time.Now()
+	b, err := codecPL.MarshalBinary()
+	resp.ExecutionTime = int64(time.Since(start) / time.Microsecond)
+	if err != nil {
+		resp.Error = codec.ErrorDetails(err)
+		return &resp, nil
+	}
+	resp.Data = b
+	resp.HexData = hex.EncodeToString(b)
+
+	return &resp, nil
+}
+
+// validatePayloadCodec validates the payload codec settings (of an
+// application or device-profile). The codec type must be known, the schema
+// of the schema codec must be valid and the scripts of the custom
+// JavaScript codec must compile.
+func validatePayloadCodec(t codec.Type, encoderScript, decoderScript string) error {
+	switch t {
+	case "", codec.CayenneLPPType, codec.CayenneLPPLenientType:
+	case codec.SchemaType:
+		if _, err := codec.ParseSchema(decoderScript); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "invalid payload schema: %s", err)
+		}
+	case codec.CustomJSType:
+		if err := codec.ValidateScript(encoderScript); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "invalid payload encoder script: %s", err)
+		}
+		if err := codec.ValidateScript(decoderScript); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "invalid payload decoder script: %s", err)
+		}
+	default:
+		return grpc.Errorf(codes.InvalidArgument, "invalid payload codec: %s", t)
+	}
+
+	return nil
+}
+
+// alertMarginThreshold returns the margin threshold of the low margin alert,
+// nil when the alert is disabled.
+func alertMarginThreshold(enabled bool, threshold int32) *int {
+	if !enabled {
+		return nil
+	}
+	i := int(threshold)
+	return &i
+}
@@ -3,24 +3,45 @@ package api
 import (
 	"crypto/aes"
 	"crypto/rand"
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
 	"time"
 
+	"github.com/Frankz/lora-app-server/internal/clocksync"
 	"github.com/Frankz/lora-app-server/internal/codec"
 	"github.com/Frankz/lora-app-server/internal/gwping"
 
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/coverage"
+	"github.com/Frankz/lora-app-server/internal/eventlog"
+	"github.com/Frankz/lora-app-server/internal/fuota"
+	"github.com/Frankz/lora-app-server/internal/geolocation"
 	"github.com/Frankz/lora-app-server/internal/handler"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/Frankz/loraserver/api/as"
 	"github.com/Frankz/lorawan"
 )
 
+const (
+	// deviceLocationUpdateInterval defines the interval in which an
+	// unchanged resolved device location is stored.
+	deviceLocationUpdateInterval = 15 * time.Minute
+
+	// deviceLocationMinDistance defines the min. distance (in meters) by
+	// which the resolved location must differ from the stored location, to
+	// be considered as changed.
+	deviceLocationMinDistance = 10
+)
+
 // ApplicationServerAPI implements the as.ApplicationServerServer interface.
 type ApplicationServerAPI struct {
 }
@@ -85,19 +106,19 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, grpc.Errorf(codes.Internal, "decrypt payload error: %s", err)
 	}
 
-	codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
-	if codecPL != nil {
-		if err := codecPL.UnmarshalBinary(b); err != nil {
-			log.WithFields(log.Fields{
-				"codec":          app.PayloadCodec,
-				"application_id": app.ID,
-				"f_port":         req.FPort,
-				"f_cnt":          req.FCnt,
-				"dev_eui":        d.DevEUI,
-			}).WithError(err).Error("decode payload error")
+	if req.FPort == fuota.FragPort {
+		if err := fuota.HandleUplink(d.DevEUI, b); err != nil {
+			log.WithField("dev_eui", d.DevEUI).Errorf("handle fuota uplink error: %s", err)
 		}
 	}
 
+	pcs, err := storage.GetPayloadCodecSettingsForDevEUI(common.DB, d.DevEUI)
+	if err != nil {
+		errStr := fmt.Sprintf("get payload codec settings error: %s", err)
+		log.WithField("dev_eui", d.DevEUI).Error(errStr)
+		return nil, grpc.Errorf(codes.Internal, errStr)
+	}
+
 	pl := handler.DataUpPayload{
 		ApplicationID:       app.ID,
 		ApplicationName:     app.Name,
@@ -105,6 +126,7 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		DevEUI:              devEUI,
 		DeviceStatusBattery: d.DeviceStatusBattery,
 		DeviceStatusMargin:  d.DeviceStatusMargin,
+		Tags:                app.Tags.Merge(d.Tags),
 		RXInfo:              []handler.RXInfo{},
 		TXInfo: handler.TXInfo{
 			Frequency: int(req.TxInfo.Frequency),
@@ -117,10 +139,31 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 			ADR:      req.TxInfo.Adr,
 			CodeRate: req.TxInfo.CodeRate,
 		},
-		FCnt:   req.FCnt,
-		FPort:  uint8(req.FPort),
-		Data:   b,
-		Object: codecPL,
+		FCnt:  req.FCnt,
+		FPort: uint8(req.FPort),
+		Data:  b,
+	}
+
+	var macs []lorawan.EUI64
+	for _, rxInfo := range req.RxInfo {
+		var mac lorawan.EUI64
+		copy(mac[:], rxInfo.Mac)
+		macs = append(macs, mac)
+	}
+
+	gateways := make(map[lorawan.EUI64]storage.Gateway)
+	gws, err := storage.GetGatewaysForMACs(common.DB, macs)
+	if err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("get gateways error: %s", err)
+	}
+	for _, gw := range gws {
+		gateways[gw.MAC] = gw
+
+		if gatewayLastSeenUpdateDue(gw, now) {
+			if err := storage.UpdateGatewayLastSeenAt(common.DB, gw.MAC, now); err != nil {
+				log.WithField("mac", gw.MAC).Errorf("update gateway last-seen error: %s", err)
+			}
+		}
 	}
 
 	for _, rxInfo := range req.RxInfo {
@@ -140,17 +183,78 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 			}
 		}
 		pl.RXInfo = append(pl.RXInfo, handler.RXInfo{
-			MAC:       mac,
-			Time:      timestamp,
-			RSSI:      int(rxInfo.Rssi),
-			LoRaSNR:   rxInfo.LoRaSNR,
-			Name:      rxInfo.Name,
-			Latitude:  rxInfo.Latitude,
-			Longitude: rxInfo.Longitude,
-			Altitude:  rxInfo.Altitude,
+			MAC:           mac,
+			Time:          timestamp,
+			RSSI:          int(rxInfo.Rssi),
+			LoRaSNR:       rxInfo.LoRaSNR,
+			Name:          rxInfo.Name,
+			Latitude:      rxInfo.Latitude,
+			Longitude:     rxInfo.Longitude,
+			Altitude:      rxInfo.Altitude,
+			FineTimestamp: timestamp != nil && gateways[mac].FineTimestamp,
 		})
 	}
 
+	if common.GeolocationResolver != nil {
+		loc, err := common.GeolocationResolver.Resolve(pl.RXInfo)
+		if err == nil {
+			locUpdatedAt := time.Now()
+			updateDue := deviceLocationUpdateDue(d, loc, locUpdatedAt)
+
+			d.Latitude = &loc.Latitude
+			d.Longitude = &loc.Longitude
+			d.Altitude = &loc.Altitude
+			d.LocationAccuracy = &loc.Accuracy
+			d.LocationSource = loc.Source
+			d.LocationUpdatedAt = &locUpdatedAt
+
+			if updateDue {
+				if err := storage.UpdateDeviceLocation(common.DB, &d); err != nil {
+					log.WithField("dev_eui", d.DevEUI).Errorf("update device location error: %s", err)
+				}
+			}
+			pl.Location = &loc
+		} else if errors.Cause(err) != geolocation.ErrNotEnoughData {
+			log.WithField("dev_eui", d.DevEUI).Warningf("resolve device location error: %s", err)
+		}
+	}
+
+	// locations resolved from the RSSI are derived from the same RSSI values
+	if d.Latitude != nil && d.Longitude != nil && d.LocationSource != geolocation.RSSISource {
+		if err := coverage.HandleUplink(common.DB, d.DevEUI, *d.Latitude, *d.Longitude, pl.RXInfo); err != nil {
+			log.WithField("dev_eui", d.DevEUI).Errorf("handle coverage uplink error: %s", err)
+		}
+	}
+
+	if req.FPort == clocksync.Port && app.ClockSyncEnabled {
+		// use the earliest gateway timestamp, as the receive time of the
+		// uplink is used for calculating the time correction
+		rxTime := time.Now()
+		for _, rxInfo := range pl.RXInfo {
+			if rxInfo.Time != nil && rxInfo.Time.Before(rxTime) {
+				rxTime = *rxInfo.Time
+			}
+		}
+
+		if err := clocksync.HandleUplink(d.DevEUI, rxTime, b); err != nil {
+			log.WithField("dev_eui", d.DevEUI).Errorf("handle clock sync uplink error: %s", err)
+		}
+	}
+
+	codecPL := codec.NewPayload(pcs.PayloadCodec, app.ID, uint8(req.FPort), pcs.PayloadEncoderScript, pcs.PayloadDecoderScript)
+	if codecPL != nil {
+		if err := decodePayload(codecPL, pl); err != nil {
+			log.WithFields(log.Fields{
+				"codec":          pcs.PayloadCodec,
+				"application_id": app.ID,
+				"f_port":         req.FPort,
+				"f_cnt":          req.FCnt,
+				"dev_eui":        d.DevEUI,
+			}).WithError(err).Error("decode payload error")
+		}
+		pl.Object = codecPL
+	}
+
 	err = common.Handler.SendDataUp(pl)
 	if err != nil {
 		errStr := fmt.Sprintf("send data up to handler error: %s", err)
@@ -158,6 +262,11 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
+	fPort := int(req.FPort)
+	if err := eventlog.LogEventForDevice(app.ID, devEUI, storage.DeviceEventUplink, &fPort, pl); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("log device event error: %s", err)
+	}
+
 	return &as.HandleUplinkDataResponse{}, nil
 }
 
@@ -179,32 +288,45 @@ func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.Ha
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
-	dqm, err := storage.GetDeviceQueueMappingForDevEUIAndFCnt(common.DB, devEUI, req.FCnt)
+	dl, err := storage.GetPendingDownlinkForDevEUIAndFCnt(common.DB, devEUI, req.FCnt)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
-	if err := storage.DeleteDeviceQueueMapping(common.DB, dqm.ID); err != nil {
+	if req.Acknowledged {
+		dl.SetStatus(storage.DownlinkAcked, time.Now())
+	} else {
+		dl.SetStatus(storage.DownlinkNacked, time.Now())
+	}
+
+	if err := storage.UpdateDownlink(common.DB, &dl); err != nil {
 		return nil, errToRPCError(err)
 	}
 
 	log.WithFields(log.Fields{
 		"dev_eui": devEUI,
+		"status":  dl.Status,
 	}).Info("downlink device-queue item acknowledged")
 
-	err = common.Handler.SendACKNotification(handler.ACKNotification{
+	pl := handler.ACKNotification{
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
 		DevEUI:          devEUI,
-		Reference:       dqm.Reference,
+		Reference:       dl.Reference,
 		Acknowledged:    req.Acknowledged,
 		FCnt:            req.FCnt,
-	})
+	}
+
+	err = common.Handler.SendACKNotification(pl)
 	if err != nil {
 		log.Errorf("send ack notification to handler error: %s", err)
 	}
 
+	if err := eventlog.LogEventForDevice(app.ID, devEUI, storage.DeviceEventACK, nil, pl); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("log device event error: %s", err)
+	}
+
 	return &as.HandleDownlinkACKResponse{}, nil
 }
 
@@ -231,7 +353,7 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 		"dev_eui": devEUI,
 	}).Error(req.Error)
 
-	err = common.Handler.SendErrorNotification(handler.ErrorNotification{
+	pl := handler.ErrorNotification{
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
 		DeviceName:      d.Name,
@@ -239,13 +361,19 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 		Type:            req.Type.String(),
 		Error:           req.Error,
 		FCnt:            req.FCnt,
-	})
+	}
+
+	err = common.Handler.SendErrorNotification(pl)
 	if err != nil {
 		errStr := fmt.Sprintf("send error notification to handler error: %s", err)
 		log.Error(errStr)
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
+	if err := eventlog.LogEventForDevice(app.ID, devEUI, storage.DeviceEventError, nil, pl); err != nil {
+		log.WithField("dev_eui", devEUI).Errorf("log device event error: %s", err)
+	}
+
 	return &as.HandleErrorResponse{}, nil
 }
 
@@ -261,6 +389,92 @@ func (a *ApplicationServerAPI) HandleProprietaryUplink(ctx context.Context, req
 	return &as.HandleProprietaryUplinkResponse{}, nil
 }
 
+// decodePayload decodes the uplink payload using the given codec payload.
+// When the codec supports the device context, the persisted codec state is
+// passed to the codec and the updated state is stored after decoding.
+// gatewayLastSeenUpdateDue returns if the last-seen timestamp of the given
+// gateway must be updated. To avoid a write on every uplink, it is only
+// updated once it is older than a tenth of the gateway offline timeout.
+func gatewayLastSeenUpdateDue(gw storage.Gateway, now time.Time) bool {
+	return gw.LastSeenAt == nil || now.Sub(*gw.LastSeenAt) >= common.GatewayOfflineTimeout/10
+}
+
+// deviceLocationUpdateDue returns if the resolved location must be stored
+// for the given device. To avoid a write on every uplink, an unchanged
+// location is only stored once every deviceLocationUpdateInterval.
+func deviceLocationUpdateDue(d storage.Device, loc handler.Location, now time.Time) bool {
+	if d.Latitude == nil || d.Longitude == nil || d.LocationUpdatedAt == nil || d.LocationSource != loc.Source {
+		return true
+	}
+
+	if now.Sub(*d.LocationUpdatedAt) >= deviceLocationUpdateInterval {
+		return true
+	}
+
+	return geolocation.Distance(*d.Latitude, *d.Longitude, loc.Latitude, loc.Longitude) > math.Max(loc.Accuracy, deviceLocationMinDistance)
+}
+
+func decodePayload(codecPL codec.Payload, pl handler.DataUpPayload) error {
+	ctxPL, ok := codecPL.(codec.ContextPayload)
+	if !ok {
+		return codecPL.UnmarshalBinary(pl.Data)
+	}
+
+	// The state is read, updated by the codec and written within a single
+	// transaction, locking the device so that the state updates of
+	// concurrent uplinks of the same device are not lost.
+	return storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		state, err := storage.GetDeviceCodecState(tx, pl.DevEUI, true)
+		if err != nil && err != storage.ErrDoesNotExist {
+			return errors.Wrap(err, "get device codec state error")
+		}
+
+		codecCtx := codec.Context{
+			ApplicationID: pl.ApplicationID,
+			DevEUI:        pl.DevEUI,
+			DeviceName:    pl.DeviceName,
+			FCnt:          pl.FCnt,
+			RXInfo:        pl.RXInfo,
+			State:         state,
+		}
+		ctxPL.SetContext(&codecCtx)
+
+		if err := ctxPL.UnmarshalBinary(pl.Data); err != nil {
+			return err
+		}
+
+		if codecStateEqual(state, codecCtx.State) {
+			return nil
+		}
+
+		if err := storage.SetDeviceCodecState(tx, pl.DevEUI, codecCtx.State); err != nil {
+			return errors.Wrap(err, "set device codec state error")
+		}
+
+		return nil
+	})
+}
+
+// codecStateEqual returns if the given codec states are equal. The states
+// are compared after decoding, as the stored state is normalized by the
+// database. An empty state equals an empty object.
+func codecStateEqual(a, b json.RawMessage) bool {
+	var aState, bState interface{}
+	if len(a) == 0 {
+		a = json.RawMessage("{}")
+	}
+	if len(b) == 0 {
+		b = json.RawMessage("{}")
+	}
+	if err := json.Unmarshal(a, &aState); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bState); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aState, bState)
+}
+
 // getAppNonce returns a random application nonce (used for OTAA).
 func getAppNonce() ([3]byte, error) {
 	var b [3]byte
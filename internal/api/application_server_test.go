@@ -10,6 +10,7 @@ import (
 
 	"github.com/Frankz/lora-app-server/internal/codec"
 	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/geolocation"
 	"github.com/Frankz/lora-app-server/internal/handler"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/Frankz/lora-app-server/internal/test"
@@ -73,6 +74,7 @@ func TestApplicationServerAPI(t *testing.T) {
 			OrganizationID:   org.ID,
 			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
 			Name:             "test-app",
+			Tags:             storage.Tags{"site": "berlin", "floor": "2"},
 		}
 		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
 
@@ -81,6 +83,7 @@ func TestApplicationServerAPI(t *testing.T) {
 			Name:            "test-node",
 			DevEUI:          [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
 			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Tags:            storage.Tags{"floor": "3"},
 		}
 		So(storage.CreateDevice(common.DB, &d), ShouldBeNil)
 
@@ -126,6 +129,14 @@ func TestApplicationServerAPI(t *testing.T) {
 					FCnt:            123,
 				})
 			})
+
+			Convey("Then the error has been stored as device event", func() {
+				events, err := storage.GetDeviceEvents(common.DB, d.DevEUI, storage.DeviceEventFilters{}, 10, 0)
+				So(err, ShouldBeNil)
+				So(events, ShouldHaveLength, 1)
+				So(events[0].Type, ShouldEqual, storage.DeviceEventError)
+				So(events[0].FPort, ShouldBeNil)
+			})
 		})
 
 		Convey("Given the device is activated", func() {
@@ -192,6 +203,7 @@ func TestApplicationServerAPI(t *testing.T) {
 						DevEUI:              d.DevEUI,
 						DeviceStatusBattery: &ten,
 						DeviceStatusMargin:  &eleven,
+						Tags:                map[string]string{"site": "berlin", "floor": "3"},
 						RXInfo: []handler.RXInfo{
 							{
 								MAC:       mac,
@@ -230,6 +242,63 @@ func TestApplicationServerAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Given a geolocation resolver is configured and the uplink was received by two gateways", func() {
+				common.GeolocationResolver = geolocation.RSSIResolver{}
+				Reset(func() {
+					common.GeolocationResolver = nil
+				})
+
+				reqTwoGateways := req
+				reqTwoGateways.RxInfo = append([]*as.RXInfo{
+					{
+						Mac:       []byte{8, 7, 6, 5, 4, 3, 2, 1},
+						Latitude:  52.3760364,
+						Longitude: 4.9144401,
+						Altitude:  20,
+						Rssi:      -60,
+						LoRaSNR:   5,
+					},
+				}, req.RxInfo...)
+
+				Convey("When calling HandleUplinkData", func() {
+					_, err := api.HandleUplinkData(ctx, &reqTwoGateways)
+					So(err, ShouldBeNil)
+
+					Convey("Then the payload contains the location", func() {
+						So(h.SendDataUpChan, ShouldHaveLength, 1)
+						pl := <-h.SendDataUpChan
+						So(pl.Location, ShouldNotBeNil)
+						So(pl.Location.Latitude, ShouldAlmostEqual, 52.3750364)
+						So(pl.Location.Longitude, ShouldAlmostEqual, 4.9144401)
+						So(pl.Location.Source, ShouldEqual, geolocation.RSSISource)
+					})
+
+					Convey("Then the device location was updated", func() {
+						d, err := storage.GetDevice(common.DB, d.DevEUI)
+						So(err, ShouldBeNil)
+						So(*d.Latitude, ShouldAlmostEqual, 52.3750364)
+						So(*d.Longitude, ShouldAlmostEqual, 4.9144401)
+						So(*d.Altitude, ShouldAlmostEqual, 15)
+						So(d.LocationSource, ShouldEqual, geolocation.RSSISource)
+						So(time.Now().Sub(*d.LocationUpdatedAt), ShouldBeLessThan, time.Second)
+					})
+
+					Convey("When calling HandleUplinkData again for the same location", func() {
+						d1, err := storage.GetDevice(common.DB, d.DevEUI)
+						So(err, ShouldBeNil)
+
+						_, err = api.HandleUplinkData(ctx, &reqTwoGateways)
+						So(err, ShouldBeNil)
+
+						Convey("Then the device location was not written again", func() {
+							d2, err := storage.GetDevice(common.DB, d.DevEUI)
+							So(err, ShouldBeNil)
+							So(d2.LocationUpdatedAt.Equal(*d1.LocationUpdatedAt), ShouldBeTrue)
+						})
+					})
+				})
+			})
+
 			Convey("When calling HandleUplinkData without device-status data", func() {
 				_, err := api.HandleUplinkData(ctx, &req)
 				So(err, ShouldBeNil)
@@ -245,6 +314,33 @@ func TestApplicationServerAPI(t *testing.T) {
 					So(d.DeviceStatusMargin, ShouldBeNil)
 					So(time.Now().Sub(*d.LastSeenAt), ShouldBeLessThan, time.Second)
 				})
+
+				Convey("Then the gateway last-seen timestamp was updated", func() {
+					gw, err := storage.GetGateway(common.DB, gw.MAC, false)
+					So(err, ShouldBeNil)
+					So(time.Now().Sub(*gw.LastSeenAt), ShouldBeLessThan, time.Second)
+				})
+			})
+
+			Convey("Given the gateway was seen recently", func() {
+				common.GatewayOfflineTimeout = 5 * time.Minute
+				Reset(func() {
+					common.GatewayOfflineTimeout = 0
+				})
+
+				lastSeenAt := time.Now().Add(-10 * time.Second).Truncate(time.Millisecond)
+				So(storage.UpdateGatewayLastSeenAt(common.DB, gw.MAC, lastSeenAt), ShouldBeNil)
+
+				Convey("When calling HandleUplinkData", func() {
+					_, err := api.HandleUplinkData(ctx, &req)
+					So(err, ShouldBeNil)
+
+					Convey("Then the gateway last-seen timestamp was not updated", func() {
+						gw, err := storage.GetGateway(common.DB, gw.MAC, false)
+						So(err, ShouldBeNil)
+						So(gw.LastSeenAt.Equal(lastSeenAt), ShouldBeTrue)
+					})
+				})
 			})
 
 			Convey("When calling HandleUplinkData (Custom JS codec configured)", func() {
@@ -270,6 +366,74 @@ func TestApplicationServerAPI(t *testing.T) {
 					So(err, ShouldBeNil)
 					So(string(b), ShouldEqual, `{"fPort":3,"firstByte":67}`)
 				})
+
+				Convey("Then the decoded payload has been stored as device event", func() {
+					events, err := storage.GetDeviceEvents(common.DB, d.DevEUI, storage.DeviceEventFilters{}, 10, 0)
+					So(err, ShouldBeNil)
+					So(events, ShouldHaveLength, 1)
+					So(events[0].Type, ShouldEqual, storage.DeviceEventUplink)
+					So(*events[0].FPort, ShouldEqual, 3)
+
+					var pl struct {
+						Object map[string]int `json:"object"`
+					}
+					So(json.Unmarshal(events[0].Payload, &pl), ShouldBeNil)
+					So(pl.Object, ShouldResemble, map[string]int{"fPort": 3, "firstByte": 67})
+				})
+			})
+
+			Convey("When calling HandleUplinkData (Custom JS codec using the device context)", func() {
+				app.PayloadCodec = codec.CustomJSType
+				app.PayloadDecoderScript = `
+					function Decode(fPort, bytes, context) {
+						context.state.count = (context.state.count || 0) + 1;
+						return {
+							"count": context.state.count,
+							"gateway": context.rxInfo[0].name
+						}
+					}
+				`
+				So(storage.UpdateApplication(common.DB, app), ShouldBeNil)
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				So(err, ShouldBeNil)
+				_, err = api.HandleUplinkData(ctx, &req)
+				So(err, ShouldBeNil)
+
+				Convey("Then the context has been passed to the codec", func() {
+					So(h.SendDataUpChan, ShouldHaveLength, 2)
+					<-h.SendDataUpChan
+					pl := <-h.SendDataUpChan
+					b, err := json.Marshal(pl.Object)
+					So(err, ShouldBeNil)
+					So(string(b), ShouldEqual, `{"count":2,"gateway":"test-gateway"}`)
+				})
+
+				Convey("Then the codec state has been stored", func() {
+					state, err := storage.GetDeviceCodecState(common.DB, d.DevEUI, false)
+					So(err, ShouldBeNil)
+					So(string(state), ShouldEqual, `{"count": 2}`)
+				})
+			})
+
+			Convey("When calling HandleUplinkData (Custom JS codec not changing the device context state)", func() {
+				app.PayloadCodec = codec.CustomJSType
+				app.PayloadDecoderScript = `
+					function Decode(fPort, bytes, context) {
+						return {
+							"gateway": context.rxInfo[0].name
+						}
+					}
+				`
+				So(storage.UpdateApplication(common.DB, app), ShouldBeNil)
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				So(err, ShouldBeNil)
+
+				Convey("Then no codec state has been stored", func() {
+					_, err := storage.GetDeviceCodecState(common.DB, d.DevEUI, false)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
 			})
 
 			Convey("When calling HandleUplinkData (no codec configured)", func() {
@@ -283,6 +447,7 @@ func TestApplicationServerAPI(t *testing.T) {
 						ApplicationName: "test-app",
 						DeviceName:      "test-node",
 						DevEUI:          d.DevEUI,
+						Tags:            map[string]string{"site": "berlin", "floor": "3"},
 						RXInfo: []handler.RXInfo{
 							{
 								MAC:       mac,
@@ -313,13 +478,15 @@ func TestApplicationServerAPI(t *testing.T) {
 				})
 			})
 
-			Convey("Given a device-queue mapping", func() {
-				dqm := storage.DeviceQueueMapping{
+			Convey("Given a confirmed downlink", func() {
+				dl := storage.Downlink{
 					Reference: "test-1234",
 					DevEUI:    d.DevEUI,
 					FCnt:      10,
+					FPort:     1,
+					Confirmed: true,
 				}
-				So(storage.CreateDeviceQueueMapping(common.DB, &dqm), ShouldBeNil)
+				So(storage.CreateDownlink(common.DB, &dl), ShouldBeNil)
 
 				Convey("On HandleDownlinkACK (ack: true)", func() {
 					_, err := api.HandleDownlinkACK(ctx, &as.HandleDownlinkACKRequest{
@@ -329,9 +496,11 @@ func TestApplicationServerAPI(t *testing.T) {
 					})
 					So(err, ShouldBeNil)
 
-					Convey("Then the device-queue mapping has been removed", func() {
-						_, err := storage.GetDeviceQueueMappingForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
-						So(err, ShouldEqual, storage.ErrDoesNotExist)
+					Convey("Then the downlink status has been set to ACKED", func() {
+						dl, err := storage.GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+						So(err, ShouldBeNil)
+						So(dl.Status, ShouldEqual, storage.DownlinkAcked)
+						So(dl.AckedAt, ShouldNotBeNil)
 					})
 
 					Convey("Then an ack (true) notification was sent to the handler", func() {
@@ -341,7 +510,7 @@ func TestApplicationServerAPI(t *testing.T) {
 							ApplicationName: app.Name,
 							DeviceName:      d.Name,
 							DevEUI:          d.DevEUI,
-							Reference:       dqm.Reference,
+							Reference:       dl.Reference,
 							Acknowledged:    true,
 							FCnt:            10,
 						})
@@ -356,9 +525,11 @@ func TestApplicationServerAPI(t *testing.T) {
 					})
 					So(err, ShouldBeNil)
 
-					Convey("Then the device-queue mapping has been removed", func() {
-						_, err := storage.GetDeviceQueueMappingForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
-						So(err, ShouldEqual, storage.ErrDoesNotExist)
+					Convey("Then the downlink status has been set to NACKED", func() {
+						dl, err := storage.GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+						So(err, ShouldBeNil)
+						So(dl.Status, ShouldEqual, storage.DownlinkNacked)
+						So(dl.AckedAt, ShouldNotBeNil)
 					})
 
 					Convey("Then an ack (true) notification was sent to the handler", func() {
@@ -368,7 +539,7 @@ func TestApplicationServerAPI(t *testing.T) {
 							ApplicationName: app.Name,
 							DeviceName:      d.Name,
 							DevEUI:          d.DevEUI,
-							Reference:       dqm.Reference,
+							Reference:       dl.Reference,
 							Acknowledged:    false,
 							FCnt:            10,
 						})
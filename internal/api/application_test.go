@@ -1,7 +1,9 @@
 package api
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -11,6 +13,9 @@ import (
 
 	pb "github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/handler/httphandler"
+	"github.com/Frankz/lora-app-server/internal/handler/influxdbhandler"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/Frankz/lora-app-server/internal/test"
 	"github.com/Frankz/lorawan/backend"
@@ -60,8 +65,8 @@ func TestApplicationAPI(t *testing.T) {
 				Description:          "A test application",
 				ServiceProfileID:     sp.ServiceProfile.ServiceProfileID,
 				PayloadCodec:         "CUSTOM_JS",
-				PayloadEncoderScript: "Encode() {}",
-				PayloadDecoderScript: "Decode() {}",
+				PayloadEncoderScript: "function Encode() {}",
+				PayloadDecoderScript: "function Decode() {}",
 			})
 			So(err, ShouldBeNil)
 			So(validator.ctx, ShouldResemble, ctx)
@@ -82,8 +87,8 @@ func TestApplicationAPI(t *testing.T) {
 					Description:          "A test application",
 					ServiceProfileID:     sp.ServiceProfile.ServiceProfileID,
 					PayloadCodec:         "CUSTOM_JS",
-					PayloadEncoderScript: "Encode() {}",
-					PayloadDecoderScript: "Decode() {}",
+					PayloadEncoderScript: "function Encode() {}",
+					PayloadDecoderScript: "function Decode() {}",
 				})
 			})
 
@@ -150,13 +155,19 @@ func TestApplicationAPI(t *testing.T) {
 
 			Convey("When updating the application", func() {
 				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
-					Id:                   createResp.Id,
-					Name:                 "test-app-updated",
-					Description:          "An updated test description",
-					ServiceProfileID:     sp.ServiceProfile.ServiceProfileID,
-					PayloadCodec:         "CUSTOM_JS",
-					PayloadEncoderScript: "Encode2() {}",
-					PayloadDecoderScript: "Decode2() {}",
+					Id:                      createResp.Id,
+					Name:                    "test-app-updated",
+					Description:             "An updated test description",
+					ServiceProfileID:        sp.ServiceProfile.ServiceProfileID,
+					PayloadCodec:            "CUSTOM_JS",
+					PayloadEncoderScript:    "function Encode2() {}",
+					PayloadDecoderScript:    "function Decode2() {}",
+					ClockSyncEnabled:        true,
+					ClockSyncResyncInterval: 86400,
+					AlertUplinkInterval:     7200,
+					AlertBatteryThreshold:   50,
+					AlertMarginEnabled:      true,
+					AlertMarginThreshold:    -5,
 				})
 				So(err, ShouldBeNil)
 				So(validator.ctx, ShouldResemble, ctx)
@@ -168,18 +179,60 @@ func TestApplicationAPI(t *testing.T) {
 					})
 					So(err, ShouldBeNil)
 					So(app, ShouldResemble, &pb.GetApplicationResponse{
-						OrganizationID:       org.ID,
-						Id:                   createResp.Id,
-						Name:                 "test-app-updated",
-						Description:          "An updated test description",
-						ServiceProfileID:     sp.ServiceProfile.ServiceProfileID,
-						PayloadCodec:         "CUSTOM_JS",
-						PayloadEncoderScript: "Encode2() {}",
-						PayloadDecoderScript: "Decode2() {}",
+						OrganizationID:          org.ID,
+						Id:                      createResp.Id,
+						Name:                    "test-app-updated",
+						Description:             "An updated test description",
+						ServiceProfileID:        sp.ServiceProfile.ServiceProfileID,
+						PayloadCodec:            "CUSTOM_JS",
+						PayloadEncoderScript:    "function Encode2() {}",
+						PayloadDecoderScript:    "function Decode2() {}",
+						ClockSyncEnabled:        true,
+						ClockSyncResyncInterval: 86400,
+						AlertUplinkInterval:     7200,
+						AlertBatteryThreshold:   50,
+						AlertMarginEnabled:      true,
+						AlertMarginThreshold:    -5,
 					})
 				})
 			})
 
+			Convey("When updating the application with an invalid payload schema", func() {
+				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+					Id:                   createResp.Id,
+					Name:                 "test-app",
+					ServiceProfileID:     sp.ServiceProfile.ServiceProfileID,
+					PayloadCodec:         "SCHEMA",
+					PayloadDecoderScript: `{"layouts": []}`,
+				})
+
+				Convey("Then an InvalidArgument error is returned", func() {
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When updating the application with a valid payload schema", func() {
+				schema := `{"layouts": [{"fields": [{"name": "temperature", "offset": 0, "length": 2, "signed": true, "scale": 0.1}]}]}`
+				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+					Id:                   createResp.Id,
+					Name:                 "test-app",
+					ServiceProfileID:     sp.ServiceProfile.ServiceProfileID,
+					PayloadCodec:         "SCHEMA",
+					PayloadDecoderScript: schema,
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the schema has been stored", func() {
+					app, err := api.Get(ctx, &pb.GetApplicationRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(app.PayloadCodec, ShouldEqual, "SCHEMA")
+					So(app.PayloadDecoderScript, ShouldEqual, schema)
+				})
+			})
+
 			Convey("When deleting the application", func() {
 				_, err := api.Delete(ctx, &pb.DeleteApplicationRequest{
 					Id: createResp.Id,
@@ -205,6 +258,7 @@ func TestApplicationAPI(t *testing.T) {
 					JoinNotificationURL:  "http://join",
 					AckNotificationURL:   "http://ack",
 					ErrorNotificationURL: "http://error",
+					Marshaler:            "protobuf",
 				}
 				_, err := api.CreateHTTPIntegration(ctx, &integration)
 				So(err, ShouldBeNil)
@@ -237,6 +291,43 @@ func TestApplicationAPI(t *testing.T) {
 					So(*i, ShouldResemble, integration)
 				})
 
+				Convey("When updating the integration with a signing secret", func() {
+					integration.SigningSecret = "verysecretsecret"
+					_, err := api.UpdateHTTPIntegration(ctx, &integration)
+					So(err, ShouldBeNil)
+
+					Convey("Then the signing secret is not returned", func() {
+						i, err := api.GetHTTPIntegration(ctx, &pb.GetHTTPIntegrationRequest{Id: createResp.Id})
+						So(err, ShouldBeNil)
+						So(i.SigningSecret, ShouldEqual, "")
+					})
+
+					Convey("Then updating without signing secret keeps the stored secret", func() {
+						integration.SigningSecret = ""
+						_, err := api.UpdateHTTPIntegration(ctx, &integration)
+						So(err, ShouldBeNil)
+
+						stored, err := storage.GetIntegrationByApplicationID(common.DB, createResp.Id, handler.HTTPHandlerKind)
+						So(err, ShouldBeNil)
+						var conf httphandler.HandlerConfig
+						So(json.Unmarshal(stored.Settings, &conf), ShouldBeNil)
+						So(conf.SigningSecret, ShouldEqual, "verysecretsecret")
+					})
+
+					Convey("Then updating with clearSigningSecret removes the stored secret", func() {
+						integration.SigningSecret = ""
+						integration.ClearSigningSecret = true
+						_, err := api.UpdateHTTPIntegration(ctx, &integration)
+						So(err, ShouldBeNil)
+
+						stored, err := storage.GetIntegrationByApplicationID(common.DB, createResp.Id, handler.HTTPHandlerKind)
+						So(err, ShouldBeNil)
+						var conf httphandler.HandlerConfig
+						So(json.Unmarshal(stored.Settings, &conf), ShouldBeNil)
+						So(conf.SigningSecret, ShouldEqual, "")
+					})
+				})
+
 				Convey("Then the integration can be deleted", func() {
 					_, err := api.DeleteHTTPIntegration(ctx, &pb.DeleteIntegrationRequest{Id: createResp.Id})
 					So(err, ShouldBeNil)
@@ -246,6 +337,203 @@ func TestApplicationAPI(t *testing.T) {
 					So(err, ShouldNotBeNil)
 					So(grpc.Code(err), ShouldEqual, codes.NotFound)
 				})
+
+				Convey("Then the delivery status can be retrieved", func() {
+					resp, err := api.GetHTTPIntegrationStatus(ctx, &pb.GetHTTPIntegrationStatusRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp, ShouldResemble, &pb.GetHTTPIntegrationStatusResponse{})
+				})
+
+				Convey("Given a dead letter for the integration", func() {
+					i, err := storage.GetIntegrationByApplicationID(common.DB, createResp.Id, handler.HTTPHandlerKind)
+					So(err, ShouldBeNil)
+
+					dl := storage.IntegrationDeadLetter{
+						CreatedAt:     time.Now(),
+						IntegrationID: i.ID,
+						Event:         "up",
+						Payload:       []byte(`{"fCnt":10}`),
+						Attempts:      10,
+						LastError:     "expected 2XX response, got: 500",
+					}
+					So(storage.CreateIntegrationDeadLetter(common.DB, &dl), ShouldBeNil)
+
+					Convey("Then the dead letters can be listed", func() {
+						resp, err := api.ListHTTPIntegrationDeadLetters(ctx, &pb.ListHTTPIntegrationDeadLettersRequest{
+							Id:    createResp.Id,
+							Limit: 10,
+						})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+						So(resp.TotalCount, ShouldEqual, 1)
+						So(resp.Result, ShouldHaveLength, 1)
+						So(resp.Result[0].Id, ShouldEqual, dl.ID)
+						So(resp.Result[0].Event, ShouldEqual, "up")
+						So(resp.Result[0].Attempts, ShouldEqual, 10)
+						So(resp.Result[0].LastError, ShouldEqual, "expected 2XX response, got: 500")
+						So(resp.Result[0].PayloadJSON, ShouldEqual, `{"fCnt":10}`)
+						So(resp.Result[0].Payload, ShouldResemble, []byte(`{"fCnt":10}`))
+
+						status, err := api.GetHTTPIntegrationStatus(ctx, &pb.GetHTTPIntegrationStatusRequest{Id: createResp.Id})
+						So(err, ShouldBeNil)
+						So(status.DeadLetterCount, ShouldEqual, 1)
+					})
+
+					Convey("Then the dead letter can be replayed", func() {
+						_, err := api.ReplayHTTPIntegrationDeadLetter(ctx, &pb.ReplayHTTPIntegrationDeadLetterRequest{
+							Id:           createResp.Id,
+							DeadLetterID: dl.ID,
+						})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+						status, err := api.GetHTTPIntegrationStatus(ctx, &pb.GetHTTPIntegrationStatusRequest{Id: createResp.Id})
+						So(err, ShouldBeNil)
+						So(status.DeadLetterCount, ShouldEqual, 0)
+						So(status.QueueSize, ShouldEqual, 1)
+					})
+				})
+			})
+
+			Convey("When testing a custom JS payload decoder", func() {
+				resp, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					Id:                   createResp.Id,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: "function Decode(fPort, bytes) { return {fPort: fPort, value: bytes[0]}; }",
+					FPort:                10,
+					HexData:              "05",
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the decoded object is returned", func() {
+					So(resp.Error, ShouldEqual, "")
+					So(resp.JsonObject, ShouldEqual, `{"fPort":10,"value":5}`)
+				})
+			})
+
+			Convey("When testing a custom JS payload decoder containing an error", func() {
+				resp, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					Id:                   createResp.Id,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadDecoderScript: "function Decode(fPort, bytes) {\n\treturn foo;\n}",
+					Data:                 []byte{5},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the error is returned", func() {
+					So(resp.JsonObject, ShouldEqual, "")
+					So(resp.Error, ShouldContainSubstring, "ReferenceError: 'foo' is not defined")
+				})
+			})
+
+			Convey("When testing a custom JS payload encoder", func() {
+				resp, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					Id:                   createResp.Id,
+					PayloadCodec:         "CUSTOM_JS",
+					PayloadEncoderScript: "function Encode(fPort, obj) { return [fPort, obj.value]; }",
+					FPort:                10,
+					JsonObject:           `{"value": 5}`,
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the encoded bytes are returned", func() {
+					So(resp.Error, ShouldEqual, "")
+					So(resp.Data, ShouldResemble, []byte{10, 5})
+					So(resp.HexData, ShouldEqual, "0a05")
+				})
+			})
+
+			Convey("Then testing a payload codec without data or object returns an error", func() {
+				_, err := api.TestPayloadCodec(ctx, &pb.TestPayloadCodecRequest{
+					Id:           createResp.Id,
+					PayloadCodec: "CAYENNE_LPP",
+				})
+				So(err, ShouldNotBeNil)
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+
+			Convey("When creating an InfluxDB integration", func() {
+				integration := pb.InfluxDBIntegration{
+					Id:                  createResp.Id,
+					Endpoint:            "http://localhost:8086/write",
+					Db:                  "lora",
+					Username:            "user",
+					Password:            "password",
+					RetentionPolicyName: "one_week",
+					Precision:           "s",
+				}
+				_, err := api.CreateInfluxDBIntegration(ctx, &integration)
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the integration can be retrieved without password", func() {
+					i, err := api.GetInfluxDBIntegration(ctx, &pb.GetInfluxDBIntegrationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					integration.Password = ""
+					So(*i, ShouldResemble, integration)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+				})
+
+				Convey("Then the integrations can be listed", func() {
+					resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(resp.Kinds, ShouldResemble, []pb.IntegrationKind{pb.IntegrationKind_INFLUXDB})
+				})
+
+				Convey("Then the integration can be updated", func() {
+					integration.Db = "lora2"
+					integration.Precision = "ms"
+					_, err := api.UpdateInfluxDBIntegration(ctx, &integration)
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					i, err := api.GetInfluxDBIntegration(ctx, &pb.GetInfluxDBIntegrationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					integration.Password = ""
+					So(*i, ShouldResemble, integration)
+				})
+
+				Convey("Then updating without password keeps the stored password", func() {
+					integration.Password = ""
+					_, err := api.UpdateInfluxDBIntegration(ctx, &integration)
+					So(err, ShouldBeNil)
+
+					stored, err := storage.GetIntegrationByApplicationID(common.DB, createResp.Id, handler.InfluxDBHandlerKind)
+					So(err, ShouldBeNil)
+					var conf influxdbhandler.HandlerConfig
+					So(json.Unmarshal(stored.Settings, &conf), ShouldBeNil)
+					So(conf.Password, ShouldEqual, "password")
+
+					Convey("Then updating with clearPassword removes the stored password", func() {
+						integration.ClearPassword = true
+						_, err := api.UpdateInfluxDBIntegration(ctx, &integration)
+						So(err, ShouldBeNil)
+
+						stored, err := storage.GetIntegrationByApplicationID(common.DB, createResp.Id, handler.InfluxDBHandlerKind)
+						So(err, ShouldBeNil)
+						var conf influxdbhandler.HandlerConfig
+						So(json.Unmarshal(stored.Settings, &conf), ShouldBeNil)
+						So(conf.Password, ShouldEqual, "")
+					})
+				})
+
+				Convey("Then updating with an invalid precision returns an error", func() {
+					integration.Precision = "d"
+					_, err := api.UpdateInfluxDBIntegration(ctx, &integration)
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+
+				Convey("Then the integration can be deleted", func() {
+					_, err := api.DeleteInfluxDBIntegration(ctx, &pb.DeleteIntegrationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					_, err = api.GetInfluxDBIntegration(ctx, &pb.GetInfluxDBIntegrationRequest{Id: createResp.Id})
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
 			})
 		})
 	})
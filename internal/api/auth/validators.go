@@ -47,6 +47,31 @@ const userQuery = `
 	left join device d
 		on a.id = d.application_id`
 
+// The multicast-group, scheduled downlink and FUOTA session (given as $2)
+// are matched using a subquery instead of joining them into userQuery, as
+// each additional join multiplies the number of rows counted by all the
+// other validators.
+const (
+	multicastGroupExists = `exists (
+		select 1
+		from multicast_group mg
+		where
+			mg.id = $2
+			and mg.service_profile_id = sp.service_profile_id)`
+	scheduledDownlinkExists = `exists (
+		select 1
+		from scheduled_downlink sd
+		where
+			sd.id = $2
+			and sd.application_id = a.id)`
+	fuotaSessionExists = `exists (
+		select 1
+		from fuota_session fs
+		where
+			fs.id = $2
+			and fs.organization_id = o.id)`
+)
+
 // ValidateActiveUser validates if the user in the JWT claim is active.
 func ValidateActiveUser() ValidatorFunc {
 	where := [][]string{
@@ -775,6 +800,197 @@ func ValidateDeviceProfileAccess(flag Flag, id string) ValidatorFunc {
 	}
 }
 
+// ValidateMulticastGroupsAccess validates if the client has access to the
+// multicast-groups.
+func ValidateMulticastGroupsAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
+		}
+	case List:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "$2 > 0", "o.id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+	}
+}
+
+// ValidateMulticastGroupAccess validates if the client has access to the
+// given multicast-group.
+func ValidateMulticastGroupAccess(flag Flag, id string) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", multicastGroupExists},
+		}
+	case Update, Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", multicastGroupExists},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateMulticastGroupMemberAccess validates if the client has access to
+// add or remove the given device to or from the given multicast-group. Both
+// the device and the multicast-group must belong to the same organization.
+func ValidateMulticastGroupMemberAccess(flag Flag, id string, devEUI lorawan.EUI64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", multicastGroupExists, "d.dev_eui = $3"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id, devEUI[:])
+	}
+}
+
+// ValidateMulticastGroupQueueAccess validates if the client has access to
+// the queue of the given multicast-group.
+func ValidateMulticastGroupQueueAccess(flag Flag, id string) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", multicastGroupExists},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateScheduledDownlinksAccess validates if the client has access to
+// the scheduled downlinks of the given application.
+func ValidateScheduledDownlinksAccess(flag Flag, applicationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, List:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+	}
+}
+
+// ValidateScheduledDownlinkAccess validates if the client has access to
+// the given scheduled downlink.
+func ValidateScheduledDownlinkAccess(flag Flag, id string) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read, Update, Delete:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", scheduledDownlinkExists},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateFUOTASessionsAccess validates if the client has access to the
+// FUOTA sessions of the given organization.
+func ValidateFUOTASessionsAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case List:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "$2 > 0", "o.id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+	}
+}
+
+// ValidateFUOTASessionAccess validates if the client has access to the
+// given FUOTA session.
+func ValidateFUOTASessionAccess(flag Flag, id string) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read, Update:
+		// global admin
+		// organization user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", fuotaSessionExists},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
 func executeQuery(db sqlx.Queryer, query string, where [][]string, args ...interface{}) (bool, error) {
 	var ors []string
 	for _, ands := range where {
@@ -3,6 +3,7 @@ package auth
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Frankz/lora-app-server/internal/common"
 	"github.com/Frankz/lora-app-server/internal/storage"
@@ -56,6 +57,14 @@ func TestValidators(t *testing.T) {
 	   Gateways:
 	   0101010101010101: organization 1 gw
 	   0202020202020202: organization 2 gw
+
+	   Multicast-groups:
+	   1: organization 1 multicast-group
+	   2: organization 2 multicast-group
+
+	   Scheduled downlinks:
+	   1: application 1 scheduled downlink
+	   2: application 2 scheduled downlink
 	*/
 	networkServers := []storage.NetworkServer{
 		{Name: "test-ns", Server: "test-ns:1234"},
@@ -169,6 +178,37 @@ func TestValidators(t *testing.T) {
 		}
 	}
 
+	multicastGroups := []storage.MulticastGroup{
+		{Name: "multicast-group-1", ServiceProfileID: serviceProfiles[0].ServiceProfile.ServiceProfileID},
+		{Name: "multicast-group-2", ServiceProfileID: serviceProfiles[1].ServiceProfile.ServiceProfileID},
+	}
+	for i := range multicastGroups {
+		if err := storage.CreateMulticastGroup(db, &multicastGroups[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scheduleAt := time.Now().Add(time.Hour)
+	scheduledDownlinks := []storage.ScheduledDownlink{
+		{Name: "scheduled-downlink-1", ApplicationID: applications[0].ID, FPort: 10, ScheduleAt: &scheduleAt},
+		{Name: "scheduled-downlink-2", ApplicationID: applications[1].ID, FPort: 10, ScheduleAt: &scheduleAt},
+	}
+	for i := range scheduledDownlinks {
+		if err := storage.CreateScheduledDownlink(db, &scheduledDownlinks[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fuotaSessions := []storage.FUOTASession{
+		{Name: "fuota-session-1", DevEUI: &devices[0].DevEUI, FragSize: 10, Descriptor: make([]byte, 4), Payload: make([]byte, 100), UnicastTimeout: time.Minute, SessionTimeout: time.Hour},
+		{Name: "fuota-session-2", DevEUI: &devices[1].DevEUI, FragSize: 10, Descriptor: make([]byte, 4), Payload: make([]byte, 100), UnicastTimeout: time.Minute, SessionTimeout: time.Hour},
+	}
+	for i := range fuotaSessions {
+		if err := storage.CreateFUOTASession(db, &fuotaSessions[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	Convey("Given a set of test users, applications and devices", t, func() {
 
 		Convey("When testing ValidateUsersAccess (DisableAssignExistingUsers=false)", func() {
@@ -1045,6 +1085,266 @@ func TestValidators(t *testing.T) {
 
 			runTests(tests, db)
 		})
+
+		Convey("When testing ValidateMulticastGroupsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(Create, organizations[0].ID), ValidateMulticastGroupsAccess(List, organizations[0].ID), ValidateMulticastGroupsAccess(List, 0)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(Create, organizations[0].ID), ValidateMulticastGroupsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create or list when organization id = 0",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(Create, organizations[0].ID), ValidateMulticastGroupsAccess(List, 0)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(Create, organizations[0].ID), ValidateMulticastGroupsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateMulticastGroupAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(Read, multicastGroups[0].ID), ValidateMulticastGroupAccess(Update, multicastGroups[0].ID), ValidateMulticastGroupAccess(Delete, multicastGroups[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(Read, multicastGroups[0].ID), ValidateMulticastGroupAccess(Update, multicastGroups[0].ID), ValidateMulticastGroupAccess(Delete, multicastGroups[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(Read, multicastGroups[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not update and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(Update, multicastGroups[0].ID), ValidateMulticastGroupAccess(Delete, multicastGroups[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(Read, multicastGroups[0].ID), ValidateMulticastGroupAccess(Update, multicastGroups[0].ID), ValidateMulticastGroupAccess(Delete, multicastGroups[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateMulticastGroupMemberAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can add and remove devices",
+					Validators: []ValidatorFunc{ValidateMulticastGroupMemberAccess(Create, multicastGroups[0].ID, devices[0].DevEUI), ValidateMulticastGroupMemberAccess(Delete, multicastGroups[0].ID, devices[0].DevEUI)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can add and remove devices of the same organization",
+					Validators: []ValidatorFunc{ValidateMulticastGroupMemberAccess(Create, multicastGroups[0].ID, devices[0].DevEUI), ValidateMulticastGroupMemberAccess(Delete, multicastGroups[0].ID, devices[0].DevEUI)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not add and remove devices of a different organization",
+					Validators: []ValidatorFunc{ValidateMulticastGroupMemberAccess(Create, multicastGroups[0].ID, devices[1].DevEUI), ValidateMulticastGroupMemberAccess(Delete, multicastGroups[0].ID, devices[1].DevEUI)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can not add and remove devices",
+					Validators: []ValidatorFunc{ValidateMulticastGroupMemberAccess(Create, multicastGroups[0].ID, devices[0].DevEUI), ValidateMulticastGroupMemberAccess(Delete, multicastGroups[0].ID, devices[0].DevEUI)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not add and remove devices",
+					Validators: []ValidatorFunc{ValidateMulticastGroupMemberAccess(Create, multicastGroups[0].ID, devices[0].DevEUI), ValidateMulticastGroupMemberAccess(Delete, multicastGroups[0].ID, devices[0].DevEUI)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateMulticastGroupQueueAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can enqueue",
+					Validators: []ValidatorFunc{ValidateMulticastGroupQueueAccess(Create, multicastGroups[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can enqueue",
+					Validators: []ValidatorFunc{ValidateMulticastGroupQueueAccess(Create, multicastGroups[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "non-organization users can not enqueue",
+					Validators: []ValidatorFunc{ValidateMulticastGroupQueueAccess(Create, multicastGroups[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateScheduledDownlinksAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinksAccess(Create, applications[0].ID), ValidateScheduledDownlinksAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can create and list",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinksAccess(Create, applications[0].ID), ValidateScheduledDownlinksAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create and list for an application of a different organization",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinksAccess(Create, applications[1].ID), ValidateScheduledDownlinksAccess(List, applications[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not create and list",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinksAccess(Create, applications[0].ID), ValidateScheduledDownlinksAccess(List, applications[0].ID)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateScheduledDownlinkAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinkAccess(Read, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Update, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Delete, scheduledDownlinks[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinkAccess(Read, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Update, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Delete, scheduledDownlinks[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read, update and delete scheduled downlinks of a different organization",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinkAccess(Read, scheduledDownlinks[1].ID), ValidateScheduledDownlinkAccess(Update, scheduledDownlinks[1].ID), ValidateScheduledDownlinkAccess(Delete, scheduledDownlinks[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateScheduledDownlinkAccess(Read, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Update, scheduledDownlinks[0].ID), ValidateScheduledDownlinkAccess(Delete, scheduledDownlinks[0].ID)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateFUOTASessionsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can list",
+					Validators: []ValidatorFunc{ValidateFUOTASessionsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateFUOTASessionsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not list for a different organization",
+					Validators: []ValidatorFunc{ValidateFUOTASessionsAccess(List, organizations[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not list",
+					Validators: []ValidatorFunc{ValidateFUOTASessionsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateFUOTASessionAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read and update",
+					Validators: []ValidatorFunc{ValidateFUOTASessionAccess(Read, fuotaSessions[0].ID), ValidateFUOTASessionAccess(Update, fuotaSessions[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read and update",
+					Validators: []ValidatorFunc{ValidateFUOTASessionAccess(Read, fuotaSessions[0].ID), ValidateFUOTASessionAccess(Update, fuotaSessions[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read and update fuota sessions of a different organization",
+					Validators: []ValidatorFunc{ValidateFUOTASessionAccess(Read, fuotaSessions[1].ID), ValidateFUOTASessionAccess(Update, fuotaSessions[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "non-organization users can not read and update",
+					Validators: []ValidatorFunc{ValidateFUOTASessionAccess(Read, fuotaSessions[0].ID), ValidateFUOTASessionAccess(Update, fuotaSessions[0].ID)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
 	})
 }
 
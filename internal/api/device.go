@@ -14,6 +14,7 @@ import (
 	pb "github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/api/auth"
 	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/eventlog"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/Frankz/loraserver/api/ns"
 	"github.com/Frankz/lorawan"
@@ -54,6 +55,7 @@ func (a *DeviceAPI) Create(ctx context.Context, req *pb.CreateDeviceRequest) (*p
 		DeviceProfileID: req.DeviceProfileID,
 		Name:            req.Name,
 		Description:     req.Description,
+		Tags:            req.Tags,
 	}
 
 	// as this also performs a remote call to create the node on the
@@ -93,6 +95,7 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 		DeviceProfileID:     d.DeviceProfileID,
 		DeviceStatusBattery: 256,
 		DeviceStatusMargin:  256,
+		Tags:                d.Tags,
 	}
 
 	if d.DeviceStatusBattery != nil {
@@ -104,6 +107,22 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 	if d.LastSeenAt != nil {
 		resp.LastSeenAt = d.LastSeenAt.Format(time.RFC3339Nano)
 	}
+	if d.Latitude != nil && d.Longitude != nil {
+		resp.Location = &pb.DeviceLocation{
+			Latitude:  *d.Latitude,
+			Longitude: *d.Longitude,
+			Source:    d.LocationSource,
+		}
+		if d.Altitude != nil {
+			resp.Location.Altitude = *d.Altitude
+		}
+		if d.LocationAccuracy != nil {
+			resp.Location.Accuracy = *d.LocationAccuracy
+		}
+		if d.LocationUpdatedAt != nil {
+			resp.Location.UpdatedAt = d.LocationUpdatedAt.Format(time.RFC3339Nano)
+		}
+	}
 
 	return &resp, nil
 }
@@ -115,11 +134,16 @@ func (a *DeviceAPI) ListByApplicationID(ctx context.Context, req *pb.ListDeviceB
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	devices, err := storage.GetDevicesForApplicationID(common.DB, req.ApplicationID, int(req.Limit), int(req.Offset), req.Search)
+	selector, err := storage.ParseTagSelector(req.TagSelector)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "tagSelector: %s", err)
+	}
+
+	devices, err := storage.GetDevicesForApplicationID(common.DB, req.ApplicationID, int(req.Limit), int(req.Offset), req.Search, selector)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
-	count, err := storage.GetDeviceCountForApplicationID(common.DB, req.ApplicationID, req.Search)
+	count, err := storage.GetDeviceCountForApplicationID(common.DB, req.ApplicationID, req.Search, selector)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
@@ -146,6 +170,7 @@ func (a *DeviceAPI) Update(ctx context.Context, req *pb.UpdateDeviceRequest) (*p
 	d.DeviceProfileID = req.DeviceProfileID
 	d.Name = req.Name
 	d.Description = req.Description
+	d.Tags = req.Tags
 
 	// as this also performs a remote call to update the node on the
 	// network-server, wrap it in a transaction
@@ -382,7 +407,7 @@ func (a *DeviceAPI) Activate(ctx context.Context, req *pb.ActivateDeviceRequest)
 		return nil, errToRPCError(err)
 	}
 
-	if err = storage.FlushDeviceQueueMappingForDevEUI(common.DB, d.DevEUI); err != nil {
+	if err = storage.ExpireQueuedDownlinksForDevEUI(common.DB, d.DevEUI); err != nil {
 		return nil, errToRPCError(err)
 	}
 
@@ -543,6 +568,172 @@ func (a *DeviceAPI) GetFrameLogs(ctx context.Context, req *pb.GetFrameLogsReques
 	return &out, nil
 }
 
+// ListEvents returns the stored events for the given DevEUI, sorted by the
+// most recent event first.
+func (a *DeviceAPI) ListEvents(ctx context.Context, req *pb.ListDeviceEventsRequest) (*pb.ListDeviceEventsResponse, error) {
+	var devEUI lorawan.EUI64
+
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filters := storage.DeviceEventFilters{
+		FPort: int(req.FPort),
+		Type:  storage.DeviceEventType(req.Type),
+	}
+
+	if req.StartTime != "" {
+		ts, err := time.Parse(time.RFC3339Nano, req.StartTime)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "startTime: %s", err)
+		}
+		filters.StartTime = &ts
+	}
+
+	if req.EndTime != "" {
+		ts, err := time.Parse(time.RFC3339Nano, req.EndTime)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "endTime: %s", err)
+		}
+		filters.EndTime = &ts
+	}
+
+	events, err := storage.GetDeviceEvents(common.DB, devEUI, filters, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	count, err := storage.GetDeviceEventCount(common.DB, devEUI, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListDeviceEventsResponse{
+		TotalCount: int64(count),
+	}
+	for _, e := range events {
+		item := pb.DeviceEvent{
+			Id:          e.ID,
+			CreatedAt:   e.CreatedAt.Format(time.RFC3339Nano),
+			Type:        string(e.Type),
+			PayloadJSON: string(e.Payload),
+		}
+		if e.FPort != nil {
+			item.FPort = uint32(*e.FPort)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// StreamEvents streams the events for the given DevEUI as they are received.
+func (a *DeviceAPI) StreamEvents(req *pb.StreamDeviceEventsRequest, srv pb.Device_StreamEventsServer) error {
+	var devEUI lorawan.EUI64
+
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	events := make(chan eventlog.Event)
+	subErr := make(chan error, 1)
+	go func() {
+		subErr <- eventlog.GetEventsForDevice(srv.Context(), devEUI, events)
+	}()
+
+	for {
+		select {
+		case e := <-events:
+			resp := pb.DeviceEvent{
+				Id:          e.ID,
+				CreatedAt:   e.CreatedAt.Format(time.RFC3339Nano),
+				Type:        string(e.Type),
+				PayloadJSON: string(e.Payload),
+			}
+			if e.FPort != nil {
+				resp.FPort = uint32(*e.FPort)
+			}
+
+			if err := srv.Send(&resp); err != nil {
+				log.WithField("dev_eui", devEUI).Errorf("send event error: %s", err)
+				return err
+			}
+		case err := <-subErr:
+			if err != nil {
+				return errToRPCError(err)
+			}
+			return nil
+		}
+	}
+}
+
+// ListAlerts returns the alerts raised for the devices of the given
+// application, sorted by the most recent alert first.
+func (a *DeviceAPI) ListAlerts(ctx context.Context, req *pb.ListDeviceAlertsRequest) (*pb.ListDeviceAlertsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodesAccess(req.ApplicationID, auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filters := storage.DeviceAlertFilters{
+		State: storage.DeviceAlertState(req.State),
+	}
+
+	switch filters.State {
+	case "", storage.DeviceAlertOpen, storage.DeviceAlertResolved:
+	default:
+		return nil, errToRPCError(storage.ErrDeviceAlertInvalidState)
+	}
+
+	if req.DevEUI != "" {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		}
+		filters.DevEUI = &devEUI
+	}
+
+	alerts, err := storage.GetDeviceAlertsForApplicationID(common.DB, req.ApplicationID, filters, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	count, err := storage.GetDeviceAlertCountForApplicationID(common.DB, req.ApplicationID, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListDeviceAlertsResponse{
+		TotalCount: int64(count),
+	}
+	for _, al := range alerts {
+		item := pb.DeviceAlert{
+			Id:        al.ID,
+			DevEUI:    al.DevEUI.String(),
+			Type:      string(al.Type),
+			State:     string(al.State),
+			Message:   al.Message,
+			CreatedAt: al.CreatedAt.Format(time.RFC3339Nano),
+		}
+		if al.ResolvedAt != nil {
+			item.ResolvedAt = al.ResolvedAt.Format(time.RFC3339Nano)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
 // GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
 func (a *DeviceAPI) GetRandomDevAddr(ctx context.Context, req *pb.GetRandomDevAddrRequest) (*pb.GetRandomDevAddrResponse, error) {
 	var devEUI lorawan.EUI64
@@ -588,6 +779,7 @@ func (a *DeviceAPI) returnList(count int, devices []storage.DeviceListItem) (*pb
 			DeviceProfileName:   device.DeviceProfileName,
 			DeviceStatusBattery: 256,
 			DeviceStatusMargin:  256,
+			Tags:                device.Tags,
 		}
 
 		if device.DeviceStatusBattery != nil {
@@ -0,0 +1,577 @@
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/api/auth"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+)
+
+// Device import / export formats.
+const (
+	deviceFormatCSV  = "CSV"
+	deviceFormatJSON = "JSON"
+)
+
+// Device import row statuses.
+const (
+	importStatusValid    = "VALID"
+	importStatusImported = "IMPORTED"
+	importStatusInvalid  = "INVALID"
+	importStatusFailed   = "FAILED"
+	importStatusSkipped  = "SKIPPED"
+)
+
+// exportBatchSize defines the number of devices exported per chunk.
+const exportBatchSize = 100
+
+// deviceColumns holds the CSV columns (and JSON keys) of a device row.
+var deviceColumns = []string{
+	"devEUI",
+	"name",
+	"description",
+	"deviceProfileID",
+	"appKey",
+	"devAddr",
+	"appSKey",
+	"nwkSKey",
+	"fCntUp",
+	"fCntDown",
+	"skipFCntCheck",
+	"tags",
+}
+
+// deviceRow defines a single device of an import or export.
+type deviceRow struct {
+	DevEUI          string            `json:"devEUI"`
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	DeviceProfileID string            `json:"deviceProfileID"`
+	AppKey          string            `json:"appKey,omitempty"`
+	DevAddr         string            `json:"devAddr,omitempty"`
+	AppSKey         string            `json:"appSKey,omitempty"`
+	NwkSKey         string            `json:"nwkSKey,omitempty"`
+	FCntUp          uint32            `json:"fCntUp,omitempty"`
+	FCntDown        uint32            `json:"fCntDown,omitempty"`
+	SkipFCntCheck   bool              `json:"skipFCntCheck,omitempty"`
+	Tags            map[string]string `json:"tags,omitempty"`
+}
+
+// ImportDevices validates and imports the given devices. The data is
+// received in chunks and the result is streamed per row. When one of the
+// rows is invalid, none of the devices are imported. Unless partial is
+// set, none of the devices are imported when one of the devices fails to
+// import.
+func (a *DeviceAPI) ImportDevices(srv pb.Device_ImportDevicesServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return grpc.Errorf(codes.InvalidArgument, "no devices to import")
+		}
+		return err
+	}
+
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateNodesAccess(req.ApplicationID, auth.Create)); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	rows, rowErrs, err := parseDeviceRows(req.Format, &importDataReader{srv: srv, data: req.Data})
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "parse data error: %s", err)
+	}
+	if len(rows) == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "no devices to import")
+	}
+
+	// convert the rows and validate the rows which could be parsed
+	var imports []storage.DeviceImport
+	var importRows []int
+	for i := range rows {
+		if rowErrs[i] != nil {
+			continue
+		}
+
+		imp, err := rows[i].deviceImport()
+		if err != nil {
+			rowErrs[i] = err
+			continue
+		}
+
+		imports = append(imports, imp)
+		importRows = append(importRows, i)
+	}
+
+	valErrs, err := storage.ValidateDeviceImports(common.DB, req.ApplicationID, imports)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	invalid := len(imports) != len(rows)
+	for i, err := range valErrs {
+		if err != nil {
+			rowErrs[importRows[i]] = err
+			invalid = true
+		}
+	}
+
+	statuses := make([]string, len(rows))
+	switch {
+	case invalid || req.DryRun:
+		for i := range rows {
+			switch {
+			case rowErrs[i] != nil:
+				statuses[i] = importStatusInvalid
+			case req.DryRun:
+				statuses[i] = importStatusValid
+			default:
+				statuses[i] = importStatusSkipped
+			}
+		}
+	default:
+		impErrs, err := storage.ImportDevices(common.DB, req.ApplicationID, imports, req.Partial)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		// without partial, no devices have been imported when one of
+		// the devices failed to import
+		imported := importStatusImported
+		if !req.Partial {
+			for _, err := range impErrs {
+				if err != nil {
+					imported = importStatusSkipped
+				}
+			}
+		}
+
+		for i, err := range impErrs {
+			if err != nil {
+				statuses[importRows[i]] = importStatusFailed
+				rowErrs[importRows[i]] = err
+			} else {
+				statuses[importRows[i]] = imported
+			}
+		}
+	}
+
+	for i := range rows {
+		resp := pb.ImportDevicesResponse{
+			Row:    uint32(i + 1),
+			DevEUI: rows[i].DevEUI,
+			Status: statuses[i],
+		}
+		if rowErrs[i] != nil {
+			resp.Error = rowErrs[i].Error()
+		}
+
+		if err := srv.Send(&resp); err != nil {
+			log.WithField("application_id", req.ApplicationID).Errorf("send import result error: %s", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportDevices exports the devices of the given application, including
+// their keys (OTAA) or last activation (ABP). The data is streamed in chunks.
+func (a *DeviceAPI) ExportDevices(req *pb.ExportDevicesRequest, srv pb.Device_ExportDevicesServer) error {
+	// the export contains the device keys, therefore update access is
+	// required
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateApplicationAccess(req.ApplicationID, auth.Update)); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	format := strings.ToUpper(req.Format)
+	if format != deviceFormatCSV && format != deviceFormatJSON {
+		return grpc.Errorf(codes.InvalidArgument, "invalid format: %s", req.Format)
+	}
+
+	var first = true
+	for offset := 0; ; offset += exportBatchSize {
+		devices, err := storage.GetDevicesForApplicationID(common.DB, req.ApplicationID, exportBatchSize, offset, "", nil)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		rows := make([]deviceRow, 0, len(devices))
+		for _, d := range devices {
+			row, err := getDeviceRow(d.Device)
+			if err != nil {
+				return errToRPCError(err)
+			}
+			rows = append(rows, row)
+		}
+
+		var buf bytes.Buffer
+		if format == deviceFormatCSV {
+			err = writeDeviceCSV(&buf, rows, first)
+		} else {
+			err = writeDeviceJSON(&buf, rows, first)
+		}
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		last := len(devices) < exportBatchSize
+		if last && format == deviceFormatJSON {
+			buf.WriteString("\n]\n")
+		}
+
+		if buf.Len() != 0 {
+			if err := srv.Send(&pb.ExportDevicesResponse{Data: buf.String()}); err != nil {
+				log.WithField("application_id", req.ApplicationID).Errorf("send export data error: %s", err)
+				return err
+			}
+		}
+
+		if last {
+			return nil
+		}
+		first = false
+	}
+}
+
+// importDataReader implements io.Reader, reading the data of the import
+// requests received on the stream.
+type importDataReader struct {
+	srv  pb.Device_ImportDevicesServer
+	data string
+}
+
+func (r *importDataReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		req, err := r.srv.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.data = req.Data
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// parseDeviceRows parses the data read from the given reader. Besides the
+// rows, it returns an error per row (nil when valid). An error is returned
+// when the data as a whole could not be parsed.
+func parseDeviceRows(format string, r io.Reader) ([]deviceRow, []error, error) {
+	switch strings.ToUpper(format) {
+	case deviceFormatCSV:
+		return parseDeviceCSV(r)
+	case deviceFormatJSON:
+		var rows []deviceRow
+		if err := json.NewDecoder(r).Decode(&rows); err != nil {
+			return nil, nil, err
+		}
+		return rows, make([]error, len(rows)), nil
+	default:
+		return nil, nil, fmt.Errorf("invalid format: %s", format)
+	}
+}
+
+// parseDeviceCSV parses the given CSV data. The first record must be the
+// header, the order of the columns is free.
+func parseDeviceCSV(r io.Reader) ([]deviceRow, []error, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "read header error")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !isDeviceColumn(name) {
+			return nil, nil, fmt.Errorf("unknown column: %s", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range []string{"devEUI", "deviceProfileID"} {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("column %s is required", name)
+		}
+	}
+
+	var rows []deviceRow
+	var rowErrs []error
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := deviceRow{
+			DevEUI:          get("devEUI"),
+			Name:            get("name"),
+			Description:     get("description"),
+			DeviceProfileID: get("deviceProfileID"),
+			AppKey:          get("appKey"),
+			DevAddr:         get("devAddr"),
+			AppSKey:         get("appSKey"),
+			NwkSKey:         get("nwkSKey"),
+		}
+		rowErr := parseDeviceCSVCounters(&row, get)
+		if rowErr == nil {
+			row.Tags, rowErr = parseDeviceCSVTags(get("tags"))
+		}
+
+		rows = append(rows, row)
+		rowErrs = append(rowErrs, rowErr)
+	}
+
+	return rows, rowErrs, nil
+}
+
+// parseDeviceCSVCounters parses the frame-counter columns of a CSV record.
+func parseDeviceCSVCounters(row *deviceRow, get func(string) string) error {
+	if v := get("fCntUp"); v != "" {
+		i, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return errors.Wrap(err, "fCntUp")
+		}
+		row.FCntUp = uint32(i)
+	}
+
+	if v := get("fCntDown"); v != "" {
+		i, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return errors.Wrap(err, "fCntDown")
+		}
+		row.FCntDown = uint32(i)
+	}
+
+	if v := get("skipFCntCheck"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return errors.Wrap(err, "skipFCntCheck")
+		}
+		row.SkipFCntCheck = b
+	}
+
+	return nil
+}
+
+// parseDeviceCSVTags parses the tags column of a CSV record, formatted as
+// comma separated key=value pairs.
+func parseDeviceCSVTags(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	tags := make(map[string]string)
+	for _, kv := range strings.Split(s, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("tags: expected key=value, got: %s", kv)
+		}
+		tags[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return tags, nil
+}
+
+// formatDeviceCSVTags formats the given tags as comma separated key=value
+// pairs, sorted by key.
+func formatDeviceCSVTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+tags[k])
+	}
+	return strings.Join(pairs, ",")
+}
+
+func isDeviceColumn(name string) bool {
+	for _, c := range deviceColumns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// deviceImport converts the row into a storage.DeviceImport.
+func (r deviceRow) deviceImport() (storage.DeviceImport, error) {
+	var imp storage.DeviceImport
+
+	if err := imp.Device.DevEUI.UnmarshalText([]byte(r.DevEUI)); err != nil {
+		return imp, fmt.Errorf("devEUI: %s", err)
+	}
+
+	imp.Device.Name = r.Name
+	if imp.Device.Name == "" {
+		imp.Device.Name = imp.Device.DevEUI.String()
+	}
+	imp.Device.Description = r.Description
+	imp.Device.DeviceProfileID = r.DeviceProfileID
+	imp.Device.Tags = storage.Tags(r.Tags)
+
+	if r.AppKey != "" {
+		var keys storage.DeviceKeys
+		if err := keys.AppKey.UnmarshalText([]byte(r.AppKey)); err != nil {
+			return imp, fmt.Errorf("appKey: %s", err)
+		}
+		imp.DeviceKeys = &keys
+	}
+
+	if r.DevAddr != "" || r.AppSKey != "" || r.NwkSKey != "" {
+		var da storage.DeviceActivation
+		if err := da.DevAddr.UnmarshalText([]byte(r.DevAddr)); err != nil {
+			return imp, fmt.Errorf("devAddr: %s", err)
+		}
+		if err := da.AppSKey.UnmarshalText([]byte(r.AppSKey)); err != nil {
+			return imp, fmt.Errorf("appSKey: %s", err)
+		}
+		if err := da.NwkSKey.UnmarshalText([]byte(r.NwkSKey)); err != nil {
+			return imp, fmt.Errorf("nwkSKey: %s", err)
+		}
+		imp.Activation = &da
+		imp.FCntUp = r.FCntUp
+		imp.FCntDown = r.FCntDown
+		imp.SkipFCntCheck = r.SkipFCntCheck
+	}
+
+	return imp, nil
+}
+
+// csvRecord returns the row as CSV record, in the order of deviceColumns.
+func (r deviceRow) csvRecord() []string {
+	rec := []string{
+		r.DevEUI,
+		r.Name,
+		r.Description,
+		r.DeviceProfileID,
+		r.AppKey,
+		r.DevAddr,
+		r.AppSKey,
+		r.NwkSKey,
+		"",
+		"",
+		"",
+		formatDeviceCSVTags(r.Tags),
+	}
+	if r.DevAddr != "" {
+		rec[8] = strconv.FormatUint(uint64(r.FCntUp), 10)
+		rec[9] = strconv.FormatUint(uint64(r.FCntDown), 10)
+		rec[10] = strconv.FormatBool(r.SkipFCntCheck)
+	}
+	return rec
+}
+
+// getDeviceRow returns the export row for the given device. For OTAA
+// devices the keys are exported, for ABP devices the last activation.
+// Note that the frame-counters are not exported as these are kept by
+// the network-server.
+func getDeviceRow(d storage.Device) (deviceRow, error) {
+	row := deviceRow{
+		DevEUI:          d.DevEUI.String(),
+		Name:            d.Name,
+		Description:     d.Description,
+		DeviceProfileID: d.DeviceProfileID,
+		Tags:            d.Tags,
+	}
+
+	keys, err := storage.GetDeviceKeys(common.DB, d.DevEUI)
+	if err == nil {
+		row.AppKey = keys.AppKey.String()
+		return row, nil
+	}
+	if errors.Cause(err) != storage.ErrDoesNotExist {
+		return row, errors.Wrap(err, "get device-keys error")
+	}
+
+	da, err := storage.GetLastDeviceActivationForDevEUI(common.DB, d.DevEUI)
+	if err == nil {
+		row.DevAddr = da.DevAddr.String()
+		row.AppSKey = da.AppSKey.String()
+		row.NwkSKey = da.NwkSKey.String()
+		return row, nil
+	}
+	if errors.Cause(err) != storage.ErrDoesNotExist {
+		return row, errors.Wrap(err, "get device-activation error")
+	}
+
+	return row, nil
+}
+
+// writeDeviceCSV writes the given rows as CSV, including the header when
+// first is set.
+func writeDeviceCSV(w io.Writer, rows []deviceRow, first bool) error {
+	cw := csv.NewWriter(w)
+	if first {
+		if err := cw.Write(deviceColumns); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range rows {
+		if err := cw.Write(r.csvRecord()); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeDeviceJSON writes the given rows as elements of a JSON array. The
+// opening bracket is written when first is set.
+func writeDeviceJSON(w io.Writer, rows []deviceRow, first bool) error {
+	if first {
+		if _, err := io.WriteString(w, "[\n"); err != nil {
+			return err
+		}
+	}
+
+	for i, r := range rows {
+		if !first || i != 0 {
+			if _, err := io.WriteString(w, ",\n"); err != nil {
+				return err
+			}
+		}
+
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testImportDevicesServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  []pb.ImportDevicesRequest
+	responses []pb.ImportDevicesResponse
+}
+
+func (s *testImportDevicesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testImportDevicesServer) Recv() (*pb.ImportDevicesRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return &req, nil
+}
+
+func (s *testImportDevicesServer) Send(resp *pb.ImportDevicesResponse) error {
+	s.responses = append(s.responses, *resp)
+	return nil
+}
+
+type testExportDevicesServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data []string
+}
+
+func (s *testExportDevicesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testExportDevicesServer) Send(resp *pb.ExportDevicesResponse) error {
+	s.data = append(s.data, resp.Data)
+	return nil
+}
+
+func TestDeviceImportExportAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	common.DB = db
+
+	Convey("Given a clean database with an organization, application and device-profile", t, func() {
+		test.MustResetDB(common.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+			DeviceProfile: &ns.DeviceProfile{
+				SupportsJoin: true,
+			},
+		}
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewDeviceAPI(validator)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+		dpID := dp.DeviceProfile.DeviceProfileID
+
+		csvData := "devEUI,name,deviceProfileID,appKey,tags\n" +
+			"0102030405060708,device-1," + dpID + ",01020304050607080102030405060708,\"site=berlin,floor=3\"\n" +
+			"0102030405060709,," + dpID + ",01020304050607080102030405060708,\n"
+
+		Convey("When importing OTAA devices as a dry-run", func() {
+			srv := testImportDevicesServer{ctx: ctx, requests: []pb.ImportDevicesRequest{{
+				ApplicationID: app.ID,
+				Format:        "csv",
+				Data:          csvData,
+				DryRun:        true,
+			}}}
+			So(api.ImportDevices(&srv), ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+			Convey("Then all rows are valid and no devices have been created", func() {
+				So(srv.responses, ShouldResemble, []pb.ImportDevicesResponse{
+					{Row: 1, DevEUI: "0102030405060708", Status: "VALID"},
+					{Row: 2, DevEUI: "0102030405060709", Status: "VALID"},
+				})
+
+				count, err := storage.GetDeviceCountForApplicationID(common.DB, app.ID, "", nil)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When importing OTAA devices streamed in chunks", func() {
+			srv := testImportDevicesServer{ctx: ctx, requests: []pb.ImportDevicesRequest{
+				{ApplicationID: app.ID, Format: "CSV", Data: csvData[:30]},
+				{Data: csvData[30:100]},
+				{Data: csvData[100:]},
+			}}
+			So(api.ImportDevices(&srv), ShouldBeNil)
+
+			Convey("Then the devices have been imported", func() {
+				So(srv.responses, ShouldResemble, []pb.ImportDevicesResponse{
+					{Row: 1, DevEUI: "0102030405060708", Status: "IMPORTED"},
+					{Row: 2, DevEUI: "0102030405060709", Status: "IMPORTED"},
+				})
+
+				count, err := storage.GetDeviceCountForApplicationID(common.DB, app.ID, "", nil)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When importing devices containing an invalid row", func() {
+			srv := testImportDevicesServer{ctx: ctx, requests: []pb.ImportDevicesRequest{{
+				ApplicationID: app.ID,
+				Format:        "CSV",
+				Data:          csvData + "0102030405060708,device-3," + dpID + ",01020304050607080102030405060708\n",
+			}}}
+			So(api.ImportDevices(&srv), ShouldBeNil)
+
+			Convey("Then the invalid row is reported and no devices have been created", func() {
+				So(srv.responses, ShouldResemble, []pb.ImportDevicesResponse{
+					{Row: 1, DevEUI: "0102030405060708", Status: "SKIPPED"},
+					{Row: 2, DevEUI: "0102030405060709", Status: "SKIPPED"},
+					{Row: 3, DevEUI: "0102030405060708", Status: "INVALID", Error: "duplicate devEUI"},
+				})
+
+				count, err := storage.GetDeviceCountForApplicationID(common.DB, app.ID, "", nil)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When importing OTAA devices", func() {
+			srv := testImportDevicesServer{ctx: ctx, requests: []pb.ImportDevicesRequest{{
+				ApplicationID: app.ID,
+				Format:        "CSV",
+				Data:          csvData,
+			}}}
+			So(api.ImportDevices(&srv), ShouldBeNil)
+
+			Convey("Then the devices and keys have been created", func() {
+				So(srv.responses, ShouldResemble, []pb.ImportDevicesResponse{
+					{Row: 1, DevEUI: "0102030405060708", Status: "IMPORTED"},
+					{Row: 2, DevEUI: "0102030405060709", Status: "IMPORTED"},
+				})
+				So(nsClient.CreateDeviceChan, ShouldHaveLength, 2)
+
+				d, err := storage.GetDevice(common.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 9})
+				So(err, ShouldBeNil)
+				So(d.Name, ShouldEqual, "0102030405060709")
+
+				d, err = storage.GetDevice(common.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+				So(err, ShouldBeNil)
+				So(d.Tags, ShouldResemble, storage.Tags{"site": "berlin", "floor": "3"})
+
+				dk, err := storage.GetDeviceKeys(common.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+				So(err, ShouldBeNil)
+				So(dk.AppKey, ShouldEqual, lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8})
+			})
+
+			Convey("Then importing the same devices again returns INVALID rows", func() {
+				srv := testImportDevicesServer{ctx: ctx, requests: []pb.ImportDevicesRequest{{
+					ApplicationID: app.ID,
+					Format:        "CSV",
+					Data:          csvData,
+				}}}
+				So(api.ImportDevices(&srv), ShouldBeNil)
+				So(srv.responses, ShouldHaveLength, 2)
+				So(srv.responses[0].Status, ShouldEqual, "INVALID")
+				So(srv.responses[0].Error, ShouldEqual, "object already exists")
+			})
+
+			Convey("Then the devices can be exported as CSV", func() {
+				srv := testExportDevicesServer{ctx: ctx}
+				So(api.ExportDevices(&pb.ExportDevicesRequest{
+					ApplicationID: app.ID,
+					Format:        "CSV",
+				}, &srv), ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				So(strings.Join(srv.data, ""), ShouldEqual, "devEUI,name,description,deviceProfileID,appKey,devAddr,appSKey,nwkSKey,fCntUp,fCntDown,skipFCntCheck,tags\n"+
+					"0102030405060708,device-1,,"+dpID+",01020304050607080102030405060708,,,,,,,\"floor=3,site=berlin\"\n"+
+					"0102030405060709,0102030405060709,,"+dpID+",01020304050607080102030405060708,,,,,,,\n")
+			})
+
+			Convey("Then the devices can be exported as JSON", func() {
+				srv := testExportDevicesServer{ctx: ctx}
+				So(api.ExportDevices(&pb.ExportDevicesRequest{
+					ApplicationID: app.ID,
+					Format:        "JSON",
+				}, &srv), ShouldBeNil)
+
+				rows, _, err := parseDeviceRows("JSON", strings.NewReader(strings.Join(srv.data, "")))
+				So(err, ShouldBeNil)
+				So(rows, ShouldResemble, []deviceRow{
+					{DevEUI: "0102030405060708", Name: "device-1", DeviceProfileID: dpID, AppKey: "01020304050607080102030405060708", Tags: map[string]string{"site": "berlin", "floor": "3"}},
+					{DevEUI: "0102030405060709", Name: "0102030405060709", DeviceProfileID: dpID, AppKey: "01020304050607080102030405060708"},
+				})
+			})
+		})
+
+		Convey("Given the device-profile is ABP", func() {
+			nsClient.GetDeviceProfileResponse.DeviceProfile.SupportsJoin = false
+
+			Convey("When importing an ABP device as JSON", func() {
+				srv := testImportDevicesServer{ctx: ctx, requests: []pb.ImportDevicesRequest{{
+					ApplicationID: app.ID,
+					Format:        "JSON",
+					Data: `[{
+						"devEUI": "0102030405060708",
+						"deviceProfileID": "` + dpID + `",
+						"devAddr": "01020304",
+						"appSKey": "01020304050607080102030405060708",
+						"nwkSKey": "08070605040302010807060504030201",
+						"fCntUp": 10,
+						"fCntDown": 11,
+						"skipFCntCheck": true
+					}]`,
+				}}}
+				So(api.ImportDevices(&srv), ShouldBeNil)
+
+				Convey("Then the device has been activated", func() {
+					So(srv.responses, ShouldResemble, []pb.ImportDevicesResponse{
+						{Row: 1, DevEUI: "0102030405060708", Status: "IMPORTED"},
+					})
+
+					So(<-nsClient.ActivateDeviceChan, ShouldResemble, ns.ActivateDeviceRequest{
+						DevEUI:        []byte{1, 2, 3, 4, 5, 6, 7, 8},
+						DevAddr:       []byte{1, 2, 3, 4},
+						NwkSKey:       []byte{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+						FCntUp:        10,
+						FCntDown:      11,
+						SkipFCntCheck: true,
+					})
+
+					da, err := storage.GetLastDeviceActivationForDevEUI(common.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+					So(err, ShouldBeNil)
+					So(da.DevAddr, ShouldEqual, lorawan.DevAddr{1, 2, 3, 4})
+				})
+			})
+
+			Convey("When importing an ABP device without activation", func() {
+				srv := testImportDevicesServer{ctx: ctx, requests: []pb.ImportDevicesRequest{{
+					ApplicationID: app.ID,
+					Format:        "CSV",
+					Data:          "devEUI,deviceProfileID,fCntUp\n0102030405060708," + dpID + ",abc\n0102030405060709," + dpID + ",\n",
+				}}}
+				So(api.ImportDevices(&srv), ShouldBeNil)
+
+				Convey("Then the rows are INVALID", func() {
+					So(srv.responses, ShouldHaveLength, 2)
+					So(srv.responses[0].Status, ShouldEqual, "INVALID")
+					So(srv.responses[1].Status, ShouldEqual, "INVALID")
+					So(srv.responses[1].Error, ShouldEqual, "devAddr, appSKey and nwkSKey are required for ABP devices")
+				})
+			})
+		})
+	})
+}
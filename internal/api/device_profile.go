@@ -5,6 +5,7 @@ import (
 
 	pb "github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/api/auth"
+	"github.com/Frankz/lora-app-server/internal/codec"
 	"github.com/Frankz/lora-app-server/internal/common"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/Frankz/lorawan/backend"
@@ -38,10 +39,20 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := validatePayloadCodec(codec.Type(req.PayloadCodec), req.PayloadEncoderScript, req.PayloadDecoderScript); err != nil {
+		return nil, err
+	}
+
 	dp := storage.DeviceProfile{
-		OrganizationID:  req.OrganizationID,
-		NetworkServerID: req.NetworkServerID,
-		Name:            req.Name,
+		OrganizationID:        req.OrganizationID,
+		NetworkServerID:       req.NetworkServerID,
+		Name:                  req.Name,
+		PayloadCodec:          codec.Type(req.PayloadCodec),
+		PayloadEncoderScript:  req.PayloadEncoderScript,
+		PayloadDecoderScript:  req.PayloadDecoderScript,
+		AlertUplinkInterval:   time.Duration(req.AlertUplinkInterval) * time.Second,
+		AlertBatteryThreshold: int(req.AlertBatteryThreshold),
+		AlertMarginThreshold:  alertMarginThreshold(req.AlertMarginEnabled, req.AlertMarginThreshold),
 		DeviceProfile: backend.DeviceProfile{
 			SupportsClassB:    req.DeviceProfile.SupportsClassB,
 			ClassBTimeout:     int(req.DeviceProfile.ClassBTimeout),
@@ -96,11 +107,16 @@ func (a *DeviceProfileServiceAPI) Get(ctx context.Context, req *pb.GetDeviceProf
 	}
 
 	resp := pb.GetDeviceProfileResponse{
-		Name:            dp.Name,
-		OrganizationID:  dp.OrganizationID,
-		NetworkServerID: dp.NetworkServerID,
-		CreatedAt:       dp.CreatedAt.Format(time.RFC3339Nano),
-		UpdatedAt:       dp.UpdatedAt.Format(time.RFC3339Nano),
+		Name:                  dp.Name,
+		OrganizationID:        dp.OrganizationID,
+		NetworkServerID:       dp.NetworkServerID,
+		CreatedAt:             dp.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:             dp.UpdatedAt.Format(time.RFC3339Nano),
+		PayloadCodec:          string(dp.PayloadCodec),
+		PayloadEncoderScript:  dp.PayloadEncoderScript,
+		PayloadDecoderScript:  dp.PayloadDecoderScript,
+		AlertUplinkInterval:   uint32(dp.AlertUplinkInterval / time.Second),
+		AlertBatteryThreshold: uint32(dp.AlertBatteryThreshold),
 		DeviceProfile: &pb.DeviceProfile{
 			DeviceProfileID:   dp.DeviceProfile.DeviceProfileID,
 			SupportsClassB:    dp.DeviceProfile.SupportsClassB,
@@ -128,6 +144,11 @@ func (a *DeviceProfileServiceAPI) Get(ctx context.Context, req *pb.GetDeviceProf
 		resp.DeviceProfile.FactoryPresetFreqs = append(resp.DeviceProfile.FactoryPresetFreqs, uint32(freq))
 	}
 
+	if dp.AlertMarginThreshold != nil {
+		resp.AlertMarginThreshold = int32(*dp.AlertMarginThreshold)
+		resp.AlertMarginEnabled = true
+	}
+
 	return &resp, nil
 }
 
@@ -143,12 +164,22 @@ func (a *DeviceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateDevi
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := validatePayloadCodec(codec.Type(req.PayloadCodec), req.PayloadEncoderScript, req.PayloadDecoderScript); err != nil {
+		return nil, err
+	}
+
 	dp, err := storage.GetDeviceProfile(common.DB, req.DeviceProfile.DeviceProfileID)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
 	dp.Name = req.Name
+	dp.PayloadCodec = codec.Type(req.PayloadCodec)
+	dp.PayloadEncoderScript = req.PayloadEncoderScript
+	dp.PayloadDecoderScript = req.PayloadDecoderScript
+	dp.AlertUplinkInterval = time.Duration(req.AlertUplinkInterval) * time.Second
+	dp.AlertBatteryThreshold = int(req.AlertBatteryThreshold)
+	dp.AlertMarginThreshold = alertMarginThreshold(req.AlertMarginEnabled, req.AlertMarginThreshold)
 	dp.DeviceProfile = backend.DeviceProfile{
 		DeviceProfileID:   req.DeviceProfile.DeviceProfileID,
 		SupportsClassB:    req.DeviceProfile.SupportsClassB,
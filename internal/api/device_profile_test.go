@@ -46,11 +46,30 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 		}
 		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
 
+		Convey("Then Create with an invalid payload schema returns an InvalidArgument error", func() {
+			_, err := api.Create(ctx, &pb.CreateDeviceProfileRequest{
+				Name:                 "test-dp",
+				OrganizationID:       org.ID,
+				NetworkServerID:      n.ID,
+				PayloadCodec:         "SCHEMA",
+				PayloadDecoderScript: `{"layouts": []}`,
+				DeviceProfile:        &pb.DeviceProfile{},
+			})
+			So(err, ShouldNotBeNil)
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(nsClient.CreateDeviceProfileChan, ShouldHaveLength, 0)
+		})
+
 		Convey("Then Create creates a device-profile", func() {
 			createReq := pb.CreateDeviceProfileRequest{
-				Name:            "test-dp",
-				OrganizationID:  org.ID,
-				NetworkServerID: n.ID,
+				Name:                  "test-dp",
+				OrganizationID:        org.ID,
+				NetworkServerID:       n.ID,
+				PayloadCodec:          "CUSTOM_JS",
+				PayloadEncoderScript:  "function Encode() {}",
+				PayloadDecoderScript:  "function Decode() {}",
+				AlertUplinkInterval:   3600,
+				AlertBatteryThreshold: 50,
 				DeviceProfile: &pb.DeviceProfile{
 					SupportsClassB:     true,
 					ClassBTimeout:      10,
@@ -93,6 +112,13 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 				So(getResp.Name, ShouldEqual, createReq.Name)
 				So(getResp.OrganizationID, ShouldEqual, createReq.OrganizationID)
 				So(getResp.NetworkServerID, ShouldEqual, createReq.NetworkServerID)
+				So(getResp.PayloadCodec, ShouldEqual, "CUSTOM_JS")
+				So(getResp.PayloadEncoderScript, ShouldEqual, "function Encode() {}")
+				So(getResp.PayloadDecoderScript, ShouldEqual, "function Decode() {}")
+				So(getResp.AlertUplinkInterval, ShouldEqual, 3600)
+				So(getResp.AlertBatteryThreshold, ShouldEqual, 50)
+				So(getResp.AlertMarginEnabled, ShouldBeFalse)
+				So(getResp.AlertMarginThreshold, ShouldEqual, 0)
 				So(getResp.DeviceProfile, ShouldResemble, &pb.DeviceProfile{
 					DeviceProfileID:    createResp.DeviceProfileID,
 					SupportsClassB:     true,
@@ -119,7 +145,10 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 
 			Convey("Then Update updates the device-profile", func() {
 				_, err := api.Update(ctx, &pb.UpdateDeviceProfileRequest{
-					Name: "updated-dp",
+					Name:                 "updated-dp",
+					PayloadCodec:         "CAYENNE_LPP",
+					AlertMarginEnabled:   true,
+					AlertMarginThreshold: -3,
 					DeviceProfile: &pb.DeviceProfile{
 						DeviceProfileID:    createResp.DeviceProfileID,
 						SupportsClassB:     true,
@@ -156,6 +185,11 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 				})
 				So(err, ShouldBeNil)
 				So(getResp.Name, ShouldEqual, "updated-dp")
+				So(getResp.PayloadCodec, ShouldEqual, "CAYENNE_LPP")
+				So(getResp.PayloadDecoderScript, ShouldEqual, "")
+				So(getResp.AlertUplinkInterval, ShouldEqual, 0)
+				So(getResp.AlertMarginEnabled, ShouldBeTrue)
+				So(getResp.AlertMarginThreshold, ShouldEqual, -3)
 				So(getResp.OrganizationID, ShouldEqual, createReq.OrganizationID)
 				So(getResp.NetworkServerID, ShouldEqual, createReq.NetworkServerID)
 				So(getResp.DeviceProfile, ShouldResemble, &pb.DeviceProfile{
@@ -182,6 +216,24 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Then Update with an invalid payload codec returns an InvalidArgument error", func() {
+				for _, req := range []pb.UpdateDeviceProfileRequest{
+					{PayloadCodec: "UNKNOWN"},
+					{PayloadCodec: "SCHEMA", PayloadDecoderScript: `{"layouts": []}`},
+					{PayloadCodec: "CUSTOM_JS", PayloadDecoderScript: "function Decode(fPort, bytes) {"},
+				} {
+					req.Name = "updated-dp"
+					req.DeviceProfile = &pb.DeviceProfile{
+						DeviceProfileID: createResp.DeviceProfileID,
+					}
+
+					_, err := api.Update(ctx, &req)
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				}
+				So(nsClient.UpdateDeviceProfileChan, ShouldHaveLength, 0)
+			})
+
 			Convey("Then Delete deletes the device-profile", func() {
 				_, err := api.Delete(ctx, &pb.DeleteDeviceProfileRequest{
 					DeviceProfileID: createResp.DeviceProfileID,
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"time"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -45,20 +46,15 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 
 	// if JSON object is set, try to encode it to bytes
 	if req.JsonObject != "" {
-		dev, err := storage.GetDevice(common.DB, devEUI)
+		pcs, err := storage.GetPayloadCodecSettingsForDevEUI(common.DB, devEUI)
 		if err != nil {
 			return nil, errToRPCError(err)
 		}
 
-		app, err := storage.GetApplication(common.DB, dev.ApplicationID)
-		if err != nil {
-			return nil, errToRPCError(err)
-		}
-
-		// get codec payload configured for the application
-		codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
+		// get codec payload configured for the device-profile or application
+		codecPL := codec.NewPayload(pcs.PayloadCodec, pcs.ApplicationID, uint8(req.FPort), pcs.PayloadEncoderScript, pcs.PayloadDecoderScript)
 		if codecPL == nil {
-			return nil, grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for application")
+			return nil, grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for device-profile or application")
 		}
 
 		err = json.Unmarshal([]byte(req.JsonObject), &codecPL)
@@ -72,8 +68,11 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 		}
 	}
 
+	var fCnt uint32
 	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
-		if err := downlink.EnqueueDownlinkPayload(tx, devEUI, req.Reference, req.Confirmed, uint8(req.FPort), req.Data); err != nil {
+		var err error
+		fCnt, err = downlink.EnqueueDownlinkPayload(tx, devEUI, req.Reference, req.Confirmed, uint8(req.FPort), req.Data)
+		if err != nil {
 			return errors.Wrap(err, "enqueue downlink payload error")
 		}
 		return nil
@@ -82,7 +81,9 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 		return nil, errToRPCError(err)
 	}
 
-	return &pb.EnqueueDeviceQueueItemResponse{}, nil
+	return &pb.EnqueueDeviceQueueItemResponse{
+		FCnt: fCnt,
+	}, nil
 }
 
 // Flush flushes the downlink device-queue.
@@ -108,7 +109,7 @@ func (d *DeviceQueueAPI) Flush(ctx context.Context, req *pb.FlushDeviceQueueRequ
 	}
 
 	err = storage.Transaction(common.DB, func(tx sqlx.Ext) error {
-		if err := storage.FlushDeviceQueueMappingForDevEUI(tx, devEUI); err != nil {
+		if err := storage.ExpireQueuedDownlinksForDevEUI(tx, devEUI); err != nil {
 			return errToRPCError(err)
 		}
 
@@ -173,3 +174,80 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 
 	return &resp, nil
 }
+
+// GetDownlinkStatus returns the status of the downlink matching the given
+// frame-counter.
+func (d *DeviceQueueAPI) GetDownlinkStatus(ctx context.Context, req *pb.GetDownlinkStatusRequest) (*pb.GetDownlinkStatusResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := d.validator.Validate(ctx,
+		auth.ValidateDeviceQueueAccess(devEUI, auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	dl, err := storage.GetDownlinkForDevEUIAndFCnt(common.DB, devEUI, req.FCnt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return marshalDownlink(dl), nil
+}
+
+// ListDownlinks lists the downlinks (and their status) of the device.
+func (d *DeviceQueueAPI) ListDownlinks(ctx context.Context, req *pb.ListDownlinksRequest) (*pb.ListDownlinksResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := d.validator.Validate(ctx,
+		auth.ValidateDeviceQueueAccess(devEUI, auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetDownlinkCountForDevEUI(common.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	downlinks, err := storage.GetDownlinksForDevEUI(common.DB, devEUI, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListDownlinksResponse{
+		TotalCount: int64(count),
+	}
+	for _, dl := range downlinks {
+		resp.Result = append(resp.Result, marshalDownlink(dl))
+	}
+
+	return &resp, nil
+}
+
+func marshalDownlink(dl storage.Downlink) *pb.GetDownlinkStatusResponse {
+	resp := pb.GetDownlinkStatusResponse{
+		DevEUI:    dl.DevEUI.String(),
+		Reference: dl.Reference,
+		FCnt:      dl.FCnt,
+		FPort:     uint32(dl.FPort),
+		Confirmed: dl.Confirmed,
+		Status:    pb.DownlinkStatus(pb.DownlinkStatus_value[string(dl.Status)]),
+		QueuedAt:  dl.CreatedAt.Format(time.RFC3339Nano),
+	}
+
+	if dl.SentAt != nil {
+		resp.SentAt = dl.SentAt.Format(time.RFC3339Nano)
+	}
+	if dl.AckedAt != nil {
+		resp.AckedAt = dl.AckedAt.Format(time.RFC3339Nano)
+	}
+	if dl.ExpiredAt != nil {
+		resp.ExpiredAt = dl.ExpiredAt.Format(time.RFC3339Nano)
+	}
+
+	return &resp
+}
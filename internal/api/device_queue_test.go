@@ -13,6 +13,8 @@ import (
 	"github.com/Frankz/lorawan"
 	"github.com/Frankz/lorawan/backend"
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 func TestDownlinkQueueAPI(t *testing.T) {
@@ -129,7 +131,7 @@ func TestDownlinkQueueAPI(t *testing.T) {
 		})
 
 		Convey("When enqueueing a downlink queue item", func() {
-			_, err := api.Enqueue(ctx, &pb.EnqueueDeviceQueueItemRequest{
+			resp, err := api.Enqueue(ctx, &pb.EnqueueDeviceQueueItemRequest{
 				DevEUI: d.DevEUI.String(),
 				FPort:  10,
 				Data:   []byte{1, 2, 3, 4},
@@ -137,6 +139,7 @@ func TestDownlinkQueueAPI(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(validator.ctx, ShouldResemble, ctx)
 			So(validator.validatorFuncs, ShouldHaveLength, 1)
+			So(resp.FCnt, ShouldEqual, 12)
 
 			Convey("Then the expected request has been made to the network-server", func() {
 				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
@@ -180,13 +183,52 @@ func TestDownlinkQueueAPI(t *testing.T) {
 			})
 		})
 
-		Convey("Given a device-queue mapping", func() {
-			dqm := storage.DeviceQueueMapping{
+		Convey("Given a queued downlink", func() {
+			dl := storage.Downlink{
 				DevEUI:    d.DevEUI,
 				Reference: "test-123",
 				FCnt:      12,
+				FPort:     10,
+				Confirmed: true,
 			}
-			So(storage.CreateDeviceQueueMapping(common.DB, &dqm), ShouldBeNil)
+			So(storage.CreateDownlink(common.DB, &dl), ShouldBeNil)
+
+			Convey("Then GetDownlinkStatus returns the downlink status", func() {
+				resp, err := api.GetDownlinkStatus(ctx, &pb.GetDownlinkStatusRequest{
+					DevEUI: d.DevEUI.String(),
+					FCnt:   12,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.DevEUI, ShouldEqual, d.DevEUI.String())
+				So(resp.Reference, ShouldEqual, "test-123")
+				So(resp.FCnt, ShouldEqual, 12)
+				So(resp.FPort, ShouldEqual, 10)
+				So(resp.Confirmed, ShouldBeTrue)
+				So(resp.Status, ShouldEqual, pb.DownlinkStatus_QUEUED)
+				So(resp.QueuedAt, ShouldNotEqual, "")
+				So(resp.SentAt, ShouldEqual, "")
+			})
+
+			Convey("Then GetDownlinkStatus returns NotFound for an unknown frame-counter", func() {
+				_, err := api.GetDownlinkStatus(ctx, &pb.GetDownlinkStatusRequest{
+					DevEUI: d.DevEUI.String(),
+					FCnt:   13,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+
+			Convey("Then ListDownlinks returns the downlink", func() {
+				resp, err := api.ListDownlinks(ctx, &pb.ListDownlinksRequest{
+					DevEUI: d.DevEUI.String(),
+					Limit:  10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].FCnt, ShouldEqual, 12)
+			})
 
 			Convey("When calling Flush", func() {
 				_, err := api.Flush(ctx, &pb.FlushDeviceQueueRequest{
@@ -201,9 +243,10 @@ func TestDownlinkQueueAPI(t *testing.T) {
 					})
 				})
 
-				Convey("Then the device-queue mapping has been removed", func() {
-					_, err := storage.GetDeviceQueueMappingForDevEUIAndFCnt(common.DB, d.DevEUI, 12)
-					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				Convey("Then the downlink has expired", func() {
+					dl, err := storage.GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 12)
+					So(err, ShouldBeNil)
+					So(dl.Status, ShouldEqual, storage.DownlinkExpired)
 				})
 			})
 		})
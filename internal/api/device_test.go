@@ -103,6 +103,7 @@ func TestNodeAPI(t *testing.T) {
 				Description:     "test device description",
 				DevEUI:          "0807060504030201",
 				DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+				Tags:            map[string]string{"site": "berlin"},
 			})
 			So(err, ShouldBeNil)
 			So(validator.ctx, ShouldResemble, ctx)
@@ -123,6 +124,7 @@ func TestNodeAPI(t *testing.T) {
 					DeviceProfileID:     dp.DeviceProfile.DeviceProfileID,
 					DeviceStatusMargin:  256,
 					DeviceStatusBattery: 256,
+					Tags:                map[string]string{"site": "berlin"},
 				})
 
 				Convey("When setting the device-status battery and margin", func() {
@@ -161,6 +163,39 @@ func TestNodeAPI(t *testing.T) {
 						So(d.LastSeenAt, ShouldEqual, now.Format(time.RFC3339Nano))
 					})
 				})
+
+				Convey("When setting the device location", func() {
+					now := time.Now().Truncate(time.Millisecond)
+					lat := 52.1
+					lon := 5.1
+					alt := 10.0
+					acc := 150.0
+
+					d, err := storage.GetDevice(common.DB, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+					So(err, ShouldBeNil)
+					d.Latitude = &lat
+					d.Longitude = &lon
+					d.Altitude = &alt
+					d.LocationAccuracy = &acc
+					d.LocationSource = "RSSI"
+					d.LocationUpdatedAt = &now
+					So(storage.UpdateDeviceLocation(common.DB, &d), ShouldBeNil)
+
+					Convey("Then Get returns the location", func() {
+						d, err := api.Get(ctx, &pb.GetDeviceRequest{
+							DevEUI: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+						So(d.Location, ShouldResemble, &pb.DeviceLocation{
+							Latitude:  52.1,
+							Longitude: 5.1,
+							Altitude:  10,
+							Source:    "RSSI",
+							Accuracy:  150,
+							UpdatedAt: now.Format(time.RFC3339Nano),
+						})
+					})
+				})
 			})
 
 			Convey("Then listing the devices for the application returns a single items", func() {
@@ -183,9 +218,37 @@ func TestNodeAPI(t *testing.T) {
 					DeviceProfileName:   dp.Name,
 					DeviceStatusBattery: 256,
 					DeviceStatusMargin:  256,
+					Tags:                map[string]string{"site": "berlin"},
 				})
 			})
 
+			Convey("Then listing the devices filtered by tag selector returns the matching items", func() {
+				devices, err := api.ListByApplicationID(ctx, &pb.ListDeviceByApplicationIDRequest{
+					ApplicationID: app.ID,
+					Limit:         10,
+					TagSelector:   "site=berlin",
+				})
+				So(err, ShouldBeNil)
+				So(devices.Result, ShouldHaveLength, 1)
+				So(devices.TotalCount, ShouldEqual, 1)
+
+				devices, err = api.ListByApplicationID(ctx, &pb.ListDeviceByApplicationIDRequest{
+					ApplicationID: app.ID,
+					Limit:         10,
+					TagSelector:   "site!=berlin",
+				})
+				So(err, ShouldBeNil)
+				So(devices.Result, ShouldHaveLength, 0)
+				So(devices.TotalCount, ShouldEqual, 0)
+
+				_, err = api.ListByApplicationID(ctx, &pb.ListDeviceByApplicationIDRequest{
+					ApplicationID: app.ID,
+					Limit:         10,
+					TagSelector:   "=berlin",
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+
 			Convey("When updating the device", func() {
 				_, err := api.Update(ctx, &pb.UpdateDeviceRequest{
 					ApplicationID:   app.ID,
@@ -193,6 +256,7 @@ func TestNodeAPI(t *testing.T) {
 					Name:            "test-device-updated",
 					Description:     "test device description updated",
 					DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+					Tags:            map[string]string{"site": "hamburg"},
 				})
 				So(err, ShouldBeNil)
 				So(validator.ctx, ShouldResemble, ctx)
@@ -211,6 +275,7 @@ func TestNodeAPI(t *testing.T) {
 						DeviceProfileID:     dp.DeviceProfile.DeviceProfileID,
 						DeviceStatusBattery: 256,
 						DeviceStatusMargin:  256,
+						Tags:                map[string]string{"site": "hamburg"},
 					})
 				})
 			})
@@ -447,6 +512,129 @@ func TestNodeAPI(t *testing.T) {
 					})
 				})
 			})
+
+			Convey("Given an uplink and an error event", func() {
+				devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+				fPort := 10
+
+				So(storage.CreateDeviceEvent(common.DB, &storage.DeviceEvent{
+					DevEUI:  devEUI,
+					Type:    storage.DeviceEventUplink,
+					FPort:   &fPort,
+					Payload: []byte(`{"fCnt": 1}`),
+				}), ShouldBeNil)
+				So(storage.CreateDeviceEvent(common.DB, &storage.DeviceEvent{
+					DevEUI:  devEUI,
+					Type:    storage.DeviceEventError,
+					Payload: []byte(`{"error": "BOOM"}`),
+				}), ShouldBeNil)
+
+				Convey("Then ListEvents returns both events", func() {
+					resp, err := api.ListEvents(ctx, &pb.ListDeviceEventsRequest{
+						DevEUI: devEUI.String(),
+						Limit:  10,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp.TotalCount, ShouldEqual, 2)
+					So(resp.Result, ShouldHaveLength, 2)
+					So(resp.Result[0].Type, ShouldEqual, "error")
+					So(resp.Result[0].PayloadJSON, ShouldEqual, `{"error": "BOOM"}`)
+					So(resp.Result[1].Type, ShouldEqual, "uplink")
+					So(resp.Result[1].FPort, ShouldEqual, 10)
+				})
+
+				Convey("Then ListEvents filtered by type and fPort returns the uplink event", func() {
+					resp, err := api.ListEvents(ctx, &pb.ListDeviceEventsRequest{
+						DevEUI: devEUI.String(),
+						Limit:  10,
+						Type:   "uplink",
+						FPort:  10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result[0].PayloadJSON, ShouldEqual, `{"fCnt": 1}`)
+				})
+
+				Convey("Then ListEvents filtered by a future start time returns no events", func() {
+					resp, err := api.ListEvents(ctx, &pb.ListDeviceEventsRequest{
+						DevEUI:    devEUI.String(),
+						Limit:     10,
+						StartTime: time.Now().Add(time.Hour).Format(time.RFC3339Nano),
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 0)
+				})
+
+				Convey("Then ListEvents with an invalid start time returns an error", func() {
+					_, err := api.ListEvents(ctx, &pb.ListDeviceEventsRequest{
+						DevEUI:    devEUI.String(),
+						StartTime: "foo",
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("Given an open and a resolved alert", func() {
+				devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+				now := time.Now()
+
+				resolved := storage.DeviceAlert{
+					DevEUI:     devEUI,
+					Type:       storage.DeviceAlertLowBattery,
+					State:      storage.DeviceAlertResolved,
+					Message:    "battery level 10 is below threshold 50",
+					ResolvedAt: &now,
+				}
+				So(storage.CreateDeviceAlert(common.DB, &resolved), ShouldBeNil)
+
+				open := storage.DeviceAlert{
+					DevEUI:  devEUI,
+					Type:    storage.DeviceAlertInactive,
+					State:   storage.DeviceAlertOpen,
+					Message: "no uplink received",
+				}
+				So(storage.CreateDeviceAlert(common.DB, &open), ShouldBeNil)
+
+				Convey("Then ListAlerts returns both alerts", func() {
+					resp, err := api.ListAlerts(ctx, &pb.ListDeviceAlertsRequest{
+						ApplicationID: app.ID,
+						Limit:         10,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp.TotalCount, ShouldEqual, 2)
+					So(resp.Result, ShouldHaveLength, 2)
+					So(resp.Result[0].Id, ShouldEqual, open.ID)
+					So(resp.Result[0].DevEUI, ShouldEqual, devEUI.String())
+					So(resp.Result[0].Type, ShouldEqual, "INACTIVE")
+					So(resp.Result[0].State, ShouldEqual, "OPEN")
+					So(resp.Result[0].Message, ShouldEqual, "no uplink received")
+					So(resp.Result[0].ResolvedAt, ShouldEqual, "")
+					So(resp.Result[1].Id, ShouldEqual, resolved.ID)
+					So(resp.Result[1].ResolvedAt, ShouldNotEqual, "")
+				})
+
+				Convey("Then ListAlerts filtered by state returns the open alert", func() {
+					resp, err := api.ListAlerts(ctx, &pb.ListDeviceAlertsRequest{
+						ApplicationID: app.ID,
+						DevEUI:        devEUI.String(),
+						State:         "OPEN",
+						Limit:         10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result[0].Id, ShouldEqual, open.ID)
+				})
+
+				Convey("Then ListAlerts with an invalid state returns an error", func() {
+					_, err := api.ListAlerts(ctx, &pb.ListDeviceAlertsRequest{
+						ApplicationID: app.ID,
+						State:         "foo",
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
 		})
 	})
 }
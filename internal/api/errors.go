@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/Frankz/lora-app-server/internal/handler/httphandler"
+	"github.com/Frankz/lora-app-server/internal/handler/influxdbhandler"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -9,18 +10,52 @@ import (
 )
 
 var errToCode = map[error]codes.Code{
-	storage.ErrAlreadyExists:             codes.AlreadyExists,
-	storage.ErrDoesNotExist:              codes.NotFound,
-	storage.ErrUsedByOtherObjects:        codes.FailedPrecondition,
-	storage.ErrApplicationInvalidName:    codes.InvalidArgument,
-	storage.ErrNodeInvalidName:           codes.InvalidArgument,
-	storage.ErrNodeMaxRXDelay:            codes.InvalidArgument,
-	storage.ErrCFListTooManyChannels:     codes.InvalidArgument,
-	storage.ErrUserInvalidUsername:       codes.InvalidArgument,
-	storage.ErrUserPasswordLength:        codes.InvalidArgument,
-	storage.ErrInvalidUsernameOrPassword: codes.Unauthenticated,
-	storage.ErrInvalidEmail:              codes.InvalidArgument,
-	httphandler.ErrInvalidHeaderName:     codes.InvalidArgument,
+	storage.ErrAlreadyExists:                              codes.AlreadyExists,
+	storage.ErrDoesNotExist:                               codes.NotFound,
+	storage.ErrUsedByOtherObjects:                         codes.FailedPrecondition,
+	storage.ErrApplicationInvalidName:                     codes.InvalidArgument,
+	storage.ErrApplicationInvalidClockSyncResyncInterval:  codes.InvalidArgument,
+	storage.ErrNodeInvalidName:                            codes.InvalidArgument,
+	storage.ErrNodeMaxRXDelay:                             codes.InvalidArgument,
+	storage.ErrCFListTooManyChannels:                      codes.InvalidArgument,
+	storage.ErrUserInvalidUsername:                        codes.InvalidArgument,
+	storage.ErrUserPasswordLength:                         codes.InvalidArgument,
+	storage.ErrInvalidUsernameOrPassword:                  codes.Unauthenticated,
+	storage.ErrInvalidEmail:                               codes.InvalidArgument,
+	storage.ErrDeviceEventInvalidType:                     codes.InvalidArgument,
+	storage.ErrAlertInvalidUplinkInterval:                 codes.InvalidArgument,
+	storage.ErrAlertInvalidBatteryThreshold:               codes.InvalidArgument,
+	storage.ErrAlertInvalidMarginThreshold:                codes.InvalidArgument,
+	storage.ErrDeviceAlertInvalidType:                     codes.InvalidArgument,
+	storage.ErrDeviceAlertInvalidState:                    codes.InvalidArgument,
+	storage.ErrInvalidTag:                                 codes.InvalidArgument,
+	storage.ErrInvalidTagSelector:                         codes.InvalidArgument,
+	storage.ErrMulticastGroupInvalidName:                  codes.InvalidArgument,
+	storage.ErrMulticastGroupServiceProfileMismatch:       codes.FailedPrecondition,
+	storage.ErrMulticastGroupDeviceNotClassC:              codes.FailedPrecondition,
+	storage.ErrScheduledDownlinkInvalidFPort:              codes.InvalidArgument,
+	storage.ErrScheduledDownlinkInvalidSchedule:           codes.InvalidArgument,
+	storage.ErrScheduledDownlinkInvalidCron:               codes.InvalidArgument,
+	storage.ErrScheduledDownlinkDeviceApplicationMismatch: codes.InvalidArgument,
+	storage.ErrScheduledDownlinkInvalidTarget:             codes.InvalidArgument,
+	storage.ErrFUOTASessionInvalidTarget:                  codes.InvalidArgument,
+	storage.ErrFUOTASessionInvalidFragIndex:               codes.InvalidArgument,
+	storage.ErrFUOTASessionInvalidBlockAckDelay:           codes.InvalidArgument,
+	storage.ErrFUOTASessionInvalidFragSize:                codes.InvalidArgument,
+	storage.ErrFUOTASessionInvalidDescriptor:              codes.InvalidArgument,
+	storage.ErrFUOTASessionInvalidPayload:                 codes.InvalidArgument,
+	storage.ErrFUOTASessionInvalidTimeout:                 codes.InvalidArgument,
+	storage.ErrFUOTASessionNoDevices:                      codes.FailedPrecondition,
+	storage.ErrFUOTASessionDeviceBusy:                     codes.FailedPrecondition,
+	storage.ErrFUOTASessionNotActive:                      codes.FailedPrecondition,
+	httphandler.ErrInvalidHeaderName:                      codes.InvalidArgument,
+	httphandler.ErrInvalidSigningSecret:                   codes.InvalidArgument,
+	httphandler.ErrInvalidCACert:                          codes.InvalidArgument,
+	httphandler.ErrInvalidTLSCertificate:                  codes.InvalidArgument,
+	httphandler.ErrInvalidMarshaler:                       codes.InvalidArgument,
+	influxdbhandler.ErrInvalidEndpoint:                    codes.InvalidArgument,
+	influxdbhandler.ErrInvalidDatabase:                    codes.InvalidArgument,
+	influxdbhandler.ErrInvalidPrecision:                   codes.InvalidArgument,
 }
 
 func errToRPCError(err error) error {
@@ -0,0 +1,234 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/api/auth"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/fuota"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+var fuotaSessionDeviceStates = map[storage.FUOTASessionDeviceState]pb.FUOTASessionDeviceState{
+	storage.FUOTASessionDevicePending:    pb.FUOTASessionDeviceState_PENDING,
+	storage.FUOTASessionDeviceSetup:      pb.FUOTASessionDeviceState_SETUP_DONE,
+	storage.FUOTASessionDeviceComplete:   pb.FUOTASessionDeviceState_COMPLETE,
+	storage.FUOTASessionDeviceIncomplete: pb.FUOTASessionDeviceState_INCOMPLETE,
+	storage.FUOTASessionDeviceError:      pb.FUOTASessionDeviceState_ERROR,
+}
+
+// FUOTASessionAPI exports the FUOTA session related functions.
+type FUOTASessionAPI struct {
+	validator auth.Validator
+}
+
+// NewFUOTASessionAPI creates a new FUOTASessionAPI.
+func NewFUOTASessionAPI(validator auth.Validator) *FUOTASessionAPI {
+	return &FUOTASessionAPI{
+		validator: validator,
+	}
+}
+
+// Create creates and starts the given FUOTA session.
+func (a *FUOTASessionAPI) Create(ctx context.Context, req *pb.CreateFUOTASessionRequest) (*pb.CreateFUOTASessionResponse, error) {
+	if req.FuotaSession == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fuotaSession must not be nil")
+	}
+
+	s := storage.FUOTASession{
+		Name:           req.FuotaSession.Name,
+		Payload:        req.FuotaSession.Payload,
+		FragSize:       uint8(req.FuotaSession.FragSize),
+		Redundancy:     int(req.FuotaSession.Redundancy),
+		FragIndex:      uint8(req.FuotaSession.FragIndex),
+		BlockAckDelay:  uint8(req.FuotaSession.BlockAckDelay),
+		Descriptor:     req.FuotaSession.Descriptor,
+		UnicastTimeout: time.Duration(req.FuotaSession.UnicastTimeout) * time.Second,
+		SessionTimeout: time.Duration(req.FuotaSession.SessionTimeout) * time.Second,
+	}
+	if len(s.Descriptor) == 0 {
+		s.Descriptor = make([]byte, 4)
+	}
+	// make sure the values are not truncated by the uint8 conversion
+	switch {
+	case req.FuotaSession.FragSize > 255:
+		return nil, errToRPCError(storage.ErrFUOTASessionInvalidFragSize)
+	case req.FuotaSession.FragIndex > 255:
+		return nil, errToRPCError(storage.ErrFUOTASessionInvalidFragIndex)
+	case req.FuotaSession.BlockAckDelay > 255:
+		return nil, errToRPCError(storage.ErrFUOTASessionInvalidBlockAckDelay)
+	}
+
+	var validator auth.ValidatorFunc
+	switch {
+	case req.FuotaSession.DevEUI != "" && req.FuotaSession.MulticastGroupID == "":
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(req.FuotaSession.DevEUI)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		}
+		s.DevEUI = &devEUI
+		validator = auth.ValidateDeviceQueueAccess(devEUI, auth.Create)
+	case req.FuotaSession.MulticastGroupID != "" && req.FuotaSession.DevEUI == "":
+		s.MulticastGroupID = &req.FuotaSession.MulticastGroupID
+		validator = auth.ValidateMulticastGroupQueueAccess(auth.Create, req.FuotaSession.MulticastGroupID)
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "either devEUI or multicastGroupID must be set")
+	}
+
+	if err := a.validator.Validate(ctx, validator); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.CreateFUOTASession(common.DB, &s); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateFUOTASessionResponse{
+		Id: s.ID,
+	}, nil
+}
+
+// Get returns the FUOTA session matching the given id.
+func (a *FUOTASessionAPI) Get(ctx context.Context, req *pb.GetFUOTASessionRequest) (*pb.GetFUOTASessionResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTASessionAccess(auth.Read, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	s, err := storage.GetFUOTASession(common.DB, req.Id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetFUOTASessionResponse{
+		Id:             s.ID,
+		OrganizationID: s.OrganizationID,
+		FuotaSession: &pb.FUOTASession{
+			Name:           s.Name,
+			FragSize:       uint32(s.FragSize),
+			Redundancy:     uint32(s.Redundancy),
+			FragIndex:      uint32(s.FragIndex),
+			BlockAckDelay:  uint32(s.BlockAckDelay),
+			Descriptor:     s.Descriptor,
+			UnicastTimeout: uint32(s.UnicastTimeout / time.Second),
+			SessionTimeout: uint32(s.SessionTimeout / time.Second),
+		},
+		State:     pb.FUOTASessionState(pb.FUOTASessionState_value[string(s.State)]),
+		NbFrag:    uint32(s.NbFrag()),
+		CreatedAt: s.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: s.UpdatedAt.Format(time.RFC3339Nano),
+	}
+	if s.DevEUI != nil {
+		resp.FuotaSession.DevEUI = s.DevEUI.String()
+	}
+	if s.MulticastGroupID != nil {
+		resp.FuotaSession.MulticastGroupID = *s.MulticastGroupID
+	}
+	if s.NextStepAt != nil {
+		resp.NextStepAt = s.NextStepAt.Format(time.RFC3339Nano)
+	}
+
+	return &resp, nil
+}
+
+// Cancel cancels the FUOTA session matching the given id.
+func (a *FUOTASessionAPI) Cancel(ctx context.Context, req *pb.CancelFUOTASessionRequest) (*pb.CancelFUOTASessionResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTASessionAccess(auth.Update, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := fuota.CancelSession(req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CancelFUOTASessionResponse{}, nil
+}
+
+// List lists the FUOTA sessions of the given organization.
+func (a *FUOTASessionAPI) List(ctx context.Context, req *pb.ListFUOTASessionRequest) (*pb.ListFUOTASessionResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTASessionsAccess(auth.List, req.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetFUOTASessionCountForOrganizationID(common.DB, req.OrganizationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	items, err := storage.GetFUOTASessionsForOrganizationID(common.DB, req.OrganizationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListFUOTASessionResponse{
+		TotalCount: int64(count),
+	}
+	for _, s := range items {
+		item := pb.FUOTASessionListItem{
+			Id:        s.ID,
+			Name:      s.Name,
+			State:     pb.FUOTASessionState(pb.FUOTASessionState_value[string(s.State)]),
+			CreatedAt: s.CreatedAt.Format(time.RFC3339Nano),
+			UpdatedAt: s.UpdatedAt.Format(time.RFC3339Nano),
+		}
+		if s.DevEUI != nil {
+			item.DevEUI = s.DevEUI.String()
+		}
+		if s.MulticastGroupID != nil {
+			item.MulticastGroupID = *s.MulticastGroupID
+		}
+		if s.NextStepAt != nil {
+			item.NextStepAt = s.NextStepAt.Format(time.RFC3339Nano)
+		}
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// ListDevices lists the devices (and their status) of the given FUOTA
+// session.
+func (a *FUOTASessionAPI) ListDevices(ctx context.Context, req *pb.ListFUOTASessionDevicesRequest) (*pb.ListFUOTASessionDevicesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTASessionAccess(auth.Read, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetFUOTASessionDeviceCount(common.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	devices, err := storage.GetFUOTASessionDevices(common.DB, req.Id, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListFUOTASessionDevicesResponse{
+		TotalCount: int64(count),
+	}
+	for _, d := range devices {
+		resp.Result = append(resp.Result, &pb.FUOTASessionDevice{
+			DevEUI:         d.DevEUI.String(),
+			State:          fuotaSessionDeviceStates[d.State],
+			ErrorMessage:   d.ErrorMessage,
+			NbFragReceived: uint32(d.NbFragReceived),
+			MissingFrag:    uint32(d.MissingFrag),
+			UpdatedAt:      d.UpdatedAt.Format(time.RFC3339Nano),
+		})
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,206 @@
+package api
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFUOTASessionAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	common.DB = db
+
+	Convey("Given a clean database with an application + device and api instance", t, func() {
+		test.MustResetDB(common.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewFUOTASessionAPI(validator)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		device := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(common.DB, &device), ShouldBeNil)
+
+		Convey("Then invalid FUOTA sessions return an InvalidArgument error", func() {
+			tests := []pb.FUOTASession{
+				{Name: "no-target", Payload: make([]byte, 100), FragSize: 10, UnicastTimeout: 60, SessionTimeout: 3600},
+				{Name: "invalid-deveui", DevEUI: "0102", Payload: make([]byte, 100), FragSize: 10, UnicastTimeout: 60, SessionTimeout: 3600},
+				{Name: "no-payload", DevEUI: device.DevEUI.String(), FragSize: 10, UnicastTimeout: 60, SessionTimeout: 3600},
+				{Name: "invalid-frag-size", DevEUI: device.DevEUI.String(), Payload: make([]byte, 100), FragSize: 266, UnicastTimeout: 60, SessionTimeout: 3600},
+				{Name: "invalid-frag-index", DevEUI: device.DevEUI.String(), Payload: make([]byte, 100), FragSize: 10, FragIndex: 4, UnicastTimeout: 60, SessionTimeout: 3600},
+				{Name: "invalid-descriptor", DevEUI: device.DevEUI.String(), Payload: make([]byte, 100), FragSize: 10, Descriptor: []byte{1, 2}, UnicastTimeout: 60, SessionTimeout: 3600},
+				{Name: "no-timeout", DevEUI: device.DevEUI.String(), Payload: make([]byte, 100), FragSize: 10},
+			}
+
+			for i := range tests {
+				_, err := api.Create(ctx, &pb.CreateFUOTASessionRequest{
+					FuotaSession: &tests[i],
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			}
+		})
+
+		Convey("When creating a FUOTA session for the device", func() {
+			createResp, err := api.Create(ctx, &pb.CreateFUOTASessionRequest{
+				FuotaSession: &pb.FUOTASession{
+					Name:           "firmware-v2",
+					DevEUI:         device.DevEUI.String(),
+					Payload:        make([]byte, 95),
+					FragSize:       10,
+					Redundancy:     5,
+					FragIndex:      1,
+					BlockAckDelay:  2,
+					UnicastTimeout: 60,
+					SessionTimeout: 3600,
+				},
+			})
+			So(err, ShouldBeNil)
+			So(validator.ctx, ShouldResemble, ctx)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+			Convey("Then a second session for the same device returns a FailedPrecondition error", func() {
+				_, err := api.Create(ctx, &pb.CreateFUOTASessionRequest{
+					FuotaSession: &pb.FUOTASession{
+						Name:           "firmware-v3",
+						DevEUI:         device.DevEUI.String(),
+						Payload:        make([]byte, 95),
+						FragSize:       10,
+						UnicastTimeout: 60,
+						SessionTimeout: 3600,
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+			})
+
+			Convey("Then the FUOTA session can be retrieved", func() {
+				resp, err := api.Get(ctx, &pb.GetFUOTASessionRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.OrganizationID, ShouldEqual, org.ID)
+				So(resp.State, ShouldEqual, pb.FUOTASessionState_SETUP)
+				So(resp.NbFrag, ShouldEqual, 10)
+				So(resp.NextStepAt, ShouldNotEqual, "")
+				So(resp.FuotaSession, ShouldResemble, &pb.FUOTASession{
+					Name:           "firmware-v2",
+					DevEUI:         "0102030405060708",
+					FragSize:       10,
+					Redundancy:     5,
+					FragIndex:      1,
+					BlockAckDelay:  2,
+					Descriptor:     []byte{0, 0, 0, 0},
+					UnicastTimeout: 60,
+					SessionTimeout: 3600,
+				})
+			})
+
+			Convey("Then the FUOTA sessions of the organization can be listed", func() {
+				resp, err := api.List(ctx, &pb.ListFUOTASessionRequest{
+					OrganizationID: org.ID,
+					Limit:          10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Id, ShouldEqual, createResp.Id)
+				So(resp.Result[0].DevEUI, ShouldEqual, "0102030405060708")
+				So(resp.Result[0].State, ShouldEqual, pb.FUOTASessionState_SETUP)
+			})
+
+			Convey("Then the devices of the FUOTA session can be listed", func() {
+				resp, err := api.ListDevices(ctx, &pb.ListFUOTASessionDevicesRequest{
+					Id:    createResp.Id,
+					Limit: 10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].DevEUI, ShouldEqual, "0102030405060708")
+				So(resp.Result[0].State, ShouldEqual, pb.FUOTASessionDeviceState_PENDING)
+			})
+
+			Convey("When cancelling the FUOTA session", func() {
+				_, err := api.Cancel(ctx, &pb.CancelFUOTASessionRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the state is CANCELLED", func() {
+					resp, err := api.Get(ctx, &pb.GetFUOTASessionRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.State, ShouldEqual, pb.FUOTASessionState_CANCELLED)
+					So(resp.NextStepAt, ShouldEqual, "")
+				})
+
+				Convey("Then cancelling it again returns a FailedPrecondition error", func() {
+					_, err := api.Cancel(ctx, &pb.CancelFUOTASessionRequest{
+						Id: createResp.Id,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+				})
+			})
+		})
+	})
+}
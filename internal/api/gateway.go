@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -11,6 +12,7 @@ import (
 	pb "github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/api/auth"
 	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/coverage"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/Frankz/loraserver/api/ns"
 	"github.com/Frankz/lorawan"
@@ -64,7 +66,12 @@ func (a *GatewayAPI) Create(ctx context.Context, req *pb.CreateGatewayRequest) (
 			Description:     req.Description,
 			OrganizationID:  req.OrganizationID,
 			Ping:            req.Ping,
+			PingInterval:    time.Duration(req.PingInterval) * time.Second,
+			PingFrequency:   int(req.PingFrequency),
+			PingDR:          gatewayPingDR(req.CustomPingDR, req.PingDR),
+			FineTimestamp:   req.FineTimestamp,
 			NetworkServerID: req.NetworkServerID,
+			Tags:            req.Tags,
 		})
 		if err != nil {
 			return errToRPCError(err)
@@ -143,7 +150,25 @@ func (a *GatewayAPI) Get(ctx context.Context, req *pb.GetGatewayRequest) (*pb.Ge
 		LastSeenAt:             getResp.LastSeenAt,
 		ChannelConfigurationID: getResp.ChannelConfigurationID,
 		NetworkServerID:        gw.NetworkServerID,
+		Tags:                   gw.Tags,
+		Status:                 string(gw.Status),
+		PingInterval:           uint32(gw.PingInterval / time.Second),
+		PingFrequency:          uint32(gw.PingFrequency),
+		FineTimestamp:          gw.FineTimestamp,
 	}
+
+	if gw.PingDR != nil {
+		ret.PingDR = uint32(*gw.PingDR)
+		ret.CustomPingDR = true
+	}
+
+	if gw.LastSeenAt != nil {
+		ret.LastSeenAt = gw.LastSeenAt.Format(time.RFC3339Nano)
+	}
+	if gw.StatusChangedAt != nil {
+		ret.StatusChangedAt = gw.StatusChangedAt.Format(time.RFC3339Nano)
+	}
+
 	return ret, err
 }
 
@@ -154,6 +179,11 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	selector, err := storage.ParseTagSelector(req.TagSelector)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "tagSelector: %s", err)
+	}
+
 	var count int
 	var gws []storage.Gateway
 
@@ -165,12 +195,12 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 
 		if isAdmin {
 			// in case of admin user list all gateways
-			count, err = storage.GetGatewayCount(common.DB)
+			count, err = storage.GetGatewayCount(common.DB, selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 
-			gws, err = storage.GetGateways(common.DB, int(req.Limit), int(req.Offset))
+			gws, err = storage.GetGateways(common.DB, int(req.Limit), int(req.Offset), selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
@@ -180,21 +210,21 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetGatewayCountForUser(common.DB, username)
+			count, err = storage.GetGatewayCountForUser(common.DB, username, selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			gws, err = storage.GetGatewaysForUser(common.DB, username, int(req.Limit), int(req.Offset))
+			gws, err = storage.GetGatewaysForUser(common.DB, username, int(req.Limit), int(req.Offset), selector)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 		}
 	} else {
-		count, err = storage.GetGatewayCountForOrganizationID(common.DB, req.OrganizationID)
+		count, err = storage.GetGatewayCountForOrganizationID(common.DB, req.OrganizationID, selector)
 		if err != nil {
 			return nil, errToRPCError(err)
 		}
-		gws, err = storage.GetGatewaysForOrganizationID(common.DB, req.OrganizationID, int(req.Limit), int(req.Offset))
+		gws, err = storage.GetGatewaysForOrganizationID(common.DB, req.OrganizationID, int(req.Limit), int(req.Offset), selector)
 		if err != nil {
 			return nil, errToRPCError(err)
 		}
@@ -202,7 +232,7 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 
 	result := make([]*pb.ListGatewayItem, 0, len(gws))
 	for i := range gws {
-		result = append(result, &pb.ListGatewayItem{
+		item := pb.ListGatewayItem{
 			Mac:             gws[i].MAC.String(),
 			Name:            gws[i].Name,
 			Description:     gws[i].Description,
@@ -210,7 +240,13 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 			UpdatedAt:       gws[i].UpdatedAt.Format(time.RFC3339Nano),
 			OrganizationID:  gws[i].OrganizationID,
 			NetworkServerID: gws[i].NetworkServerID,
-		})
+			Tags:            gws[i].Tags,
+			Status:          string(gws[i].Status),
+		}
+		if gws[i].LastSeenAt != nil {
+			item.LastSeenAt = gws[i].LastSeenAt.Format(time.RFC3339Nano)
+		}
+		result = append(result, &item)
 	}
 
 	return &pb.ListGatewayResponse{
@@ -245,6 +281,11 @@ func (a *GatewayAPI) Update(ctx context.Context, req *pb.UpdateGatewayRequest) (
 		gw.Name = req.Name
 		gw.Description = req.Description
 		gw.Ping = req.Ping
+		gw.PingInterval = time.Duration(req.PingInterval) * time.Second
+		gw.PingFrequency = int(req.PingFrequency)
+		gw.PingDR = gatewayPingDR(req.CustomPingDR, req.PingDR)
+		gw.FineTimestamp = req.FineTimestamp
+		gw.Tags = req.Tags
 		if isAdmin {
 			gw.OrganizationID = req.OrganizationID
 		}
@@ -450,6 +491,135 @@ func (a *GatewayAPI) GetLastPing(ctx context.Context, req *pb.GetLastPingRequest
 	return &resp, nil
 }
 
+// ListPings returns the emitted pings and gateways receiving these pings,
+// most recent first.
+func (a *GatewayAPI) ListPings(ctx context.Context, req *pb.ListGatewayPingsRequest) (*pb.ListGatewayPingsResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.Mac)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	err := a.validator.Validate(ctx, auth.ValidateGatewayAccess(auth.Read, mac))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetGatewayPingCountForGatewayMAC(common.DB, mac)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	pings, err := storage.GetGatewayPingsForGatewayMAC(common.DB, mac, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	ids := make([]int64, 0, len(pings))
+	for _, ping := range pings {
+		ids = append(ids, ping.ID)
+	}
+
+	rxs, err := storage.GetGatewayPingRXForPingIDs(common.DB, ids)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	pingRXs := make(map[int64][]storage.GatewayPingRX)
+	for _, rx := range rxs {
+		pingRXs[rx.PingID] = append(pingRXs[rx.PingID], rx)
+	}
+
+	resp := pb.ListGatewayPingsResponse{
+		TotalCount: int32(count),
+	}
+
+	for _, ping := range pings {
+		item := pb.GatewayPingItem{
+			Id:        ping.ID,
+			CreatedAt: ping.CreatedAt.Format(time.RFC3339Nano),
+			Frequency: uint32(ping.Frequency),
+			Dr:        uint32(ping.DR),
+		}
+
+		for _, rx := range pingRXs[ping.ID] {
+			item.PingRX = append(item.PingRX, &pb.PingRX{
+				Mac:       rx.GatewayMAC.String(),
+				Rssi:      int32(rx.RSSI),
+				LoraSNR:   rx.LoRaSNR,
+				Latitude:  rx.Location.Latitude,
+				Longitude: rx.Location.Longitude,
+				Altitude:  rx.Altitude,
+			})
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// GetCoverage returns the coverage of the gateways of an organization as
+// GeoJSON feature collection.
+func (a *GatewayAPI) GetCoverage(ctx context.Context, req *pb.GetGatewayCoverageRequest) (*pb.GetGatewayCoverageResponse, error) {
+	if req.OrganizationID == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "organizationID must be set")
+	}
+
+	precision := int(req.Precision)
+	if precision == 0 {
+		precision = 7
+	}
+	if precision > coverage.StoragePrecision {
+		return nil, grpc.Errorf(codes.InvalidArgument, "precision must be between 1 and %d", coverage.StoragePrecision)
+	}
+
+	err := a.validator.Validate(ctx, auth.ValidateGatewaysAccess(auth.List, req.OrganizationID))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	cells, err := coverage.GetCoverageForOrganizationID(common.DB, req.OrganizationID, precision)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetGatewayCoverageResponse{
+		Type:     "FeatureCollection",
+		Features: make([]*pb.GatewayCoverageFeature, 0, len(cells)),
+	}
+
+	for _, c := range cells {
+		f := pb.GatewayCoverageFeature{
+			Type: "Feature",
+			Geometry: &pb.GatewayCoverageGeometry{
+				Type:        "Polygon",
+				Coordinates: geoJSONPolygonCoordinates(c.Bounds.Ring()),
+			},
+			Bbox: []float64{c.Bounds.LongitudeMin, c.Bounds.LatitudeMin, c.Bounds.LongitudeMax, c.Bounds.LatitudeMax},
+			Properties: &pb.GatewayCoverageProperties{
+				Geohash: c.Geohash,
+			},
+		}
+
+		for _, gw := range c.Gateways {
+			f.Properties.Gateways = append(f.Properties.Gateways, &pb.GatewayCoverageRX{
+				Mac:        gw.MAC.String(),
+				Count:      uint32(gw.Count),
+				RssiMin:    int32(gw.RSSIMin),
+				RssiAvg:    gw.RSSIAvg,
+				RssiMax:    int32(gw.RSSIMax),
+				LoRaSNRMin: gw.LoRaSNRMin,
+				LoRaSNRAvg: gw.LoRaSNRAvg,
+				LoRaSNRMax: gw.LoRaSNRMax,
+			})
+		}
+
+		resp.Features = append(resp.Features, &f)
+	}
+
+	return &resp, nil
+}
+
 // CreateChannelConfiguration creates the given channel-configuration.
 func (a *GatewayAPI) CreateChannelConfiguration(ctx context.Context, req *pb.CreateChannelConfigurationRequest) (*pb.CreateChannelConfigurationResponse, error) {
 	err := a.validator.Validate(ctx, auth.ValidateChannelConfigurationAccess(auth.Create))
@@ -744,3 +914,37 @@ func (a *GatewayAPI) GetExtraChannelsForChannelConfigurationID(ctx context.Conte
 
 	return &out, nil
 }
+
+// gatewayPingDR returns the ping data-rate of the gateway, nil when the
+// default data-rate must be used.
+func gatewayPingDR(custom bool, dr uint32) *int {
+	if !custom {
+		return nil
+	}
+	i := int(dr)
+	return &i
+}
+
+// geoJSONPolygonCoordinates returns the GeoJSON Polygon coordinates for
+// the given linear ring.
+func geoJSONPolygonCoordinates(ring [][2]float64) *structpb.ListValue {
+	positions := make([]*structpb.Value, 0, len(ring))
+	for _, pos := range ring {
+		positions = append(positions, &structpb.Value{
+			Kind: &structpb.Value_ListValue{
+				ListValue: &structpb.ListValue{
+					Values: []*structpb.Value{
+						{Kind: &structpb.Value_NumberValue{NumberValue: pos[0]}},
+						{Kind: &structpb.Value_NumberValue{NumberValue: pos[1]}},
+					},
+				},
+			},
+		})
+	}
+
+	return &structpb.ListValue{
+		Values: []*structpb.Value{
+			{Kind: &structpb.Value_ListValue{ListValue: &structpb.ListValue{Values: positions}}},
+		},
+	}
+}
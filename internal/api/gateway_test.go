@@ -75,7 +75,13 @@ func TestGatewayAPI(t *testing.T) {
 			LastSeenAt:      now.UTC().Add(3 * time.Second).Format(time.RFC3339Nano),
 			OrganizationID:  org.ID,
 			Ping:            true,
+			PingInterval:    3600,
+			PingFrequency:   868300000,
+			PingDR:          3,
+			CustomPingDR:    true,
+			FineTimestamp:   true,
 			NetworkServerID: n.ID,
+			Status:          "UNKNOWN",
 		}
 
 		Convey("When calling create", func() {
@@ -88,6 +94,11 @@ func TestGatewayAPI(t *testing.T) {
 				Altitude:        5.5,
 				OrganizationID:  org.ID,
 				Ping:            true,
+				PingInterval:    3600,
+				PingFrequency:   868300000,
+				PingDR:          3,
+				CustomPingDR:    true,
+				FineTimestamp:   true,
 				NetworkServerID: n.ID,
 			})
 			So(err, ShouldBeNil)
@@ -107,8 +118,12 @@ func TestGatewayAPI(t *testing.T) {
 			})
 
 			Convey("Then the gateway was created in the common.DB", func() {
-				_, err := storage.GetGateway(common.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, false)
+				gw, err := storage.GetGateway(common.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, false)
 				So(err, ShouldBeNil)
+				So(gw.PingInterval, ShouldEqual, time.Hour)
+				So(gw.PingFrequency, ShouldEqual, 868300000)
+				So(*gw.PingDR, ShouldEqual, 3)
+				So(gw.FineTimestamp, ShouldBeTrue)
 			})
 
 			Convey("When calling Get", func() {
@@ -136,6 +151,83 @@ func TestGatewayAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Given the gateway has been seen and is online", func() {
+				lastSeen := now.Add(5 * time.Second)
+				So(storage.UpdateGatewayLastSeenAt(common.DB, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, lastSeen), ShouldBeNil)
+				So(storage.UpdateGatewayStatus(common.DB, &storage.Gateway{
+					MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+					Status:          storage.GatewayStatusOnline,
+					StatusChangedAt: &lastSeen,
+				}), ShouldBeNil)
+
+				Convey("Then Get returns the stored status and last-seen timestamp", func() {
+					nsClient.GetGatewayResponse = getGatewayResponseNS
+					resp, err := api.Get(ctx, &pb.GetGatewayRequest{
+						Mac: "0102030405060708",
+					})
+					So(err, ShouldBeNil)
+					So(resp.Status, ShouldEqual, "ONLINE")
+					So(resp.LastSeenAt, ShouldNotEqual, "")
+					So(resp.StatusChangedAt, ShouldNotEqual, "")
+				})
+
+				Convey("Then List returns the status and last-seen timestamp", func() {
+					validator.returnIsAdmin = true
+					gws, err := api.List(ctx, &pb.ListGatewayRequest{
+						Limit: 10,
+					})
+					So(err, ShouldBeNil)
+					So(gws.Result, ShouldHaveLength, 1)
+					So(gws.Result[0].Status, ShouldEqual, "ONLINE")
+					So(gws.Result[0].LastSeenAt, ShouldNotEqual, "")
+				})
+			})
+
+			Convey("Given coverage data for the gateway", func() {
+				So(storage.AddGatewayCoverage(common.DB, "u173zq37x", []storage.GatewayCoverageRX{
+					{GatewayMAC: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, RSSI: -80, LoRaSNR: 7},
+				}), ShouldBeNil)
+
+				Convey("Then GetCoverage returns a GeoJSON feature collection", func() {
+					resp, err := api.GetCoverage(ctx, &pb.GetGatewayCoverageRequest{
+						OrganizationID: org.ID,
+						Precision:      5,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp.Type, ShouldEqual, "FeatureCollection")
+					So(resp.Features, ShouldHaveLength, 1)
+
+					f := resp.Features[0]
+					So(f.Type, ShouldEqual, "Feature")
+					So(f.Geometry.Type, ShouldEqual, "Polygon")
+					So(f.Geometry.Coordinates.Values, ShouldHaveLength, 1)
+					So(f.Geometry.Coordinates.Values[0].GetListValue().Values, ShouldHaveLength, 5)
+					So(f.Bbox, ShouldHaveLength, 4)
+					So(f.Properties.Geohash, ShouldEqual, "u173z")
+					So(f.Properties.Gateways, ShouldResemble, []*pb.GatewayCoverageRX{
+						{
+							Mac:        "0102030405060708",
+							Count:      1,
+							RssiMin:    -80,
+							RssiAvg:    -80,
+							RssiMax:    -80,
+							LoRaSNRMin: 7,
+							LoRaSNRAvg: 7,
+							LoRaSNRMax: 7,
+						},
+					})
+				})
+
+				Convey("Then GetCoverage returns an error for an invalid precision", func() {
+					_, err := api.GetCoverage(ctx, &pb.GetGatewayCoverageRequest{
+						OrganizationID: org.ID,
+						Precision:      10,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
 			Convey("Given an extra gateway beloning to a different organization", func() {
 				org2 := storage.Organization{
 					Name: "test-org-2",
@@ -218,6 +310,7 @@ func TestGatewayAPI(t *testing.T) {
 					Altitude:       5.7,
 					OrganizationID: org2.ID,
 					Ping:           false,
+					PingInterval:   600,
 				})
 				So(err, ShouldBeNil)
 				So(validator.ctx, ShouldResemble, ctx)
@@ -230,6 +323,10 @@ func TestGatewayAPI(t *testing.T) {
 					So(gw.Description, ShouldEqual, "updated test gateway")
 					So(gw.OrganizationID, ShouldEqual, org.ID)
 					So(gw.Ping, ShouldBeFalse)
+					So(gw.PingInterval, ShouldEqual, 10*time.Minute)
+					So(gw.PingFrequency, ShouldEqual, 0)
+					So(gw.PingDR, ShouldBeNil)
+					So(gw.FineTimestamp, ShouldBeFalse)
 				})
 
 				Convey("Then the expected request was sent to the network-server", func() {
@@ -470,6 +567,57 @@ func TestGatewayAPI(t *testing.T) {
 						})
 					})
 				})
+
+				Convey("Given an older gateway ping", func() {
+					oldPing := storage.GatewayPing{
+						GatewayMAC: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+						Frequency:  868300000,
+						DR:         3,
+					}
+					So(storage.CreateGatewayPing(common.DB, &oldPing), ShouldBeNil)
+					_, err := common.DB.Exec("update gateway_ping set created_at = created_at - interval '1 hour' where id = $1", oldPing.ID)
+					So(err, ShouldBeNil)
+
+					Convey("When calling ListPings", func() {
+						resp, err := api.ListPings(ctx, &pb.ListGatewayPingsRequest{
+							Mac:   "0102030405060708",
+							Limit: 10,
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then the pings are returned, most recent first", func() {
+							So(resp.TotalCount, ShouldEqual, 2)
+							So(resp.Result, ShouldHaveLength, 2)
+
+							So(resp.Result[0].Id, ShouldEqual, ping.ID)
+							So(resp.Result[0].Frequency, ShouldEqual, 868100000)
+							So(resp.Result[0].Dr, ShouldEqual, 5)
+							So(resp.Result[0].PingRX, ShouldHaveLength, 2)
+							So(resp.Result[0].PingRX[0].Mac, ShouldEqual, "0202030405060708")
+							So(resp.Result[0].PingRX[1].Mac, ShouldEqual, "0302030405060708")
+
+							So(resp.Result[1].Id, ShouldEqual, oldPing.ID)
+							So(resp.Result[1].Frequency, ShouldEqual, 868300000)
+							So(resp.Result[1].Dr, ShouldEqual, 3)
+							So(resp.Result[1].PingRX, ShouldHaveLength, 0)
+						})
+					})
+
+					Convey("When calling ListPings with a limit and offset", func() {
+						resp, err := api.ListPings(ctx, &pb.ListGatewayPingsRequest{
+							Mac:    "0102030405060708",
+							Limit:  1,
+							Offset: 1,
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then only the older ping is returned", func() {
+							So(resp.TotalCount, ShouldEqual, 2)
+							So(resp.Result, ShouldHaveLength, 1)
+							So(resp.Result[0].Id, ShouldEqual, oldPing.ID)
+						})
+					})
+				})
 			})
 
 			Convey("When calling CreateChannelConfiguration", func() {
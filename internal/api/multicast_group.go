@@ -0,0 +1,313 @@
+package api
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/api/auth"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/downlink"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+// MulticastGroupAPI exports the multicast-group related functions.
+type MulticastGroupAPI struct {
+	validator auth.Validator
+}
+
+// NewMulticastGroupAPI creates a new MulticastGroupAPI.
+func NewMulticastGroupAPI(validator auth.Validator) *MulticastGroupAPI {
+	return &MulticastGroupAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given multicast-group.
+func (a *MulticastGroupAPI) Create(ctx context.Context, req *pb.CreateMulticastGroupRequest) (*pb.CreateMulticastGroupResponse, error) {
+	sp, err := storage.GetServiceProfile(common.DB, req.ServiceProfileID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupsAccess(auth.Create, sp.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	mg := storage.MulticastGroup{
+		Name:             req.Name,
+		ServiceProfileID: req.ServiceProfileID,
+	}
+	if err := unmarshalMulticastSession(&mg, req.McAddr, req.McNwkSKey, req.McAppSKey); err != nil {
+		return nil, err
+	}
+
+	// as this also performs a remote call to create the multicast-group
+	// on the network-server, wrap it in a transaction
+	err = storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		return storage.CreateMulticastGroup(tx, &mg)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateMulticastGroupResponse{
+		Id: mg.ID,
+	}, nil
+}
+
+// Get returns the multicast-group matching the given id.
+func (a *MulticastGroupAPI) Get(ctx context.Context, req *pb.GetMulticastGroupRequest) (*pb.GetMulticastGroupResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(auth.Read, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	mg, err := storage.GetMulticastGroup(common.DB, req.Id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.GetMulticastGroupResponse{
+		Id:               mg.ID,
+		Name:             mg.Name,
+		ServiceProfileID: mg.ServiceProfileID,
+		McAddr:           mg.MCAddr.String(),
+		McNwkSKey:        mg.MCNwkSKey.String(),
+		McAppSKey:        mg.MCAppSKey.String(),
+		CreatedAt:        mg.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:        mg.UpdatedAt.Format(time.RFC3339Nano),
+		FCnt:             mg.FCnt,
+	}, nil
+}
+
+// Update updates the given multicast-group.
+func (a *MulticastGroupAPI) Update(ctx context.Context, req *pb.UpdateMulticastGroupRequest) (*pb.UpdateMulticastGroupResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(auth.Update, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		mg, err := storage.GetMulticastGroup(tx, req.Id, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		mg.Name = req.Name
+		if err := unmarshalMulticastSession(&mg, req.McAddr, req.McNwkSKey, req.McAppSKey); err != nil {
+			return err
+		}
+
+		if err := storage.UpdateMulticastGroup(tx, &mg); err != nil {
+			return errToRPCError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateMulticastGroupResponse{}, nil
+}
+
+// Delete deletes the multicast-group matching the given id.
+func (a *MulticastGroupAPI) Delete(ctx context.Context, req *pb.DeleteMulticastGroupRequest) (*pb.DeleteMulticastGroupResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(auth.Delete, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		return storage.DeleteMulticastGroup(tx, req.Id)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.DeleteMulticastGroupResponse{}, nil
+}
+
+// List lists the available multicast-groups.
+func (a *MulticastGroupAPI) List(ctx context.Context, req *pb.ListMulticastGroupRequest) (*pb.ListMulticastGroupResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupsAccess(auth.List, req.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var count int
+	var items []storage.MulticastGroupListItem
+	var err error
+
+	if req.OrganizationID == 0 {
+		count, err = storage.GetMulticastGroupCount(common.DB)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		items, err = storage.GetMulticastGroups(common.DB, int(req.Limit), int(req.Offset))
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	} else {
+		count, err = storage.GetMulticastGroupCountForOrganizationID(common.DB, req.OrganizationID)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		items, err = storage.GetMulticastGroupsForOrganizationID(common.DB, req.OrganizationID, int(req.Limit), int(req.Offset))
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	resp := pb.ListMulticastGroupResponse{
+		TotalCount: int64(count),
+	}
+	for _, mg := range items {
+		resp.Result = append(resp.Result, &pb.MulticastGroupListItem{
+			Id:                 mg.ID,
+			Name:               mg.Name,
+			ServiceProfileID:   mg.ServiceProfileID,
+			ServiceProfileName: mg.ServiceProfileName,
+			CreatedAt:          mg.CreatedAt.Format(time.RFC3339Nano),
+			UpdatedAt:          mg.UpdatedAt.Format(time.RFC3339Nano),
+		})
+	}
+
+	return &resp, nil
+}
+
+// AddDevice adds the given device to the multicast-group.
+func (a *MulticastGroupAPI) AddDevice(ctx context.Context, req *pb.AddDeviceToMulticastGroupRequest) (*pb.AddDeviceToMulticastGroupResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupMemberAccess(auth.Create, req.MulticastGroupID, devEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		return storage.AddDeviceToMulticastGroup(tx, req.MulticastGroupID, devEUI)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.AddDeviceToMulticastGroupResponse{}, nil
+}
+
+// RemoveDevice removes the given device from the multicast-group.
+func (a *MulticastGroupAPI) RemoveDevice(ctx context.Context, req *pb.RemoveDeviceFromMulticastGroupRequest) (*pb.RemoveDeviceFromMulticastGroupResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupMemberAccess(auth.Delete, req.MulticastGroupID, devEUI),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		return storage.RemoveDeviceFromMulticastGroup(tx, req.MulticastGroupID, devEUI)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.RemoveDeviceFromMulticastGroupResponse{}, nil
+}
+
+// ListDevices lists the devices of the multicast-group.
+func (a *MulticastGroupAPI) ListDevices(ctx context.Context, req *pb.ListMulticastGroupDevicesRequest) (*pb.ListMulticastGroupDevicesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(auth.Read, req.MulticastGroupID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetDeviceCountForMulticastGroup(common.DB, req.MulticastGroupID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	devices, err := storage.GetDevicesForMulticastGroup(common.DB, req.MulticastGroupID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListMulticastGroupDevicesResponse{
+		TotalCount: int64(count),
+	}
+	for _, d := range devices {
+		resp.Result = append(resp.Result, &pb.MulticastGroupDeviceListItem{
+			DevEUI:            d.DevEUI.String(),
+			Name:              d.Name,
+			ApplicationID:     d.ApplicationID,
+			DeviceProfileID:   d.DeviceProfileID,
+			DeviceProfileName: d.DeviceProfileName,
+		})
+	}
+
+	return &resp, nil
+}
+
+// Enqueue adds the given payload to the multicast-queue of the
+// multicast-group.
+func (a *MulticastGroupAPI) Enqueue(ctx context.Context, req *pb.EnqueueMulticastQueueItemRequest) (*pb.EnqueueMulticastQueueItemResponse, error) {
+	if req.FPort == 0 || req.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 255")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupQueueAccess(auth.Create, req.MulticastGroupID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var fCnt uint32
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		var err error
+		fCnt, err = downlink.EnqueueMulticastPayload(tx, req.MulticastGroupID, uint8(req.FPort), req.Data)
+		if err != nil {
+			return errors.Wrap(err, "enqueue multicast payload error")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EnqueueMulticastQueueItemResponse{
+		FCnt: fCnt,
+	}, nil
+}
+
+func unmarshalMulticastSession(mg *storage.MulticastGroup, mcAddr, mcNwkSKey, mcAppSKey string) error {
+	if err := mg.MCAddr.UnmarshalText([]byte(mcAddr)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "mcAddr: %s", err)
+	}
+	if err := mg.MCNwkSKey.UnmarshalText([]byte(mcNwkSKey)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "mcNwkSKey: %s", err)
+	}
+	if err := mg.MCAppSKey.UnmarshalText([]byte(mcAppSKey)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "mcAppSKey: %s", err)
+	}
+	return nil
+}
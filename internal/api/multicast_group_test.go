@@ -0,0 +1,308 @@
+package api
+
+import (
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMulticastGroupAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	common.DB = db
+
+	Convey("Given a clean database with an organization, application + devices and api instance", t, func() {
+		test.MustResetDB(common.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+			DeviceProfile: &ns.DeviceProfile{
+				SupportsClassC: true,
+			},
+		}
+		nsClient.GetNextDownlinkFCntForDevEUIResponse = ns.GetNextDownlinkFCntForDevEUIResponse{
+			FCnt: 12,
+		}
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewMulticastGroupAPI(validator)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		devices := []storage.Device{
+			{ApplicationID: app.ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, Name: "test-node-1", DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}},
+			{ApplicationID: app.ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, Name: "test-node-2", DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}},
+		}
+		for i := range devices {
+			So(storage.CreateDevice(common.DB, &devices[i]), ShouldBeNil)
+		}
+
+		Convey("When creating a multicast-group with an invalid mcAddr", func() {
+			_, err := api.Create(ctx, &pb.CreateMulticastGroupRequest{
+				Name:             "test-mg",
+				ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+				McAddr:           "0102",
+			})
+
+			Convey("Then an InvalidArgument error is returned", func() {
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+		})
+
+		Convey("When creating a multicast-group", func() {
+			createResp, err := api.Create(ctx, &pb.CreateMulticastGroupRequest{
+				Name:             "test-mg",
+				ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+				McAddr:           "01020304",
+				McNwkSKey:        "01020304050607080102030405060708",
+				McAppSKey:        "08070605040302010807060504030201",
+			})
+			So(err, ShouldBeNil)
+			So(validator.ctx, ShouldResemble, ctx)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+			id := createResp.Id
+
+			Convey("Then the multicast-group has been created on the network-server", func() {
+				So(nsClient.CreateMulticastGroupChan, ShouldHaveLength, 1)
+				So(<-nsClient.CreateMulticastGroupChan, ShouldResemble, ns.CreateMulticastGroupRequest{
+					MulticastGroup: &ns.MulticastGroup{
+						MulticastGroupID: id,
+						McAddr:           []byte{1, 2, 3, 4},
+						McNwkSKey:        []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+						ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+						RoutingProfileID: common.ApplicationServerID,
+					},
+				})
+			})
+
+			Convey("Then the multicast-group has been created", func() {
+				mg, err := api.Get(ctx, &pb.GetMulticastGroupRequest{
+					Id: id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(mg.CreatedAt, ShouldNotEqual, "")
+				So(mg.UpdatedAt, ShouldNotEqual, "")
+				mg.CreatedAt = ""
+				mg.UpdatedAt = ""
+				So(mg, ShouldResemble, &pb.GetMulticastGroupResponse{
+					Id:               id,
+					Name:             "test-mg",
+					ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+					McAddr:           "01020304",
+					McNwkSKey:        "01020304050607080102030405060708",
+					McAppSKey:        "08070605040302010807060504030201",
+				})
+			})
+
+			Convey("Then the multicast-group can be listed", func() {
+				resp, err := api.List(ctx, &pb.ListMulticastGroupRequest{
+					Limit:          10,
+					OrganizationID: org.ID,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Id, ShouldEqual, id)
+				So(resp.Result[0].ServiceProfileName, ShouldEqual, sp.Name)
+			})
+
+			Convey("Then the multicast-group can be updated", func() {
+				_, err := api.Update(ctx, &pb.UpdateMulticastGroupRequest{
+					Id:        id,
+					Name:      "test-mg-updated",
+					McAddr:    "04030201",
+					McNwkSKey: "08070605040302010807060504030201",
+					McAppSKey: "01020304050607080102030405060708",
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				mg, err := api.Get(ctx, &pb.GetMulticastGroupRequest{
+					Id: id,
+				})
+				So(err, ShouldBeNil)
+				So(mg.Name, ShouldEqual, "test-mg-updated")
+				So(mg.McAddr, ShouldEqual, "04030201")
+				So(nsClient.UpdateMulticastGroupChan, ShouldHaveLength, 1)
+			})
+
+			Convey("Then the multicast-group can be deleted", func() {
+				_, err := api.Delete(ctx, &pb.DeleteMulticastGroupRequest{
+					Id: id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(nsClient.DeleteMulticastGroupChan, ShouldHaveLength, 1)
+				So(<-nsClient.DeleteMulticastGroupChan, ShouldResemble, ns.DeleteMulticastGroupRequest{
+					MulticastGroupID: id,
+				})
+
+				_, err = api.Get(ctx, &pb.GetMulticastGroupRequest{
+					Id: id,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+
+			Convey("When adding the devices to the multicast-group", func() {
+				for _, d := range devices {
+					_, err := api.AddDevice(ctx, &pb.AddDeviceToMulticastGroupRequest{
+						MulticastGroupID: id,
+						DevEUI:           d.DevEUI.String(),
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+				}
+				So(nsClient.AddDeviceToMulticastGroupChan, ShouldHaveLength, 2)
+
+				Convey("Then the devices are listed", func() {
+					resp, err := api.ListDevices(ctx, &pb.ListMulticastGroupDevicesRequest{
+						MulticastGroupID: id,
+						Limit:            10,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp.TotalCount, ShouldEqual, 2)
+					So(resp.Result, ShouldResemble, []*pb.MulticastGroupDeviceListItem{
+						{DevEUI: "0101010101010101", Name: "test-node-1", ApplicationID: app.ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, DeviceProfileName: dp.Name},
+						{DevEUI: "0202020202020202", Name: "test-node-2", ApplicationID: app.ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, DeviceProfileName: dp.Name},
+					})
+				})
+
+				Convey("Then a device can be removed", func() {
+					_, err := api.RemoveDevice(ctx, &pb.RemoveDeviceFromMulticastGroupRequest{
+						MulticastGroupID: id,
+						DevEUI:           devices[1].DevEUI.String(),
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					resp, err := api.ListDevices(ctx, &pb.ListMulticastGroupDevicesRequest{
+						MulticastGroupID: id,
+						Limit:            10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(nsClient.RemoveDeviceFromMulticastGroupChan, ShouldHaveLength, 1)
+				})
+
+				Convey("When enqueueing two payloads to the multicast-group", func() {
+					for i := 0; i < 2; i++ {
+						resp, err := api.Enqueue(ctx, &pb.EnqueueMulticastQueueItemRequest{
+							MulticastGroupID: id,
+							FPort:            10,
+							Data:             []byte{1, 2, 3, 4},
+						})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+						So(resp.FCnt, ShouldEqual, i)
+					}
+
+					Convey("Then each payload has been enqueued once, encrypted with the multicast session", func() {
+						So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+						So(nsClient.EnqueueMulticastQueueItemChan, ShouldHaveLength, 2)
+
+						for i := 0; i < 2; i++ {
+							b, err := lorawan.EncryptFRMPayload(lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}, false, lorawan.DevAddr{1, 2, 3, 4}, uint32(i), []byte{1, 2, 3, 4})
+							So(err, ShouldBeNil)
+
+							So(<-nsClient.EnqueueMulticastQueueItemChan, ShouldResemble, ns.EnqueueMulticastQueueItemRequest{
+								Item: &ns.MulticastQueueItem{
+									MulticastGroupID: id,
+									FrmPayload:       b,
+									FCnt:             uint32(i),
+									FPort:            10,
+								},
+							})
+						}
+
+						mg, err := api.Get(ctx, &pb.GetMulticastGroupRequest{
+							Id: id,
+						})
+						So(err, ShouldBeNil)
+						So(mg.FCnt, ShouldEqual, 2)
+					})
+				})
+
+				Convey("When enqueueing a payload and the network-server returns an error", func() {
+					nsClient.EnqueueMulticastQueueItemError = grpc.Errorf(codes.Unavailable, "boom")
+
+					_, err := api.Enqueue(ctx, &pb.EnqueueMulticastQueueItemRequest{
+						MulticastGroupID: id,
+						FPort:            10,
+						Data:             []byte{1, 2, 3, 4},
+					})
+					So(err, ShouldNotBeNil)
+
+					Convey("Then the frame-counter has not been incremented", func() {
+						nsClient.EnqueueMulticastQueueItemError = nil
+
+						mg, err := api.Get(ctx, &pb.GetMulticastGroupRequest{
+							Id: id,
+						})
+						So(err, ShouldBeNil)
+						So(mg.FCnt, ShouldEqual, 0)
+					})
+				})
+
+				Convey("Then enqueueing with fPort 0 returns an InvalidArgument error", func() {
+					_, err := api.Enqueue(ctx, &pb.EnqueueMulticastQueueItemRequest{
+						MulticastGroupID: id,
+						Data:             []byte{1, 2, 3, 4},
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"time"
 
 	"golang.org/x/net/context"
@@ -10,6 +11,9 @@ import (
 	pb "github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/api/auth"
 	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/handler/httphandler"
+	"github.com/Frankz/lora-app-server/internal/handler/marshaler"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/jmoiron/sqlx"
 )
@@ -283,3 +287,140 @@ func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUs
 		UpdatedAt: user.UpdatedAt.Format(time.RFC3339Nano),
 	}, nil
 }
+
+// CreateHTTPIntegration creates an HTTP organization-integration.
+func (a *OrganizationAPI) CreateHTTPIntegration(ctx context.Context, req *pb.OrganizationHTTPIntegration) (*pb.OrganizationEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	confJSON, err := organizationHTTPIntegrationSettings(req, httphandler.HandlerConfig{})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.OrganizationIntegration{
+		OrganizationID: req.Id,
+		Kind:           handler.HTTPHandlerKind,
+		Settings:       confJSON,
+	}
+	if err = storage.CreateOrganizationIntegration(common.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.OrganizationEmptyResponse{}, nil
+}
+
+// GetHTTPIntegration returns the HTTP organization-integration.
+func (a *OrganizationAPI) GetHTTPIntegration(ctx context.Context, req *pb.OrganizationRequest) (*pb.OrganizationHTTPIntegration, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(common.DB, req.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var conf httphandler.HandlerConfig
+	if err = json.Unmarshal(integration.Settings, &conf); err != nil {
+		return nil, errToRPCError(err)
+	}
+	conf = conf.Redacted()
+
+	var headers []*pb.HTTPIntegrationHeader
+	for k, v := range conf.Headers {
+		headers = append(headers, &pb.HTTPIntegrationHeader{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	return &pb.OrganizationHTTPIntegration{
+		Id:               integration.OrganizationID,
+		Headers:          headers,
+		GatewayStatusURL: conf.GatewayStatusURL,
+		SigningSecret:    conf.SigningSecret,
+		CaCert:           conf.CACert,
+		TlsCert:          conf.TLSCert,
+		TlsKey:           conf.TLSKey,
+		Marshaler:        string(conf.Marshaler),
+	}, nil
+}
+
+// UpdateHTTPIntegration updates the HTTP organization-integration.
+func (a *OrganizationAPI) UpdateHTTPIntegration(ctx context.Context, req *pb.OrganizationHTTPIntegration) (*pb.OrganizationEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(common.DB, req.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var stored httphandler.HandlerConfig
+	if err = json.Unmarshal(integration.Settings, &stored); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration.Settings, err = organizationHTTPIntegrationSettings(req, stored)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.UpdateOrganizationIntegration(common.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.OrganizationEmptyResponse{}, nil
+}
+
+// DeleteHTTPIntegration deletes the HTTP organization-integration.
+func (a *OrganizationAPI) DeleteHTTPIntegration(ctx context.Context, req *pb.OrganizationRequest) (*pb.OrganizationEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(common.DB, req.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.DeleteOrganizationIntegration(common.DB, integration.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.OrganizationEmptyResponse{}, nil
+}
+
+// organizationHTTPIntegrationSettings returns the validated and JSON encoded
+// HTTP handler configuration for the given organization-integration. The
+// secrets of the given stored configuration are kept when not set.
+func organizationHTTPIntegrationSettings(req *pb.OrganizationHTTPIntegration, stored httphandler.HandlerConfig) ([]byte, error) {
+	headers := make(map[string]string)
+	for _, h := range req.Headers {
+		headers[h.Key] = h.Value
+	}
+
+	conf := httphandler.HandlerConfig{
+		Headers:          headers,
+		GatewayStatusURL: req.GatewayStatusURL,
+		SigningSecret:    req.SigningSecret,
+		CACert:           req.CaCert,
+		TLSCert:          req.TlsCert,
+		TLSKey:           req.TlsKey,
+		Marshaler:        marshaler.Type(req.Marshaler),
+	}
+	conf.KeepSecrets(stored, req.ClearSigningSecret)
+
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
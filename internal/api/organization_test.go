@@ -5,6 +5,8 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/common"
@@ -105,6 +107,62 @@ func TestOrganizationAPI(t *testing.T) {
 
 				})
 
+				Convey("When creating an HTTP integration", func() {
+					intReq := pb.OrganizationHTTPIntegration{
+						Id: orgId,
+						Headers: []*pb.HTTPIntegrationHeader{
+							{Key: "Foo", Value: "bar"},
+						},
+						GatewayStatusURL: "http://localhost:1234/gateway-status",
+					}
+					_, err := api.CreateHTTPIntegration(ctx, &intReq)
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the integration can be retrieved", func() {
+						i, err := api.GetHTTPIntegration(ctx, &pb.OrganizationRequest{Id: orgId})
+						So(err, ShouldBeNil)
+						So(i, ShouldResemble, &intReq)
+					})
+
+					Convey("Then the integration can be updated", func() {
+						intReq.GatewayStatusURL = "http://localhost:1234/gateway-status2"
+						intReq.Marshaler = "protobuf"
+						_, err := api.UpdateHTTPIntegration(ctx, &intReq)
+						So(err, ShouldBeNil)
+
+						i, err := api.GetHTTPIntegration(ctx, &pb.OrganizationRequest{Id: orgId})
+						So(err, ShouldBeNil)
+						So(i, ShouldResemble, &intReq)
+					})
+
+					Convey("Then the signing secret is not returned", func() {
+						intReq.SigningSecret = "verysecretsecret"
+						_, err := api.UpdateHTTPIntegration(ctx, &intReq)
+						So(err, ShouldBeNil)
+
+						i, err := api.GetHTTPIntegration(ctx, &pb.OrganizationRequest{Id: orgId})
+						So(err, ShouldBeNil)
+						So(i.SigningSecret, ShouldEqual, "")
+					})
+
+					Convey("Then an invalid header name is rejected on update", func() {
+						intReq.Headers = []*pb.HTTPIntegrationHeader{
+							{Key: "Foo Bar", Value: "bar"},
+						}
+						_, err := api.UpdateHTTPIntegration(ctx, &intReq)
+						So(err, ShouldNotBeNil)
+					})
+
+					Convey("Then the integration can be deleted", func() {
+						_, err := api.DeleteHTTPIntegration(ctx, &pb.OrganizationRequest{Id: orgId})
+						So(err, ShouldBeNil)
+
+						_, err = api.GetHTTPIntegration(ctx, &pb.OrganizationRequest{Id: orgId})
+						So(grpc.Code(err), ShouldEqual, codes.NotFound)
+					})
+				})
+
 				// Add a new user for adding to the organization.
 				Convey("When adding a user", func() {
 					userReq := &pb.AddUserRequest{
@@ -0,0 +1,235 @@
+package api
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/api/auth"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/cron"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+// ScheduledDownlinkAPI exports the scheduled downlink related functions.
+type ScheduledDownlinkAPI struct {
+	validator auth.Validator
+}
+
+// NewScheduledDownlinkAPI creates a new ScheduledDownlinkAPI.
+func NewScheduledDownlinkAPI(validator auth.Validator) *ScheduledDownlinkAPI {
+	return &ScheduledDownlinkAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given scheduled downlink.
+func (a *ScheduledDownlinkAPI) Create(ctx context.Context, req *pb.CreateScheduledDownlinkRequest) (*pb.CreateScheduledDownlinkResponse, error) {
+	if req.ScheduledDownlink == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "scheduledDownlink must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateScheduledDownlinksAccess(auth.Create, req.ApplicationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sd := storage.ScheduledDownlink{
+		ApplicationID: req.ApplicationID,
+	}
+	if err := unmarshalScheduledDownlink(&sd, req.ScheduledDownlink); err != nil {
+		return nil, err
+	}
+
+	if err := storage.CreateScheduledDownlink(common.DB, &sd); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateScheduledDownlinkResponse{
+		Id: sd.ID,
+	}, nil
+}
+
+// Get returns the scheduled downlink matching the given id.
+func (a *ScheduledDownlinkAPI) Get(ctx context.Context, req *pb.GetScheduledDownlinkRequest) (*pb.GetScheduledDownlinkResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateScheduledDownlinkAccess(auth.Read, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sd, err := storage.GetScheduledDownlink(common.DB, req.Id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return marshalScheduledDownlink(sd), nil
+}
+
+// Update updates the given scheduled downlink.
+func (a *ScheduledDownlinkAPI) Update(ctx context.Context, req *pb.UpdateScheduledDownlinkRequest) (*pb.UpdateScheduledDownlinkResponse, error) {
+	if req.ScheduledDownlink == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "scheduledDownlink must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateScheduledDownlinkAccess(auth.Update, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		sd, err := storage.GetScheduledDownlink(tx, req.Id, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		if err := unmarshalScheduledDownlink(&sd, req.ScheduledDownlink); err != nil {
+			return err
+		}
+
+		if err := storage.UpdateScheduledDownlink(tx, &sd); err != nil {
+			return errToRPCError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateScheduledDownlinkResponse{}, nil
+}
+
+// Delete deletes the scheduled downlink matching the given id.
+func (a *ScheduledDownlinkAPI) Delete(ctx context.Context, req *pb.DeleteScheduledDownlinkRequest) (*pb.DeleteScheduledDownlinkResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateScheduledDownlinkAccess(auth.Delete, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteScheduledDownlink(common.DB, req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.DeleteScheduledDownlinkResponse{}, nil
+}
+
+// List lists the scheduled downlinks of the given application.
+func (a *ScheduledDownlinkAPI) List(ctx context.Context, req *pb.ListScheduledDownlinkRequest) (*pb.ListScheduledDownlinkResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateScheduledDownlinksAccess(auth.List, req.ApplicationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetScheduledDownlinkCountForApplicationID(common.DB, req.ApplicationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	items, err := storage.GetScheduledDownlinksForApplicationID(common.DB, req.ApplicationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListScheduledDownlinkResponse{
+		TotalCount: int64(count),
+	}
+	for _, sd := range items {
+		resp.Result = append(resp.Result, marshalScheduledDownlink(sd))
+	}
+
+	return &resp, nil
+}
+
+func unmarshalScheduledDownlink(sd *storage.ScheduledDownlink, in *pb.ScheduledDownlink) error {
+	if in.FPort == 0 || in.FPort > 224 {
+		return grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 - 224")
+	}
+
+	sd.Name = in.Name
+	sd.FPort = uint8(in.FPort)
+	sd.Data = in.Data
+	sd.Confirmed = in.Confirmed
+	sd.Cron = in.Cron
+	sd.Target = storage.ScheduledDownlinkTarget(in.Target.String())
+	sd.ScheduleAt = nil
+	sd.DevEUIs = nil
+
+	for _, s := range in.DevEUIs {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(s)); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		}
+		sd.DevEUIs = append(sd.DevEUIs, devEUI)
+	}
+
+	switch in.Target {
+	case pb.ScheduledDownlinkTarget_DEVICES:
+		if len(sd.DevEUIs) == 0 {
+			return grpc.Errorf(codes.InvalidArgument, "devEUIs must be set for target DEVICES")
+		}
+	case pb.ScheduledDownlinkTarget_APPLICATION:
+		if len(sd.DevEUIs) != 0 {
+			return grpc.Errorf(codes.InvalidArgument, "devEUIs must be empty for target APPLICATION")
+		}
+	default:
+		return grpc.Errorf(codes.InvalidArgument, "invalid target: %s", in.Target)
+	}
+
+	if in.ScheduleAt != "" {
+		t, err := time.Parse(time.RFC3339Nano, in.ScheduleAt)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "scheduleAt: %s", err)
+		}
+		sd.ScheduleAt = &t
+	}
+
+	if in.Cron != "" {
+		if _, err := cron.Parse(in.Cron); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "cron: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func marshalScheduledDownlink(sd storage.ScheduledDownlink) *pb.GetScheduledDownlinkResponse {
+	resp := pb.GetScheduledDownlinkResponse{
+		Id:            sd.ID,
+		ApplicationID: sd.ApplicationID,
+		ScheduledDownlink: &pb.ScheduledDownlink{
+			Name:      sd.Name,
+			FPort:     uint32(sd.FPort),
+			Data:      sd.Data,
+			Confirmed: sd.Confirmed,
+			Cron:      sd.Cron,
+			Target:    pb.ScheduledDownlinkTarget(pb.ScheduledDownlinkTarget_value[string(sd.Target)]),
+		},
+		CreatedAt: sd.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: sd.UpdatedAt.Format(time.RFC3339Nano),
+	}
+
+	for _, devEUI := range sd.DevEUIs {
+		resp.ScheduledDownlink.DevEUIs = append(resp.ScheduledDownlink.DevEUIs, devEUI.String())
+	}
+
+	if sd.ScheduleAt != nil {
+		resp.ScheduledDownlink.ScheduleAt = sd.ScheduleAt.Format(time.RFC3339Nano)
+	}
+	if sd.NextRunAt != nil {
+		resp.NextRunAt = sd.NextRunAt.Format(time.RFC3339Nano)
+	}
+	if sd.LastRunAt != nil {
+		resp.LastRunAt = sd.LastRunAt.Format(time.RFC3339Nano)
+	}
+
+	return &resp
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestScheduledDownlinkAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	common.DB = db
+
+	Convey("Given a clean database with an application + device and api instance", t, func() {
+		test.MustResetDB(common.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewScheduledDownlinkAPI(validator)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		device := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(common.DB, &device), ShouldBeNil)
+
+		scheduleAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+		Convey("Then invalid scheduled downlinks return an InvalidArgument error", func() {
+			tests := []pb.ScheduledDownlink{
+				{Name: "invalid-fport", FPort: 0, ScheduleAt: scheduleAt.Format(time.RFC3339Nano)},
+				{Name: "invalid-timestamp", FPort: 10, ScheduleAt: "tomorrow"},
+				{Name: "invalid-cron", FPort: 10, Cron: "* * *"},
+				{Name: "invalid-deveui", FPort: 10, Cron: "0 2 * * *", DevEUIs: []string{"0102"}},
+				{Name: "no-schedule", FPort: 10, DevEUIs: []string{device.DevEUI.String()}},
+				{Name: "no-devices", FPort: 10, Cron: "0 2 * * *"},
+				{Name: "application-with-devices", FPort: 10, Cron: "0 2 * * *", Target: pb.ScheduledDownlinkTarget_APPLICATION, DevEUIs: []string{device.DevEUI.String()}},
+			}
+
+			for i := range tests {
+				_, err := api.Create(ctx, &pb.CreateScheduledDownlinkRequest{
+					ApplicationID:     app.ID,
+					ScheduledDownlink: &tests[i],
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			}
+		})
+
+		Convey("When creating a one-time scheduled downlink for the device", func() {
+			createResp, err := api.Create(ctx, &pb.CreateScheduledDownlinkRequest{
+				ApplicationID: app.ID,
+				ScheduledDownlink: &pb.ScheduledDownlink{
+					Name:       "set-point",
+					DevEUIs:    []string{device.DevEUI.String()},
+					FPort:      10,
+					Data:       []byte{1, 2, 3, 4},
+					Confirmed:  true,
+					ScheduleAt: scheduleAt.Format(time.RFC3339Nano),
+				},
+			})
+			So(err, ShouldBeNil)
+			So(validator.ctx, ShouldResemble, ctx)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+			Convey("Then the scheduled downlink can be retrieved", func() {
+				resp, err := api.Get(ctx, &pb.GetScheduledDownlinkRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.ApplicationID, ShouldEqual, app.ID)
+
+				// the timezone depends on the database session
+				respScheduleAt, err := time.Parse(time.RFC3339Nano, resp.ScheduledDownlink.ScheduleAt)
+				So(err, ShouldBeNil)
+				So(respScheduleAt.Equal(scheduleAt), ShouldBeTrue)
+				resp.ScheduledDownlink.ScheduleAt = ""
+
+				So(resp.ScheduledDownlink, ShouldResemble, &pb.ScheduledDownlink{
+					Name:      "set-point",
+					DevEUIs:   []string{"0102030405060708"},
+					FPort:     10,
+					Data:      []byte{1, 2, 3, 4},
+					Confirmed: true,
+				})
+
+				nextRunAt, err := time.Parse(time.RFC3339Nano, resp.NextRunAt)
+				So(err, ShouldBeNil)
+				So(nextRunAt.Equal(scheduleAt), ShouldBeTrue)
+				So(resp.LastRunAt, ShouldEqual, "")
+			})
+
+			Convey("Then the scheduled downlink is listed for the application", func() {
+				resp, err := api.List(ctx, &pb.ListScheduledDownlinkRequest{
+					ApplicationID: app.ID,
+					Limit:         10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Id, ShouldEqual, createResp.Id)
+			})
+
+			Convey("Then the scheduled downlink can be updated to a recurring downlink for the application", func() {
+				_, err := api.Update(ctx, &pb.UpdateScheduledDownlinkRequest{
+					Id: createResp.Id,
+					ScheduledDownlink: &pb.ScheduledDownlink{
+						Name:   "nightly-set-point",
+						FPort:  20,
+						Data:   []byte{4, 3, 2, 1},
+						Cron:   "0 2 * * *",
+						Target: pb.ScheduledDownlinkTarget_APPLICATION,
+					},
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				resp, err := api.Get(ctx, &pb.GetScheduledDownlinkRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(resp.ScheduledDownlink, ShouldResemble, &pb.ScheduledDownlink{
+					Name:   "nightly-set-point",
+					FPort:  20,
+					Data:   []byte{4, 3, 2, 1},
+					Cron:   "0 2 * * *",
+					Target: pb.ScheduledDownlinkTarget_APPLICATION,
+				})
+
+				nextRunAt, err := time.Parse(time.RFC3339Nano, resp.NextRunAt)
+				So(err, ShouldBeNil)
+				So(nextRunAt.UTC().Hour(), ShouldEqual, 2)
+			})
+
+			Convey("Then the scheduled downlink can be deleted", func() {
+				_, err := api.Delete(ctx, &pb.DeleteScheduledDownlinkRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				_, err = api.Get(ctx, &pb.GetScheduledDownlinkRequest{
+					Id: createResp.Id,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+		})
+	})
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	pb "github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/eventlog"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testStreamDeviceEventsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan pb.DeviceEvent
+}
+
+func (s *testStreamDeviceEventsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStreamDeviceEventsServer) Send(e *pb.DeviceEvent) error {
+	s.events <- *e
+	return nil
+}
+
+type testStreamApplicationEventsServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan pb.StreamApplicationEventsResponse
+}
+
+func (s *testStreamApplicationEventsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStreamApplicationEventsServer) Send(e *pb.StreamApplicationEventsResponse) error {
+	s.events <- *e
+	return nil
+}
+
+func TestStreamEventsAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database and Redis with a device", t, func() {
+		test.MustResetDB(common.DB)
+		test.MustFlushRedis(common.RedisPool)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := storage.Device{
+			ApplicationID:   app.ID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(storage.CreateDevice(common.DB, &d), ShouldBeNil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		validator := &TestValidator{}
+		fPort := 10
+
+		Convey("When calling DeviceAPI.StreamEvents", func() {
+			api := NewDeviceAPI(validator)
+			srv := testStreamDeviceEventsServer{ctx: ctx, events: make(chan pb.DeviceEvent, 10)}
+			streamErr := make(chan error, 1)
+			go func() {
+				streamErr <- api.StreamEvents(&pb.StreamDeviceEventsRequest{
+					DevEUI: d.DevEUI.String(),
+				}, &srv)
+			}()
+
+			// give the stream some time to subscribe
+			time.Sleep(100 * time.Millisecond)
+
+			Convey("Then a logged event is sent", func() {
+				So(eventlog.LogEventForDevice(app.ID, d.DevEUI, storage.DeviceEventUplink, &fPort, map[string]int{"fCnt": 1}), ShouldBeNil)
+
+				e := <-srv.events
+				So(e.Id, ShouldNotEqual, 0)
+				So(e.Type, ShouldEqual, "uplink")
+				So(e.FPort, ShouldEqual, 10)
+				So(e.PayloadJSON, ShouldEqual, `{"fCnt":1}`)
+				_, err := time.Parse(time.RFC3339Nano, e.CreatedAt)
+				So(err, ShouldBeNil)
+
+				Convey("Then cancelling the context ends the stream", func() {
+					cancel()
+					So(<-streamErr, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("Then an event of an other device is not sent", func() {
+				d2 := storage.Device{
+					ApplicationID:   app.ID,
+					Name:            "test-node-2",
+					DevEUI:          lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+				}
+				So(storage.CreateDevice(common.DB, &d2), ShouldBeNil)
+				So(eventlog.LogEventForDevice(app.ID, d2.DevEUI, storage.DeviceEventError, nil, map[string]string{"error": "BOOM"}), ShouldBeNil)
+				time.Sleep(100 * time.Millisecond)
+
+				cancel()
+				So(<-streamErr, ShouldBeNil)
+				So(srv.events, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("When calling ApplicationAPI.StreamEvents", func() {
+			api := NewApplicationAPI(validator)
+			srv := testStreamApplicationEventsServer{ctx: ctx, events: make(chan pb.StreamApplicationEventsResponse, 10)}
+			streamErr := make(chan error, 1)
+			go func() {
+				streamErr <- api.StreamEvents(&pb.StreamApplicationEventsRequest{
+					Id: app.ID,
+				}, &srv)
+			}()
+
+			// give the stream some time to subscribe
+			time.Sleep(100 * time.Millisecond)
+
+			Convey("Then a logged event of a device of the application is sent", func() {
+				So(eventlog.LogEventForDevice(app.ID, d.DevEUI, storage.DeviceEventUplink, &fPort, map[string]int{"fCnt": 1}), ShouldBeNil)
+
+				e := <-srv.events
+				So(e.DevEUI, ShouldEqual, d.DevEUI.String())
+				So(e.EventID, ShouldNotEqual, 0)
+				So(e.Type, ShouldEqual, "uplink")
+				So(e.FPort, ShouldEqual, 10)
+				So(e.PayloadJSON, ShouldEqual, `{"fCnt":1}`)
+
+				Convey("Then cancelling the context ends the stream", func() {
+					cancel()
+					So(<-streamErr, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("Then an event of an other application is not sent", func() {
+				So(eventlog.LogEventForDevice(app.ID+1, d.DevEUI, storage.DeviceEventUplink, &fPort, map[string]int{"fCnt": 1}), ShouldBeNil)
+				time.Sleep(100 * time.Millisecond)
+
+				cancel()
+				So(<-streamErr, ShouldBeNil)
+				So(srv.events, ShouldHaveLength, 0)
+			})
+		})
+	})
+}
@@ -0,0 +1,171 @@
+// Package clocksync implements the LoRaWAN application layer clock
+// synchronization package, answering the AppTimeReq of the devices and
+// periodically forcing the devices to re-synchronize their clock.
+package clocksync
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Port defines the fPort used by the clock synchronization package.
+const Port = 202
+
+// MaxNbTransmissions defines the max NbTransmissions value of the
+// ForceDeviceResyncReq.
+const MaxNbTransmissions = 7
+
+// CID defines the command identifier.
+type CID byte
+
+// Available command identifiers.
+const (
+	PackageVersion           CID = 0x00
+	AppTime                  CID = 0x01
+	DeviceAppTimePeriodicity CID = 0x02
+	ForceDeviceResync        CID = 0x03
+)
+
+// PackageVersionReqPayload implements the PackageVersionReq payload.
+type PackageVersionReqPayload struct{}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p PackageVersionReqPayload) MarshalBinary() ([]byte, error) {
+	return []byte{byte(PackageVersion)}, nil
+}
+
+// PackageVersionAnsPayload implements the PackageVersionAns payload.
+type PackageVersionAnsPayload struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// UnmarshalBinary decodes the command (excluding CID) from a slice of bytes.
+func (p *PackageVersionAnsPayload) UnmarshalBinary(b []byte) error {
+	if len(b) != 2 {
+		return errors.New("clocksync: 2 bytes of data are expected")
+	}
+	p.PackageIdentifier = b[0]
+	p.PackageVersion = b[1]
+	return nil
+}
+
+// AppTimeReqPayload implements the AppTimeReq payload.
+type AppTimeReqPayload struct {
+	// DeviceTime holds the device clock as the number of seconds since the
+	// GPS epoch (modulo 2^32).
+	DeviceTime  uint32
+	AnsRequired bool
+	TokenReq    uint8
+}
+
+// UnmarshalBinary decodes the command (excluding CID) from a slice of bytes.
+func (p *AppTimeReqPayload) UnmarshalBinary(b []byte) error {
+	if len(b) != 5 {
+		return errors.New("clocksync: 5 bytes of data are expected")
+	}
+	p.DeviceTime = binary.LittleEndian.Uint32(b[0:4])
+	p.AnsRequired = b[4]&0x10 != 0
+	p.TokenReq = b[4] & 0x0f
+	return nil
+}
+
+// AppTimeAnsPayload implements the AppTimeAns payload.
+type AppTimeAnsPayload struct {
+	// TimeCorrection holds the number of seconds the device must add to
+	// its clock.
+	TimeCorrection int32
+	TokenAns       uint8
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p AppTimeAnsPayload) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 6)
+	b[0] = byte(AppTime)
+	binary.LittleEndian.PutUint32(b[1:5], uint32(p.TimeCorrection))
+	b[5] = p.TokenAns & 0x0f
+	return b, nil
+}
+
+// DeviceAppTimePeriodicityReqPayload implements the
+// DeviceAppTimePeriodicityReq payload. The device sends an AppTimeReq
+// every 128 * 2^Periodicity seconds.
+type DeviceAppTimePeriodicityReqPayload struct {
+	Periodicity uint8
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p DeviceAppTimePeriodicityReqPayload) MarshalBinary() ([]byte, error) {
+	if p.Periodicity > 15 {
+		return nil, errors.New("clocksync: max Periodicity value is 15")
+	}
+	return []byte{byte(DeviceAppTimePeriodicity), p.Periodicity}, nil
+}
+
+// DeviceAppTimePeriodicityAnsPayload implements the
+// DeviceAppTimePeriodicityAns payload.
+type DeviceAppTimePeriodicityAnsPayload struct {
+	NotSupported bool
+	DeviceTime   uint32
+}
+
+// UnmarshalBinary decodes the command (excluding CID) from a slice of bytes.
+func (p *DeviceAppTimePeriodicityAnsPayload) UnmarshalBinary(b []byte) error {
+	if len(b) != 5 {
+		return errors.New("clocksync: 5 bytes of data are expected")
+	}
+	p.NotSupported = b[0]&0x01 != 0
+	p.DeviceTime = binary.LittleEndian.Uint32(b[1:5])
+	return nil
+}
+
+// ForceDeviceResyncReqPayload implements the ForceDeviceResyncReq payload.
+type ForceDeviceResyncReqPayload struct {
+	// NbTransmissions defines the number of AppTimeReq the device must
+	// transmit (with AnsRequired set) until it receives an AppTimeAns.
+	NbTransmissions uint8
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p ForceDeviceResyncReqPayload) MarshalBinary() ([]byte, error) {
+	if p.NbTransmissions > MaxNbTransmissions {
+		return nil, fmt.Errorf("clocksync: max NbTransmissions value is %d", MaxNbTransmissions)
+	}
+	return []byte{byte(ForceDeviceResync), p.NbTransmissions}, nil
+}
+
+// UnmarshalUplinkCommands decodes the commands sent by a device. An uplink
+// may contain multiple commands.
+func UnmarshalUplinkCommands(b []byte) ([]interface{}, error) {
+	var out []interface{}
+
+	for len(b) > 0 {
+		var size int
+		var pl interface {
+			UnmarshalBinary([]byte) error
+		}
+
+		switch CID(b[0]) {
+		case PackageVersion:
+			size, pl = 2, &PackageVersionAnsPayload{}
+		case AppTime:
+			size, pl = 5, &AppTimeReqPayload{}
+		case DeviceAppTimePeriodicity:
+			size, pl = 5, &DeviceAppTimePeriodicityAnsPayload{}
+		default:
+			return out, fmt.Errorf("clocksync: unknown CID %d", b[0])
+		}
+
+		if len(b) < size+1 {
+			return out, fmt.Errorf("clocksync: %d bytes of data expected for CID %d", size, b[0])
+		}
+		if err := pl.UnmarshalBinary(b[1 : size+1]); err != nil {
+			return out, err
+		}
+		out = append(out, pl)
+		b = b[size+1:]
+	}
+
+	return out, nil
+}
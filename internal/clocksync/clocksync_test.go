@@ -0,0 +1,80 @@
+package clocksync
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCommands(t *testing.T) {
+	Convey("Given a set of downlink commands", t, func() {
+		tests := []struct {
+			Name     string
+			Payload  interface{ MarshalBinary() ([]byte, error) }
+			Expected []byte
+		}{
+			{"PackageVersionReq", PackageVersionReqPayload{}, []byte{0x00}},
+			{"AppTimeAns", AppTimeAnsPayload{TimeCorrection: -2, TokenAns: 3}, []byte{0x01, 0xfe, 0xff, 0xff, 0xff, 0x03}},
+			{"DeviceAppTimePeriodicityReq", DeviceAppTimePeriodicityReqPayload{Periodicity: 5}, []byte{0x02, 0x05}},
+			{"ForceDeviceResyncReq", ForceDeviceResyncReqPayload{NbTransmissions: 3}, []byte{0x03, 0x03}},
+		}
+
+		Convey("Then the commands are encoded correctly", func() {
+			for _, test := range tests {
+				b, err := test.Payload.MarshalBinary()
+				So(err, ShouldBeNil)
+				So(b, ShouldResemble, test.Expected)
+			}
+		})
+
+		Convey("Then invalid values return an error", func() {
+			_, err := DeviceAppTimePeriodicityReqPayload{Periodicity: 16}.MarshalBinary()
+			So(err, ShouldNotBeNil)
+			_, err = ForceDeviceResyncReqPayload{NbTransmissions: 8}.MarshalBinary()
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given an uplink containing an AppTimeReq and a PackageVersionAns", t, func() {
+		b := []byte{0x01, 0x10, 0x20, 0x30, 0x40, 0x13, 0x00, 0x01, 0x01}
+
+		Convey("Then UnmarshalUplinkCommands returns both commands", func() {
+			cmds, err := UnmarshalUplinkCommands(b)
+			So(err, ShouldBeNil)
+			So(cmds, ShouldResemble, []interface{}{
+				&AppTimeReqPayload{DeviceTime: 0x40302010, AnsRequired: true, TokenReq: 3},
+				&PackageVersionAnsPayload{PackageIdentifier: 1, PackageVersion: 1},
+			})
+		})
+
+		Convey("Then a truncated uplink returns an error", func() {
+			_, err := UnmarshalUplinkCommands(b[:4])
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then an unknown CID returns an error", func() {
+			_, err := UnmarshalUplinkCommands([]byte{0x03, 0x01})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestTimeSinceGPSEpoch(t *testing.T) {
+	Convey("Given a set of timestamps", t, func() {
+		tests := []struct {
+			Time     time.Time
+			Expected time.Duration
+		}{
+			{time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC), 0},
+			{time.Date(2016, time.December, 31, 23, 59, 59, 0, time.UTC), 1167264016 * time.Second},
+			{time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC), 1167264018 * time.Second},
+		}
+
+		Convey("Then TimeSinceGPSEpoch includes the leap seconds", func() {
+			for _, test := range tests {
+				So(TimeSinceGPSEpoch(test.Time), ShouldEqual, test.Expected)
+			}
+		})
+	})
+}
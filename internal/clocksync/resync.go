@@ -0,0 +1,76 @@
+package clocksync
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/downlink"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+const (
+	resyncBatchSize = 100
+
+	// resyncNbTransmissions defines the number of AppTimeReq a device
+	// sends after receiving the ForceDeviceResyncReq (unless answered).
+	resyncNbTransmissions = 3
+)
+
+// ResyncLoop is a never returning function forcing the devices to
+// re-synchronize their clock, when the last synchronization is older than
+// the resync interval configured for their application.
+func ResyncLoop() {
+	for {
+		if err := handleResync(); err != nil {
+			log.Errorf("handle clock resync error: %s", err)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func handleResync() error {
+	b, err := ForceDeviceResyncReqPayload{NbTransmissions: resyncNbTransmissions}.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	// The devices are marked as forced within the transaction, the
+	// ForceDeviceResyncReq is enqueued after the commit so that no
+	// network-server items are left when the transaction is rolled back.
+	var devEUIs []lorawan.EUI64
+	err = storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		now := time.Now()
+
+		var err error
+		devEUIs, err = storage.GetDevEUIsForClockResync(tx, now, resyncBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "get devices for clock resync error")
+		}
+
+		for _, devEUI := range devEUIs {
+			if err := storage.SetDeviceClockResyncForced(tx, devEUI, now); err != nil {
+				return errors.Wrap(err, "set device clock resync forced error")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, devEUI := range devEUIs {
+		// in case of an error, the device is not retried until the next
+		// interval
+		if _, err := downlink.EnqueueDownlinkPayload(common.DB, devEUI, reference, false, Port, b); err != nil {
+			log.WithField("dev_eui", devEUI).Errorf("enqueue ForceDeviceResyncReq error: %s", err)
+		}
+	}
+
+	return nil
+}
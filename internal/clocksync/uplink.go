@@ -0,0 +1,86 @@
+package clocksync
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/downlink"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+// reference is used as downlink reference for the enqueued commands.
+const reference = "clocksync"
+
+// HandleUplink handles the (decrypted) uplink payload received on the
+// clock synchronization Port. The rxTime must be set to the time at which
+// the uplink was received by the gateway(s).
+func HandleUplink(devEUI lorawan.EUI64, rxTime time.Time, b []byte) error {
+	cmds, err := UnmarshalUplinkCommands(b)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal commands error")
+	}
+
+	for _, cmd := range cmds {
+		switch pl := cmd.(type) {
+		case *AppTimeReqPayload:
+			if err := handleAppTimeReq(devEUI, rxTime, pl); err != nil {
+				return err
+			}
+		case *DeviceAppTimePeriodicityAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":       devEUI,
+				"not_supported": pl.NotSupported,
+				"device_time":   pl.DeviceTime,
+			}).Info("clocksync: DeviceAppTimePeriodicityAns received")
+		case *PackageVersionAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":            devEUI,
+				"package_identifier": pl.PackageIdentifier,
+				"package_version":    pl.PackageVersion,
+			}).Info("clocksync: PackageVersionAns received")
+		}
+	}
+
+	return nil
+}
+
+// handleAppTimeReq calculates the time correction of the device and
+// enqueues the AppTimeAns. The answer is omitted when the device clock is
+// correct and no answer is required.
+func handleAppTimeReq(devEUI lorawan.EUI64, rxTime time.Time, pl *AppTimeReqPayload) error {
+	gpsTime := uint32(TimeSinceGPSEpoch(rxTime) / time.Second)
+	timeCorrection := int32(gpsTime - pl.DeviceTime)
+
+	log.WithFields(log.Fields{
+		"dev_eui":         devEUI,
+		"device_time":     pl.DeviceTime,
+		"time_correction": timeCorrection,
+		"ans_required":    pl.AnsRequired,
+	}).Info("clocksync: AppTimeReq received")
+
+	if err := storage.SetDeviceClockSynced(common.DB, devEUI, rxTime, timeCorrection); err != nil {
+		return errors.Wrap(err, "set device clock synced error")
+	}
+
+	if timeCorrection == 0 && !pl.AnsRequired {
+		return nil
+	}
+
+	b, err := AppTimeAnsPayload{
+		TimeCorrection: timeCorrection,
+		TokenAns:       pl.TokenReq,
+	}.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	if _, err := downlink.EnqueueDownlinkPayload(common.DB, devEUI, reference, false, Port, b); err != nil {
+		return errors.Wrap(err, "enqueue AppTimeAns error")
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package clocksync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+)
+
+func TestClockSync(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+
+	Convey("Given a clean database with an activated device", t, func() {
+		test.MustResetDB(common.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			ClockSyncEnabled: true,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(common.DB, &d), ShouldBeNil)
+		So(storage.CreateDeviceActivation(common.DB, &storage.DeviceActivation{
+			DevEUI:  d.DevEUI,
+			DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+			AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		}), ShouldBeNil)
+
+		// 2017-01-01T00:00:00Z equals 1167264018 seconds since the GPS epoch
+		rxTime := time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		Convey("When handling an AppTimeReq of a device which is 10 seconds ahead", func() {
+			// DeviceTime 1167264028, TokenReq 5
+			So(HandleUplink(d.DevEUI, rxTime, []byte{0x01, 0x1c, 0x09, 0x93, 0x45, 0x05}), ShouldBeNil)
+
+			Convey("Then an AppTimeAns with a time correction of -10 seconds was enqueued", func() {
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				req := <-nsClient.CreateDeviceQueueItemChan
+				So(req.Item.FPort, ShouldEqual, Port)
+
+				data, err := lorawan.EncryptFRMPayload(lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, false, lorawan.DevAddr{1, 2, 3, 4}, req.Item.FCnt, req.Item.FrmPayload)
+				So(err, ShouldBeNil)
+				So(data, ShouldResemble, []byte{0x01, 0xf6, 0xff, 0xff, 0xff, 0x05})
+			})
+
+			Convey("Then the clock sync was stored", func() {
+				dcs, err := storage.GetDeviceClockSync(common.DB, d.DevEUI)
+				So(err, ShouldBeNil)
+				So(dcs.SyncedAt.Equal(rxTime), ShouldBeTrue)
+				So(dcs.TimeCorrection, ShouldEqual, -10)
+			})
+		})
+
+		Convey("When handling an AppTimeReq of a device which is in sync and does not require an answer", func() {
+			So(HandleUplink(d.DevEUI, rxTime, []byte{0x01, 0x12, 0x09, 0x93, 0x45, 0x00}), ShouldBeNil)
+
+			Convey("Then no AppTimeAns was enqueued", func() {
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given the application has a resync interval of one hour", func() {
+			app.ClockSyncResyncInterval = time.Hour
+			So(storage.UpdateApplication(common.DB, app), ShouldBeNil)
+
+			Convey("When handling the clock resync", func() {
+				So(handleResync(), ShouldBeNil)
+
+				Convey("Then a ForceDeviceResyncReq was enqueued", func() {
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				})
+
+				Convey("Then the device is not forced again within the interval", func() {
+					So(handleResync(), ShouldBeNil)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+
+					dcs, err := storage.GetDeviceClockSync(common.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					So(dcs.ForcedAt, ShouldNotBeNil)
+				})
+			})
+
+			Convey("When the network-server fails to enqueue the ForceDeviceResyncReq", func() {
+				nsClient.CreateDeviceQueueItemError = errors.New("boom")
+				So(handleResync(), ShouldBeNil)
+
+				Convey("Then the device has been marked as forced", func() {
+					dcs, err := storage.GetDeviceClockSync(common.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					So(dcs.ForcedAt, ShouldNotBeNil)
+				})
+
+				Convey("Then no downlink has been created", func() {
+					count, err := storage.GetDownlinkCountForDevEUI(common.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When the device synchronized its clock recently", func() {
+				So(storage.SetDeviceClockSynced(common.DB, d.DevEUI, time.Now(), 0), ShouldBeNil)
+
+				Convey("Then the device is not forced to resync", func() {
+					So(handleResync(), ShouldBeNil)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When the clock sync is disabled", func() {
+				app.ClockSyncEnabled = false
+				So(storage.UpdateApplication(common.DB, app), ShouldBeNil)
+
+				Convey("Then the device is not forced to resync", func() {
+					So(handleResync(), ShouldBeNil)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+
+					_, err := storage.GetDeviceClockSync(common.DB, d.DevEUI)
+					So(errors.Cause(err), ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+		})
+	})
+}
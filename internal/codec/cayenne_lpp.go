@@ -15,14 +15,27 @@ const (
 	lppDigitalOutput     byte = 1
 	lppAnalogInput       byte = 2
 	lppAnalogOutput      byte = 3
+	lppGenericSensor     byte = 100
 	lppIlluminanceSensor byte = 101
 	lppPresenseSensor    byte = 102
 	lppTemperatureSensor byte = 103
 	lppHumiditySensor    byte = 104
 	lppAccelerometer     byte = 113
 	lppBarometer         byte = 115
+	lppVoltage           byte = 116
+	lppCurrent           byte = 117
+	lppFrequency         byte = 118
+	lppPercentage        byte = 120
+	lppAltitude          byte = 121
+	lppPower             byte = 128
+	lppDistance          byte = 130
+	lppEnergy            byte = 131
+	lppDirection         byte = 132
+	lppUnixTime          byte = 133
 	lppGyrometer         byte = 134
+	lppColour            byte = 135
 	lppGPSLocation       byte = 136
+	lppSwitch            byte = 142
 )
 
 // Accelerometer defines the accelerometer data.
@@ -46,20 +59,56 @@ type GPSLocation struct {
 	Altitude  float64 `json:"altitude"`
 }
 
+// Colour defines the RGB colour data.
+type Colour struct {
+	R uint8 `json:"r"`
+	G uint8 `json:"g"`
+	B uint8 `json:"b"`
+}
+
+// UnknownType defines the channel and type which could not be decoded
+// (lenient mode only).
+type UnknownType struct {
+	Channel uint8 `json:"channel"`
+	Type    uint8 `json:"type"`
+}
+
 // CayenneLPP defines the Cayenne LPP data structure.
 type CayenneLPP struct {
 	DigitalInput      map[byte]uint8         `json:"digitalInput,omitempty"`
 	DigitalOutput     map[byte]uint8         `json:"digitalOutput,omitempty"`
 	AnalogInput       map[byte]float64       `json:"analogInput,omitempty"`
 	AnalogOutput      map[byte]float64       `json:"analogOutput,omitempty"`
+	GenericSensor     map[byte]uint32        `json:"genericSensor,omitempty"`
 	IlluminanceSensor map[byte]uint16        `json:"illuminanceSensor,omitempty"`
 	PresenceSensor    map[byte]uint8         `json:"presenceSensor,omitempty"`
 	TemperatureSensor map[byte]float64       `json:"temperatureSensor,omitempty"`
 	HumiditySensor    map[byte]float64       `json:"humiditySensor,omitempty"`
 	Accelerometer     map[byte]Accelerometer `json:"accelerometer,omitempty"`
 	Barometer         map[byte]float64       `json:"barometer,omitempty"`
+	Voltage           map[byte]float64       `json:"voltage,omitempty"`
+	Current           map[byte]float64       `json:"current,omitempty"`
+	Frequency         map[byte]uint32        `json:"frequency,omitempty"`
+	Percentage        map[byte]uint8         `json:"percentage,omitempty"`
+	Altitude          map[byte]int16         `json:"altitude,omitempty"`
+	Power             map[byte]uint16        `json:"power,omitempty"`
+	Distance          map[byte]float64       `json:"distance,omitempty"`
+	Energy            map[byte]float64       `json:"energy,omitempty"`
+	Direction         map[byte]uint16        `json:"direction,omitempty"`
+	UnixTime          map[byte]uint32        `json:"unixTime,omitempty"`
 	Gyrometer         map[byte]Gyrometer     `json:"gyrometer,omitempty"`
+	Colour            map[byte]Colour        `json:"colour,omitempty"`
 	GPSLocation       map[byte]GPSLocation   `json:"gpsLocation,omitempty"`
+	Switch            map[byte]uint8         `json:"switch,omitempty"`
+
+	// UnknownType is set in lenient mode when the payload contains an
+	// unknown data type. The channels decoded before are kept.
+	UnknownType *UnknownType `json:"unknownType,omitempty"`
+
+	// Lenient enables the lenient decode mode. As the length of an unknown
+	// data type can't be determined, decoding stops at the first unknown
+	// type instead of returning an error.
+	Lenient bool `json:"-"`
 }
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
@@ -86,6 +135,8 @@ func (c *CayenneLPP) UnmarshalBinary(data []byte) error {
 			err = lppAnalogInputDecode(buf[0], r, c)
 		case lppAnalogOutput:
 			err = lppAnalogOutputDecode(buf[0], r, c)
+		case lppGenericSensor:
+			err = lppGenericSensorDecode(buf[0], r, c)
 		case lppIlluminanceSensor:
 			err = lppIlluminanceSensorDecode(buf[0], r, c)
 		case lppPresenseSensor:
@@ -98,11 +149,42 @@ func (c *CayenneLPP) UnmarshalBinary(data []byte) error {
 			err = lppAccelerometerDecode(buf[0], r, c)
 		case lppBarometer:
 			err = lppBarometerDecode(buf[0], r, c)
+		case lppVoltage:
+			err = lppVoltageDecode(buf[0], r, c)
+		case lppCurrent:
+			err = lppCurrentDecode(buf[0], r, c)
+		case lppFrequency:
+			err = lppFrequencyDecode(buf[0], r, c)
+		case lppPercentage:
+			err = lppPercentageDecode(buf[0], r, c)
+		case lppAltitude:
+			err = lppAltitudeDecode(buf[0], r, c)
+		case lppPower:
+			err = lppPowerDecode(buf[0], r, c)
+		case lppDistance:
+			err = lppDistanceDecode(buf[0], r, c)
+		case lppEnergy:
+			err = lppEnergyDecode(buf[0], r, c)
+		case lppDirection:
+			err = lppDirectionDecode(buf[0], r, c)
+		case lppUnixTime:
+			err = lppUnixTimeDecode(buf[0], r, c)
 		case lppGyrometer:
 			err = lppGyrometerDecode(buf[0], r, c)
+		case lppColour:
+			err = lppColourDecode(buf[0], r, c)
 		case lppGPSLocation:
 			err = lppGPSLocationDecode(buf[0], r, c)
+		case lppSwitch:
+			err = lppSwitchDecode(buf[0], r, c)
 		default:
+			if c.Lenient {
+				c.UnknownType = &UnknownType{
+					Channel: buf[0],
+					Type:    buf[1],
+				}
+				return nil
+			}
 			return fmt.Errorf("invalid data type: %d", buf[1])
 		}
 
@@ -178,6 +260,71 @@ func (c CayenneLPP) MarshalBinary() ([]byte, error) {
 			return nil, err
 		}
 	}
+	for k, v := range c.GenericSensor {
+		if err := lppGenericSensorEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Voltage {
+		if err := lppVoltageEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Current {
+		if err := lppCurrentEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Frequency {
+		if err := lppFrequencyEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Percentage {
+		if err := lppPercentageEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Altitude {
+		if err := lppAltitudeEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Power {
+		if err := lppPowerEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Distance {
+		if err := lppDistanceEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Energy {
+		if err := lppEnergyEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Direction {
+		if err := lppDirectionEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.UnixTime {
+		if err := lppUnixTimeEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Colour {
+		if err := lppColourEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range c.Switch {
+		if err := lppSwitchEncode(k, w, v); err != nil {
+			return nil, err
+		}
+	}
 
 	return w.Bytes(), nil
 }
@@ -468,3 +615,267 @@ func lppGPSLocationEncode(channel uint8, w io.Writer, data GPSLocation) error {
 	}
 	return nil
 }
+
+func lppGenericSensorDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.GenericSensor == nil {
+		out.GenericSensor = make(map[uint8]uint32)
+	}
+	out.GenericSensor[channel] = v
+	return nil
+}
+
+func lppGenericSensorEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppGenericSensor})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppVoltageDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Voltage == nil {
+		out.Voltage = make(map[uint8]float64)
+	}
+	out.Voltage[channel] = float64(v) / 100
+	return nil
+}
+
+func lppVoltageEncode(channel uint8, w io.Writer, data float64) error {
+	w.Write([]byte{channel, lppVoltage})
+	if err := binary.Write(w, binary.BigEndian, uint16(data*100)); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppCurrentDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Current == nil {
+		out.Current = make(map[uint8]float64)
+	}
+	out.Current[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppCurrentEncode(channel uint8, w io.Writer, data float64) error {
+	w.Write([]byte{channel, lppCurrent})
+	if err := binary.Write(w, binary.BigEndian, uint16(data*1000)); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppFrequencyDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Frequency == nil {
+		out.Frequency = make(map[uint8]uint32)
+	}
+	out.Frequency[channel] = v
+	return nil
+}
+
+func lppFrequencyEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppFrequency})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppPercentageDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint8
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint8 error")
+	}
+	if out.Percentage == nil {
+		out.Percentage = make(map[uint8]uint8)
+	}
+	out.Percentage[channel] = v
+	return nil
+}
+
+func lppPercentageEncode(channel uint8, w io.Writer, data uint8) error {
+	w.Write([]byte{channel, lppPercentage})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint8 error")
+	}
+	return nil
+}
+
+func lppAltitudeDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v int16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read int16 error")
+	}
+	if out.Altitude == nil {
+		out.Altitude = make(map[uint8]int16)
+	}
+	out.Altitude[channel] = v
+	return nil
+}
+
+func lppAltitudeEncode(channel uint8, w io.Writer, data int16) error {
+	w.Write([]byte{channel, lppAltitude})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write int16 error")
+	}
+	return nil
+}
+
+func lppPowerDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Power == nil {
+		out.Power = make(map[uint8]uint16)
+	}
+	out.Power[channel] = v
+	return nil
+}
+
+func lppPowerEncode(channel uint8, w io.Writer, data uint16) error {
+	w.Write([]byte{channel, lppPower})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppDistanceDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Distance == nil {
+		out.Distance = make(map[uint8]float64)
+	}
+	out.Distance[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppDistanceEncode(channel uint8, w io.Writer, data float64) error {
+	w.Write([]byte{channel, lppDistance})
+	if err := binary.Write(w, binary.BigEndian, uint32(data*1000)); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppEnergyDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.Energy == nil {
+		out.Energy = make(map[uint8]float64)
+	}
+	out.Energy[channel] = float64(v) / 1000
+	return nil
+}
+
+func lppEnergyEncode(channel uint8, w io.Writer, data float64) error {
+	w.Write([]byte{channel, lppEnergy})
+	if err := binary.Write(w, binary.BigEndian, uint32(data*1000)); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppDirectionDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint16
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint16 error")
+	}
+	if out.Direction == nil {
+		out.Direction = make(map[uint8]uint16)
+	}
+	out.Direction[channel] = v
+	return nil
+}
+
+func lppDirectionEncode(channel uint8, w io.Writer, data uint16) error {
+	w.Write([]byte{channel, lppDirection})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint16 error")
+	}
+	return nil
+}
+
+func lppUnixTimeDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint32
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint32 error")
+	}
+	if out.UnixTime == nil {
+		out.UnixTime = make(map[uint8]uint32)
+	}
+	out.UnixTime[channel] = v
+	return nil
+}
+
+func lppUnixTimeEncode(channel uint8, w io.Writer, data uint32) error {
+	w.Write([]byte{channel, lppUnixTime})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint32 error")
+	}
+	return nil
+}
+
+func lppSwitchDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	var v uint8
+	if err := binary.Read(r, binary.BigEndian, &v); err != nil {
+		return errors.Wrap(err, "read uint8 error")
+	}
+	if out.Switch == nil {
+		out.Switch = make(map[uint8]uint8)
+	}
+	out.Switch[channel] = v
+	return nil
+}
+
+func lppSwitchEncode(channel uint8, w io.Writer, data uint8) error {
+	w.Write([]byte{channel, lppSwitch})
+	if err := binary.Write(w, binary.BigEndian, data); err != nil {
+		return errors.Wrap(err, "write uint8 error")
+	}
+	return nil
+}
+
+func lppColourDecode(channel uint8, r io.Reader, out *CayenneLPP) error {
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return errors.Wrap(err, "read error")
+	}
+	if out.Colour == nil {
+		out.Colour = make(map[uint8]Colour)
+	}
+	out.Colour[channel] = Colour{
+		R: buf[0],
+		G: buf[1],
+		B: buf[2],
+	}
+	return nil
+}
+
+func lppColourEncode(channel uint8, w io.Writer, data Colour) error {
+	w.Write([]byte{channel, lppColour})
+	if _, err := w.Write([]byte{data.R, data.G, data.B}); err != nil {
+		return errors.Wrap(err, "write error")
+	}
+	return nil
+}
@@ -133,6 +133,81 @@ func TestCayenneLPPDecode(t *testing.T) {
 					},
 				},
 			},
+			{
+				Name:  "generic sensor",
+				Bytes: []byte{1, 100, 0, 1, 0, 0},
+				Struct: CayenneLPP{
+					GenericSensor: map[byte]uint32{
+						1: 65536,
+					},
+				},
+			},
+			{
+				Name:  "voltage and current",
+				Bytes: []byte{1, 116, 4, 226, 2, 117, 1, 244},
+				Struct: CayenneLPP{
+					Voltage: map[byte]float64{
+						1: 12.5,
+					},
+					Current: map[byte]float64{
+						2: 0.5,
+					},
+				},
+			},
+			{
+				Name:  "frequency and power",
+				Bytes: []byte{1, 118, 0, 0, 3, 232, 2, 128, 0, 100},
+				Struct: CayenneLPP{
+					Frequency: map[byte]uint32{
+						1: 1000,
+					},
+					Power: map[byte]uint16{
+						2: 100,
+					},
+				},
+			},
+			{
+				Name:  "percentage, altitude and direction",
+				Bytes: []byte{1, 120, 50, 2, 121, 255, 156, 3, 132, 0, 90},
+				Struct: CayenneLPP{
+					Percentage: map[byte]uint8{
+						1: 50,
+					},
+					Altitude: map[byte]int16{
+						2: -100,
+					},
+					Direction: map[byte]uint16{
+						3: 90,
+					},
+				},
+			},
+			{
+				Name:  "distance and energy",
+				Bytes: []byte{1, 130, 0, 0, 5, 220, 2, 131, 0, 0, 8, 202},
+				Struct: CayenneLPP{
+					Distance: map[byte]float64{
+						1: 1.5,
+					},
+					Energy: map[byte]float64{
+						2: 2.25,
+					},
+				},
+			},
+			{
+				Name:  "unix time, colour and switch",
+				Bytes: []byte{1, 133, 90, 0, 0, 0, 2, 135, 255, 128, 0, 3, 142, 1},
+				Struct: CayenneLPP{
+					UnixTime: map[byte]uint32{
+						1: 1509949440,
+					},
+					Colour: map[byte]Colour{
+						2: {R: 255, G: 128, B: 0},
+					},
+					Switch: map[byte]uint8{
+						3: 1,
+					},
+				},
+			},
 		}
 
 		for i, test := range tests {
@@ -154,3 +229,31 @@ func TestCayenneLPPDecode(t *testing.T) {
 		}
 	})
 }
+
+func TestCayenneLPPUnknownType(t *testing.T) {
+	Convey("Given a payload containing an unknown data type", t, func() {
+		b := []byte{3, 0, 100, 4, 255, 1, 2, 5, 0, 210}
+
+		Convey("Then decoding in strict mode returns an error", func() {
+			lpp := CayenneLPP{}
+			err := lpp.UnmarshalBinary(b)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "invalid data type: 255")
+		})
+
+		Convey("Then decoding in lenient mode keeps the decoded channels and reports the unknown type", func() {
+			lpp := CayenneLPP{Lenient: true}
+			So(lpp.UnmarshalBinary(b), ShouldBeNil)
+			So(lpp, ShouldResemble, CayenneLPP{
+				DigitalInput: map[byte]uint8{
+					3: 100,
+				},
+				UnknownType: &UnknownType{
+					Channel: 4,
+					Type:    255,
+				},
+				Lenient: true,
+			})
+		})
+	})
+}
@@ -1,14 +1,24 @@
 package codec
 
-import "encoding"
+import (
+	"encoding"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/robertkrimen/otto"
+
+	"github.com/Frankz/lorawan"
+)
 
 // Type defines the codec type.
 type Type string
 
 // Available codec types.
 const (
-	CayenneLPPType Type = "CAYENNE_LPP"
-	CustomJSType   Type = "CUSTOM_JS"
+	CayenneLPPType        Type = "CAYENNE_LPP"
+	CayenneLPPLenientType Type = "CAYENNE_LPP_LENIENT"
+	CustomJSType          Type = "CUSTOM_JS"
+	SchemaType            Type = "SCHEMA"
 )
 
 // Payload defines a codec payload.
@@ -17,15 +27,66 @@ type Payload interface {
 	encoding.BinaryUnmarshaler
 }
 
+// Context holds the device context which is exposed to codecs implementing
+// ContextPayload.
+type Context struct {
+	ApplicationID int64           `json:"applicationID,string"`
+	DevEUI        lorawan.EUI64   `json:"devEUI"`
+	DeviceName    string          `json:"deviceName"`
+	FCnt          uint32          `json:"fCnt"`
+	RXInfo        interface{}     `json:"rxInfo"`
+	State         json.RawMessage `json:"state"`
+}
+
+// ContextPayload defines a codec payload which is able to use the device
+// context and to update the persisted device state when decoding.
+type ContextPayload interface {
+	Payload
+	SetContext(ctx *Context)
+}
+
 // NewPayload returns a new codec payload. In case of an unknown Type, nil is
-// returned.
-func NewPayload(t Type, fPort uint8, encodeScript, decodeScript string) Payload {
+// returned. For the SchemaType, the decodeScript holds the (JSON encoded)
+// schema. The applicationID is used to isolate the cached JS runtimes of
+// the applications.
+func NewPayload(t Type, applicationID int64, fPort uint8, encodeScript, decodeScript string) Payload {
 	switch t {
 	case CayenneLPPType:
 		return &CayenneLPP{}
+	case CayenneLPPLenientType:
+		return &CayenneLPP{Lenient: true}
 	case CustomJSType:
-		return NewCustomJS(fPort, encodeScript, decodeScript)
+		js := NewCustomJS(fPort, encodeScript, decodeScript)
+		js.applicationID = applicationID
+		return js
+	case SchemaType:
+		return NewSchemaCodec(fPort, decodeScript)
 	default:
 		return nil
 	}
 }
+
+// NewUncachedPayload returns a new codec payload, like NewPayload. For the
+// CustomJSType, the scripts are not added to the script cache. This must be
+// used for one-off invocations (e.g. testing a codec), so that these don't
+// evict the scripts of the applications from the cache.
+func NewUncachedPayload(t Type, fPort uint8, encodeScript, decodeScript string) Payload {
+	pl := NewPayload(t, 0, fPort, encodeScript, decodeScript)
+	if js, ok := pl.(*CustomJS); ok {
+		js.uncached = true
+	}
+	return pl
+}
+
+// ErrorDetails returns the message of the given codec error. For JavaScript
+// errors, this includes the stack-trace with the line and column numbers.
+func ErrorDetails(err error) string {
+	switch e := errors.Cause(err).(type) {
+	case *otto.Error:
+		return e.String()
+	case otto.Error:
+		return e.String()
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,34 @@
+package codec
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestErrorDetails(t *testing.T) {
+	Convey("Given a decoder script raising an error on line 3", t, func() {
+		script := `function Decode(fPort, bytes) {
+	var x = 1;
+	return foo;
+}`
+		js := NewCustomJS(1, "", script)
+		err := js.UnmarshalBinary([]byte{1})
+		So(err, ShouldNotBeNil)
+
+		Convey("Then ErrorDetails contains the error and line number", func() {
+			details := ErrorDetails(err)
+			So(details, ShouldContainSubstring, "ReferenceError: 'foo' is not defined")
+			So(details, ShouldContainSubstring, ":3:")
+		})
+	})
+
+	Convey("Given a non JavaScript error", t, func() {
+		err := errors.New("execution timeout")
+
+		Convey("Then ErrorDetails returns the error message", func() {
+			So(ErrorDetails(err), ShouldEqual, "execution timeout")
+		})
+	})
+}
@@ -13,12 +13,27 @@ import (
 // run.
 var CodecMaxExecTime = 10 * time.Millisecond
 
+// MaxStateSize holds the max. size (in bytes) of the JSON encoded device
+// state returned by the (custom) codec.
+var MaxStateSize = 1024
+
+// The calls invoking the codec functions. These are evaluated on a VM on
+// which the codec script has already been evaluated.
+var (
+	jsDecodeCall        = mustCompile("Decode(fPort, bytes);")
+	jsDecodeContextCall = mustCompile("var __context = JSON.parse(__contextJSON);\nvar __result = Decode(fPort, bytes, __context);\nvar __state = JSON.stringify(__context.state);\n__result;")
+	jsEncodeCall        = mustCompile("Encode(fPort, obj);")
+)
+
 // CustomJS is a scriptable JS codec.
 type CustomJS struct {
-	fPort        uint8
-	encodeScript string
-	decodeScript string
-	data         interface{}
+	applicationID int64
+	uncached      bool
+	fPort         uint8
+	encodeScript  string
+	decodeScript  string
+	data          interface{}
+	context       *Context
 }
 
 // NewCustomJS creates a new custom JS codec.
@@ -40,95 +55,128 @@ func (c *CustomJS) UnmarshalJSON(text []byte) error {
 	return json.Unmarshal(text, &c.data)
 }
 
+// SetContext implements ContextPayload. When set, the context is passed as
+// third argument to the Decode function and context.state is read back
+// after decoding.
+func (c *CustomJS) SetContext(ctx *Context) {
+	c.context = ctx
+}
+
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
-func (c *CustomJS) UnmarshalBinary(data []byte) (err error) {
-	defer func() {
-		if caught := recover(); caught != nil {
-			err = fmt.Errorf("%s", caught)
+func (c *CustomJS) UnmarshalBinary(data []byte) error {
+	script, err := c.getScript(c.decodeScript)
+	if err != nil {
+		return err
+	}
+
+	vars := map[string]interface{}{
+		"bytes": data,
+		"fPort": c.fPort,
+	}
+	call := jsDecodeCall
+
+	if c.context != nil {
+		if len(c.context.State) == 0 {
+			c.context.State = json.RawMessage("{}")
+		}
+
+		ctxJSON, err := json.Marshal(c.context)
+		if err != nil {
+			return errors.Wrap(err, "marshal context error")
 		}
-	}()
+		vars["__contextJSON"] = string(ctxJSON)
+		call = jsDecodeContextCall
+	}
 
-	script := c.decodeScript + "\n\nDecode(fPort, bytes);\n"
+	return script.run(vars, call, func(vm *otto.Otto, val otto.Value) error {
+		if !val.IsObject() {
+			return errors.New("function must return object")
+		}
 
-	vm := otto.New()
-	vm.Interrupt = make(chan func(), 1)
-	vm.SetStackDepthLimit(32)
-	vm.Set("bytes", data)
-	vm.Set("fPort", c.fPort)
+		var err error
+		c.data, err = val.Export()
+		if err != nil {
+			return errors.Wrap(err, "export error")
+		}
 
-	go func() {
-		time.Sleep(CodecMaxExecTime)
-		vm.Interrupt <- func() {
-			panic(errors.New("execution timeout"))
+		if c.context != nil {
+			return c.readState(vm)
 		}
-	}()
 
-	var val otto.Value
-	val, err = vm.Run(script)
+		return nil
+	})
+}
+
+// readState reads the (updated) state from the context object.
+func (c *CustomJS) readState(vm *otto.Otto) error {
+	val, err := vm.Get("__state")
 	if err != nil {
-		return errors.Wrap(err, "js vm error")
+		return errors.Wrap(err, "get state error")
 	}
 
-	if !val.IsObject() {
-		return errors.New("function must return object")
+	// JSON.stringify returns undefined when the state was removed
+	if !val.IsString() {
+		c.context.State = json.RawMessage("{}")
+		return nil
 	}
 
-	c.data, err = val.Export()
-	if err != nil {
-		return errors.Wrap(err, "export error")
+	state := val.String()
+	if len(state) > MaxStateSize {
+		return fmt.Errorf("state exceeds max. size of %d bytes", MaxStateSize)
 	}
+	c.context.State = json.RawMessage(state)
 
 	return nil
 }
 
 // MarshalBinary implements encoding.BinaryMashaler.
-func (c CustomJS) MarshalBinary() (b []byte, err error) {
-	defer func() {
-		if caught := recover(); caught != nil {
-			err = fmt.Errorf("%s", caught)
-		}
-	}()
+func (c CustomJS) MarshalBinary() ([]byte, error) {
+	script, err := c.getScript(c.encodeScript)
+	if err != nil {
+		return nil, err
+	}
 
-	script := c.encodeScript + "\n\nEncode(fPort, obj);\n"
+	vars := map[string]interface{}{
+		"obj":   c.data,
+		"fPort": c.fPort,
+	}
 
-	vm := otto.New()
-	vm.Interrupt = make(chan func(), 1)
-	vm.SetStackDepthLimit(32)
-	vm.Set("obj", c.data)
-	vm.Set("fPort", c.fPort)
+	var b []byte
+	err = script.run(vars, jsEncodeCall, func(vm *otto.Otto, val otto.Value) error {
+		if !val.IsObject() {
+			return errors.New("function must return a slice")
+		}
 
-	go func() {
-		time.Sleep(CodecMaxExecTime)
-		vm.Interrupt <- func() {
-			panic(errors.New("execution timeout"))
+		out, err := val.Export()
+		if err != nil {
+			return errors.Wrap(err, "export error")
 		}
-	}()
 
-	var val otto.Value
-	val, err = vm.Run(script)
-	if err != nil {
-		return nil, errors.Wrap(err, "js vm error")
-	}
+		switch v := out.(type) {
+		case []interface{}:
+			b, err = interfaceSliceToBytes(v)
+		case []float64:
+			b, err = floatSliceToBytes(v)
+		default:
+			return fmt.Errorf("function must return type slice, got: %T", v)
+		}
 
-	if !val.IsObject() {
-		return nil, errors.New("function must return a slice")
-	}
-	var out interface{}
-	out, err = val.Export()
+		return err
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "export error")
+		return nil, err
 	}
 
-	switch v := out.(type) {
-	case []interface{}:
-		b, err = interfaceSliceToBytes(v)
-	case []float64:
-		b, err = floatSliceToBytes(v)
-	default:
-		return nil, fmt.Errorf("function must return type slice, got: %T", v)
-	}
+	return b, nil
+}
 
-	return b, err
+// getScript returns the script for the given source, from the cache unless
+// the codec is uncached.
+func (c CustomJS) getScript(src string) (*jsScript, error) {
+	if c.uncached {
+		return newJSScript(src)
+	}
+	return getJSScript(c.applicationID, src)
 }
 
 func floatSliceToBytes(items []float64) ([]byte, error) {
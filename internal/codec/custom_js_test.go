@@ -1,11 +1,17 @@
 package codec
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/pkg/errors"
+	"github.com/robertkrimen/otto"
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lorawan"
 )
 
 func TestCustomJSDecode(t *testing.T) {
@@ -80,6 +86,192 @@ func TestCustomJSDecode(t *testing.T) {
 	})
 }
 
+func TestCustomJSDecodeWithContext(t *testing.T) {
+	Convey("Given a decode script using the device context", t, func() {
+		script := `
+			function Decode(fPort, bytes, context) {
+				var count = (context.state.count || 0) + 1;
+				context.state.count = count;
+				return {
+					"devEUI": context.devEUI,
+					"deviceName": context.deviceName,
+					"fCnt": context.fCnt,
+					"count": count
+				};
+			}
+		`
+		ctx := Context{
+			ApplicationID: 1,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			DeviceName:    "test-device",
+			FCnt:          10,
+		}
+
+		js := NewCustomJS(1, "", script)
+		js.SetContext(&ctx)
+
+		Convey("Then the context is passed and the state is initialized", func() {
+			So(js.UnmarshalBinary([]byte{1}), ShouldBeNil)
+
+			b, err := js.MarshalJSON()
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `{"count":1,"devEUI":"0102030405060708","deviceName":"test-device","fCnt":10}`)
+			So(string(ctx.State), ShouldEqual, `{"count":1}`)
+
+			Convey("Then the updated state is used on the next invocation", func() {
+				js := NewCustomJS(1, "", script)
+				js.SetContext(&ctx)
+				So(js.UnmarshalBinary([]byte{1}), ShouldBeNil)
+				So(string(ctx.State), ShouldEqual, `{"count":2}`)
+			})
+		})
+
+		Convey("Then a state exceeding the max. size returns an error", func() {
+			js := NewCustomJS(1, "", fmt.Sprintf(`
+				function Decode(fPort, bytes, context) {
+					context.state.data = new Array(%d).join("x");
+					return {};
+				}
+			`, MaxStateSize+1))
+			js.SetContext(&ctx)
+
+			err := js.UnmarshalBinary([]byte{1})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, fmt.Sprintf("state exceeds max. size of %d bytes", MaxStateSize))
+			So(string(ctx.State), ShouldEqual, `{}`)
+		})
+
+		Convey("Then scripts without context argument keep working", func() {
+			js := NewCustomJS(1, "", `
+				function Decode(fPort, bytes) {
+					return {"on": bytes[0] == 1};
+				}
+			`)
+			js.SetContext(&ctx)
+			So(js.UnmarshalBinary([]byte{1}), ShouldBeNil)
+			So(string(ctx.State), ShouldEqual, `{}`)
+		})
+	})
+}
+
+func TestCustomJSRuntime(t *testing.T) {
+	Convey("Given a decode script", t, func() {
+		script := `
+			function Decode(fPort, bytes) {
+				return {"value": bytes[0]};
+			}
+		`
+
+		Convey("When decoding twice", func() {
+			js := NewCustomJS(1, "", script)
+			So(js.UnmarshalBinary([]byte{1}), ShouldBeNil)
+			js = NewCustomJS(1, "", script)
+			So(js.UnmarshalBinary([]byte{2}), ShouldBeNil)
+
+			Convey("Then the compiled script is cached", func() {
+				s1, err := getJSScript(0, script)
+				So(err, ShouldBeNil)
+				s2, err := getJSScript(0, script)
+				So(err, ShouldBeNil)
+				So(s1, ShouldEqual, s2)
+			})
+
+			Convey("Then the second invocation returns its own result", func() {
+				b, err := js.MarshalJSON()
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"value":2}`)
+			})
+		})
+
+		Convey("Then the same script is cached separately per application", func() {
+			s1, err := getJSScript(1, script)
+			So(err, ShouldBeNil)
+			s2, err := getJSScript(2, script)
+			So(err, ShouldBeNil)
+			So(s1, ShouldNotEqual, s2)
+		})
+
+		Convey("Then a script exceeding the max. size returns an error", func() {
+			js := NewCustomJS(1, "", strings.Repeat(" ", CodecMaxScriptSize+1))
+			err := js.UnmarshalBinary([]byte{1})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, fmt.Sprintf("script exceeds max. size of %d bytes", CodecMaxScriptSize))
+		})
+	})
+
+	Convey("Given a script which sets a global", t, func() {
+		script := `
+			function Decode(fPort, bytes) {
+				var previous = typeof lastValue;
+				lastValue = bytes[0];
+				return {"previous": previous};
+			}
+		`
+
+		Convey("When decoding twice", func() {
+			js := NewCustomJS(1, "", script)
+			So(js.UnmarshalBinary([]byte{1}), ShouldBeNil)
+			js = NewCustomJS(1, "", script)
+			So(js.UnmarshalBinary([]byte{2}), ShouldBeNil)
+
+			Convey("Then the global is undefined in the second invocation", func() {
+				b, err := js.MarshalJSON()
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `{"previous":"undefined"}`)
+			})
+		})
+	})
+
+	Convey("Given a script which timed out", t, func() {
+		script := `
+			function Decode(fPort, bytes) {
+				if (bytes[0] == 1) {
+					while(true) {}
+				}
+				return {"value": bytes[0]};
+			}
+		`
+		js := NewCustomJS(1, "", script)
+		err := js.UnmarshalBinary([]byte{1})
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldEqual, "execution timeout")
+
+		Convey("Then the next invocation succeeds", func() {
+			js := NewCustomJS(1, "", script)
+			So(js.UnmarshalBinary([]byte{2}), ShouldBeNil)
+			b, err := js.MarshalJSON()
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `{"value":2}`)
+		})
+	})
+
+	Convey("Given an uncached payload", t, func() {
+		script := `
+			function Decode(fPort, bytes) {
+				return {"uncached": bytes[0]};
+			}
+		`
+		pl := NewUncachedPayload(CustomJSType, 1, "", script)
+		So(pl.UnmarshalBinary([]byte{1}), ShouldBeNil)
+
+		Convey("Then the script has not been cached", func() {
+			jsScripts.RLock()
+			_, ok := jsScripts.entries[jsScriptKey{hash: sha256.Sum256([]byte(script))}]
+			jsScripts.RUnlock()
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
+
+func TestValidateScript(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		So(ValidateScript(""), ShouldBeNil)
+		So(ValidateScript("function Decode(fPort, bytes) { return {}; }"), ShouldBeNil)
+		So(ValidateScript("function Decode(fPort, bytes) {"), ShouldNotBeNil)
+		So(ValidateScript(strings.Repeat(" ", CodecMaxScriptSize+1)), ShouldNotBeNil)
+	})
+}
+
 func TestCustomEncodeJS(t *testing.T) {
 	Convey("Given a set of tests", t, func() {
 		tests := []struct {
@@ -142,3 +334,112 @@ func TestCustomEncodeJS(t *testing.T) {
 		}
 	})
 }
+
+const benchmarkDecodeScript = `
+	function Decode(fPort, bytes) {
+		return {
+			"temperature": ((bytes[0] << 8) | bytes[1]) / 10,
+			"humidity": bytes[2] / 2
+		};
+	}
+`
+
+func BenchmarkCustomJSDecode(b *testing.B) {
+	data := []byte{1, 16, 41}
+
+	for i := 0; i < b.N; i++ {
+		js := NewCustomJS(1, "", benchmarkDecodeScript)
+		if err := js.UnmarshalBinary(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCustomJSDecodeParallel(b *testing.B) {
+	data := []byte{1, 16, 41}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			js := NewCustomJS(1, "", benchmarkDecodeScript)
+			if err := js.UnmarshalBinary(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkCustomJSDecodeWithoutCache creates a new VM and parses the script
+// on every invocation, to compare against the cached runtime.
+func BenchmarkCustomJSDecodeWithoutCache(b *testing.B) {
+	data := []byte{1, 16, 41}
+
+	for i := 0; i < b.N; i++ {
+		vm := otto.New()
+		vm.Set("bytes", data)
+		vm.Set("fPort", 1)
+		val, err := vm.Run(benchmarkDecodeScript + "\n\nDecode(fPort, bytes);\n")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := val.Export(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkJSScriptRunCopy runs the decode call on a copy of the pristine
+// VM, as done for every codec invocation.
+func BenchmarkJSScriptRunCopy(b *testing.B) {
+	s, err := newJSScript(benchmarkDecodeScript)
+	if err != nil {
+		b.Fatal(err)
+	}
+	vars := map[string]interface{}{
+		"bytes": []byte{1, 16, 41},
+		"fPort": 1,
+	}
+
+	for i := 0; i < b.N; i++ {
+		err := s.run(vars, jsDecodeCall, func(vm *otto.Otto, val otto.Value) error {
+			_, err := val.Export()
+			return err
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkJSScriptRunPooled runs the decode call on pooled VMs, which are
+// re-used without being reset, to compare against BenchmarkJSScriptRunCopy.
+func BenchmarkJSScriptRunPooled(b *testing.B) {
+	s, err := newJSScript(benchmarkDecodeScript)
+	if err != nil {
+		b.Fatal(err)
+	}
+	vms := sync.Pool{
+		New: func() interface{} {
+			s.Lock()
+			vm := s.vm.Copy()
+			s.Unlock()
+
+			vm.Interrupt = make(chan func(), 1)
+			return vm
+		},
+	}
+
+	for i := 0; i < b.N; i++ {
+		vm := vms.Get().(*otto.Otto)
+		vm.Set("bytes", []byte{1, 16, 41})
+		vm.Set("fPort", 1)
+
+		val, err := runWithTimeout(vm, jsDecodeCall)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := val.Export(); err != nil {
+			b.Fatal(err)
+		}
+		vms.Put(vm)
+	}
+}
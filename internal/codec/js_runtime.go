@@ -0,0 +1,190 @@
+package codec
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robertkrimen/otto"
+)
+
+// CodecScriptCacheSize holds the max. number of compiled (custom) codec
+// scripts which are cached.
+var CodecScriptCacheSize = 1024
+
+// CodecMaxScriptSize holds the max. size (in bytes) of a (custom) codec
+// script.
+var CodecMaxScriptSize = 64 * 1024
+
+// codecStackDepthLimit limits the call-stack depth of the codec scripts.
+const codecStackDepthLimit = 32
+
+var errExecutionTimeout = errors.New("execution timeout")
+
+// jsScriptKey defines the key of a cached script. The application ID is
+// part of the key, so that cached scripts are never shared between
+// applications.
+type jsScriptKey struct {
+	applicationID int64
+	hash          [sha256.Size]byte
+}
+
+// jsScripts holds the compiled scripts, keyed by application and the
+// SHA-256 hash of the script source.
+var jsScripts = struct {
+	sync.RWMutex
+	entries map[jsScriptKey]*jsScript
+}{
+	entries: make(map[jsScriptKey]*jsScript),
+}
+
+// jsScript holds a pristine VM on which the script has been evaluated
+// (e.g. the Decode function is defined). Every invocation runs on a copy of
+// this VM, so that globals set by the script are never retained between
+// invocations (and thus devices).
+//
+// The VMs are not pooled, as otto is not able to reset a used VM to its
+// pristine state. Likewise, otto does not account the memory allocated by
+// a script, the memory usage is bounded by the CodecMaxExecTime and the
+// codecStackDepthLimit instead. See BenchmarkJSScriptRunCopy and
+// BenchmarkJSScriptRunPooled for the cost of copying the VM compared to
+// re-using it.
+type jsScript struct {
+	sync.Mutex
+	vm *otto.Otto
+}
+
+// getJSScript returns the (cached) script for the given application and
+// source.
+func getJSScript(applicationID int64, src string) (*jsScript, error) {
+	key := jsScriptKey{
+		applicationID: applicationID,
+		hash:          sha256.Sum256([]byte(src)),
+	}
+
+	jsScripts.RLock()
+	s, ok := jsScripts.entries[key]
+	jsScripts.RUnlock()
+	if ok {
+		return s, nil
+	}
+
+	s, err := newJSScript(src)
+	if err != nil {
+		return nil, err
+	}
+
+	jsScripts.Lock()
+	if len(jsScripts.entries) >= CodecScriptCacheSize {
+		// evict a random entry
+		for k := range jsScripts.entries {
+			delete(jsScripts.entries, k)
+			break
+		}
+	}
+	jsScripts.entries[key] = s
+	jsScripts.Unlock()
+
+	return s, nil
+}
+
+// ValidateScript validates the given (custom) codec script, it must not
+// exceed the CodecMaxScriptSize and must compile. An empty script is valid.
+func ValidateScript(src string) error {
+	if len(src) > CodecMaxScriptSize {
+		return fmt.Errorf("script exceeds max. size of %d bytes", CodecMaxScriptSize)
+	}
+
+	if _, err := otto.New().Compile("", src); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// newJSScript compiles and evaluates the given source on a new VM.
+func newJSScript(src string) (*jsScript, error) {
+	if len(src) > CodecMaxScriptSize {
+		return nil, fmt.Errorf("script exceeds max. size of %d bytes", CodecMaxScriptSize)
+	}
+
+	script, err := otto.New().Compile("", src)
+	if err != nil {
+		return nil, errors.Wrap(err, "js vm error")
+	}
+
+	vm := newVM()
+	if _, err := runWithTimeout(vm, script); err != nil {
+		return nil, err
+	}
+
+	return &jsScript{vm: vm}, nil
+}
+
+// run runs the given call on a copy of the pristine VM, after setting the
+// given variables. The result must be handled (exported) within fn.
+func (s *jsScript) run(vars map[string]interface{}, call *otto.Script, fn func(vm *otto.Otto, val otto.Value) error) error {
+	s.Lock()
+	vm := s.vm.Copy()
+	s.Unlock()
+
+	vm.Interrupt = make(chan func(), 1)
+	vm.SetStackDepthLimit(codecStackDepthLimit)
+
+	for k, v := range vars {
+		if err := vm.Set(k, v); err != nil {
+			return errors.Wrap(err, "set variable error")
+		}
+	}
+
+	val, err := runWithTimeout(vm, call)
+	if err != nil {
+		return err
+	}
+
+	return fn(vm, val)
+}
+
+// newVM returns a new VM with the codec limits applied.
+func newVM() *otto.Otto {
+	vm := otto.New()
+	vm.Interrupt = make(chan func(), 1)
+	vm.SetStackDepthLimit(codecStackDepthLimit)
+	return vm
+}
+
+// runWithTimeout runs the given source or script, interrupting it when it
+// exceeds the CodecMaxExecTime.
+func runWithTimeout(vm *otto.Otto, src interface{}) (val otto.Value, err error) {
+	timer := time.AfterFunc(CodecMaxExecTime, func() {
+		vm.Interrupt <- func() {
+			panic(errExecutionTimeout)
+		}
+	})
+
+	defer func() {
+		timer.Stop()
+
+		if caught := recover(); caught != nil {
+			err = fmt.Errorf("%s", caught)
+		}
+	}()
+
+	val, err = vm.Run(src)
+	if err != nil {
+		return val, errors.Wrap(err, "js vm error")
+	}
+
+	return val, nil
+}
+
+// mustCompile compiles the given source or panics.
+func mustCompile(src string) *otto.Script {
+	script, err := otto.New().Compile("", src)
+	if err != nil {
+		panic(err)
+	}
+	return script
+}
@@ -0,0 +1,369 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+// Endianness defines the byte-order of a schema field.
+type Endianness string
+
+// Available endianness options. An empty value defaults to BigEndian.
+const (
+	BigEndian    Endianness = "big"
+	LittleEndian Endianness = "little"
+)
+
+// Schema defines a declarative binary payload definition.
+type Schema struct {
+	// Endianness defines the default byte-order of the fields.
+	Endianness Endianness `json:"endianness"`
+
+	// Layouts holds the field layouts. The layout matching the fPort is
+	// used, or else the layout without fPorts (if any).
+	Layouts []SchemaLayout `json:"layouts"`
+}
+
+// SchemaLayout defines the fields of a payload for a set of fPorts.
+type SchemaLayout struct {
+	FPorts []uint8       `json:"fPorts"`
+	Fields []SchemaField `json:"fields"`
+}
+
+// SchemaField defines a single field of a payload.
+type SchemaField struct {
+	// Name of the field in the decoded object.
+	Name string `json:"name"`
+
+	// Offset (in bytes) of the field within the payload.
+	Offset int `json:"offset"`
+
+	// Length (in bytes) of the field (1 - 8).
+	Length int `json:"length"`
+
+	// Endianness overrides the schema endianness.
+	Endianness Endianness `json:"endianness"`
+
+	// Signed defines if the value is signed (two's complement).
+	Signed bool `json:"signed"`
+
+	// BitOffset and BitLength define a bit-field within the value. The
+	// BitOffset is counted from the least significant bit. When BitLength
+	// is 0, the whole value is used.
+	BitOffset int `json:"bitOffset"`
+	BitLength int `json:"bitLength"`
+
+	// Scale and Add are applied on decoding (value * scale + add) and
+	// reversed on encoding. A Scale of 0 is handled as 1.
+	Scale float64 `json:"scale"`
+	Add   float64 `json:"add"`
+}
+
+// ParseSchema parses and validates the given (JSON encoded) schema.
+func ParseSchema(s string) (Schema, error) {
+	var schema Schema
+	if err := json.Unmarshal([]byte(s), &schema); err != nil {
+		return schema, errors.Wrap(err, "unmarshal schema error")
+	}
+
+	if err := schema.Validate(); err != nil {
+		return schema, err
+	}
+
+	return schema, nil
+}
+
+// Validate validates the schema.
+func (s Schema) Validate() error {
+	if !s.Endianness.valid() {
+		return fmt.Errorf("invalid endianness: %s", s.Endianness)
+	}
+
+	if len(s.Layouts) == 0 {
+		return errors.New("at least one layout is required")
+	}
+
+	var hasDefault bool
+	fPorts := make(map[uint8]struct{})
+
+	for i, layout := range s.Layouts {
+		if len(layout.FPorts) == 0 {
+			if hasDefault {
+				return errors.New("only one layout without fPorts is allowed")
+			}
+			hasDefault = true
+		}
+
+		for _, fPort := range layout.FPorts {
+			if _, ok := fPorts[fPort]; ok {
+				return fmt.Errorf("fPort %d is used by multiple layouts", fPort)
+			}
+			fPorts[fPort] = struct{}{}
+		}
+
+		names := make(map[string]struct{})
+		for _, f := range layout.Fields {
+			if f.Name == "" {
+				return fmt.Errorf("layout %d: field name is required", i)
+			}
+			if _, ok := names[f.Name]; ok {
+				return fmt.Errorf("layout %d: duplicate field name: %s", i, f.Name)
+			}
+			names[f.Name] = struct{}{}
+
+			if err := f.validate(); err != nil {
+				return errors.Wrapf(err, "layout %d: field %s", i, f.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// layoutForFPort returns the layout for the given fPort.
+func (s Schema) layoutForFPort(fPort uint8) (SchemaLayout, error) {
+	var def *SchemaLayout
+	for i := range s.Layouts {
+		if len(s.Layouts[i].FPorts) == 0 {
+			def = &s.Layouts[i]
+		}
+		for _, p := range s.Layouts[i].FPorts {
+			if p == fPort {
+				return s.Layouts[i], nil
+			}
+		}
+	}
+
+	if def == nil {
+		return SchemaLayout{}, fmt.Errorf("no layout for fPort %d", fPort)
+	}
+	return *def, nil
+}
+
+func (e Endianness) valid() bool {
+	switch e {
+	case "", BigEndian, LittleEndian:
+		return true
+	default:
+		return false
+	}
+}
+
+func (f SchemaField) validate() error {
+	if f.Offset < 0 {
+		return errors.New("offset must be >= 0")
+	}
+	if f.Length < 1 || f.Length > 8 {
+		return errors.New("length must be between 1 and 8 bytes")
+	}
+	if !f.Endianness.valid() {
+		return fmt.Errorf("invalid endianness: %s", f.Endianness)
+	}
+	if f.BitOffset < 0 || f.BitLength < 0 || f.BitOffset+f.BitLength > f.Length*8 {
+		return errors.New("bit-field exceeds field length")
+	}
+	if f.BitLength == 0 && f.BitOffset != 0 {
+		return errors.New("bitOffset requires bitLength to be set")
+	}
+	return nil
+}
+
+func (f SchemaField) bits() uint {
+	if f.BitLength != 0 {
+		return uint(f.BitLength)
+	}
+	return uint(f.Length * 8)
+}
+
+func (f SchemaField) mask() uint64 {
+	if f.bits() == 64 {
+		return math.MaxUint64
+	}
+	return (1 << f.bits()) - 1
+}
+
+func (f SchemaField) scale() float64 {
+	if f.Scale == 0 {
+		return 1
+	}
+	return f.Scale
+}
+
+// readRaw reads the raw (unsigned) value of the field from b.
+func (f SchemaField) readRaw(b []byte, e Endianness) uint64 {
+	if f.Endianness != "" {
+		e = f.Endianness
+	}
+
+	var v uint64
+	for i := 0; i < f.Length; i++ {
+		if e == LittleEndian {
+			v |= uint64(b[f.Offset+i]) << (8 * uint(i))
+		} else {
+			v = v<<8 | uint64(b[f.Offset+i])
+		}
+	}
+	return v
+}
+
+// writeRaw writes the raw (unsigned) value of the field to b.
+func (f SchemaField) writeRaw(b []byte, e Endianness, v uint64) {
+	if f.Endianness != "" {
+		e = f.Endianness
+	}
+
+	for i := 0; i < f.Length; i++ {
+		shift := 8 * uint(i)
+		if e != LittleEndian {
+			shift = 8 * uint(f.Length-1-i)
+		}
+		b[f.Offset+i] = byte(v >> shift)
+	}
+}
+
+// decode decodes the field value from b.
+func (f SchemaField) decode(b []byte, e Endianness) float64 {
+	raw := (f.readRaw(b, e) >> uint(f.BitOffset)) & f.mask()
+
+	var v float64
+	if f.Signed && raw&(1<<(f.bits()-1)) != 0 {
+		v = float64(int64(raw | ^f.mask()))
+	} else {
+		v = float64(raw)
+	}
+
+	return v*f.scale() + f.Add
+}
+
+// encode encodes the field value into b. Bit-fields sharing the same bytes
+// are merged.
+func (f SchemaField) encode(b []byte, e Endianness, value float64) error {
+	v := (value - f.Add) / f.scale()
+	if v < 0 {
+		v = -math.Floor(-v + 0.5)
+	} else {
+		v = math.Floor(v + 0.5)
+	}
+
+	bits := f.bits()
+	if f.Signed {
+		min := -math.Pow(2, float64(bits-1))
+		if v < min || v > -min-1 {
+			return fmt.Errorf("value %v out of range", value)
+		}
+	} else if v < 0 || v > math.Pow(2, float64(bits))-1 {
+		return fmt.Errorf("value %v out of range", value)
+	}
+
+	var u uint64
+	if v < 0 {
+		u = uint64(int64(v))
+	} else {
+		u = uint64(v)
+	}
+
+	raw := f.readRaw(b, e)
+	raw &^= f.mask() << uint(f.BitOffset)
+	raw |= (u & f.mask()) << uint(f.BitOffset)
+	f.writeRaw(b, e, raw)
+
+	return nil
+}
+
+// SchemaCodec implements a payload codec defined by a Schema.
+type SchemaCodec struct {
+	fPort  uint8
+	schema string
+	data   map[string]interface{}
+}
+
+// NewSchemaCodec creates a new schema codec. The schema is parsed on
+// (un)marshaling.
+func NewSchemaCodec(fPort uint8, schema string) *SchemaCodec {
+	return &SchemaCodec{
+		fPort:  fPort,
+		schema: schema,
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c SchemaCodec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.data)
+}
+
+// UnmarshalJSON implement json.Unmarshaler.
+func (c *SchemaCodec) UnmarshalJSON(text []byte) error {
+	return json.Unmarshal(text, &c.data)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (c *SchemaCodec) UnmarshalBinary(data []byte) error {
+	schema, err := ParseSchema(c.schema)
+	if err != nil {
+		return errors.Wrap(err, "parse schema error")
+	}
+
+	layout, err := schema.layoutForFPort(c.fPort)
+	if err != nil {
+		return err
+	}
+
+	c.data = make(map[string]interface{})
+	for _, f := range layout.Fields {
+		if f.Offset+f.Length > len(data) {
+			return fmt.Errorf("field %s: payload too short", f.Name)
+		}
+		c.data[f.Name] = f.decode(data, schema.Endianness)
+	}
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (c SchemaCodec) MarshalBinary() ([]byte, error) {
+	schema, err := ParseSchema(c.schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse schema error")
+	}
+
+	layout, err := schema.layoutForFPort(c.fPort)
+	if err != nil {
+		return nil, err
+	}
+
+	var size int
+	for _, f := range layout.Fields {
+		if f.Offset+f.Length > size {
+			size = f.Offset + f.Length
+		}
+	}
+
+	b := make([]byte, size)
+	for _, f := range layout.Fields {
+		val, ok := c.data[f.Name]
+		if !ok {
+			return nil, fmt.Errorf("field %s: value is missing", f.Name)
+		}
+
+		var v float64
+		switch t := val.(type) {
+		case float64:
+			v = t
+		case bool:
+			if t {
+				v = 1
+			}
+		default:
+			return nil, fmt.Errorf("field %s: expected number, got: %T", f.Name, val)
+		}
+
+		if err := f.encode(b, schema.Endianness, v); err != nil {
+			return nil, errors.Wrapf(err, "field %s", f.Name)
+		}
+	}
+
+	return b, nil
+}
@@ -0,0 +1,137 @@
+package codec
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testSchema = `{
+	"layouts": [
+		{
+			"fPorts": [1],
+			"fields": [
+				{"name": "temperature", "offset": 0, "length": 2, "signed": true, "scale": 0.1},
+				{"name": "humidity", "offset": 2, "length": 1, "scale": 0.5},
+				{"name": "battery", "offset": 3, "length": 2, "endianness": "little", "add": 1000},
+				{"name": "alarm", "offset": 5, "length": 1, "bitOffset": 0, "bitLength": 1},
+				{"name": "mode", "offset": 5, "length": 1, "bitOffset": 4, "bitLength": 3}
+			]
+		},
+		{
+			"fields": [
+				{"name": "counter", "offset": 0, "length": 4}
+			]
+		}
+	]
+}`
+
+func TestSchemaCodec(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name         string
+			FPort        uint8
+			Bytes        []byte
+			ExpectedJSON string
+		}{
+			{
+				Name:         "fPort layout",
+				FPort:        1,
+				Bytes:        []byte{255, 156, 41, 208, 7, 49},
+				ExpectedJSON: `{"alarm":1,"battery":3000,"humidity":20.5,"mode":3,"temperature":-10}`,
+			},
+			{
+				Name:         "default layout",
+				FPort:        2,
+				Bytes:        []byte{0, 1, 0, 0},
+				ExpectedJSON: `{"counter":65536}`,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				Convey("Decoding", func() {
+					c := NewSchemaCodec(test.FPort, testSchema)
+					So(c.UnmarshalBinary(test.Bytes), ShouldBeNil)
+
+					b, err := c.MarshalJSON()
+					So(err, ShouldBeNil)
+					So(string(b), ShouldEqual, test.ExpectedJSON)
+				})
+
+				Convey("Encoding", func() {
+					c := NewSchemaCodec(test.FPort, testSchema)
+					So(c.UnmarshalJSON([]byte(test.ExpectedJSON)), ShouldBeNil)
+
+					b, err := c.MarshalBinary()
+					So(err, ShouldBeNil)
+					So(b, ShouldResemble, test.Bytes)
+				})
+			})
+		}
+	})
+
+	Convey("Given a schema codec", t, func() {
+		c := NewSchemaCodec(1, testSchema)
+
+		Convey("Then decoding a too short payload returns an error", func() {
+			err := c.UnmarshalBinary([]byte{1, 2})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "field humidity: payload too short")
+		})
+
+		Convey("Then encoding an out of range value returns an error", func() {
+			So(c.UnmarshalJSON([]byte(`{"alarm":2,"battery":3000,"humidity":20.5,"mode":3,"temperature":-10}`)), ShouldBeNil)
+			_, err := c.MarshalBinary()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "field alarm: value 2 out of range")
+		})
+	})
+}
+
+func TestParseSchema(t *testing.T) {
+	Convey("Given a set of invalid schemas", t, func() {
+		tests := []struct {
+			Schema        string
+			ExpectedError string
+		}{
+			{
+				Schema:        `{}`,
+				ExpectedError: "at least one layout is required",
+			},
+			{
+				Schema:        `{"endianness": "middle", "layouts": [{"fields": []}]}`,
+				ExpectedError: "invalid endianness: middle",
+			},
+			{
+				Schema:        `{"layouts": [{"fields": []}, {"fields": []}]}`,
+				ExpectedError: "only one layout without fPorts is allowed",
+			},
+			{
+				Schema:        `{"layouts": [{"fPorts": [1], "fields": []}, {"fPorts": [1], "fields": []}]}`,
+				ExpectedError: "fPort 1 is used by multiple layouts",
+			},
+			{
+				Schema:        `{"layouts": [{"fields": [{"name": "a", "length": 9}]}]}`,
+				ExpectedError: "layout 0: field a: length must be between 1 and 8 bytes",
+			},
+			{
+				Schema:        `{"layouts": [{"fields": [{"name": "a", "length": 1, "bitOffset": 4, "bitLength": 5}]}]}`,
+				ExpectedError: "layout 0: field a: bit-field exceeds field length",
+			},
+			{
+				Schema:        `{"layouts": [{"fields": [{"name": "a", "length": 1}, {"name": "a", "length": 1}]}]}`,
+				ExpectedError: "layout 0: duplicate field name: a",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.ExpectedError, i), func() {
+				_, err := ParseSchema(test.Schema)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, test.ExpectedError)
+			})
+		}
+	})
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"time"
 
+	"github.com/Frankz/lora-app-server/internal/geolocation"
 	"github.com/Frankz/lora-app-server/internal/handler"
 	"github.com/Frankz/lora-app-server/internal/nsclient"
 	"github.com/garyburd/redigo/redis"
@@ -20,6 +21,10 @@ var NetworkServerPool nsclient.Pool
 // Handler holds the handler of events.
 var Handler handler.Handler
 
+// GeolocationResolver holds the resolver used for resolving the device
+// location on uplink (nil = disabled).
+var GeolocationResolver geolocation.Resolver
+
 // GatewayPingFrequency holds the frequency used for sending gateway pings.
 var GatewayPingFrequency int
 
@@ -29,8 +34,43 @@ var GatewayPingDR int
 // GatewayPingInterval holds the interval of the gateway ping.
 var GatewayPingInterval time.Duration
 
+// GatewayPingConcurrency holds the max. number of gateway pings sent
+// concurrently.
+var GatewayPingConcurrency int
+
+// DeviceEventMaxAge holds the max age of the stored device events
+// (0 = no limit).
+var DeviceEventMaxAge time.Duration
+
+// DeviceEventMaxCount holds the max number of stored events per device
+// (0 = no limit).
+var DeviceEventMaxCount int
+
 // ApplicationServerID holds the application-server ID (UUID).
 var ApplicationServerID = "6d5db27e-4ce2-4b2b-b5d7-91f069397978"
 
 // ApplicationServerServer holds the hostname:IP of the application-server.
 var ApplicationServerServer = "localhost:8001"
+
+// DownlinkACKTimeout holds the duration after which a confirmed downlink
+// without acknowledgement expires (0 = disabled).
+var DownlinkACKTimeout time.Duration
+
+// DownlinkMaxAge holds the max age of the stored downlinks (0 = no limit).
+var DownlinkMaxAge time.Duration
+
+// DownlinkMaxCount holds the max number of stored downlinks per device
+// (0 = no limit).
+var DownlinkMaxCount int
+
+// DeviceHealthCheckInterval holds the interval in which the device alert
+// rules are evaluated (0 = disabled).
+var DeviceHealthCheckInterval time.Duration
+
+// GatewayOfflineTimeout holds the duration after which a gateway which has
+// not been seen is marked as offline (0 = gateway status tracking disabled).
+var GatewayOfflineTimeout time.Duration
+
+// GatewayStatusCheckInterval holds the interval in which the gateway status
+// is updated.
+var GatewayStatusCheckInterval time.Duration
@@ -0,0 +1,211 @@
+// Package coverage aggregates the gateway coverage from the device uplinks
+// and gateway pings, binned into geohash cells.
+package coverage
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+// StoragePrecision defines the geohash precision (length) in which the
+// uplink coverage is stored. This is also the max. precision which can be
+// requested.
+const StoragePrecision = 9
+
+const (
+	uplinkLockTempl = "lora:as:coverage:%s:%s"
+
+	// uplinkCoverageInterval defines the interval in which at most one
+	// uplink per device and cell is added to the coverage.
+	uplinkCoverageInterval = time.Minute
+)
+
+// Cell contains the coverage of a geohash cell.
+type Cell struct {
+	Geohash  string
+	Bounds   Bounds
+	Gateways []GatewayCoverage
+}
+
+// GatewayCoverage contains the RSSI and SNR of the transmissions within a
+// cell, received by a gateway.
+type GatewayCoverage struct {
+	MAC        lorawan.EUI64
+	Count      int64
+	RSSIMin    int
+	RSSIMax    int
+	RSSIAvg    float64
+	LoRaSNRMin float64
+	LoRaSNRMax float64
+	LoRaSNRAvg float64
+}
+
+// HandleUplink adds the RSSI and SNR of the gateways receiving an uplink
+// transmitted by the given device at the given location to the coverage.
+// At most one uplink per device and cell is added every
+// uplinkCoverageInterval, the other uplinks are ignored.
+func HandleUplink(db sqlx.Execer, devEUI lorawan.EUI64, lat, lon float64, rxInfo []handler.RXInfo) error {
+	hash := EncodeGeohash(lat, lon, StoragePrecision)
+
+	ok, err := acquireUplinkLock(devEUI, hash)
+	if err != nil {
+		return errors.Wrap(err, "acquire lock error")
+	}
+	if !ok {
+		return nil
+	}
+
+	// a gateway might be reported more than once, keep the best reception
+	var rxs []storage.GatewayCoverageRX
+	index := make(map[lorawan.EUI64]int)
+	for _, rx := range rxInfo {
+		i, ok := index[rx.MAC]
+		if !ok {
+			index[rx.MAC] = len(rxs)
+			rxs = append(rxs, storage.GatewayCoverageRX{GatewayMAC: rx.MAC, RSSI: rx.RSSI, LoRaSNR: rx.LoRaSNR})
+			continue
+		}
+		if rx.RSSI > rxs[i].RSSI {
+			rxs[i].RSSI = rx.RSSI
+			rxs[i].LoRaSNR = rx.LoRaSNR
+		}
+	}
+
+	if err := storage.AddGatewayCoverage(db, hash, rxs); err != nil {
+		return errors.Wrap(err, "add gateway coverage error")
+	}
+	return nil
+}
+
+// acquireUplinkLock returns true when the uplink of the given device within
+// the given cell must be added to the coverage.
+func acquireUplinkLock(devEUI lorawan.EUI64, hash string) (bool, error) {
+	key := fmt.Sprintf(uplinkLockTempl, devEUI, hash)
+	c := common.RedisPool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", key, "lock", "PX", int64(uplinkCoverageInterval/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetCoverageForOrganizationID returns the coverage of the gateways of the
+// given organization, binned into geohash cells of the given precision.
+//
+// The coverage is built from the device uplinks and all gateway pings. For
+// the pings, the link between two gateways is assumed to be reciprocal: the
+// location of the receiving gateway is used as transmitter location and the
+// gateway sending the ping as receiver. The pings are aggregated per
+// receiving location by the database.
+func GetCoverageForOrganizationID(db sqlx.Queryer, organizationID int64, precision int) ([]Cell, error) {
+	gcs, err := storage.GetGatewayCoverageForOrganizationID(db, organizationID, precision)
+	if err != nil {
+		return nil, errors.Wrap(err, "get gateway coverage error")
+	}
+
+	pcs, err := storage.GetGatewayPingCoverageForOrganizationID(db, organizationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get gateway ping coverage error")
+	}
+
+	for _, pc := range pcs {
+		gcs = append(gcs, storage.GatewayCoverage{
+			Geohash:    EncodeGeohash(pc.Latitude, pc.Longitude, precision),
+			GatewayMAC: pc.GatewayMAC,
+			Count:      pc.Count,
+			RSSIMin:    pc.RSSIMin,
+			RSSIMax:    pc.RSSIMax,
+			RSSISum:    pc.RSSISum,
+			LoRaSNRMin: pc.LoRaSNRMin,
+			LoRaSNRMax: pc.LoRaSNRMax,
+			LoRaSNRSum: pc.LoRaSNRSum,
+		})
+	}
+
+	return aggregate(gcs)
+}
+
+// aggregate merges the given coverage per geohash cell and gateway. The
+// cells are sorted by geohash, the gateways within a cell by MAC.
+func aggregate(gcs []storage.GatewayCoverage) ([]Cell, error) {
+	sort.SliceStable(gcs, func(i, j int) bool {
+		if gcs[i].Geohash != gcs[j].Geohash {
+			return gcs[i].Geohash < gcs[j].Geohash
+		}
+		return bytes.Compare(gcs[i].GatewayMAC[:], gcs[j].GatewayMAC[:]) < 0
+	})
+
+	var merged []storage.GatewayCoverage
+	for _, gc := range gcs {
+		if gc.Count == 0 {
+			continue
+		}
+
+		if len(merged) == 0 || merged[len(merged)-1].Geohash != gc.Geohash || merged[len(merged)-1].GatewayMAC != gc.GatewayMAC {
+			merged = append(merged, gc)
+			continue
+		}
+
+		m := &merged[len(merged)-1]
+		m.Count += gc.Count
+		m.RSSISum += gc.RSSISum
+		m.LoRaSNRSum += gc.LoRaSNRSum
+		if gc.RSSIMin < m.RSSIMin {
+			m.RSSIMin = gc.RSSIMin
+		}
+		if gc.RSSIMax > m.RSSIMax {
+			m.RSSIMax = gc.RSSIMax
+		}
+		if gc.LoRaSNRMin < m.LoRaSNRMin {
+			m.LoRaSNRMin = gc.LoRaSNRMin
+		}
+		if gc.LoRaSNRMax > m.LoRaSNRMax {
+			m.LoRaSNRMax = gc.LoRaSNRMax
+		}
+	}
+
+	var cells []Cell
+	for _, m := range merged {
+		if len(cells) == 0 || cells[len(cells)-1].Geohash != m.Geohash {
+			b, err := DecodeGeohash(m.Geohash)
+			if err != nil {
+				return nil, errors.Wrap(err, "decode geohash error")
+			}
+			cells = append(cells, Cell{
+				Geohash: m.Geohash,
+				Bounds:  b,
+			})
+		}
+
+		c := &cells[len(cells)-1]
+		c.Gateways = append(c.Gateways, GatewayCoverage{
+			MAC:        m.GatewayMAC,
+			Count:      m.Count,
+			RSSIMin:    m.RSSIMin,
+			RSSIMax:    m.RSSIMax,
+			RSSIAvg:    float64(m.RSSISum) / float64(m.Count),
+			LoRaSNRMin: m.LoRaSNRMin,
+			LoRaSNRMax: m.LoRaSNRMax,
+			LoRaSNRAvg: m.LoRaSNRSum / float64(m.Count),
+		})
+	}
+
+	return cells, nil
+}
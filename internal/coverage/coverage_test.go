@@ -0,0 +1,152 @@
+package coverage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+func TestAggregate(t *testing.T) {
+	Convey("Given coverage for two cells and two gateways", t, func() {
+		gcs := []storage.GatewayCoverage{
+			{Geohash: "u1", GatewayMAC: lorawan.EUI64{2}, Count: 1, RSSIMin: -100, RSSIMax: -100, RSSISum: -100, LoRaSNRMin: 1, LoRaSNRMax: 1, LoRaSNRSum: 1},
+			{Geohash: "u0", GatewayMAC: lorawan.EUI64{1}, Count: 2, RSSIMin: -90, RSSIMax: -80, RSSISum: -170, LoRaSNRMin: 5, LoRaSNRMax: 7, LoRaSNRSum: 12},
+			{Geohash: "u1", GatewayMAC: lorawan.EUI64{1}, Count: 1, RSSIMin: -110, RSSIMax: -110, RSSISum: -110, LoRaSNRMin: -5, LoRaSNRMax: -5, LoRaSNRSum: -5},
+			{Geohash: "u0", GatewayMAC: lorawan.EUI64{1}, Count: 1, RSSIMin: -120, RSSIMax: -120, RSSISum: -120, LoRaSNRMin: -10, LoRaSNRMax: -10, LoRaSNRSum: -10},
+		}
+
+		Convey("Then aggregate merges the coverage per cell and gateway", func() {
+			cells, err := aggregate(gcs)
+			So(err, ShouldBeNil)
+			So(cells, ShouldHaveLength, 2)
+
+			So(cells[0].Geohash, ShouldEqual, "u0")
+			So(cells[0].Gateways, ShouldResemble, []GatewayCoverage{
+				{MAC: lorawan.EUI64{1}, Count: 3, RSSIMin: -120, RSSIMax: -80, RSSIAvg: -290.0 / 3, LoRaSNRMin: -10, LoRaSNRMax: 7, LoRaSNRAvg: 2.0 / 3},
+			})
+
+			So(cells[1].Geohash, ShouldEqual, "u1")
+			So(cells[1].Gateways, ShouldHaveLength, 2)
+			So(cells[1].Gateways[0].MAC, ShouldEqual, lorawan.EUI64{1})
+			So(cells[1].Gateways[1].MAC, ShouldEqual, lorawan.EUI64{2})
+
+			b, err := DecodeGeohash("u1")
+			So(err, ShouldBeNil)
+			So(cells[1].Bounds, ShouldResemble, b)
+		})
+	})
+}
+
+func TestGetCoverageForOrganizationID(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with two gateways", t, func() {
+		test.MustResetDB(common.DB)
+		test.MustFlushRedis(common.RedisPool)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		gws := []storage.Gateway{
+			{MAC: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, Name: "test-gw-1", OrganizationID: org.ID, NetworkServerID: n.ID},
+			{MAC: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, Name: "test-gw-2", OrganizationID: org.ID, NetworkServerID: n.ID},
+		}
+		for i := range gws {
+			So(storage.CreateGateway(common.DB, &gws[i]), ShouldBeNil)
+		}
+
+		Convey("When handling two uplinks of two devices transmitted within the same cell", func() {
+			So(HandleUplink(common.DB, lorawan.EUI64{1}, 52.3740, 4.8897, []handler.RXInfo{
+				{MAC: gws[0].MAC, RSSI: -80, LoRaSNR: 7},
+				{MAC: gws[1].MAC, RSSI: -110, LoRaSNR: -5},
+				{MAC: lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3}, RSSI: -100, LoRaSNR: 1},
+			}), ShouldBeNil)
+			So(HandleUplink(common.DB, lorawan.EUI64{2}, 52.3741, 4.8898, []handler.RXInfo{
+				{MAC: gws[0].MAC, RSSI: -90, LoRaSNR: 5},
+			}), ShouldBeNil)
+
+			Convey("Then the coverage contains one cell with both gateways", func() {
+				cells, err := GetCoverageForOrganizationID(common.DB, org.ID, 6)
+				So(err, ShouldBeNil)
+				So(cells, ShouldHaveLength, 1)
+				So(cells[0].Geohash, ShouldEqual, EncodeGeohash(52.3740, 4.8897, 6))
+				So(cells[0].Gateways, ShouldHaveLength, 2)
+
+				gw := cells[0].Gateways[0]
+				So(gw.MAC, ShouldEqual, gws[0].MAC)
+				So(gw.Count, ShouldEqual, 2)
+				So(gw.RSSIMin, ShouldEqual, -90)
+				So(gw.RSSIMax, ShouldEqual, -80)
+				So(gw.RSSIAvg, ShouldEqual, -85)
+				So(gw.LoRaSNRAvg, ShouldEqual, 6)
+			})
+
+			Convey("When handling an other uplink of the first device within the same cell", func() {
+				So(HandleUplink(common.DB, lorawan.EUI64{1}, 52.3742, 4.8899, []handler.RXInfo{
+					{MAC: gws[0].MAC, RSSI: -120, LoRaSNR: -10},
+				}), ShouldBeNil)
+
+				Convey("Then the uplink is not added to the coverage", func() {
+					cells, err := GetCoverageForOrganizationID(common.DB, org.ID, 6)
+					So(err, ShouldBeNil)
+					So(cells, ShouldHaveLength, 1)
+					So(cells[0].Gateways[0].Count, ShouldEqual, 2)
+					So(cells[0].Gateways[0].RSSIMin, ShouldEqual, -90)
+				})
+			})
+
+			Convey("Given a ping sent by the first gateway and received by the second", func() {
+				ping := storage.GatewayPing{
+					GatewayMAC: gws[0].MAC,
+					Frequency:  868100000,
+					DR:         5,
+				}
+				So(storage.CreateGatewayPing(common.DB, &ping), ShouldBeNil)
+				So(storage.CreateGatewayPingRX(common.DB, &storage.GatewayPingRX{
+					PingID:     ping.ID,
+					GatewayMAC: gws[1].MAC,
+					RSSI:       -100,
+					LoRaSNR:    3,
+					Location: storage.GPSPoint{
+						Latitude:  52.3740,
+						Longitude: 4.8897,
+					},
+				}), ShouldBeNil)
+
+				Convey("Then the ping is added to the coverage of the sending gateway", func() {
+					cells, err := GetCoverageForOrganizationID(common.DB, org.ID, 6)
+					So(err, ShouldBeNil)
+					So(cells, ShouldHaveLength, 1)
+
+					gw := cells[0].Gateways[0]
+					So(gw.MAC, ShouldEqual, gws[0].MAC)
+					So(gw.Count, ShouldEqual, 3)
+					So(gw.RSSIMin, ShouldEqual, -100)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,104 @@
+package coverage
+
+import (
+	"fmt"
+	"strings"
+)
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Bounds contains the bounding box of a geohash cell.
+type Bounds struct {
+	LatitudeMin  float64
+	LatitudeMax  float64
+	LongitudeMin float64
+	LongitudeMax float64
+}
+
+// Ring returns the corners of the bounding box as closed linear ring of
+// [longitude, latitude] positions, counterclockwise as required by GeoJSON.
+func (b Bounds) Ring() [][2]float64 {
+	return [][2]float64{
+		{b.LongitudeMin, b.LatitudeMin},
+		{b.LongitudeMax, b.LatitudeMin},
+		{b.LongitudeMax, b.LatitudeMax},
+		{b.LongitudeMin, b.LatitudeMax},
+		{b.LongitudeMin, b.LatitudeMin},
+	}
+}
+
+// EncodeGeohash returns the geohash of the given precision (length) for the
+// given location.
+func EncodeGeohash(lat, lon float64, precision int) string {
+	b := Bounds{LatitudeMin: -90, LatitudeMax: 90, LongitudeMin: -180, LongitudeMax: 180}
+	hash := make([]byte, 0, precision)
+	even := true
+	var bit, ch int
+
+	for len(hash) < precision {
+		// even bits encode the longitude, odd bits the latitude
+		if even {
+			mid := (b.LongitudeMin + b.LongitudeMax) / 2
+			if lon >= mid {
+				ch = ch<<1 | 1
+				b.LongitudeMin = mid
+			} else {
+				ch = ch << 1
+				b.LongitudeMax = mid
+			}
+		} else {
+			mid := (b.LatitudeMin + b.LatitudeMax) / 2
+			if lat >= mid {
+				ch = ch<<1 | 1
+				b.LatitudeMin = mid
+			} else {
+				ch = ch << 1
+				b.LatitudeMax = mid
+			}
+		}
+		even = !even
+
+		bit++
+		if bit == 5 {
+			hash = append(hash, geohashAlphabet[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return string(hash)
+}
+
+// DecodeGeohash returns the bounding box of the given geohash.
+func DecodeGeohash(hash string) (Bounds, error) {
+	b := Bounds{LatitudeMin: -90, LatitudeMax: 90, LongitudeMin: -180, LongitudeMax: 180}
+	even := true
+
+	for _, c := range hash {
+		ch := strings.IndexRune(geohashAlphabet, c)
+		if ch == -1 {
+			return b, fmt.Errorf("invalid geohash character: %q", c)
+		}
+
+		for i := 4; i >= 0; i-- {
+			set := ch>>uint(i)&1 == 1
+			if even {
+				mid := (b.LongitudeMin + b.LongitudeMax) / 2
+				if set {
+					b.LongitudeMin = mid
+				} else {
+					b.LongitudeMax = mid
+				}
+			} else {
+				mid := (b.LatitudeMin + b.LatitudeMax) / 2
+				if set {
+					b.LatitudeMin = mid
+				} else {
+					b.LatitudeMax = mid
+				}
+			}
+			even = !even
+		}
+	}
+
+	return b, nil
+}
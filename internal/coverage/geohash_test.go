@@ -0,0 +1,56 @@
+package coverage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGeohash(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Latitude  float64
+			Longitude float64
+			Precision int
+			Expected  string
+		}{
+			{57.64911, 10.40744, 11, "u4pruydqqvj"},
+			{42.605, -5.603, 5, "ezs42"},
+			{-25.382708, -49.265506, 9, "6gkzwgjzn"},
+			{0, 0, 1, "s"},
+		}
+
+		for _, test := range tests {
+			Convey("Then "+test.Expected+" is encoded and decoded", func() {
+				hash := EncodeGeohash(test.Latitude, test.Longitude, test.Precision)
+				So(hash, ShouldEqual, test.Expected)
+
+				b, err := DecodeGeohash(hash)
+				So(err, ShouldBeNil)
+				So(test.Latitude, ShouldBeBetweenOrEqual, b.LatitudeMin, b.LatitudeMax)
+				So(test.Longitude, ShouldBeBetweenOrEqual, b.LongitudeMin, b.LongitudeMax)
+			})
+		}
+
+		Convey("Then the bounds of ezs42 are returned", func() {
+			b, err := DecodeGeohash("ezs42")
+			So(err, ShouldBeNil)
+			So(b.LatitudeMin, ShouldAlmostEqual, 42.583008, 0.000001)
+			So(b.LatitudeMax, ShouldAlmostEqual, 42.626953, 0.000001)
+			So(b.LongitudeMin, ShouldAlmostEqual, -5.625, 0.000001)
+			So(b.LongitudeMax, ShouldAlmostEqual, -5.581055, 0.000001)
+		})
+
+		Convey("Then Ring returns the closed outline of the bounds", func() {
+			b := Bounds{LatitudeMin: 1, LatitudeMax: 2, LongitudeMin: 3, LongitudeMax: 4}
+			So(b.Ring(), ShouldResemble, [][2]float64{
+				{3, 1}, {4, 1}, {4, 2}, {3, 2}, {3, 1},
+			})
+		})
+
+		Convey("Then decoding an invalid geohash returns an error", func() {
+			_, err := DecodeGeohash("ezs4a")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,183 @@
+// Package cron implements parsing of (standard, five field) cron expressions
+// and calculating the next activation time of such an expression.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// searchYears defines the max number of years to search for the next
+// activation time of a schedule (e.g. "0 0 30 2 *" will never match).
+const searchYears = 5
+
+type field struct {
+	name string
+	min  int
+	max  int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// Schedule defines a parsed cron expression.
+type Schedule struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// when both the day of month and day of week are restricted (not "*"),
+	// a day matches when either of the fields matches
+	domStar bool
+	dowStar bool
+}
+
+// Parse parses the given cron expression. The expression must contain
+// five space separated fields: minute, hour, day of month, month and day
+// of week. Each field may contain "*", a single value, a range ("1-5"),
+// a step ("*/15" or "0-30/10") or a comma separated list of these.
+// For the day of week, both 0 and 7 represent Sunday.
+func Parse(expr string) (Schedule, error) {
+	var s Schedule
+
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return s, fmt.Errorf("expected %d fields, got %d", len(fields), len(parts))
+	}
+
+	masks := make([]uint64, len(fields))
+	for i, f := range fields {
+		mask, err := parseField(parts[i], f)
+		if err != nil {
+			return s, err
+		}
+		masks[i] = mask
+	}
+
+	s.minute = masks[0]
+	s.hour = masks[1]
+	s.dom = masks[2]
+	s.month = masks[3]
+	s.dow = masks[4]
+	s.domStar = parts[2] == "*"
+	s.dowStar = parts[4] == "*"
+
+	// 7 is an alias for Sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+// Next returns the first activation time after the given time. The
+// returned time uses the location of the given time. A zero time is
+// returned when the schedule does not activate within the next five years.
+func (s Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+	limit := t.Year() + searchYears
+
+	for t.Year() <= limit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func parseField(str string, f field) (uint64, error) {
+	var mask uint64
+
+	for _, part := range strings.Split(str, ",") {
+		start, end, step := f.min, f.max, 1
+
+		rangeStr := part
+		if i := strings.Index(part, "/"); i != -1 {
+			var err error
+			rangeStr = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("%s: invalid step '%s'", f.name, part[i+1:])
+			}
+		}
+
+		switch {
+		case rangeStr == "*":
+		case strings.Contains(rangeStr, "-"):
+			bounds := strings.SplitN(rangeStr, "-", 2)
+			var err error
+			if start, err = parseValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if end, err = parseValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("%s: invalid range '%s'", f.name, rangeStr)
+			}
+		default:
+			var err error
+			if start, err = parseValue(rangeStr, f); err != nil {
+				return 0, err
+			}
+			// a single value without step matches only that value,
+			// with a step ("5/15") it is the start of the range
+			if rangeStr == part {
+				end = start
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			mask |= 1 << uint(i)
+		}
+	}
+
+	return mask, nil
+}
+
+func parseValue(str string, f field) (int, error) {
+	v, err := strconv.Atoi(str)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s: value '%s' must be between %d - %d", f.name, str, f.min, f.max)
+	}
+	return v, nil
+}
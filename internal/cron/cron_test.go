@@ -0,0 +1,66 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	Convey("Given a set of invalid expressions", t, func() {
+		tests := []string{
+			"",
+			"* * * *",
+			"* * * * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * * 13 *",
+			"* * * * 8",
+			"*/0 * * * *",
+			"10-5 * * * *",
+			"a * * * *",
+		}
+
+		for _, expr := range tests {
+			Convey("Then parsing '"+expr+"' returns an error", func() {
+				_, err := Parse(expr)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
+
+func TestScheduleNext(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		now := time.Date(2018, 1, 15, 10, 30, 45, 0, time.UTC) // Monday
+
+		tests := []struct {
+			Expr     string
+			Expected time.Time
+		}{
+			{"* * * * *", time.Date(2018, 1, 15, 10, 31, 0, 0, time.UTC)},
+			{"*/15 * * * *", time.Date(2018, 1, 15, 10, 45, 0, 0, time.UTC)},
+			{"0 2 * * *", time.Date(2018, 1, 16, 2, 0, 0, 0, time.UTC)},
+			{"30 10 * * *", time.Date(2018, 1, 16, 10, 30, 0, 0, time.UTC)},
+			{"0 8-17/4 * * *", time.Date(2018, 1, 15, 12, 0, 0, 0, time.UTC)},
+			{"0 0 1 * *", time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC)},
+			{"0 0 * * 0", time.Date(2018, 1, 21, 0, 0, 0, 0, time.UTC)},
+			{"0 0 * * 7", time.Date(2018, 1, 21, 0, 0, 0, 0, time.UTC)},
+			{"0 0 * * 1,3,5", time.Date(2018, 1, 17, 0, 0, 0, 0, time.UTC)},
+			{"0 0 20 * 5", time.Date(2018, 1, 19, 0, 0, 0, 0, time.UTC)},
+			{"0 0 29 2 *", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+			{"5/20 * * * *", time.Date(2018, 1, 15, 10, 45, 0, 0, time.UTC)},
+			{"0 0 30 2 *", time.Time{}},
+		}
+
+		for _, test := range tests {
+			Convey("Then the next activation of '"+test.Expr+"' is as expected", func() {
+				s, err := Parse(test.Expr)
+				So(err, ShouldBeNil)
+				So(s.Next(now), ShouldResemble, test.Expected)
+			})
+		}
+	})
+}
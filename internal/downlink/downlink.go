@@ -47,21 +47,23 @@ func handleDataDownPayload(pl handler.DataDownPayload) error {
 		return errors.New("enqueue downlink payload: device does not exist for given application")
 	}
 
-	// if Object is set, try to encode it to bytes using the application codec
+	// if Object is set, try to encode it to bytes using the codec of the
+	// device-profile or application
 	if pl.Object != nil {
-		app, err := storage.GetApplication(common.DB, d.ApplicationID)
+		pcs, err := storage.GetPayloadCodecSettingsForDevEUI(common.DB, d.DevEUI)
 		if err != nil {
-			return errors.Wrap(err, "get application error")
+			return errors.Wrap(err, "get payload codec settings error")
 		}
 
-		// get the codec payload configured for the application
-		codecPL := codec.NewPayload(app.PayloadCodec, pl.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
+		// get the codec payload configured for the device
+		codecPL := codec.NewPayload(pcs.PayloadCodec, d.ApplicationID, pl.FPort, pcs.PayloadEncoderScript, pcs.PayloadDecoderScript)
 		if codecPL == nil {
 			log.WithFields(log.Fields{
-				"application_id": app.ID,
-				"codec_type":     app.PayloadCodec,
-			}).Error("no or invalid codec configured for application")
-			return errors.New("no or invalid codec configured for application")
+				"application_id": d.ApplicationID,
+				"dev_eui":        d.DevEUI,
+				"codec_type":     pcs.PayloadCodec,
+			}).Error("no or invalid codec configured for device-profile or application")
+			return errors.New("no or invalid codec configured for device-profile or application")
 		}
 
 		err = json.Unmarshal(pl.Object, &codecPL)
@@ -76,24 +78,27 @@ func handleDataDownPayload(pl handler.DataDownPayload) error {
 	}
 
 	return storage.Transaction(common.DB, func(tx sqlx.Ext) error {
-		if err := EnqueueDownlinkPayload(tx, pl.DevEUI, pl.Reference, pl.Confirmed, pl.FPort, pl.Data); err != nil {
+		if _, err := EnqueueDownlinkPayload(tx, pl.DevEUI, pl.Reference, pl.Confirmed, pl.FPort, pl.Data); err != nil {
 			return errors.Wrap(err, "enqueue downlink device-queue item error")
 		}
 		return nil
 	})
 }
 
-// EnqueueDownlinkPayload adds the downlink payload to the network-server
-// device-queue.
-func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, reference string, confirmed bool, fPort uint8, data []byte) error {
+// EnqueueDownlinkPayload creates a downlink record for tracking its status
+// and adds the downlink payload to the network-server device-queue. The
+// record is created first, so that the enqueued item is always tracked.
+// When enqueueing fails, the record is removed again.
+// It returns the frame-counter of the enqueued item.
+func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, reference string, confirmed bool, fPort uint8, data []byte) (uint32, error) {
 	// get network-server and network-server api client
 	n, err := storage.GetNetworkServerForDevEUI(db, devEUI)
 	if err != nil {
-		return errors.Wrap(err, "get network-server error")
+		return 0, errors.Wrap(err, "get network-server error")
 	}
 	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
 	if err != nil {
-		return errors.Wrap(err, "get network-server client error")
+		return 0, errors.Wrap(err, "get network-server client error")
 	}
 
 	// get fCnt to use for encrypting and enqueueing
@@ -101,32 +106,32 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, reference string,
 		DevEUI: devEUI[:],
 	})
 	if err != nil {
-		return errors.Wrap(err, "get next downlink fcnt for deveui error")
+		return 0, errors.Wrap(err, "get next downlink fcnt for deveui error")
 	}
 
 	// get current device-activation for AppSKey
 	da, err := storage.GetLastDeviceActivationForDevEUI(db, devEUI)
 	if err != nil {
-		return errors.Wrap(err, "get last device-activation error")
+		return 0, errors.Wrap(err, "get last device-activation error")
 	}
 
 	// encrypt payload
 	b, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, resp.FCnt, data)
 	if err != nil {
-		return errors.Wrap(err, "encrypt frmpayload error")
+		return 0, errors.Wrap(err, "encrypt frmpayload error")
 	}
 
-	// create device-queue mapping (for mapping a device-queue item to an
-	// user-given reference)
-	if confirmed == true {
-		err = storage.CreateDeviceQueueMapping(db, &storage.DeviceQueueMapping{
-			Reference: reference,
-			DevEUI:    devEUI,
-			FCnt:      resp.FCnt,
-		})
-		if err != nil {
-			return errors.Wrap(err, "create device-queue mapping error")
-		}
+	// create downlink record (for tracking the status of the device-queue
+	// item and mapping it to the user-given reference)
+	dl := storage.Downlink{
+		DevEUI:    devEUI,
+		Reference: reference,
+		FCnt:      resp.FCnt,
+		FPort:     fPort,
+		Confirmed: confirmed,
+	}
+	if err = storage.CreateDownlink(db, &dl); err != nil {
+		return 0, errors.Wrap(err, "create downlink error")
 	}
 
 	// enqueue device-queue item
@@ -140,7 +145,11 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, reference string,
 		},
 	})
 	if err != nil {
-		return errors.Wrap(err, "create device-queue item error")
+		// when not called within a transaction, the record would remain
+		if dErr := storage.DeleteDownlink(db, dl.ID); dErr != nil {
+			log.WithField("id", dl.ID).Errorf("delete downlink error: %s", dErr)
+		}
+		return 0, errors.Wrap(err, "create device-queue item error")
 	}
 
 	log.WithFields(log.Fields{
@@ -150,5 +159,57 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, reference string,
 		"confirmed": confirmed,
 	}).Info("downlink device-queue item handled")
 
-	return nil
+	return resp.FCnt, nil
+}
+
+// EnqueueMulticastPayload adds the (unconfirmed) downlink payload to the
+// network-server multicast-queue of the given multicast-group. The payload
+// is encrypted using the McAppSKey and the frame-counter of the group, so
+// that it is transmitted once for all the devices within the group.
+// It returns the frame-counter of the enqueued item.
+func EnqueueMulticastPayload(db sqlx.Ext, multicastGroupID string, fPort uint8, data []byte) (uint32, error) {
+	// the frame-counter is incremented first, so that the multicast-group
+	// is locked until the item has been enqueued
+	fCnt, err := storage.IncrementMulticastGroupFCnt(db, multicastGroupID)
+	if err != nil {
+		return 0, errors.Wrap(err, "increment multicast-group fcnt error")
+	}
+
+	mg, err := storage.GetMulticastGroup(db, multicastGroupID, false)
+	if err != nil {
+		return 0, errors.Wrap(err, "get multicast-group error")
+	}
+
+	n, err := storage.GetNetworkServerForServiceProfileID(db, mg.ServiceProfileID)
+	if err != nil {
+		return 0, errors.Wrap(err, "get network-server error")
+	}
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return 0, errors.Wrap(err, "get network-server client error")
+	}
+
+	b, err := lorawan.EncryptFRMPayload(mg.MCAppSKey, false, mg.MCAddr, fCnt, data)
+	if err != nil {
+		return 0, errors.Wrap(err, "encrypt frmpayload error")
+	}
+
+	_, err = nsClient.EnqueueMulticastQueueItem(context.Background(), &ns.EnqueueMulticastQueueItemRequest{
+		Item: &ns.MulticastQueueItem{
+			MulticastGroupID: multicastGroupID,
+			FrmPayload:       b,
+			FCnt:             fCnt,
+			FPort:            uint32(fPort),
+		},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "create multicast-queue item error")
+	}
+
+	log.WithFields(log.Fields{
+		"f_cnt":              fCnt,
+		"multicast_group_id": multicastGroupID,
+	}).Info("multicast-queue item handled")
+
+	return fCnt, nil
 }
@@ -95,7 +95,6 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 				PayloadCodec         codec.Type
 				PayloadEncoderScript string
 
-				ExpectedDeviceQueueMapping           bool
 				ExpectedError                        error
 				ExpectedCreateDeviceQueueItemRequest ns.CreateDeviceQueueItemRequest
 			}{
@@ -140,7 +139,6 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 							Confirmed:  true,
 						},
 					},
-					ExpectedDeviceQueueMapping: true,
 				},
 				{
 					Name: "invalid application id",
@@ -205,16 +203,26 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
 					So(<-nsClient.CreateDeviceQueueItemChan, ShouldResemble, test.ExpectedCreateDeviceQueueItemRequest)
 
-					if test.ExpectedDeviceQueueMapping {
-						dqm, err := storage.GetDeviceQueueMappingForDevEUIAndFCnt(common.DB, device.DevEUI, 12)
-						So(err, ShouldBeNil)
-						So(dqm.Reference, ShouldEqual, test.Payload.Reference)
-					} else {
-						_, err := storage.GetDeviceQueueMappingForDevEUIAndFCnt(common.DB, device.DevEUI, 12)
-						So(err, ShouldEqual, storage.ErrDoesNotExist)
-					}
+					dl, err := storage.GetDownlinkForDevEUIAndFCnt(common.DB, device.DevEUI, 12)
+					So(err, ShouldBeNil)
+					So(dl.Reference, ShouldEqual, test.Payload.Reference)
+					So(dl.Confirmed, ShouldEqual, test.Payload.Confirmed)
+					So(dl.FPort, ShouldEqual, test.Payload.FPort)
+					So(dl.Status, ShouldEqual, storage.DownlinkQueued)
 				})
 			}
+
+			Convey("When the network-server fails to enqueue the item", func() {
+				nsClient.CreateDeviceQueueItemError = errors.New("boom")
+				_, err := EnqueueDownlinkPayload(common.DB, device.DevEUI, "test", false, 10, []byte{1, 2, 3})
+				So(err, ShouldNotBeNil)
+
+				Convey("Then no downlink record has been created", func() {
+					count, err := storage.GetDownlinkCountForDevEUI(common.DB, device.DevEUI)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
 		})
 	})
 }
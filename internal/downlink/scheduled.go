@@ -0,0 +1,148 @@
+package downlink
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+const (
+	scheduledDownlinkLockTempl = "lora:as:scheduled-downlink:lock:%s:%d"
+	scheduledDownlinkLockTTL   = time.Minute
+	scheduledDownlinkBatchSize = 10
+)
+
+// ScheduledDownlinkLoop is a never returning function enqueueing the
+// scheduled downlinks which are due.
+func ScheduledDownlinkLoop() {
+	for {
+		if err := handleScheduledDownlinks(); err != nil {
+			log.Errorf("handle scheduled downlinks error: %s", err)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func handleScheduledDownlinks() error {
+	ids, err := storage.GetDueScheduledDownlinkIDs(common.DB, time.Now(), scheduledDownlinkBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "get due scheduled downlinks error")
+	}
+
+	for _, id := range ids {
+		if err := handleScheduledDownlink(id); err != nil {
+			log.WithField("id", id).Errorf("handle scheduled downlink error: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func handleScheduledDownlink(id string) error {
+	sd, err := storage.GetScheduledDownlink(common.DB, id, false)
+	if err != nil {
+		return errors.Wrap(err, "get scheduled downlink error")
+	}
+	if sd.NextRunAt == nil {
+		return nil
+	}
+
+	// Multiple lora-app-server instances might select the same scheduled
+	// downlink. The first instance acquiring the lock for this run handles
+	// it, the other instances ignore it.
+	locked, err := acquireScheduledDownlinkLock(sd.ID, *sd.NextRunAt)
+	if err != nil {
+		return errors.Wrap(err, "acquire lock error")
+	}
+	if !locked {
+		return nil
+	}
+
+	// The run is marked as handled before enqueueing the payloads, so that
+	// the payloads are never enqueued twice for the same run (e.g. when the
+	// lock expires). The network-server calls are made after the commit.
+	var devEUIs []lorawan.EUI64
+	err = storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		// re-fetch and lock the record, it might have been updated or
+		// handled in the meantime
+		var err error
+		sd, err = storage.GetScheduledDownlink(tx, id, true)
+		if err != nil {
+			return errors.Wrap(err, "get scheduled downlink error")
+		}
+		now := time.Now()
+		if sd.NextRunAt == nil || sd.NextRunAt.After(now) {
+			return nil
+		}
+
+		devEUIs, err = storage.GetDevEUIsForScheduledDownlink(tx, sd)
+		if err != nil {
+			return errors.Wrap(err, "get devices for scheduled downlink error")
+		}
+
+		sd.LastRunAt = &now
+		if err := sd.SetNextRunAt(now); err != nil {
+			return errors.Wrap(err, "set next run error")
+		}
+
+		if err := storage.UpdateScheduledDownlinkRun(tx, sd); err != nil {
+			return errors.Wrap(err, "update scheduled downlink run error")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var count int
+	for _, devEUI := range devEUIs {
+		// a failing device (e.g. not yet activated) must not block the
+		// other devices
+		if _, err := EnqueueDownlinkPayload(common.DB, devEUI, sd.ID, sd.Confirmed, sd.FPort, sd.Data); err != nil {
+			log.WithFields(log.Fields{
+				"id":      sd.ID,
+				"dev_eui": devEUI,
+			}).Errorf("enqueue scheduled downlink error: %s", err)
+			continue
+		}
+		count++
+	}
+
+	if len(devEUIs) != 0 {
+		log.WithFields(log.Fields{
+			"id":             sd.ID,
+			"application_id": sd.ApplicationID,
+			"device_count":   count,
+			"next_run_at":    sd.NextRunAt,
+		}).Info("scheduled downlink enqueued")
+	}
+
+	return nil
+}
+
+// acquireScheduledDownlinkLock returns true when the lock for the given
+// scheduled downlink run was acquired.
+func acquireScheduledDownlinkLock(id string, runAt time.Time) (bool, error) {
+	key := fmt.Sprintf(scheduledDownlinkLockTempl, id, runAt.UnixNano())
+	c := common.RedisPool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", key, "lock", "PX", int64(scheduledDownlinkLockTTL/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,189 @@
+package downlink
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+)
+
+func TestHandleScheduledDownlinks(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with an application and two activated devices", t, func() {
+		test.MustResetDB(common.DB)
+		test.MustFlushRedis(common.RedisPool)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetNextDownlinkFCntForDevEUIResponse = ns.GetNextDownlinkFCntForDevEUIResponse{
+			FCnt: 12,
+		}
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		devEUIs := []lorawan.EUI64{
+			{1, 1, 1, 1, 1, 1, 1, 1},
+			{2, 2, 2, 2, 2, 2, 2, 2},
+		}
+		for i, devEUI := range devEUIs {
+			So(storage.CreateDevice(common.DB, &storage.Device{
+				ApplicationID:   app.ID,
+				DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+				Name:            "test-node-" + devEUI.String(),
+				DevEUI:          devEUI,
+			}), ShouldBeNil)
+
+			So(storage.CreateDeviceActivation(common.DB, &storage.DeviceActivation{
+				DevEUI:  devEUI,
+				DevAddr: lorawan.DevAddr{1, 2, 3, byte(i)},
+				AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			}), ShouldBeNil)
+		}
+
+		Convey("Given a due one-time scheduled downlink for a single device", func() {
+			scheduleAt := time.Now().Add(-time.Second)
+			sd := storage.ScheduledDownlink{
+				Name:          "test-sd",
+				ApplicationID: app.ID,
+				Target:        storage.ScheduledDownlinkTargetDevices,
+				DevEUIs:       storage.EUI64Slice{devEUIs[1]},
+				FPort:         10,
+				Data:          []byte{1, 2, 3, 4},
+				ScheduleAt:    &scheduleAt,
+			}
+			So(storage.CreateScheduledDownlink(common.DB, &sd), ShouldBeNil)
+
+			Convey("When handling the scheduled downlinks", func() {
+				So(handleScheduledDownlinks(), ShouldBeNil)
+
+				Convey("Then the payload was enqueued for the device", func() {
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+					req := <-nsClient.CreateDeviceQueueItemChan
+					So(req.Item.DevEUI, ShouldResemble, devEUIs[1][:])
+					So(req.Item.FPort, ShouldEqual, 10)
+				})
+
+				Convey("Then it will not run again", func() {
+					sdGet, err := storage.GetScheduledDownlink(common.DB, sd.ID, false)
+					So(err, ShouldBeNil)
+					So(sdGet.LastRunAt, ShouldNotBeNil)
+					So(sdGet.NextRunAt, ShouldBeNil)
+
+					So(handleScheduledDownlinks(), ShouldBeNil)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("When the network-server fails to enqueue the payload", func() {
+				nsClient.CreateDeviceQueueItemError = errors.New("boom")
+				So(handleScheduledDownlinks(), ShouldBeNil)
+
+				Convey("Then the run has been marked as handled", func() {
+					sdGet, err := storage.GetScheduledDownlink(common.DB, sd.ID, false)
+					So(err, ShouldBeNil)
+					So(sdGet.LastRunAt, ShouldNotBeNil)
+					So(sdGet.NextRunAt, ShouldBeNil)
+				})
+
+				Convey("Then no downlink has been created", func() {
+					count, err := storage.GetDownlinkCountForDevEUI(common.DB, devEUIs[1])
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When the run has been locked by an other instance", func() {
+				locked, err := acquireScheduledDownlinkLock(sd.ID, *sd.NextRunAt)
+				So(err, ShouldBeNil)
+				So(locked, ShouldBeTrue)
+
+				Convey("Then handling the scheduled downlinks does not enqueue the payload", func() {
+					So(handleScheduledDownlinks(), ShouldBeNil)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+				})
+			})
+		})
+
+		Convey("Given a due recurring scheduled downlink for the application", func() {
+			sd := storage.ScheduledDownlink{
+				Name:          "test-sd",
+				ApplicationID: app.ID,
+				Target:        storage.ScheduledDownlinkTargetApplication,
+				FPort:         10,
+				Data:          []byte{1, 2, 3, 4},
+				Cron:          "0 2 * * *",
+			}
+			So(storage.CreateScheduledDownlink(common.DB, &sd), ShouldBeNil)
+
+			// make it due
+			past := time.Now().Add(-time.Minute)
+			sd.NextRunAt = &past
+			So(storage.UpdateScheduledDownlinkRun(common.DB, sd), ShouldBeNil)
+
+			Convey("When handling the scheduled downlinks", func() {
+				So(handleScheduledDownlinks(), ShouldBeNil)
+
+				Convey("Then the payload was enqueued for all devices of the application", func() {
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+				})
+
+				Convey("Then the next run is set to the next activation of the cron expression", func() {
+					sdGet, err := storage.GetScheduledDownlink(common.DB, sd.ID, false)
+					So(err, ShouldBeNil)
+					So(sdGet.LastRunAt, ShouldNotBeNil)
+					So(sdGet.NextRunAt, ShouldNotBeNil)
+					So(sdGet.NextRunAt.After(time.Now()), ShouldBeTrue)
+					So(sdGet.NextRunAt.UTC().Hour(), ShouldEqual, 2)
+					So(sdGet.NextRunAt.UTC().Minute(), ShouldEqual, 0)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,225 @@
+package downlink
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/eventlog"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+const (
+	downlinkStatusInterval  = 10 * time.Second
+	downlinkStatusBatchSize = 10
+	downlinkStatusLockKey   = "lora:as:downlink-status:lock"
+	downlinkStatusLockTTL   = time.Second
+	downlinkPruneInterval   = time.Minute
+)
+
+// downlinkPrunedAt holds the time the downlinks were last pruned by this
+// instance.
+var downlinkPrunedAt time.Time
+
+// DownlinkStatusLoop is a never returning function updating the status of
+// the tracked downlinks. Queued downlinks which are no longer in the
+// network-server device-queue are marked as sent and confirmed downlinks
+// which have not been acknowledged within common.DownlinkACKTimeout are
+// marked as expired. Every downlinkPruneInterval, the downlinks exceeding
+// common.DownlinkMaxAge or common.DownlinkMaxCount are removed. When
+// running multiple instances, only one of them polls the network-server
+// per iteration.
+func DownlinkStatusLoop() {
+	for {
+		locked, err := acquireDownlinkStatusLock()
+		if err != nil {
+			log.Errorf("acquire downlink status lock error: %s", err)
+		} else if locked {
+			handleDownlinkStatus()
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
+func handleDownlinkStatus() {
+	if err := handleSentDownlinks(); err != nil {
+		log.Errorf("handle sent downlinks error: %s", err)
+	}
+
+	if common.DownlinkACKTimeout != 0 {
+		if err := handleTimedOutDownlinks(); err != nil {
+			log.Errorf("handle timed-out downlinks error: %s", err)
+		}
+	}
+
+	if time.Since(downlinkPrunedAt) >= downlinkPruneInterval {
+		if err := storage.PruneDownlinks(common.DB, common.DownlinkMaxAge, common.DownlinkMaxCount); err != nil {
+			log.Errorf("prune downlinks error: %s", err)
+		}
+		downlinkPrunedAt = time.Now()
+	}
+}
+
+func acquireDownlinkStatusLock() (bool, error) {
+	c := common.RedisPool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", downlinkStatusLockKey, "lock", "PX", int64(downlinkStatusLockTTL/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func handleSentDownlinks() error {
+	devEUIs, err := storage.GetDevEUIsWithQueuedDownlinks(common.DB, time.Now().Add(-downlinkStatusInterval), downlinkStatusBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "get devices with queued downlinks error")
+	}
+
+	for _, devEUI := range devEUIs {
+		if err := handleSentDownlinksForDevEUI(devEUI); err != nil {
+			log.WithField("dev_eui", devEUI).Errorf("handle sent downlinks error: %s", err)
+		}
+
+		// also when the above failed, so that the other devices are handled
+		// by the next run
+		if err := storage.TouchQueuedDownlinksForDevEUI(common.DB, devEUI); err != nil {
+			return errors.Wrap(err, "touch queued downlinks error")
+		}
+	}
+
+	return nil
+}
+
+func handleSentDownlinksForDevEUI(devEUI lorawan.EUI64) error {
+	n, err := storage.GetNetworkServerForDevEUI(common.DB, devEUI)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	resp, err := nsClient.GetDeviceQueueItemsForDevEUI(context.Background(), &ns.GetDeviceQueueItemsForDevEUIRequest{
+		DevEUI: devEUI[:],
+	})
+	if err != nil {
+		return errors.Wrap(err, "get device-queue items error")
+	}
+
+	inQueue := make(map[uint32]struct{})
+	for _, qi := range resp.Items {
+		inQueue[qi.FCnt] = struct{}{}
+	}
+
+	downlinks, err := storage.GetQueuedDownlinksForDevEUI(common.DB, devEUI)
+	if err != nil {
+		return errors.Wrap(err, "get queued downlinks error")
+	}
+
+	now := time.Now()
+	for i := range downlinks {
+		if _, ok := inQueue[downlinks[i].FCnt]; ok {
+			continue
+		}
+
+		downlinks[i].SetStatus(storage.DownlinkSent, now)
+		if err := storage.UpdateDownlink(common.DB, &downlinks[i]); err != nil {
+			return errors.Wrap(err, "update downlink error")
+		}
+	}
+
+	return nil
+}
+
+func handleTimedOutDownlinks() error {
+	var expired []storage.Downlink
+
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		var err error
+		expired, err = storage.GetTimedOutDownlinks(tx, time.Now().Add(-common.DownlinkACKTimeout), downlinkStatusBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "get timed-out downlinks error")
+		}
+
+		now := time.Now()
+		for i := range expired {
+			expired[i].SetStatus(storage.DownlinkExpired, now)
+			if err := storage.UpdateDownlink(tx, &expired[i]); err != nil {
+				return errors.Wrap(err, "update downlink error")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the notifications are sent after the commit, so that they are not
+	// sent twice when the transaction is rolled back
+	for _, dl := range expired {
+		if err := sendDownlinkACKTimeoutNotification(dl); err != nil {
+			log.WithFields(log.Fields{
+				"dev_eui": dl.DevEUI,
+				"f_cnt":   dl.FCnt,
+			}).Errorf("send ack timeout notification error: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func sendDownlinkACKTimeoutNotification(dl storage.Downlink) error {
+	d, err := storage.GetDevice(common.DB, dl.DevEUI)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	app, err := storage.GetApplication(common.DB, d.ApplicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	pl := handler.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          dl.DevEUI,
+		Type:            "DOWNLINK_ACK_TIMEOUT",
+		Error:           fmt.Sprintf("no acknowledgement received within %s", common.DownlinkACKTimeout),
+		FCnt:            dl.FCnt,
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":   dl.DevEUI,
+		"f_cnt":     dl.FCnt,
+		"reference": dl.Reference,
+	}).Warning(pl.Error)
+
+	if err := common.Handler.SendErrorNotification(pl); err != nil {
+		return errors.Wrap(err, "send error notification to handler error")
+	}
+
+	if err := eventlog.LogEventForDevice(app.ID, dl.DevEUI, storage.DeviceEventError, nil, pl); err != nil {
+		log.WithField("dev_eui", dl.DevEUI).Errorf("log device event error: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package downlink
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lora-app-server/internal/test/testhandler"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+)
+
+func TestDownlinkStatus(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with a device and two tracked downlinks", t, func() {
+		test.MustResetDB(common.DB)
+		test.MustFlushRedis(common.RedisPool)
+
+		h := testhandler.NewTestHandler()
+		common.Handler = h
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(common.DB, &d), ShouldBeNil)
+
+		downlinks := []storage.Downlink{
+			{DevEUI: d.DevEUI, FCnt: 10, FPort: 1, Confirmed: true, Reference: "a"},
+			{DevEUI: d.DevEUI, FCnt: 11, FPort: 1, Confirmed: true, Reference: "b"},
+		}
+		for i := range downlinks {
+			So(storage.CreateDownlink(common.DB, &downlinks[i]), ShouldBeNil)
+		}
+
+		// make the downlinks eligible for the status checks
+		_, err := common.DB.Exec("update downlink set created_at = $1, updated_at = $1", time.Now().Add(-2*time.Hour))
+		So(err, ShouldBeNil)
+
+		Convey("Given the first downlink is no longer in the network-server queue", func() {
+			nsClient.GetDeviceQueueItemsForDevEUIResponse = ns.GetDeviceQueueItemsForDevEUIResponse{
+				Items: []*ns.DeviceQueueItem{
+					{DevEUI: d.DevEUI[:], FCnt: 11, FPort: 1, Confirmed: true},
+				},
+			}
+
+			Convey("When handling the sent downlinks", func() {
+				So(handleSentDownlinks(), ShouldBeNil)
+
+				Convey("Then the device-queue was requested", func() {
+					So(nsClient.GetDeviceQueueItemsForDevEUIChan, ShouldHaveLength, 1)
+					req := <-nsClient.GetDeviceQueueItemsForDevEUIChan
+					So(req.DevEUI, ShouldResemble, d.DevEUI[:])
+				})
+
+				Convey("Then only the first downlink was marked as sent", func() {
+					dl, err := storage.GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+					So(err, ShouldBeNil)
+					So(dl.Status, ShouldEqual, storage.DownlinkSent)
+					So(dl.SentAt, ShouldNotBeNil)
+
+					dl, err = storage.GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 11)
+					So(err, ShouldBeNil)
+					So(dl.Status, ShouldEqual, storage.DownlinkQueued)
+				})
+
+				Convey("Then the device is not checked again directly", func() {
+					So(handleSentDownlinks(), ShouldBeNil)
+					So(nsClient.GetDeviceQueueItemsForDevEUIChan, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("Given a max downlink count of one and acknowledged downlinks", func() {
+			common.DownlinkMaxCount = 1
+			defer func() {
+				common.DownlinkMaxCount = 0
+			}()
+			downlinkPrunedAt = time.Time{}
+
+			for i := range downlinks {
+				downlinks[i].SetStatus(storage.DownlinkAcked, time.Now())
+				So(storage.UpdateDownlink(common.DB, &downlinks[i]), ShouldBeNil)
+			}
+
+			Convey("When handling the downlink status", func() {
+				handleDownlinkStatus()
+
+				Convey("Then the oldest downlink has been pruned", func() {
+					count, err := storage.GetDownlinkCountForDevEUI(common.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+
+					_, err = storage.GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+		})
+
+		Convey("Given an ACK timeout of one hour", func() {
+			common.DownlinkACKTimeout = time.Hour
+
+			Convey("When handling the timed-out downlinks", func() {
+				So(handleTimedOutDownlinks(), ShouldBeNil)
+
+				Convey("Then both downlinks expired", func() {
+					for _, fCnt := range []uint32{10, 11} {
+						dl, err := storage.GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, fCnt)
+						So(err, ShouldBeNil)
+						So(dl.Status, ShouldEqual, storage.DownlinkExpired)
+						So(dl.ExpiredAt, ShouldNotBeNil)
+					}
+				})
+
+				Convey("Then an error notification was sent for each downlink", func() {
+					So(h.SendErrorNotificationChan, ShouldHaveLength, 2)
+					pl := <-h.SendErrorNotificationChan
+					So(pl.Type, ShouldEqual, "DOWNLINK_ACK_TIMEOUT")
+					So(pl.DevEUI, ShouldEqual, d.DevEUI)
+					So(pl.ApplicationID, ShouldEqual, app.ID)
+					So(pl.FCnt, ShouldEqual, 10)
+				})
+
+				Convey("Then the downlinks do not expire twice", func() {
+					So(handleTimedOutDownlinks(), ShouldBeNil)
+					So(h.SendErrorNotificationChan, ShouldHaveLength, 2)
+				})
+			})
+		})
+	})
+}
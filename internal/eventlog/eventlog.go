@@ -0,0 +1,174 @@
+// Package eventlog stores the device events (uplinks, joins, acks and errors)
+// and publishes them to the real-time event subscribers.
+package eventlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+const (
+	deviceEventsChannelTempl      = "lora:as:device:%s:events"
+	applicationEventsChannelTempl = "lora:as:application:%d:events"
+)
+
+// pruneInterval defines the interval in which the stored events exceeding
+// the configured retention limits are removed.
+const pruneInterval = time.Minute
+
+// Event defines a device event as published to the subscribers.
+type Event struct {
+	ApplicationID int64
+	storage.DeviceEvent
+}
+
+// LogEventForDevice stores the given event payload and publishes the event
+// to the subscribers of the device and its application. The event is
+// published, even when storing it failed. The fPort must only be given for
+// uplink events.
+func LogEventForDevice(applicationID int64, devEUI lorawan.EUI64, typ storage.DeviceEventType, fPort *int, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "marshal payload error")
+	}
+
+	e := Event{
+		ApplicationID: applicationID,
+		DeviceEvent: storage.DeviceEvent{
+			DevEUI:  devEUI,
+			Type:    typ,
+			FPort:   fPort,
+			Payload: b,
+		},
+	}
+
+	// the event is published, even when it could not be stored
+	createErr := storage.CreateDeviceEvent(common.DB, &e.DeviceEvent)
+	if createErr != nil && e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+
+	if err = publishEvent(e); err != nil {
+		if createErr != nil {
+			log.WithField("dev_eui", devEUI).Errorf("create device event error: %s", createErr)
+		}
+		return err
+	}
+
+	if createErr != nil {
+		return errors.Wrap(createErr, "create device event error")
+	}
+
+	return nil
+}
+
+// publishEvent publishes the given event to the subscribers of the device
+// and its application.
+func publishEvent(e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal event error")
+	}
+
+	c := common.RedisPool.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("PUBLISH", fmt.Sprintf(deviceEventsChannelTempl, e.DevEUI), b)
+	c.Send("PUBLISH", fmt.Sprintf(applicationEventsChannelTempl, e.ApplicationID), b)
+	if _, err = c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "publish event error")
+	}
+
+	return nil
+}
+
+// PruneLoop is a never returning function removing the stored events
+// exceeding the configured retention limits.
+func PruneLoop() {
+	for {
+		if err := pruneDeviceEvents(); err != nil {
+			log.Errorf("prune device events error: %s", err)
+		}
+		time.Sleep(pruneInterval)
+	}
+}
+
+func pruneDeviceEvents() error {
+	err := storage.PruneDeviceEvents(common.DB, common.DeviceEventMaxAge, common.DeviceEventMaxCount)
+	if err != nil {
+		return errors.Wrap(err, "prune device events error")
+	}
+	return nil
+}
+
+// GetEventsForDevice subscribes to the events of the given device and sends
+// them to the given channel. This function blocks until the given context
+// is cancelled or an error occurs.
+func GetEventsForDevice(ctx context.Context, devEUI lorawan.EUI64, events chan<- Event) error {
+	return subscribe(ctx, fmt.Sprintf(deviceEventsChannelTempl, devEUI), events)
+}
+
+// GetEventsForApplication subscribes to the events of all the devices of the
+// given application and sends them to the given channel. This function blocks
+// until the given context is cancelled or an error occurs.
+func GetEventsForApplication(ctx context.Context, applicationID int64, events chan<- Event) error {
+	return subscribe(ctx, fmt.Sprintf(applicationEventsChannelTempl, applicationID), events)
+}
+
+func subscribe(ctx context.Context, channel string, events chan<- Event) error {
+	c := common.RedisPool.Get()
+	defer c.Close()
+
+	psc := redis.PubSubConn{Conn: c}
+	if err := psc.Subscribe(channel); err != nil {
+		return errors.Wrap(err, "subscribe error")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				var e Event
+				if err := json.Unmarshal(v.Data, &e); err != nil {
+					log.WithField("channel", channel).Errorf("unmarshal event error: %s", err)
+					continue
+				}
+
+				select {
+				case events <- e:
+				case <-ctx.Done():
+				}
+			case redis.Subscription:
+				if v.Count == 0 {
+					done <- nil
+					return
+				}
+			case error:
+				done <- v
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		if err := psc.Unsubscribe(); err != nil {
+			return errors.Wrap(err, "unsubscribe error")
+		}
+		return <-done
+	case err := <-done:
+		return errors.Wrap(err, "receive error")
+	}
+}
@@ -0,0 +1,154 @@
+package eventlog
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+func TestEventLog(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database and Redis with a device", t, func() {
+		test.MustResetDB(common.DB)
+		test.MustFlushRedis(common.RedisPool)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := storage.Device{
+			ApplicationID:   app.ID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(storage.CreateDevice(common.DB, &d), ShouldBeNil)
+
+		Convey("Given a device and an application subscriber", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+
+			deviceEvents := make(chan Event, 10)
+			deviceErr := make(chan error, 1)
+			go func() {
+				deviceErr <- GetEventsForDevice(ctx, d.DevEUI, deviceEvents)
+			}()
+
+			appEvents := make(chan Event, 10)
+			appErr := make(chan error, 1)
+			go func() {
+				appErr <- GetEventsForApplication(ctx, app.ID, appEvents)
+			}()
+
+			// give the subscribers some time to subscribe
+			time.Sleep(100 * time.Millisecond)
+
+			Convey("When calling LogEventForDevice", func() {
+				fPort := 10
+				So(LogEventForDevice(app.ID, d.DevEUI, storage.DeviceEventUplink, &fPort, map[string]int{"fCnt": 1}), ShouldBeNil)
+
+				Convey("Then the event has been stored", func() {
+					events, err := storage.GetDeviceEvents(common.DB, d.DevEUI, storage.DeviceEventFilters{}, 10, 0)
+					So(err, ShouldBeNil)
+					So(events, ShouldHaveLength, 1)
+					So(events[0].Type, ShouldEqual, storage.DeviceEventUplink)
+					So(*events[0].FPort, ShouldEqual, 10)
+				})
+
+				Convey("Then the event has been published to both subscribers", func() {
+					for _, events := range []chan Event{deviceEvents, appEvents} {
+						e := <-events
+						So(e.ApplicationID, ShouldEqual, app.ID)
+						So(e.DevEUI, ShouldEqual, d.DevEUI)
+						So(e.Type, ShouldEqual, storage.DeviceEventUplink)
+						So(*e.FPort, ShouldEqual, 10)
+						So(string(e.Payload), ShouldEqual, `{"fCnt":1}`)
+					}
+				})
+
+				Convey("Then cancelling the context stops the subscribers", func() {
+					cancel()
+					So(<-deviceErr, ShouldBeNil)
+					So(<-appErr, ShouldBeNil)
+				})
+			})
+
+			Convey("When calling LogEventForDevice and storing the event fails", func() {
+				devEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+				So(LogEventForDevice(app.ID, devEUI, storage.DeviceEventJoin, nil, map[string]string{"devAddr": "01020304"}), ShouldNotBeNil)
+
+				Convey("Then the event has been published to the application subscriber", func() {
+					e := <-appEvents
+					So(e.ApplicationID, ShouldEqual, app.ID)
+					So(e.DevEUI, ShouldEqual, devEUI)
+					So(e.Type, ShouldEqual, storage.DeviceEventJoin)
+					So(e.CreatedAt.IsZero(), ShouldBeFalse)
+				})
+			})
+
+			Reset(func() {
+				cancel()
+			})
+		})
+
+		Convey("When pruning the events with a max count of 1", func() {
+			common.DeviceEventMaxCount = 1
+			defer func() { common.DeviceEventMaxCount = 0 }()
+
+			So(LogEventForDevice(app.ID, d.DevEUI, storage.DeviceEventACK, nil, map[string]bool{"acknowledged": true}), ShouldBeNil)
+			So(LogEventForDevice(app.ID, d.DevEUI, storage.DeviceEventError, nil, map[string]string{"error": "BOOM"}), ShouldBeNil)
+			So(pruneDeviceEvents(), ShouldBeNil)
+
+			Convey("Then only the most recent event is stored", func() {
+				events, err := storage.GetDeviceEvents(common.DB, d.DevEUI, storage.DeviceEventFilters{}, 10, 0)
+				So(err, ShouldBeNil)
+				So(events, ShouldHaveLength, 1)
+				So(events[0].Type, ShouldEqual, storage.DeviceEventError)
+			})
+		})
+	})
+}
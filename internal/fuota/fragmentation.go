@@ -0,0 +1,319 @@
+package fuota
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// FragPort defines the fPort used by the fragmented data block transport
+// protocol.
+const FragPort = 201
+
+// Limits defined by the fragmented data block transport protocol.
+const (
+	MaxFragIndex = 3
+	MaxNbFrag    = 16383 // 14 bits
+)
+
+// CID defines the command identifier of the fragmented data block transport
+// protocol. Requests and answers share the same identifier.
+type CID byte
+
+// Available command identifiers.
+const (
+	PackageVersion    CID = 0x00
+	FragSessionStatus CID = 0x01
+	FragSessionSetup  CID = 0x02
+	FragSessionDelete CID = 0x03
+	DataFragment      CID = 0x08
+)
+
+// FragSessionSetupReqPayload implements the FragSessionSetupReq payload.
+type FragSessionSetupReqPayload struct {
+	FragIndex           uint8
+	McGroupBitMask      uint8
+	NbFrag              uint16
+	FragSize            uint8
+	FragmentationMatrix uint8
+	BlockAckDelay       uint8
+	Padding             uint8
+	Descriptor          [4]byte
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p FragSessionSetupReqPayload) MarshalBinary() ([]byte, error) {
+	if p.FragIndex > MaxFragIndex {
+		return nil, fmt.Errorf("fuota: max FragIndex value is %d", MaxFragIndex)
+	}
+	if p.NbFrag > MaxNbFrag {
+		return nil, fmt.Errorf("fuota: max NbFrag value is %d", MaxNbFrag)
+	}
+
+	b := make([]byte, 11)
+	b[0] = byte(FragSessionSetup)
+	b[1] = (p.FragIndex&0x03)<<4 | p.McGroupBitMask&0x0f
+	binary.LittleEndian.PutUint16(b[2:4], p.NbFrag)
+	b[4] = p.FragSize
+	b[5] = (p.FragmentationMatrix&0x07)<<3 | p.BlockAckDelay&0x07
+	b[6] = p.Padding
+	copy(b[7:11], p.Descriptor[:])
+	return b, nil
+}
+
+// FragSessionSetupAnsPayload implements the FragSessionSetupAns payload.
+type FragSessionSetupAnsPayload struct {
+	FragIndex                    uint8
+	WrongDescriptor              bool
+	FragSessionIndexNotSupported bool
+	NotEnoughMemory              bool
+	EncodingUnsupported          bool
+}
+
+// Error returns the error reported by the device or nil.
+func (p FragSessionSetupAnsPayload) Error() error {
+	switch {
+	case p.EncodingUnsupported:
+		return errors.New("encoding unsupported")
+	case p.NotEnoughMemory:
+		return errors.New("not enough memory")
+	case p.FragSessionIndexNotSupported:
+		return errors.New("fragmentation session index not supported")
+	case p.WrongDescriptor:
+		return errors.New("wrong descriptor")
+	}
+	return nil
+}
+
+// UnmarshalBinary decodes the command payload (excluding CID).
+func (p *FragSessionSetupAnsPayload) UnmarshalBinary(b []byte) error {
+	if len(b) != 1 {
+		return errors.New("fuota: 1 byte of data is expected")
+	}
+	p.FragIndex = b[0] >> 6
+	p.WrongDescriptor = b[0]&0x08 != 0
+	p.FragSessionIndexNotSupported = b[0]&0x04 != 0
+	p.NotEnoughMemory = b[0]&0x02 != 0
+	p.EncodingUnsupported = b[0]&0x01 != 0
+	return nil
+}
+
+// FragSessionStatusReqPayload implements the FragSessionStatusReq payload.
+type FragSessionStatusReqPayload struct {
+	FragIndex    uint8
+	Participants bool
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p FragSessionStatusReqPayload) MarshalBinary() ([]byte, error) {
+	if p.FragIndex > MaxFragIndex {
+		return nil, fmt.Errorf("fuota: max FragIndex value is %d", MaxFragIndex)
+	}
+
+	b := []byte{byte(FragSessionStatus), (p.FragIndex & 0x03) << 1}
+	if p.Participants {
+		b[1] |= 0x01
+	}
+	return b, nil
+}
+
+// FragSessionStatusAnsPayload implements the FragSessionStatusAns payload.
+type FragSessionStatusAnsPayload struct {
+	FragIndex             uint8
+	NbFragReceived        uint16
+	MissingFrag           uint8
+	NotEnoughMatrixMemory bool
+}
+
+// UnmarshalBinary decodes the command payload (excluding CID).
+func (p *FragSessionStatusAnsPayload) UnmarshalBinary(b []byte) error {
+	if len(b) != 4 {
+		return errors.New("fuota: 4 bytes of data are expected")
+	}
+	v := binary.LittleEndian.Uint16(b[0:2])
+	p.FragIndex = uint8(v >> 14)
+	p.NbFragReceived = v & MaxNbFrag
+	p.MissingFrag = b[2]
+	p.NotEnoughMatrixMemory = b[3]&0x01 != 0
+	return nil
+}
+
+// FragSessionDeleteReqPayload implements the FragSessionDeleteReq payload.
+type FragSessionDeleteReqPayload struct {
+	FragIndex uint8
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p FragSessionDeleteReqPayload) MarshalBinary() ([]byte, error) {
+	if p.FragIndex > MaxFragIndex {
+		return nil, fmt.Errorf("fuota: max FragIndex value is %d", MaxFragIndex)
+	}
+	return []byte{byte(FragSessionDelete), p.FragIndex & 0x03}, nil
+}
+
+// FragSessionDeleteAnsPayload implements the FragSessionDeleteAns payload.
+type FragSessionDeleteAnsPayload struct {
+	FragIndex           uint8
+	SessionDoesNotExist bool
+}
+
+// UnmarshalBinary decodes the command payload (excluding CID).
+func (p *FragSessionDeleteAnsPayload) UnmarshalBinary(b []byte) error {
+	if len(b) != 1 {
+		return errors.New("fuota: 1 byte of data is expected")
+	}
+	p.FragIndex = b[0] & 0x03
+	p.SessionDoesNotExist = b[0]&0x04 != 0
+	return nil
+}
+
+// PackageVersionAnsPayload implements the PackageVersionAns payload.
+type PackageVersionAnsPayload struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// UnmarshalBinary decodes the command payload (excluding CID).
+func (p *PackageVersionAnsPayload) UnmarshalBinary(b []byte) error {
+	if len(b) != 2 {
+		return errors.New("fuota: 2 bytes of data are expected")
+	}
+	p.PackageIdentifier = b[0]
+	p.PackageVersion = b[1]
+	return nil
+}
+
+// DataFragmentPayload implements the DataFragment payload.
+type DataFragmentPayload struct {
+	FragIndex uint8
+	N         uint16
+	Payload   []byte
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p DataFragmentPayload) MarshalBinary() ([]byte, error) {
+	if p.FragIndex > MaxFragIndex {
+		return nil, fmt.Errorf("fuota: max FragIndex value is %d", MaxFragIndex)
+	}
+	if p.N == 0 || p.N > MaxNbFrag {
+		return nil, fmt.Errorf("fuota: N must be between 1 - %d", MaxNbFrag)
+	}
+
+	b := make([]byte, 3, 3+len(p.Payload))
+	b[0] = byte(DataFragment)
+	binary.LittleEndian.PutUint16(b[1:3], uint16(p.FragIndex)<<14|p.N)
+	return append(b, p.Payload...), nil
+}
+
+// UnmarshalUplinkCommands decodes the answers sent by the device on the
+// FragPort. An uplink may contain multiple answers. The returned slice
+// contains pointers to the *AnsPayload types.
+func UnmarshalUplinkCommands(b []byte) ([]interface{}, error) {
+	var out []interface{}
+
+	for len(b) > 0 {
+		var size int
+		var pl interface {
+			UnmarshalBinary([]byte) error
+		}
+
+		switch CID(b[0]) {
+		case PackageVersion:
+			size, pl = 2, &PackageVersionAnsPayload{}
+		case FragSessionStatus:
+			size, pl = 4, &FragSessionStatusAnsPayload{}
+		case FragSessionSetup:
+			size, pl = 1, &FragSessionSetupAnsPayload{}
+		case FragSessionDelete:
+			size, pl = 1, &FragSessionDeleteAnsPayload{}
+		default:
+			return out, fmt.Errorf("fuota: unknown CID %d", b[0])
+		}
+
+		if len(b) < size+1 {
+			return out, fmt.Errorf("fuota: %d bytes of data expected for CID %d", size, b[0])
+		}
+		if err := pl.UnmarshalBinary(b[1 : size+1]); err != nil {
+			return out, err
+		}
+		out = append(out, pl)
+		b = b[size+1:]
+	}
+
+	return out, nil
+}
+
+// Fragment splits the given data into fragments of fragSize bytes and
+// appends the given number of redundant fragments, using the
+// forward-error-correction code as defined by the fragmented data block
+// transport protocol. The last uncoded fragment is padded with zeros. It
+// returns the fragments (index 0 holds fragment N=1) and the padding.
+func Fragment(data []byte, fragSize, redundancy int) ([][]byte, int, error) {
+	if fragSize <= 0 {
+		return nil, 0, errors.New("fuota: fragSize must be > 0")
+	}
+	if len(data) == 0 {
+		return nil, 0, errors.New("fuota: data must not be empty")
+	}
+
+	padding := (fragSize - len(data)%fragSize) % fragSize
+	m := (len(data) + padding) / fragSize
+	if m+redundancy > MaxNbFrag {
+		return nil, 0, fmt.Errorf("fuota: max number of fragments (including redundancy) is %d", MaxNbFrag)
+	}
+
+	padded := make([]byte, len(data)+padding)
+	copy(padded, data)
+
+	frags := make([][]byte, 0, m+redundancy)
+	for i := 0; i < m; i++ {
+		frags = append(frags, padded[i*fragSize:(i+1)*fragSize])
+	}
+
+	for n := 1; n <= redundancy; n++ {
+		coded := make([]byte, fragSize)
+		for i, set := range parityMatrixLine(n, m) {
+			if !set {
+				continue
+			}
+			for j := range coded {
+				coded[j] ^= frags[i][j]
+			}
+		}
+		frags = append(frags, coded)
+	}
+
+	return frags, padding, nil
+}
+
+// parityMatrixLine returns line n (starting at 1) of the parity matrix used
+// for generating the redundant fragments for m uncoded fragments.
+func parityMatrixLine(n, m int) []bool {
+	line := make([]bool, m)
+
+	var mTemp uint32
+	if m&(m-1) == 0 {
+		mTemp = 1
+	}
+
+	x := uint32(1 + 1001*n)
+	for nbCoeff := 0; nbCoeff < m/2; nbCoeff++ {
+		r := uint32(1 << 16)
+		for r >= uint32(m) {
+			x = prbs23(x)
+			r = x % (uint32(m) + mTemp)
+		}
+		line[r] = true
+	}
+
+	return line
+}
+
+// prbs23 implements the pseudo-random binary sequence generator used by the
+// parity matrix.
+func prbs23(x uint32) uint32 {
+	b0 := x & 0x01
+	b1 := (x & 0x20) >> 5
+	return (x >> 1) + ((b0 ^ b1) << 22)
+}
@@ -0,0 +1,175 @@
+package fuota
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFragSessionCommands(t *testing.T) {
+	Convey("Given a set of downlink commands", t, func() {
+		tests := []struct {
+			Name     string
+			Command  interface{ MarshalBinary() ([]byte, error) }
+			Expected []byte
+		}{
+			{
+				Name: "FragSessionSetupReq",
+				Command: FragSessionSetupReqPayload{
+					FragIndex:     1,
+					NbFrag:        258,
+					FragSize:      50,
+					BlockAckDelay: 3,
+					Padding:       10,
+					Descriptor:    [4]byte{1, 2, 3, 4},
+				},
+				Expected: []byte{0x02, 0x10, 0x02, 0x01, 0x32, 0x03, 0x0a, 0x01, 0x02, 0x03, 0x04},
+			},
+			{
+				Name:     "FragSessionStatusReq",
+				Command:  FragSessionStatusReqPayload{FragIndex: 2, Participants: true},
+				Expected: []byte{0x01, 0x05},
+			},
+			{
+				Name:     "FragSessionDeleteReq",
+				Command:  FragSessionDeleteReqPayload{FragIndex: 3},
+				Expected: []byte{0x03, 0x03},
+			},
+			{
+				Name:     "DataFragment",
+				Command:  DataFragmentPayload{FragIndex: 1, N: 2, Payload: []byte{5, 6}},
+				Expected: []byte{0x08, 0x02, 0x40, 0x05, 0x06},
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				b, err := test.Command.MarshalBinary()
+				So(err, ShouldBeNil)
+				So(b, ShouldResemble, test.Expected)
+			})
+		}
+	})
+
+	Convey("Given an uplink containing a FragSessionSetupAns and FragSessionStatusAns", t, func() {
+		b := []byte{0x02, 0x42, 0x01, 0x0a, 0x40, 0x03, 0x00}
+
+		Convey("Then UnmarshalUplinkCommands returns both answers", func() {
+			cmds, err := UnmarshalUplinkCommands(b)
+			So(err, ShouldBeNil)
+			So(cmds, ShouldHaveLength, 2)
+			So(cmds[0], ShouldResemble, &FragSessionSetupAnsPayload{
+				FragIndex:       1,
+				NotEnoughMemory: true,
+			})
+			So(cmds[1], ShouldResemble, &FragSessionStatusAnsPayload{
+				FragIndex:      1,
+				NbFragReceived: 10,
+				MissingFrag:    3,
+			})
+		})
+
+		Convey("Then a truncated payload returns an error", func() {
+			_, err := UnmarshalUplinkCommands(b[:5])
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestFragment(t *testing.T) {
+	Convey("Given 1000 bytes of random data", t, func() {
+		data := make([]byte, 1000)
+		rand.New(rand.NewSource(1)).Read(data)
+
+		Convey("When fragmenting with fragSize 48 and 10 redundant fragments", func() {
+			frags, padding, err := Fragment(data, 48, 10)
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected number of fragments and padding are returned", func() {
+				So(padding, ShouldEqual, 8)
+				So(frags, ShouldHaveLength, 21+10)
+				So(bytes.Join(frags[:21], nil), ShouldResemble, append(data, make([]byte, 8)...))
+			})
+
+			Convey("Then the data can be recovered when fragments are lost", func() {
+				// drop 5 of the uncoded fragments
+				lost := map[int]bool{0: true, 3: true, 7: true, 12: true, 19: true}
+				received := make(map[int][]byte)
+				for i := range frags {
+					if !lost[i] {
+						received[i+1] = frags[i]
+					}
+				}
+
+				out, ok := decode(received, 21, 48)
+				So(ok, ShouldBeTrue)
+				So(out[:len(data)], ShouldResemble, data)
+			})
+		})
+
+		Convey("Then an invalid fragSize returns an error", func() {
+			_, _, err := Fragment(data, 0, 0)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then too many fragments return an error", func() {
+			_, _, err := Fragment(data, 1, MaxNbFrag)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+// decode reconstructs the m uncoded fragments from the received fragments
+// (key = N) using gaussian elimination over GF(2), as a device would do.
+func decode(received map[int][]byte, m, fragSize int) ([]byte, bool) {
+	type row struct {
+		coeff []bool
+		data  []byte
+	}
+
+	var rows []row
+	for n, b := range received {
+		r := row{coeff: make([]bool, m), data: append([]byte{}, b...)}
+		if n <= m {
+			r.coeff[n-1] = true
+		} else {
+			r.coeff = parityMatrixLine(n-m, m)
+		}
+		rows = append(rows, r)
+	}
+
+	for col := 0; col < m; col++ {
+		pivot := -1
+		for i := col; i < len(rows); i++ {
+			if rows[i].coeff[col] {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			return nil, false
+		}
+		rows[col], rows[pivot] = rows[pivot], rows[col]
+
+		for i := range rows {
+			if i == col || !rows[i].coeff[col] {
+				continue
+			}
+			for j := range rows[i].coeff {
+				rows[i].coeff[j] = rows[i].coeff[j] != rows[col].coeff[j]
+			}
+			for j := range rows[i].data {
+				rows[i].data[j] ^= rows[col].data[j]
+			}
+		}
+	}
+
+	out := make([]byte, 0, m*fragSize)
+	for i := 0; i < m; i++ {
+		out = append(out, rows[i].data...)
+	}
+	return out, true
+}
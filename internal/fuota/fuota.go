@@ -0,0 +1,435 @@
+// Package fuota implements firmware updates over the air, using the
+// fragmented data block transport protocol on top of the device-queue or,
+// for sessions targeting a multicast-group, the multicast-queue.
+package fuota
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/downlink"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+const (
+	// mcGroupBitMask is the McGroupBitMask used for sessions targeting a
+	// multicast-group. The multicast context of the group is expected to be
+	// provisioned on the devices as McGroupID 0.
+	mcGroupBitMask = 1 << 0
+
+	sessionLockTempl = "lora:as:fuota-session:lock:%s:%s"
+	sessionLockTTL   = time.Minute
+	sessionBatchSize = 10
+)
+
+// SessionLoop is a never returning function handling the next step of the
+// FUOTA sessions which are due.
+func SessionLoop() {
+	for {
+		if err := handleSessions(); err != nil {
+			log.Errorf("handle fuota sessions error: %s", err)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func handleSessions() error {
+	ids, err := storage.GetDueFUOTASessionIDs(common.DB, time.Now(), sessionBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "get due fuota sessions error")
+	}
+
+	for _, id := range ids {
+		if err := handleSession(id); err != nil {
+			log.WithField("id", id).Errorf("handle fuota session error: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func handleSession(id string) error {
+	s, err := storage.GetFUOTASession(common.DB, id, false)
+	if err != nil {
+		return errors.Wrap(err, "get fuota session error")
+	}
+
+	// Multiple lora-app-server instances might select the same session.
+	// The first instance acquiring the lock for the current step handles
+	// it, the other instances ignore it.
+	locked, err := acquireSessionLock(s.ID, s.State)
+	if err != nil {
+		return errors.Wrap(err, "acquire lock error")
+	}
+	if !locked {
+		return nil
+	}
+
+	// The next step is handled within a transaction, updating the state of
+	// the session and its devices. The payloads are enqueued after the
+	// transaction has been committed, so that no network-server calls are
+	// made while holding the lock on the session.
+	var enq *enqueueItems
+	err = storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		// re-fetch and lock the record, it might have been cancelled or
+		// handled in the meantime
+		s, err := storage.GetFUOTASession(tx, id, true)
+		if err != nil {
+			return errors.Wrap(err, "get fuota session error")
+		}
+		if s.NextStepAt == nil || s.NextStepAt.After(time.Now()) {
+			return nil
+		}
+
+		switch s.State {
+		case storage.FUOTASessionSetup:
+			enq, err = handleSetupStep(tx, &s)
+		case storage.FUOTASessionEnqueue:
+			enq, err = handleEnqueueStep(tx, &s)
+		case storage.FUOTASessionStatus:
+			err = handleStatusStep(tx, &s)
+		default:
+			s.NextStepAt = nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := storage.UpdateFUOTASession(tx, &s); err != nil {
+			return errors.Wrap(err, "update fuota session error")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if enq == nil {
+		return nil
+	}
+
+	if enq.multicastGroupID != "" && len(enq.multicastPayloads) != 0 {
+		if err := enqueueForMulticastGroup(common.DB, id, enq); err != nil {
+			return err
+		}
+	}
+
+	return enqueueForDevices(common.DB, id, enq)
+}
+
+// enqueueItems contains the payloads to enqueue for the given devices and,
+// for sessions targeting a multicast-group, the payloads to enqueue once
+// for the multicast-group.
+type enqueueItems struct {
+	devices           []storage.FUOTASessionDevice
+	payloads          [][]byte
+	multicastGroupID  string
+	multicastPayloads [][]byte
+}
+
+// handleSetupStep returns the FragSessionSetupReq to enqueue for each
+// device. The fragments are enqueued after the unicast timeout, for the
+// devices which answered the request.
+func handleSetupStep(db sqlx.Ext, s *storage.FUOTASession) (*enqueueItems, error) {
+	// For a session targeting a single device, the McGroupBitMask is left 0
+	// and the fragments are sent as unicast downlinks.
+	req := FragSessionSetupReqPayload{
+		FragIndex:     s.FragIndex,
+		NbFrag:        uint16(s.NbFrag() + s.Redundancy),
+		FragSize:      s.FragSize,
+		BlockAckDelay: s.BlockAckDelay,
+		Padding:       uint8(s.NbFrag()*int(s.FragSize) - len(s.Payload)),
+	}
+	copy(req.Descriptor[:], s.Descriptor)
+	if s.MulticastGroupID != nil {
+		req.McGroupBitMask = mcGroupBitMask
+	}
+
+	b, err := req.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal binary error")
+	}
+
+	devices, err := storage.GetFUOTASessionDevicesForState(db, s.ID, storage.FUOTASessionDevicePending)
+	if err != nil {
+		return nil, errors.Wrap(err, "get fuota session devices error")
+	}
+
+	now := time.Now()
+	nextStepAt := now.Add(s.UnicastTimeout)
+	s.State = storage.FUOTASessionEnqueue
+	s.NextStepAt = &nextStepAt
+
+	return &enqueueItems{devices: devices, payloads: [][]byte{b}}, nil
+}
+
+// handleEnqueueStep returns the fragments, followed by a
+// FragSessionStatusReq, to enqueue for each device which answered the
+// FragSessionSetupReq. For a session targeting a multicast-group, the
+// fragments are enqueued once for the multicast-group and only the
+// FragSessionStatusReq is enqueued for each device.
+func handleEnqueueStep(db sqlx.Ext, s *storage.FUOTASession) (*enqueueItems, error) {
+	if err := setDeviceErrors(db, s.ID, storage.FUOTASessionDevicePending, "no FragSessionSetupAns received"); err != nil {
+		return nil, err
+	}
+
+	frags, _, err := Fragment(s.Payload, int(s.FragSize), s.Redundancy)
+	if err != nil {
+		return nil, errors.Wrap(err, "fragment payload error")
+	}
+
+	var fragPayloads [][]byte
+	for i := range frags {
+		b, err := DataFragmentPayload{
+			FragIndex: s.FragIndex,
+			N:         uint16(i + 1),
+			Payload:   frags[i],
+		}.MarshalBinary()
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal binary error")
+		}
+		fragPayloads = append(fragPayloads, b)
+	}
+
+	statusReq, err := FragSessionStatusReqPayload{FragIndex: s.FragIndex}.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal binary error")
+	}
+
+	devices, err := storage.GetFUOTASessionDevicesForState(db, s.ID, storage.FUOTASessionDeviceSetup)
+	if err != nil {
+		return nil, errors.Wrap(err, "get fuota session devices error")
+	}
+
+	now := time.Now()
+	nextStepAt := now.Add(s.SessionTimeout)
+	s.State = storage.FUOTASessionStatus
+	s.NextStepAt = &nextStepAt
+
+	if s.MulticastGroupID != nil {
+		return &enqueueItems{
+			devices:           devices,
+			payloads:          [][]byte{statusReq},
+			multicastGroupID:  *s.MulticastGroupID,
+			multicastPayloads: fragPayloads,
+		}, nil
+	}
+
+	return &enqueueItems{devices: devices, payloads: append(fragPayloads, statusReq)}, nil
+}
+
+// handleStatusStep completes the session after the session timeout.
+func handleStatusStep(db sqlx.Ext, s *storage.FUOTASession) error {
+	if err := setDeviceErrors(db, s.ID, storage.FUOTASessionDeviceSetup, "no FragSessionStatusAns received"); err != nil {
+		return err
+	}
+
+	s.State = storage.FUOTASessionDone
+	s.NextStepAt = nil
+
+	return nil
+}
+
+// enqueueForMulticastGroup enqueues the multicast payloads for the
+// multicast-group. Each payload is enqueued on its own, so that a failure
+// does not roll back the frame-counter of the payloads which have already
+// been enqueued. When enqueueing fails, the devices are set to the ERROR
+// state and are removed from enq. This must not be called within a
+// transaction.
+func enqueueForMulticastGroup(db sqlx.Ext, id string, enq *enqueueItems) error {
+	for _, b := range enq.multicastPayloads {
+		if _, err := downlink.EnqueueMulticastPayload(db, enq.multicastGroupID, FragPort, b); err != nil {
+			log.WithFields(log.Fields{
+				"id":                 id,
+				"multicast_group_id": enq.multicastGroupID,
+			}).Errorf("enqueue fuota multicast payload error: %s", err)
+
+			for i := range enq.devices {
+				enq.devices[i].State = storage.FUOTASessionDeviceError
+				enq.devices[i].ErrorMessage = fmt.Sprintf("multicast enqueue error: %s", err)
+				if err := storage.UpdateFUOTASessionDevice(db, &enq.devices[i]); err != nil {
+					return errors.Wrap(err, "update fuota session device error")
+				}
+			}
+			enq.devices = nil
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// enqueueForDevices enqueues the given payloads for each device. When
+// enqueueing fails for a device (e.g. it has not been activated), the
+// remaining payloads are not enqueued for this device and the device is
+// set to the ERROR state. This must not be called within a transaction.
+func enqueueForDevices(db sqlx.Ext, id string, enq *enqueueItems) error {
+	for i := range enq.devices {
+		for _, b := range enq.payloads {
+			if _, err := downlink.EnqueueDownlinkPayload(db, enq.devices[i].DevEUI, id, false, FragPort, b); err != nil {
+				log.WithFields(log.Fields{
+					"id":      id,
+					"dev_eui": enq.devices[i].DevEUI,
+				}).Errorf("enqueue fuota payload error: %s", err)
+
+				enq.devices[i].State = storage.FUOTASessionDeviceError
+				enq.devices[i].ErrorMessage = fmt.Sprintf("enqueue error: %s", err)
+				if err := storage.UpdateFUOTASessionDevice(db, &enq.devices[i]); err != nil {
+					return errors.Wrap(err, "update fuota session device error")
+				}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// setDeviceErrors sets the devices of the session in the given state to
+// the ERROR state.
+func setDeviceErrors(db sqlx.Ext, id string, state storage.FUOTASessionDeviceState, msg string) error {
+	devices, err := storage.GetFUOTASessionDevicesForState(db, id, state)
+	if err != nil {
+		return errors.Wrap(err, "get fuota session devices error")
+	}
+
+	for i := range devices {
+		devices[i].State = storage.FUOTASessionDeviceError
+		devices[i].ErrorMessage = msg
+		if err := storage.UpdateFUOTASessionDevice(db, &devices[i]); err != nil {
+			return errors.Wrap(err, "update fuota session device error")
+		}
+	}
+
+	return nil
+}
+
+// CancelSession cancels the given FUOTA session. When the fragments have
+// already been enqueued, the device-queue of each device is flushed.
+// Fragments which have been enqueued for a multicast-group are not
+// removed from the multicast-queue, the FragSessionDeleteReq makes the
+// devices ignore them. When
+// the FragSessionSetupReq has been sent, a FragSessionDeleteReq is enqueued.
+// The session is set to CANCELLED within a transaction, the device-queues
+// are flushed and the FragSessionDeleteReq is enqueued after the transaction
+// has been committed.
+func CancelSession(id string) error {
+	var prevState storage.FUOTASessionState
+	var devices []storage.FUOTASessionDevice
+	var fragIndex uint8
+
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		s, err := storage.GetFUOTASession(tx, id, true)
+		if err != nil {
+			return errors.Wrap(err, "get fuota session error")
+		}
+		if !s.Active() {
+			return storage.ErrFUOTASessionNotActive
+		}
+
+		prevState = s.State
+		fragIndex = s.FragIndex
+
+		if s.State != storage.FUOTASessionSetup {
+			count, err := storage.GetFUOTASessionDeviceCount(tx, s.ID)
+			if err != nil {
+				return errors.Wrap(err, "get fuota session device count error")
+			}
+			devices, err = storage.GetFUOTASessionDevices(tx, s.ID, count, 0)
+			if err != nil {
+				return errors.Wrap(err, "get fuota session devices error")
+			}
+		}
+
+		s.State = storage.FUOTASessionCancelled
+		s.NextStepAt = nil
+		if err := storage.UpdateFUOTASession(tx, &s); err != nil {
+			return errors.Wrap(err, "update fuota session error")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(devices) == 0 {
+		return nil
+	}
+
+	b, err := FragSessionDeleteReqPayload{FragIndex: fragIndex}.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal binary error")
+	}
+
+	for _, d := range devices {
+		if prevState == storage.FUOTASessionStatus {
+			if err := flushDeviceQueue(common.DB, d.DevEUI); err != nil {
+				log.WithFields(log.Fields{
+					"id":      id,
+					"dev_eui": d.DevEUI,
+				}).Errorf("flush device-queue error: %s", err)
+			}
+		}
+
+		if _, err := downlink.EnqueueDownlinkPayload(common.DB, d.DevEUI, id, false, FragPort, b); err != nil {
+			log.WithFields(log.Fields{
+				"id":      id,
+				"dev_eui": d.DevEUI,
+			}).Errorf("enqueue FragSessionDeleteReq error: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func flushDeviceQueue(db sqlx.Ext, devEUI lorawan.EUI64) error {
+	n, err := storage.GetNetworkServerForDevEUI(db, devEUI)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	if err := storage.ExpireQueuedDownlinksForDevEUI(db, devEUI); err != nil {
+		return errors.Wrap(err, "expire queued downlinks error")
+	}
+
+	_, err = nsClient.FlushDeviceQueueForDevEUI(context.Background(), &ns.FlushDeviceQueueForDevEUIRequest{
+		DevEUI: devEUI[:],
+	})
+	if err != nil {
+		return errors.Wrap(err, "flush device-queue error")
+	}
+
+	return nil
+}
+
+// acquireSessionLock returns true when the lock for the given session step
+// was acquired.
+func acquireSessionLock(id string, state storage.FUOTASessionState) (bool, error) {
+	key := fmt.Sprintf(sessionLockTempl, id, state)
+	c := common.RedisPool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", key, "lock", "PX", int64(sessionLockTTL/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
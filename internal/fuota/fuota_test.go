@@ -0,0 +1,394 @@
+package fuota
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+)
+
+func TestFUOTASession(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with two activated devices and a FUOTA session for each device", t, func() {
+		test.MustResetDB(common.DB)
+		test.MustFlushRedis(common.RedisPool)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		devEUIs := []lorawan.EUI64{
+			{1, 1, 1, 1, 1, 1, 1, 1},
+			{2, 2, 2, 2, 2, 2, 2, 2},
+		}
+		for i, devEUI := range devEUIs {
+			So(storage.CreateDevice(common.DB, &storage.Device{
+				ApplicationID:   app.ID,
+				DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+				Name:            devEUI.String(),
+				DevEUI:          devEUI,
+			}), ShouldBeNil)
+			So(storage.CreateDeviceActivation(common.DB, &storage.DeviceActivation{
+				DevEUI:  devEUI,
+				DevAddr: lorawan.DevAddr{1, 2, 3, byte(i)},
+				AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			}), ShouldBeNil)
+		}
+
+		sessions := make([]storage.FUOTASession, len(devEUIs))
+		for i := range devEUIs {
+			sessions[i] = storage.FUOTASession{
+				Name:           "firmware-v2",
+				DevEUI:         &devEUIs[i],
+				FragIndex:      1,
+				FragSize:       10,
+				Redundancy:     5,
+				Descriptor:     []byte{1, 2, 3, 4},
+				Payload:        make([]byte, 95),
+				UnicastTimeout: time.Minute,
+				SessionTimeout: time.Hour,
+			}
+			So(storage.CreateFUOTASession(common.DB, &sessions[i]), ShouldBeNil)
+		}
+		s := sessions[0]
+
+		Convey("When handling the sessions and the network-server returns an error", func() {
+			nsClient.CreateDeviceQueueItemError = errors.New("boom")
+			So(handleSessions(), ShouldBeNil)
+
+			Convey("Then the devices are in the ERROR state and the sessions are in the ENQUEUE state", func() {
+				for i, devEUI := range devEUIs {
+					d, err := storage.GetFUOTASessionDevice(common.DB, sessions[i].ID, devEUI, false)
+					So(err, ShouldBeNil)
+					So(d.State, ShouldEqual, storage.FUOTASessionDeviceError)
+					So(d.ErrorMessage, ShouldStartWith, "enqueue error:")
+
+					s, err := storage.GetFUOTASession(common.DB, sessions[i].ID, false)
+					So(err, ShouldBeNil)
+					So(s.State, ShouldEqual, storage.FUOTASessionEnqueue)
+				}
+			})
+		})
+
+		Convey("When handling the sessions", func() {
+			So(handleSessions(), ShouldBeNil)
+
+			Convey("Then a FragSessionSetupReq was enqueued for each device", func() {
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+				for range devEUIs {
+					req := <-nsClient.CreateDeviceQueueItemChan
+					So(req.Item.FPort, ShouldEqual, FragPort)
+				}
+			})
+
+			Convey("Then the session is in the ENQUEUE state", func() {
+				s, err := storage.GetFUOTASession(common.DB, s.ID, false)
+				So(err, ShouldBeNil)
+				So(s.State, ShouldEqual, storage.FUOTASessionEnqueue)
+				So(s.NextStepAt, ShouldNotBeNil)
+				So(s.NextStepAt.After(time.Now()), ShouldBeTrue)
+			})
+
+			Convey("Then the session is not handled again before the unicast timeout", func() {
+				So(handleSessions(), ShouldBeNil)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+			})
+
+			Convey("When the first device answers the FragSessionSetupReq", func() {
+				So(HandleUplink(devEUIs[0], []byte{byte(FragSessionSetup), 0x40}), ShouldBeNil)
+
+				Convey("Then the device is in the SETUP state", func() {
+					d, err := storage.GetFUOTASessionDevice(common.DB, s.ID, devEUIs[0], false)
+					So(err, ShouldBeNil)
+					So(d.State, ShouldEqual, storage.FUOTASessionDeviceSetup)
+				})
+
+				Convey("When the unicast timeout has expired and the sessions are handled", func() {
+					_, err := common.DB.Exec("update fuota_session set next_step_at = $1", time.Now().Add(-time.Second))
+					So(err, ShouldBeNil)
+					for len(nsClient.CreateDeviceQueueItemChan) > 0 {
+						<-nsClient.CreateDeviceQueueItemChan
+					}
+
+					So(handleSessions(), ShouldBeNil)
+
+					Convey("Then the fragments and the FragSessionStatusReq were enqueued for the first device only", func() {
+						// 10 uncoded fragments + 5 redundant fragments + status request
+						So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 16)
+						for i := 0; i < 16; i++ {
+							req := <-nsClient.CreateDeviceQueueItemChan
+							So(req.Item.DevEUI, ShouldResemble, devEUIs[0][:])
+						}
+					})
+
+					Convey("Then the second device is in the ERROR state", func() {
+						d, err := storage.GetFUOTASessionDevice(common.DB, sessions[1].ID, devEUIs[1], false)
+						So(err, ShouldBeNil)
+						So(d.State, ShouldEqual, storage.FUOTASessionDeviceError)
+						So(d.ErrorMessage, ShouldEqual, "no FragSessionSetupAns received")
+					})
+
+					Convey("When the first device reports that all fragments were received", func() {
+						So(HandleUplink(devEUIs[0], []byte{byte(FragSessionStatus), 0x0f, 0x40, 0x00, 0x00}), ShouldBeNil)
+
+						Convey("Then the device is COMPLETE and the session is DONE", func() {
+							d, err := storage.GetFUOTASessionDevice(common.DB, s.ID, devEUIs[0], false)
+							So(err, ShouldBeNil)
+							So(d.State, ShouldEqual, storage.FUOTASessionDeviceComplete)
+							So(d.NbFragReceived, ShouldEqual, 15)
+							So(d.MissingFrag, ShouldEqual, 0)
+
+							s, err := storage.GetFUOTASession(common.DB, s.ID, false)
+							So(err, ShouldBeNil)
+							So(s.State, ShouldEqual, storage.FUOTASessionDone)
+							So(s.NextStepAt, ShouldBeNil)
+						})
+					})
+
+					Convey("When the session is cancelled", func() {
+						So(CancelSession(s.ID), ShouldBeNil)
+
+						Convey("Then the device-queue was flushed and a FragSessionDeleteReq was enqueued", func() {
+							So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 1)
+							So(<-nsClient.FlushDeviceQueueForDevEUIChan, ShouldResemble, ns.FlushDeviceQueueForDevEUIRequest{
+								DevEUI: devEUIs[0][:],
+							})
+							// the enqueued fragments + status request and
+							// the delete request
+							So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 17)
+						})
+
+						Convey("Then the session is CANCELLED", func() {
+							s, err := storage.GetFUOTASession(common.DB, s.ID, false)
+							So(err, ShouldBeNil)
+							So(s.State, ShouldEqual, storage.FUOTASessionCancelled)
+						})
+
+						Convey("Then cancelling the session again returns an error", func() {
+							So(CancelSession(s.ID), ShouldEqual, storage.ErrFUOTASessionNotActive)
+						})
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestFUOTASessionMulticastGroup(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with a multicast-group with two activated devices and a FUOTA session for the multicast-group", t, func() {
+		test.MustResetDB(common.DB)
+		test.MustFlushRedis(common.RedisPool)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+			DeviceProfile: &ns.DeviceProfile{
+				SupportsClassC: true,
+			},
+		}
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		mg := storage.MulticastGroup{
+			Name:             "test-mg",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			MCAddr:           lorawan.DevAddr{1, 2, 3, 4},
+			MCNwkSKey:        lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			MCAppSKey:        lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+		}
+		So(storage.CreateMulticastGroup(common.DB, &mg), ShouldBeNil)
+
+		devEUIs := []lorawan.EUI64{
+			{1, 1, 1, 1, 1, 1, 1, 1},
+			{2, 2, 2, 2, 2, 2, 2, 2},
+		}
+		for i, devEUI := range devEUIs {
+			So(storage.CreateDevice(common.DB, &storage.Device{
+				ApplicationID:   app.ID,
+				DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+				Name:            devEUI.String(),
+				DevEUI:          devEUI,
+			}), ShouldBeNil)
+			So(storage.CreateDeviceActivation(common.DB, &storage.DeviceActivation{
+				DevEUI:  devEUI,
+				DevAddr: lorawan.DevAddr{1, 2, 3, byte(i)},
+				AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			}), ShouldBeNil)
+			So(storage.AddDeviceToMulticastGroup(common.DB, mg.ID, devEUI), ShouldBeNil)
+		}
+
+		s := storage.FUOTASession{
+			Name:             "firmware-v2",
+			MulticastGroupID: &mg.ID,
+			FragIndex:        1,
+			FragSize:         10,
+			Redundancy:       5,
+			Descriptor:       []byte{1, 2, 3, 4},
+			Payload:          make([]byte, 95),
+			UnicastTimeout:   time.Minute,
+			SessionTimeout:   time.Hour,
+		}
+		So(storage.CreateFUOTASession(common.DB, &s), ShouldBeNil)
+
+		Convey("When handling the session", func() {
+			So(handleSessions(), ShouldBeNil)
+
+			Convey("Then a FragSessionSetupReq with the McGroupBitMask set was enqueued for each device", func() {
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+				for i := range devEUIs {
+					req := <-nsClient.CreateDeviceQueueItemChan
+					So(req.Item.FPort, ShouldEqual, FragPort)
+
+					b, err := lorawan.EncryptFRMPayload(lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, false, lorawan.DevAddr{1, 2, 3, byte(i)}, req.Item.FCnt, req.Item.FrmPayload)
+					So(err, ShouldBeNil)
+					So(b[0], ShouldEqual, byte(FragSessionSetup))
+					So(b[1]&0x0f, ShouldEqual, mcGroupBitMask)
+				}
+				So(nsClient.EnqueueMulticastQueueItemChan, ShouldHaveLength, 0)
+			})
+
+			Convey("When both devices answered the FragSessionSetupReq and the unicast timeout has expired", func() {
+				for _, devEUI := range devEUIs {
+					So(HandleUplink(devEUI, []byte{byte(FragSessionSetup), 0x40}), ShouldBeNil)
+				}
+				_, err := common.DB.Exec("update fuota_session set next_step_at = $1", time.Now().Add(-time.Second))
+				So(err, ShouldBeNil)
+				for len(nsClient.CreateDeviceQueueItemChan) > 0 {
+					<-nsClient.CreateDeviceQueueItemChan
+				}
+
+				Convey("When the multicast enqueue fails", func() {
+					nsClient.EnqueueMulticastQueueItemError = errors.New("boom")
+					So(handleSessions(), ShouldBeNil)
+
+					Convey("Then the devices are in the ERROR state", func() {
+						for _, devEUI := range devEUIs {
+							d, err := storage.GetFUOTASessionDevice(common.DB, s.ID, devEUI, false)
+							So(err, ShouldBeNil)
+							So(d.State, ShouldEqual, storage.FUOTASessionDeviceError)
+							So(d.ErrorMessage, ShouldStartWith, "multicast enqueue error:")
+						}
+						So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("When handling the session", func() {
+					So(handleSessions(), ShouldBeNil)
+
+					Convey("Then the fragments were enqueued once to the multicast-queue", func() {
+						// 10 uncoded fragments + 5 redundant fragments
+						So(nsClient.EnqueueMulticastQueueItemChan, ShouldHaveLength, 15)
+						for i := 0; i < 15; i++ {
+							req := <-nsClient.EnqueueMulticastQueueItemChan
+							So(req.Item.MulticastGroupID, ShouldEqual, mg.ID)
+							So(req.Item.FCnt, ShouldEqual, i)
+							So(req.Item.FPort, ShouldEqual, FragPort)
+						}
+					})
+
+					Convey("Then the FragSessionStatusReq was enqueued for each device", func() {
+						So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+						for _, devEUI := range devEUIs {
+							req := <-nsClient.CreateDeviceQueueItemChan
+							So(req.Item.DevEUI, ShouldResemble, devEUI[:])
+						}
+					})
+
+					Convey("Then the session is in the STATUS state", func() {
+						s, err := storage.GetFUOTASession(common.DB, s.ID, false)
+						So(err, ShouldBeNil)
+						So(s.State, ShouldEqual, storage.FUOTASessionStatus)
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,156 @@
+package fuota
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+// HandleUplink handles the (decrypted) uplink payload received on the
+// FragPort and updates the state of the device within its active FUOTA
+// session.
+func HandleUplink(devEUI lorawan.EUI64, b []byte) error {
+	cmds, err := UnmarshalUplinkCommands(b)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal commands error")
+	}
+
+	for _, cmd := range cmds {
+		switch pl := cmd.(type) {
+		case *FragSessionSetupAnsPayload:
+			err = handleDeviceAnswer(devEUI, pl.FragIndex, func(d *storage.FUOTASessionDevice) bool {
+				return handleFragSessionSetupAns(d, pl)
+			})
+		case *FragSessionStatusAnsPayload:
+			err = handleDeviceAnswer(devEUI, pl.FragIndex, func(d *storage.FUOTASessionDevice) bool {
+				return handleFragSessionStatusAns(d, pl)
+			})
+		case *FragSessionDeleteAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":                devEUI,
+				"frag_index":             pl.FragIndex,
+				"session_does_not_exist": pl.SessionDoesNotExist,
+			}).Info("fuota: FragSessionDeleteAns received")
+		case *PackageVersionAnsPayload:
+			log.WithFields(log.Fields{
+				"dev_eui":            devEUI,
+				"package_identifier": pl.PackageIdentifier,
+				"package_version":    pl.PackageVersion,
+			}).Info("fuota: PackageVersionAns received")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleDeviceAnswer applies the given function to the device of the active
+// FUOTA session of the given DevEUI. When the function returns true, the
+// device is updated. The session is completed when all its devices have
+// reported their status.
+func handleDeviceAnswer(devEUI lorawan.EUI64, fragIndex uint8, fn func(*storage.FUOTASessionDevice) bool) error {
+	d, err := storage.GetActiveFUOTASessionDeviceForDevEUI(common.DB, devEUI)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			log.WithField("dev_eui", devEUI).Warning("fuota: answer received but device is not part of an active fuota session")
+			return nil
+		}
+		return errors.Wrap(err, "get active fuota session device error")
+	}
+
+	return storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		// lock the session first, as the session loop does
+		s, err := storage.GetFUOTASession(tx, d.FUOTASessionID, true)
+		if err != nil {
+			return errors.Wrap(err, "get fuota session error")
+		}
+		if !s.Active() {
+			return nil
+		}
+		if s.FragIndex != fragIndex {
+			log.WithFields(log.Fields{
+				"dev_eui":    devEUI,
+				"id":         s.ID,
+				"frag_index": fragIndex,
+			}).Warning("fuota: answer received for a different fragmentation session index")
+			return nil
+		}
+
+		d, err := storage.GetFUOTASessionDevice(tx, s.ID, devEUI, true)
+		if err != nil {
+			return errors.Wrap(err, "get fuota session device error")
+		}
+
+		if !fn(&d) {
+			return nil
+		}
+		if err := storage.UpdateFUOTASessionDevice(tx, &d); err != nil {
+			return errors.Wrap(err, "update fuota session device error")
+		}
+
+		if s.State != storage.FUOTASessionStatus {
+			return nil
+		}
+
+		pending, err := storage.GetFUOTASessionDeviceCount(tx, s.ID, storage.FUOTASessionDevicePending, storage.FUOTASessionDeviceSetup)
+		if err != nil {
+			return errors.Wrap(err, "get fuota session device count error")
+		}
+		if pending == 0 {
+			s.State = storage.FUOTASessionDone
+			s.NextStepAt = nil
+			if err := storage.UpdateFUOTASession(tx, &s); err != nil {
+				return errors.Wrap(err, "update fuota session error")
+			}
+		}
+
+		return nil
+	})
+}
+
+func handleFragSessionSetupAns(d *storage.FUOTASessionDevice, pl *FragSessionSetupAnsPayload) bool {
+	if d.State != storage.FUOTASessionDevicePending {
+		return false
+	}
+
+	if err := pl.Error(); err != nil {
+		d.State = storage.FUOTASessionDeviceError
+		d.ErrorMessage = fmt.Sprintf("FragSessionSetupAns error: %s", err)
+	} else {
+		d.State = storage.FUOTASessionDeviceSetup
+	}
+
+	return true
+}
+
+func handleFragSessionStatusAns(d *storage.FUOTASessionDevice, pl *FragSessionStatusAnsPayload) bool {
+	switch d.State {
+	case storage.FUOTASessionDeviceSetup, storage.FUOTASessionDeviceComplete, storage.FUOTASessionDeviceIncomplete:
+	default:
+		return false
+	}
+
+	d.NbFragReceived = int(pl.NbFragReceived)
+	d.MissingFrag = int(pl.MissingFrag)
+	d.ErrorMessage = ""
+
+	switch {
+	case pl.NotEnoughMatrixMemory:
+		d.State = storage.FUOTASessionDeviceError
+		d.ErrorMessage = "FragSessionStatusAns error: not enough matrix memory"
+	case pl.MissingFrag == 0:
+		d.State = storage.FUOTASessionDeviceComplete
+	default:
+		d.State = storage.FUOTASessionDeviceIncomplete
+	}
+
+	return true
+}
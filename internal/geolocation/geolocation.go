@@ -0,0 +1,144 @@
+// Package geolocation implements resolving the location of a device, based
+// on the RX meta-data of the gateways which received the uplink.
+package geolocation
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Frankz/lora-app-server/internal/handler"
+)
+
+// Resolver names which can be used with NewResolver.
+const (
+	RSSIResolverName = "rssi"
+	TDOAResolverName = "tdoa"
+)
+
+// Location sources.
+const (
+	RSSISource = "RSSI"
+	TDOASource = "TDOA"
+)
+
+// earthRadius defines the (mean) radius of the earth in meters.
+const earthRadius = 6371000
+
+// ErrNotEnoughData is returned when the RX meta-data does not contain enough
+// data for resolving the location.
+var ErrNotEnoughData = errors.New("not enough data to resolve location")
+
+// Resolver defines the interface of a geolocation resolver.
+type Resolver interface {
+	// Resolve returns the location of the device, based on the RX meta-data
+	// of the gateways which received the uplink.
+	Resolve(rxInfo []handler.RXInfo) (handler.Location, error)
+}
+
+// NewResolver returns a new Resolver for the given resolver names, in order
+// of preference. When the given list is empty, nil is returned.
+func NewResolver(names []string) (Resolver, error) {
+	var resolvers MultiResolver
+
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case "":
+			continue
+		case RSSIResolverName:
+			resolvers = append(resolvers, RSSIResolver{})
+		case TDOAResolverName:
+			resolvers = append(resolvers, TDOAResolver{})
+		default:
+			return nil, errors.Errorf("unknown geolocation resolver: %s", name)
+		}
+	}
+
+	if len(resolvers) == 0 {
+		return nil, nil
+	}
+
+	return resolvers, nil
+}
+
+// MultiResolver returns the location of the first resolver which is able to
+// resolve the location.
+type MultiResolver []Resolver
+
+// Resolve returns the location of the first resolver which is able to
+// resolve the location. When none of the resolvers succeeds, the error of the
+// last resolver is returned.
+func (m MultiResolver) Resolve(rxInfo []handler.RXInfo) (handler.Location, error) {
+	err := ErrNotEnoughData
+	for _, r := range m {
+		var loc handler.Location
+		loc, err = r.Resolve(rxInfo)
+		if err == nil {
+			return loc, nil
+		}
+	}
+	return handler.Location{}, err
+}
+
+// gatewaysWithLocation returns the RX meta-data of the gateways having a
+// location.
+func gatewaysWithLocation(rxInfo []handler.RXInfo) []handler.RXInfo {
+	var out []handler.RXInfo
+	for _, rx := range rxInfo {
+		if rx.Latitude == 0 && rx.Longitude == 0 {
+			continue
+		}
+		out = append(out, rx)
+	}
+	return out
+}
+
+// Distance returns the distance in meters between the given locations.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	p := plane{lat: lat1, lon: lon1}
+	x, y := p.toXY(lat2, lon2)
+	return math.Hypot(x, y)
+}
+
+// plane defines a local (equirectangular) projection of the earth surface
+// around the given reference point, in which the coordinates are expressed
+// in meters. This is accurate enough for the distances between the gateways
+// receiving the same uplink.
+type plane struct {
+	lat, lon float64
+}
+
+// newPlane returns a plane around the center of the given gateways.
+func newPlane(rxInfo []handler.RXInfo) plane {
+	var p plane
+	for _, rx := range rxInfo {
+		p.lat += rx.Latitude
+		p.lon += rx.Longitude
+	}
+	p.lat /= float64(len(rxInfo))
+	p.lon /= float64(len(rxInfo))
+	return p
+}
+
+// toXY returns the x and y coordinates (in meters) of the given location.
+func (p plane) toXY(lat, lon float64) (float64, float64) {
+	x := toRadians(lon-p.lon) * math.Cos(toRadians(p.lat)) * earthRadius
+	y := toRadians(lat-p.lat) * earthRadius
+	return x, y
+}
+
+// toLatLon returns the latitude and longitude of the given coordinates.
+func (p plane) toLatLon(x, y float64) (float64, float64) {
+	lat := p.lat + toDegrees(y/earthRadius)
+	lon := p.lon + toDegrees(x/(earthRadius*math.Cos(toRadians(p.lat))))
+	return lat, lon
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,171 @@
+package geolocation
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/handler"
+)
+
+// rxInfoForDevice returns the RX meta-data of the given gateways, as if
+// the uplink was transmitted at the given location.
+func rxInfoForDevice(lat, lon float64, gws []handler.RXInfo) []handler.RXInfo {
+	txTime := time.Date(2018, time.January, 1, 12, 0, 0, 1000, time.UTC)
+
+	var out []handler.RXInfo
+	for _, gw := range gws {
+		d := Distance(lat, lon, gw.Latitude, gw.Longitude)
+		ts := txTime.Add(time.Duration(d / speedOfLight * float64(time.Second)))
+		gw.Time = &ts
+		gw.FineTimestamp = true
+		out = append(out, gw)
+	}
+	return out
+}
+
+func TestNewResolver(t *testing.T) {
+	Convey("Given a list of resolver names", t, func() {
+		Convey("Then NewResolver returns the resolvers in order", func() {
+			r, err := NewResolver([]string{"tdoa", " rssi"})
+			So(err, ShouldBeNil)
+			So(r, ShouldResemble, MultiResolver{TDOAResolver{}, RSSIResolver{}})
+		})
+
+		Convey("Then NewResolver returns nil for an empty list", func() {
+			r, err := NewResolver([]string{""})
+			So(err, ShouldBeNil)
+			So(r, ShouldBeNil)
+		})
+
+		Convey("Then NewResolver returns an error for an unknown resolver", func() {
+			_, err := NewResolver([]string{"foo"})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestRSSIResolver(t *testing.T) {
+	Convey("Given an RSSIResolver", t, func() {
+		r := RSSIResolver{}
+
+		Convey("Then an uplink without gateway locations returns ErrNotEnoughData", func() {
+			_, err := r.Resolve([]handler.RXInfo{{RSSI: -50}})
+			So(err, ShouldEqual, ErrNotEnoughData)
+		})
+
+		Convey("Then a single gateway returns ErrNotEnoughData", func() {
+			_, err := r.Resolve([]handler.RXInfo{
+				{RSSI: -50, Latitude: 52.1, Longitude: 5.1, Altitude: 10},
+				{RSSI: -60},
+			})
+			So(err, ShouldEqual, ErrNotEnoughData)
+		})
+
+		Convey("Then two gateways with equal RSSI return the center", func() {
+			loc, err := r.Resolve([]handler.RXInfo{
+				{RSSI: -50, Latitude: 52.1, Longitude: 5.1, Altitude: 10},
+				{RSSI: -50, Latitude: 52.2, Longitude: 5.1, Altitude: 20},
+			})
+			So(err, ShouldBeNil)
+			So(loc.Latitude, ShouldAlmostEqual, 52.15)
+			So(loc.Longitude, ShouldAlmostEqual, 5.1)
+			So(loc.Altitude, ShouldAlmostEqual, 15)
+			So(loc.Source, ShouldEqual, RSSISource)
+			So(loc.Accuracy, ShouldAlmostEqual, Distance(52.1, 5.1, 52.15, 5.1), 1)
+		})
+
+		Convey("Then the location is weighted towards the strongest gateway", func() {
+			loc, err := r.Resolve([]handler.RXInfo{
+				{RSSI: -60, Latitude: 52.0, Longitude: 5.0},
+				{RSSI: -100, Latitude: 52.0, Longitude: 5.1},
+				{RSSI: -120},
+			})
+			So(err, ShouldBeNil)
+			So(loc.Latitude, ShouldAlmostEqual, 52.0)
+			So(loc.Longitude, ShouldAlmostEqual, 5.001, 0.0001)
+			So(loc.Accuracy, ShouldBeGreaterThan, 0)
+		})
+	})
+}
+
+func TestTDOAResolver(t *testing.T) {
+	Convey("Given a TDOAResolver and four gateways", t, func() {
+		r := TDOAResolver{}
+		gws := []handler.RXInfo{
+			{RSSI: -80, Latitude: 52.00, Longitude: 5.00, Altitude: 10},
+			{RSSI: -90, Latitude: 52.05, Longitude: 5.00, Altitude: 20},
+			{RSSI: -90, Latitude: 52.00, Longitude: 5.08, Altitude: 30},
+			{RSSI: -100, Latitude: 52.06, Longitude: 5.09, Altitude: 40},
+		}
+
+		Convey("Then the device location is resolved", func() {
+			tests := []struct {
+				Latitude  float64
+				Longitude float64
+			}{
+				{52.02, 5.03},
+				{52.01, 5.07},
+				{52.045, 5.01},
+			}
+
+			for _, test := range tests {
+				loc, err := r.Resolve(rxInfoForDevice(test.Latitude, test.Longitude, gws))
+				So(err, ShouldBeNil)
+				So(Distance(test.Latitude, test.Longitude, loc.Latitude, loc.Longitude), ShouldBeLessThan, 5)
+				So(loc.Altitude, ShouldAlmostEqual, 25)
+				So(loc.Source, ShouldEqual, TDOASource)
+				So(loc.Accuracy, ShouldBeLessThan, 5)
+			}
+		})
+
+		Convey("Then the location is resolved using three gateways", func() {
+			loc, err := r.Resolve(rxInfoForDevice(52.02, 5.03, gws[:3]))
+			So(err, ShouldBeNil)
+			So(Distance(52.02, 5.03, loc.Latitude, loc.Longitude), ShouldBeLessThan, 5)
+		})
+
+		Convey("Then two gateways return ErrNotEnoughData", func() {
+			_, err := r.Resolve(rxInfoForDevice(52.02, 5.03, gws[:2]))
+			So(err, ShouldEqual, ErrNotEnoughData)
+		})
+
+		Convey("Then gateways without fine timestamp are ignored", func() {
+			rxInfo := rxInfoForDevice(52.02, 5.03, gws[:3])
+			rxInfo[0].FineTimestamp = false
+
+			_, err := r.Resolve(rxInfo)
+			So(err, ShouldEqual, ErrNotEnoughData)
+		})
+
+		Convey("Then implausible time differences return ErrNoSolution", func() {
+			rxInfo := rxInfoForDevice(52.02, 5.03, gws)
+			ts := rxInfo[1].Time.Add(time.Millisecond)
+			rxInfo[1].Time = &ts
+
+			_, err := r.Resolve(rxInfo)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestMultiResolver(t *testing.T) {
+	Convey("Given a MultiResolver with TDOA and RSSI resolvers", t, func() {
+		r := MultiResolver{TDOAResolver{}, RSSIResolver{}}
+
+		Convey("Then the RSSI resolver is used when TDOA is not possible", func() {
+			loc, err := r.Resolve([]handler.RXInfo{
+				{RSSI: -50, Latitude: 52.1, Longitude: 5.1},
+				{RSSI: -60, Latitude: 52.2, Longitude: 5.1},
+			})
+			So(err, ShouldBeNil)
+			So(loc.Source, ShouldEqual, RSSISource)
+		})
+
+		Convey("Then ErrNotEnoughData is returned when no resolver succeeds", func() {
+			_, err := r.Resolve([]handler.RXInfo{{RSSI: -50}})
+			So(err, ShouldEqual, ErrNotEnoughData)
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package geolocation
+
+import (
+	"math"
+
+	"github.com/Frankz/lora-app-server/internal/handler"
+)
+
+// rssiMinGateways defines the minimum number of gateways needed for
+// resolving the location by RSSI. The location of a single gateway says
+// nothing about the distance to the device.
+const rssiMinGateways = 2
+
+// RSSIResolver resolves the location as the centroid of the gateway
+// locations, weighted by the RSSI of the uplink. It requires at least two
+// gateways having a location.
+type RSSIResolver struct{}
+
+// Resolve returns the RSSI weighted centroid of the gateway locations. The
+// accuracy is set to the weighted RMS distance (in meters) between the
+// gateways and the centroid.
+func (r RSSIResolver) Resolve(rxInfo []handler.RXInfo) (handler.Location, error) {
+	gws := gatewaysWithLocation(rxInfo)
+	if len(gws) < rssiMinGateways {
+		return handler.Location{}, ErrNotEnoughData
+	}
+
+	p := newPlane(gws)
+
+	var x, y, alt, sum float64
+	weights := make([]float64, len(gws))
+	for i, gw := range gws {
+		// convert the RSSI (dBm) into a linear (amplitude) scale
+		weights[i] = math.Pow(10, float64(gw.RSSI)/20)
+		gwX, gwY := p.toXY(gw.Latitude, gw.Longitude)
+
+		x += weights[i] * gwX
+		y += weights[i] * gwY
+		alt += weights[i] * gw.Altitude
+		sum += weights[i]
+	}
+	x /= sum
+	y /= sum
+	alt /= sum
+
+	var variance float64
+	for i, gw := range gws {
+		gwX, gwY := p.toXY(gw.Latitude, gw.Longitude)
+		variance += weights[i] * (math.Pow(gwX-x, 2) + math.Pow(gwY-y, 2))
+	}
+	variance /= sum
+
+	lat, lon := p.toLatLon(x, y)
+
+	return handler.Location{
+		Latitude:  lat,
+		Longitude: lon,
+		Altitude:  alt,
+		Source:    RSSISource,
+		Accuracy:  math.Sqrt(variance),
+	}, nil
+}
@@ -0,0 +1,157 @@
+package geolocation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/Frankz/lora-app-server/internal/handler"
+)
+
+const (
+	// speedOfLight in meters per second.
+	speedOfLight = 299792458
+
+	// tdoaMinGateways defines the minimum number of gateways needed for
+	// TDOA multilateration.
+	tdoaMinGateways = 3
+
+	// tdoaMaxIterations defines the max. number of iterations used for
+	// solving the location.
+	tdoaMaxIterations = 50
+
+	// tdoaConvergence defines the step size (in meters) at which the
+	// solution is considered converged.
+	tdoaConvergence = 0.01
+
+	// tdoaTolerance defines the distance (in meters) by which the measured
+	// range difference may exceed the distance between two gateways, to
+	// allow for timestamp inaccuracies.
+	tdoaTolerance = 300
+
+	// tdoaMaxDistance defines the max. distance (in meters) between the
+	// solution and the center of the gateways.
+	tdoaMaxDistance = 100000
+)
+
+// ErrNoSolution is returned when the TDOA multilateration does not result in
+// a (plausible) location.
+var ErrNoSolution = errors.New("no tdoa solution")
+
+// TDOAResolver resolves the location by multilateration of the time
+// differences of arrival of the uplink. It requires at least three gateways
+// having a location and reporting a fine (GPS synchronized) timestamp.
+type TDOAResolver struct{}
+
+// Resolve returns the location solved from the time differences of arrival.
+// The accuracy is set to the RMS of the range residuals (in meters). As the
+// altitude can not be solved reliably from the gateway geometry, the mean
+// altitude of the gateways is used.
+func (r TDOAResolver) Resolve(rxInfo []handler.RXInfo) (handler.Location, error) {
+	var gws []handler.RXInfo
+	for _, gw := range gatewaysWithLocation(rxInfo) {
+		if gw.Time == nil || !gw.FineTimestamp {
+			continue
+		}
+		gws = append(gws, gw)
+	}
+	if len(gws) < tdoaMinGateways {
+		return handler.Location{}, ErrNotEnoughData
+	}
+
+	// use the gateway which received the uplink first as reference
+	sort.Slice(gws, func(i, j int) bool {
+		return gws[i].Time.Before(*gws[j].Time)
+	})
+
+	p := newPlane(gws)
+	xs := make([]float64, len(gws))
+	ys := make([]float64, len(gws))
+	ranges := make([]float64, len(gws))
+	var alt float64
+
+	for i, gw := range gws {
+		xs[i], ys[i] = p.toXY(gw.Latitude, gw.Longitude)
+		ranges[i] = gws[i].Time.Sub(*gws[0].Time).Seconds() * speedOfLight
+		alt += gw.Altitude
+
+		// the range difference can not exceed the distance between the
+		// gateways
+		if ranges[i] > math.Hypot(xs[i]-xs[0], ys[i]-ys[0])+tdoaTolerance {
+			return handler.Location{}, errors.Wrap(ErrNoSolution, "time difference exceeds gateway distance")
+		}
+	}
+	alt /= float64(len(gws))
+
+	// Gauss-Newton, starting at the center of the gateways
+	var x, y float64
+	converged := false
+	for i := 0; i < tdoaMaxIterations; i++ {
+		res, jx, jy := tdoaResiduals(x, y, xs, ys, ranges)
+
+		// solve the normal equations (J^T J) d = -J^T r
+		var a, b, c, e, f float64
+		for k := range res {
+			a += jx[k] * jx[k]
+			b += jx[k] * jy[k]
+			c += jy[k] * jy[k]
+			e -= jx[k] * res[k]
+			f -= jy[k] * res[k]
+		}
+		det := a*c - b*b
+		if math.Abs(det) < 1e-12 {
+			return handler.Location{}, errors.Wrap(ErrNoSolution, "singular gateway geometry")
+		}
+		dx := (c*e - b*f) / det
+		dy := (a*f - b*e) / det
+
+		x += dx
+		y += dy
+
+		if math.Hypot(dx, dy) < tdoaConvergence {
+			converged = true
+			break
+		}
+	}
+
+	if !converged || math.IsNaN(x) || math.IsNaN(y) || math.Hypot(x, y) > tdoaMaxDistance {
+		return handler.Location{}, ErrNoSolution
+	}
+
+	res, _, _ := tdoaResiduals(x, y, xs, ys, ranges)
+	var sum float64
+	for _, r := range res {
+		sum += r * r
+	}
+
+	lat, lon := p.toLatLon(x, y)
+
+	return handler.Location{
+		Latitude:  lat,
+		Longitude: lon,
+		Altitude:  alt,
+		Source:    TDOASource,
+		Accuracy:  math.Sqrt(sum / float64(len(res))),
+	}, nil
+}
+
+// tdoaResiduals returns the range-difference residuals for the given
+// position (relative to the first gateway) and their partial derivatives to
+// x and y.
+func tdoaResiduals(x, y float64, xs, ys, ranges []float64) ([]float64, []float64, []float64) {
+	n := len(xs) - 1
+	res := make([]float64, n)
+	jx := make([]float64, n)
+	jy := make([]float64, n)
+
+	d0 := math.Max(math.Hypot(x-xs[0], y-ys[0]), 1e-6)
+	for i := 1; i < len(xs); i++ {
+		di := math.Max(math.Hypot(x-xs[i], y-ys[i]), 1e-6)
+		res[i-1] = di - d0 - ranges[i]
+		jx[i-1] = (x-xs[i])/di - (x-xs[0])/d0
+		jy[i-1] = (y-ys[i])/di - (y-ys[0])/d0
+	}
+
+	return res, jx, jy
+}
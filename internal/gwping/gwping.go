@@ -3,8 +3,8 @@ package gwping
 import (
 	"context"
 	"crypto/rand"
-	"database/sql"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/garyburd/redigo/redis"
@@ -22,13 +22,21 @@ import (
 const (
 	micLookupExpire = time.Second * 10
 	micLookupTempl  = "lora:as:gwping:%s"
+
+	// pingCandidateLimit defines the max. number of gateways for which a
+	// ping is due, to consider within a single iteration.
+	pingCandidateLimit = 100
+
+	// pingOverlapMaxAge defines the max. age of the ping history used to
+	// determine which gateways overlap.
+	pingOverlapMaxAge = 30 * 24 * time.Hour
 )
 
 // SendPingLoop is a never returning function sending the gateway pings.
 func SendPingLoop() {
 	for {
-		if err := sendGatewayPing(); err != nil {
-			log.Errorf("send gateway ping error: %s", err)
+		if err := sendGatewayPings(); err != nil {
+			log.Errorf("send gateway pings error: %s", err)
 		}
 		time.Sleep(time.Second)
 	}
@@ -97,15 +105,39 @@ func HandleReceivedPing(req *as.HandleProprietaryUplinkRequest) error {
 	return nil
 }
 
-// sendGatewayPing selects the next gateway to ping, creates the "ping"
-// frame and sends this frame to the network-server for transmission.
-func sendGatewayPing() error {
+// sendGatewayPings selects the gateways to ping and sends the pings
+// concurrently.
+func sendGatewayPings() error {
+	macs, err := getGatewaysForPing(time.Now())
+	if err != nil {
+		return errors.Wrap(err, "get gateways for ping error")
+	}
+
+	var wg sync.WaitGroup
+	for _, mac := range macs {
+		wg.Add(1)
+		go func(mac lorawan.EUI64) {
+			defer wg.Done()
+			if err := sendGatewayPing(mac); err != nil {
+				log.WithField("gateway_mac", mac).Errorf("send gateway ping error: %s", err)
+			}
+		}(mac)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// sendGatewayPing creates the "ping" frame for the given gateway and sends
+// this frame to the network-server for transmission. In case the ping is
+// no longer due (e.g. it was sent by an other instance), no ping is sent.
+func sendGatewayPing(mac lorawan.EUI64) error {
 	return storage.Transaction(common.DB, func(tx sqlx.Ext) error {
-		gw, err := getGatewayForPing(tx)
+		gw, err := storage.GetGateway(tx, mac, true)
 		if err != nil {
-			return errors.Wrap(err, "get gateway for ping error")
+			return errors.Wrap(err, "get gateway error")
 		}
-		if gw == nil {
+		if !pingDue(gw, time.Now()) {
 			return nil
 		}
 
@@ -114,6 +146,12 @@ func sendGatewayPing() error {
 			Frequency:  common.GatewayPingFrequency,
 			DR:         common.GatewayPingDR,
 		}
+		if gw.PingFrequency != 0 {
+			ping.Frequency = gw.PingFrequency
+		}
+		if gw.PingDR != nil {
+			ping.DR = *gw.PingDR
+		}
 		err = storage.CreateGatewayPing(tx, &ping)
 		if err != nil {
 			return errors.Wrap(err, "create gateway ping error")
@@ -137,7 +175,7 @@ func sendGatewayPing() error {
 		gw.LastPingID = &ping.ID
 		gw.LastPingSentAt = &ping.CreatedAt
 
-		err = storage.UpdateGateway(tx, gw)
+		err = storage.UpdateGateway(tx, &gw)
 		if err != nil {
 			return errors.Wrap(err, "update gateway error")
 		}
@@ -146,30 +184,118 @@ func sendGatewayPing() error {
 	})
 }
 
-// getGatewayForPing returns the next gateway for sending a ping. If no gateway
-// matches the filter criteria, nil is returned.
-func getGatewayForPing(tx sqlx.Ext) (*storage.Gateway, error) {
-	var gw storage.Gateway
-
-	err := sqlx.Get(tx, &gw, `
-		select *
-		from gateway
-		where
-			ping = true
-			and (last_ping_sent_at is null or last_ping_sent_at <= $1)
-		order by last_ping_sent_at
-		limit 1
-		for update`,
-		time.Now().Add(-common.GatewayPingInterval),
-	)
+// getGatewaysForPing returns the gateways to ping. At most
+// common.GatewayPingConcurrency gateways are returned and gateways which
+// (based on the ping history) overlap with each other, or with a gateway of
+// which the ping could still be in the air, are never returned together.
+//
+// As the overlap of a gateway without ping history is unknown, such a
+// gateway is pinged on its own: it is only returned when no other ping
+// could be in the air and no other gateway is pinged while its ping could
+// still be in the air.
+func getGatewaysForPing(now time.Time) ([]lorawan.EUI64, error) {
+	gws, err := storage.GetGatewaysForPing(common.DB, now, common.GatewayPingInterval, pingCandidateLimit)
 	if err != nil {
-		if err == sql.ErrNoRows {
+		return nil, errors.Wrap(err, "get gateways for ping error")
+	}
+	if len(gws) == 0 {
+		return nil, nil
+	}
+
+	busy, err := storage.GetGatewayMACsWithPingSentAfter(common.DB, now.Add(-micLookupExpire))
+	if err != nil {
+		return nil, errors.Wrap(err, "get gateways with recent ping error")
+	}
+
+	macs := append([]lorawan.EUI64{}, busy...)
+	for _, gw := range gws {
+		macs = append(macs, gw.MAC)
+	}
+
+	// the ping of a busy gateway could still be in the air and is therefore
+	// excluded from its history
+	withHistory, err := storage.GetGatewayMACsWithPingHistory(common.DB, macs, now.Add(-pingOverlapMaxAge), now.Add(-micLookupExpire))
+	if err != nil {
+		return nil, errors.Wrap(err, "get gateways with ping history error")
+	}
+
+	known := make(map[lorawan.EUI64]bool)
+	for _, mac := range withHistory {
+		known[mac] = true
+	}
+
+	for _, mac := range busy {
+		if !known[mac] {
 			return nil, nil
 		}
-		return nil, errors.Wrap(err, "select error")
 	}
 
-	return &gw, nil
+	overlaps, err := storage.GetGatewayPingOverlaps(common.DB, macs, now.Add(-pingOverlapMaxAge))
+	if err != nil {
+		return nil, errors.Wrap(err, "get gateway ping overlaps error")
+	}
+
+	overlapping := make(map[lorawan.EUI64][]lorawan.EUI64)
+	for _, o := range overlaps {
+		overlapping[o.GatewayMAC] = append(overlapping[o.GatewayMAC], o.OverlappingGatewayMAC)
+		overlapping[o.OverlappingGatewayMAC] = append(overlapping[o.OverlappingGatewayMAC], o.GatewayMAC)
+	}
+
+	blocked := make(map[lorawan.EUI64]bool)
+	block := func(mac lorawan.EUI64) {
+		blocked[mac] = true
+		for _, o := range overlapping[mac] {
+			blocked[o] = true
+		}
+	}
+	for _, mac := range busy {
+		block(mac)
+	}
+
+	concurrency := common.GatewayPingConcurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var out []lorawan.EUI64
+	for _, gw := range gws {
+		if len(out) == concurrency {
+			break
+		}
+		if !known[gw.MAC] {
+			// the gateways are sorted by the least recently pinged first,
+			// stopping here avoids that the gateway keeps waiting for the
+			// pings of the other gateways
+			if len(out) == 0 && len(busy) == 0 {
+				return []lorawan.EUI64{gw.MAC}, nil
+			}
+			break
+		}
+		if blocked[gw.MAC] {
+			continue
+		}
+		out = append(out, gw.MAC)
+		block(gw.MAC)
+	}
+
+	return out, nil
+}
+
+// pingDue returns if a ping is due for the given gateway.
+func pingDue(gw storage.Gateway, now time.Time) bool {
+	if !gw.Ping {
+		return false
+	}
+	if gw.LastPingSentAt == nil {
+		return true
+	}
+
+	interval := common.GatewayPingInterval
+	if gw.PingInterval != 0 {
+		interval = gw.PingInterval
+	}
+
+	return !gw.LastPingSentAt.After(now.Add(-interval))
 }
 
 func sendPing(mic lorawan.MIC, ping storage.GatewayPing) error {
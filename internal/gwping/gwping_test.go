@@ -24,6 +24,8 @@ func TestGatewayPing(t *testing.T) {
 	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
 	common.GatewayPingDR = 5
 	common.GatewayPingFrequency = 868100000
+	common.GatewayPingInterval = time.Hour
+	common.GatewayPingConcurrency = 10
 
 	Convey("Given a clean database and a gateway", t, func() {
 		nsClient := test.NewNetworkServerClient()
@@ -52,8 +54,8 @@ func TestGatewayPing(t *testing.T) {
 		}
 		So(storage.CreateGateway(common.DB, &gw), ShouldBeNil)
 
-		Convey("When calling sendGatewayPing", func() {
-			So(sendGatewayPing(), ShouldBeNil)
+		Convey("When calling sendGatewayPings", func() {
+			So(sendGatewayPings(), ShouldBeNil)
 
 			Convey("Then the gateway ping fields have been set", func() {
 				gwGet, err := storage.GetGateway(common.DB, gw.MAC, false)
@@ -141,5 +143,161 @@ func TestGatewayPing(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("Given the gateway has a ping frequency and data-rate override", func() {
+			dr := 3
+			gw.PingFrequency = 868300000
+			gw.PingDR = &dr
+			So(storage.UpdateGateway(common.DB, &gw), ShouldBeNil)
+
+			Convey("Then sendGatewayPings uses the gateway frequency and data-rate", func() {
+				So(sendGatewayPings(), ShouldBeNil)
+				So(nsClient.SendProprietaryPayloadChan, ShouldHaveLength, 1)
+				req := <-nsClient.SendProprietaryPayloadChan
+				So(req.Dr, ShouldEqual, 3)
+				So(req.Frequency, ShouldEqual, 868300000)
+			})
+		})
+
+		Convey("Given the gateway has only a ping data-rate override", func() {
+			dr := 0
+			gw.PingDR = &dr
+			So(storage.UpdateGateway(common.DB, &gw), ShouldBeNil)
+
+			Convey("Then sendGatewayPings uses the default frequency and the gateway data-rate", func() {
+				So(sendGatewayPings(), ShouldBeNil)
+				So(nsClient.SendProprietaryPayloadChan, ShouldHaveLength, 1)
+				req := <-nsClient.SendProprietaryPayloadChan
+				So(req.Dr, ShouldEqual, 0)
+				So(req.Frequency, ShouldEqual, uint32(common.GatewayPingFrequency))
+			})
+		})
+
+		Convey("Given the gateway has been pinged 10 minutes ago", func() {
+			ts := time.Now().Add(-10 * time.Minute)
+			gw.LastPingSentAt = &ts
+			So(storage.UpdateGateway(common.DB, &gw), ShouldBeNil)
+
+			Convey("Then no ping is due using the global interval", func() {
+				macs, err := getGatewaysForPing(time.Now())
+				So(err, ShouldBeNil)
+				So(macs, ShouldHaveLength, 0)
+			})
+
+			Convey("Then a ping is due when the gateway has a ping interval of 5 minutes", func() {
+				gw.PingInterval = 5 * time.Minute
+				So(storage.UpdateGateway(common.DB, &gw), ShouldBeNil)
+
+				macs, err := getGatewaysForPing(time.Now())
+				So(err, ShouldBeNil)
+				So(macs, ShouldResemble, []lorawan.EUI64{gw.MAC})
+			})
+		})
+
+		Convey("Given two more gateways of which one overlaps with the gateway", func() {
+			gw2 := storage.Gateway{
+				MAC:             lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+				Name:            "test-gw-2",
+				Description:     "test gateway 2",
+				OrganizationID:  org.ID,
+				Ping:            true,
+				NetworkServerID: n.ID,
+			}
+			gw3 := storage.Gateway{
+				MAC:             lorawan.EUI64{3, 2, 3, 4, 5, 6, 7, 8},
+				Name:            "test-gw-3",
+				Description:     "test gateway 3",
+				OrganizationID:  org.ID,
+				Ping:            true,
+				NetworkServerID: n.ID,
+			}
+			So(storage.CreateGateway(common.DB, &gw2), ShouldBeNil)
+			So(storage.CreateGateway(common.DB, &gw3), ShouldBeNil)
+
+			ping := storage.GatewayPing{
+				GatewayMAC: gw2.MAC,
+				Frequency:  868100000,
+				DR:         5,
+			}
+			So(storage.CreateGatewayPing(common.DB, &ping), ShouldBeNil)
+			So(storage.CreateGatewayPingRX(common.DB, &storage.GatewayPingRX{
+				PingID:     ping.ID,
+				GatewayMAC: gw.MAC,
+				RSSI:       -10,
+				LoRaSNR:    5.5,
+			}), ShouldBeNil)
+
+			Convey("Then getGatewaysForPing returns the gateways without ping history one by one", func() {
+				macs, err := getGatewaysForPing(time.Now())
+				So(err, ShouldBeNil)
+				So(macs, ShouldHaveLength, 1)
+
+				Convey("Then no other gateway is returned while its ping could be in the air", func() {
+					ts := time.Now()
+					gw.LastPingSentAt = &ts
+					So(storage.UpdateGateway(common.DB, &gw), ShouldBeNil)
+					So(storage.CreateGatewayPing(common.DB, &storage.GatewayPing{
+						GatewayMAC: gw.MAC,
+						Frequency:  868100000,
+						DR:         5,
+					}), ShouldBeNil)
+
+					macs, err := getGatewaysForPing(time.Now())
+					So(err, ShouldBeNil)
+					So(macs, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("Given all gateways have sent a ping an hour ago", func() {
+				for _, mac := range []lorawan.EUI64{gw.MAC, gw3.MAC} {
+					So(storage.CreateGatewayPing(common.DB, &storage.GatewayPing{
+						GatewayMAC: mac,
+						Frequency:  868100000,
+						DR:         5,
+					}), ShouldBeNil)
+				}
+				_, err := common.DB.Exec("update gateway_ping set created_at = $1", time.Now().Add(-time.Hour))
+				So(err, ShouldBeNil)
+				_, err = common.DB.Exec("update gateway_ping_rx set created_at = $1", time.Now().Add(-time.Hour))
+				So(err, ShouldBeNil)
+
+				Convey("Then getGatewaysForPing does not return the overlapping gateways together", func() {
+					macs, err := getGatewaysForPing(time.Now())
+					So(err, ShouldBeNil)
+					So(macs, ShouldHaveLength, 2)
+					So(macs, ShouldContain, gw3.MAC)
+					So(macs[0] == gw.MAC && macs[1] == gw2.MAC || macs[0] == gw2.MAC && macs[1] == gw.MAC, ShouldBeFalse)
+				})
+
+				Convey("Then getGatewaysForPing returns at most GatewayPingConcurrency gateways", func() {
+					common.GatewayPingConcurrency = 1
+					defer func() { common.GatewayPingConcurrency = 10 }()
+
+					macs, err := getGatewaysForPing(time.Now())
+					So(err, ShouldBeNil)
+					So(macs, ShouldHaveLength, 1)
+				})
+
+				Convey("When the gateway has just been pinged", func() {
+					ts := time.Now()
+					gw.LastPingSentAt = &ts
+					So(storage.UpdateGateway(common.DB, &gw), ShouldBeNil)
+
+					Convey("Then getGatewaysForPing only returns the non-overlapping gateway", func() {
+						macs, err := getGatewaysForPing(time.Now())
+						So(err, ShouldBeNil)
+						So(macs, ShouldResemble, []lorawan.EUI64{gw3.MAC})
+					})
+				})
+
+				Convey("When calling sendGatewayPings", func() {
+					So(sendGatewayPings(), ShouldBeNil)
+
+					Convey("Then two pings have been sent", func() {
+						So(nsClient.SendProprietaryPayloadChan, ShouldHaveLength, 2)
+					})
+				})
+			})
+		})
 	})
 }
@@ -0,0 +1,181 @@
+// Package gwstatus keeps track of the gateway status (online / offline),
+// based on the gateway stats reports received by the network-server and on
+// the gateways receiving the uplinks of the devices.
+package gwstatus
+
+import (
+	"context"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+const (
+	checkLockKey   = "lora:as:gwstatus:lock"
+	checkBatchSize = 100
+)
+
+// CheckLoop is a never returning function updating the gateway status
+// every common.GatewayStatusCheckInterval. When running multiple instances,
+// only one of them updates the status per interval.
+func CheckLoop() {
+	for {
+		locked, err := acquireCheckLock()
+		if err != nil {
+			log.Errorf("acquire gateway status check lock error: %s", err)
+		} else if locked {
+			if err := checkGateways(time.Now()); err != nil {
+				log.Errorf("check gateway status error: %s", err)
+			}
+		}
+		time.Sleep(common.GatewayStatusCheckInterval)
+	}
+}
+
+func acquireCheckLock() (bool, error) {
+	c := common.RedisPool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", checkLockKey, "lock", "PX", int64(common.GatewayStatusCheckInterval/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// checkGateways updates the status of all gateways.
+func checkGateways(now time.Time) error {
+	var after lorawan.EUI64
+
+	for {
+		gws, err := storage.GetGatewaysAfterMAC(common.DB, after, checkBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "get gateways error")
+		}
+
+		for i := range gws {
+			if err := checkGateway(&gws[i], now); err != nil {
+				log.WithField("mac", gws[i].MAC).Errorf("check gateway status error: %s", err)
+			}
+		}
+
+		if len(gws) < checkBatchSize {
+			return nil
+		}
+		after = gws[len(gws)-1].MAC
+	}
+}
+
+// checkGateway updates the status of the given gateway and sends a
+// notification when the gateway connected or disconnected. When the
+// gateway has not been seen recently, its last-seen timestamp is first
+// updated from the last gateway stats report.
+func checkGateway(gw *storage.Gateway, now time.Time) error {
+	if gw.LastSeenAt == nil || now.Sub(*gw.LastSeenAt) > common.GatewayOfflineTimeout/2 {
+		ts, err := getLastStatsTimestamp(gw)
+		if err != nil {
+			return errors.Wrap(err, "get last stats timestamp error")
+		}
+
+		if ts != nil && (gw.LastSeenAt == nil || ts.After(*gw.LastSeenAt)) {
+			if err := storage.UpdateGatewayLastSeenAt(common.DB, gw.MAC, *ts); err != nil {
+				return errors.Wrap(err, "update gateway last-seen error")
+			}
+			gw.LastSeenAt = ts
+		}
+	}
+
+	oldStatus := gw.Status
+	newStatus := getStatus(gw.LastSeenAt, now, common.GatewayOfflineTimeout)
+	if newStatus == oldStatus {
+		return nil
+	}
+
+	gw.Status = newStatus
+	gw.StatusChangedAt = &now
+	if err := storage.UpdateGatewayStatus(common.DB, gw); err != nil {
+		return errors.Wrap(err, "update gateway status error")
+	}
+
+	// a gateway which was never online does not disconnect
+	if newStatus == storage.GatewayStatusOffline && oldStatus != storage.GatewayStatusOnline {
+		return nil
+	}
+
+	h, ok := common.Handler.(handler.GatewayHandler)
+	if !ok {
+		return nil
+	}
+
+	err := h.SendGatewayStatusNotification(handler.GatewayStatusNotification{
+		OrganizationID: gw.OrganizationID,
+		MAC:            gw.MAC,
+		Name:           gw.Name,
+		Status:         string(gw.Status),
+		LastSeenAt:     gw.LastSeenAt,
+	})
+	if err != nil {
+		return errors.Wrap(err, "send gateway status notification error")
+	}
+
+	return nil
+}
+
+// getLastStatsTimestamp returns the timestamp of the last stats report of
+// the gateway, as tracked by the network-server (the last-seen timestamp of
+// the gateway). As the gateway sends its stats periodically, also when no
+// packets were received or transmitted, an idle gateway is not reported as
+// offline. Nil is returned when the gateway has never been seen.
+func getLastStatsTimestamp(gw *storage.Gateway) (*time.Time, error) {
+	n, err := storage.GetNetworkServer(common.DB, gw.NetworkServerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server client error")
+	}
+
+	resp, err := nsClient.GetGateway(context.Background(), &ns.GetGatewayRequest{
+		Mac: gw.MAC[:],
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "get gateway error")
+	}
+
+	if resp.LastSeenAt == "" {
+		return nil, nil
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, resp.LastSeenAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse timestamp error")
+	}
+
+	return &ts, nil
+}
+
+// getStatus returns the status of a gateway given its last-seen timestamp.
+func getStatus(lastSeenAt *time.Time, now time.Time, timeout time.Duration) storage.GatewayStatus {
+	if lastSeenAt == nil {
+		return storage.GatewayStatusUnknown
+	}
+
+	if now.Sub(*lastSeenAt) > timeout {
+		return storage.GatewayStatusOffline
+	}
+
+	return storage.GatewayStatusOnline
+}
@@ -0,0 +1,164 @@
+package gwstatus
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lora-app-server/internal/test/testhandler"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+func TestGetStatus(t *testing.T) {
+	now := time.Now()
+	recent := now.Add(-time.Minute)
+	old := now.Add(-time.Hour)
+
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name       string
+			LastSeenAt *time.Time
+			Expected   storage.GatewayStatus
+		}{
+			{
+				Name:     "never seen",
+				Expected: storage.GatewayStatusUnknown,
+			},
+			{
+				Name:       "seen within timeout",
+				LastSeenAt: &recent,
+				Expected:   storage.GatewayStatusOnline,
+			},
+			{
+				Name:       "not seen within timeout",
+				LastSeenAt: &old,
+				Expected:   storage.GatewayStatusOffline,
+			},
+		}
+
+		for _, test := range tests {
+			Convey(test.Name, func() {
+				So(getStatus(test.LastSeenAt, now, 5*time.Minute), ShouldEqual, test.Expected)
+			})
+		}
+	})
+}
+
+func TestCheckGateways(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.GatewayOfflineTimeout = 5 * time.Minute
+
+	Convey("Given a clean database with a gateway", t, func() {
+		test.MustResetDB(common.DB)
+
+		h := testhandler.NewTestHandler()
+		common.Handler = h
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		gw := storage.Gateway{
+			MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Name:            "test-gw",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateGateway(common.DB, &gw), ShouldBeNil)
+
+		now := time.Now()
+
+		Convey("When the gateway has never been seen", func() {
+			So(checkGateways(now), ShouldBeNil)
+
+			Convey("Then the gateway was requested from the network-server", func() {
+				So(nsClient.GetGatewayChan, ShouldHaveLength, 1)
+				req := <-nsClient.GetGatewayChan
+				So(req.Mac, ShouldResemble, gw.MAC[:])
+			})
+
+			Convey("Then the status is unknown and no notification was sent", func() {
+				gw, err := storage.GetGateway(common.DB, gw.MAC, false)
+				So(err, ShouldBeNil)
+				So(gw.Status, ShouldEqual, storage.GatewayStatusUnknown)
+				So(h.SendGatewayStatusNotificationChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("When the gateway has sent its stats recently", func() {
+			statsTime := now.Add(-time.Minute).Truncate(time.Second)
+			nsClient.GetGatewayResponse = ns.GetGatewayResponse{
+				LastSeenAt: statsTime.Format(time.RFC3339Nano),
+			}
+			So(checkGateways(now), ShouldBeNil)
+
+			Convey("Then the gateway is online", func() {
+				gw, err := storage.GetGateway(common.DB, gw.MAC, false)
+				So(err, ShouldBeNil)
+				So(gw.Status, ShouldEqual, storage.GatewayStatusOnline)
+				So(gw.LastSeenAt.Equal(statsTime), ShouldBeTrue)
+				So(gw.StatusChangedAt, ShouldNotBeNil)
+			})
+
+			Convey("Then an online notification was sent", func() {
+				So(h.SendGatewayStatusNotificationChan, ShouldHaveLength, 1)
+				pl := <-h.SendGatewayStatusNotificationChan
+				So(pl.OrganizationID, ShouldEqual, org.ID)
+				So(pl.MAC, ShouldEqual, gw.MAC)
+				So(pl.Name, ShouldEqual, gw.Name)
+				So(pl.Status, ShouldEqual, string(storage.GatewayStatusOnline))
+			})
+
+			Convey("When the gateway is not seen within the offline timeout", func() {
+				<-h.SendGatewayStatusNotificationChan
+				So(checkGateways(now.Add(10*time.Minute)), ShouldBeNil)
+
+				Convey("Then the gateway is offline and an offline notification was sent", func() {
+					gw, err := storage.GetGateway(common.DB, gw.MAC, false)
+					So(err, ShouldBeNil)
+					So(gw.Status, ShouldEqual, storage.GatewayStatusOffline)
+
+					So(h.SendGatewayStatusNotificationChan, ShouldHaveLength, 1)
+					pl := <-h.SendGatewayStatusNotificationChan
+					So(pl.Status, ShouldEqual, string(storage.GatewayStatusOffline))
+				})
+			})
+		})
+
+		Convey("When the gateway was seen recently by an uplink", func() {
+			So(storage.UpdateGatewayLastSeenAt(common.DB, gw.MAC, now), ShouldBeNil)
+			So(checkGateways(now), ShouldBeNil)
+
+			Convey("Then the gateway was not requested from the network-server", func() {
+				So(nsClient.GetGatewayChan, ShouldHaveLength, 0)
+			})
+
+			Convey("Then the gateway is online", func() {
+				gw, err := storage.GetGateway(common.DB, gw.MAC, false)
+				So(err, ShouldBeNil)
+				So(gw.Status, ShouldEqual, storage.GatewayStatusOnline)
+				So(h.SendGatewayStatusNotificationChan, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
@@ -2,7 +2,8 @@ package handler
 
 // Handler kinds
 const (
-	HTTPHandlerKind = "HTTP"
+	HTTPHandlerKind     = "HTTP"
+	InfluxDBHandlerKind = "INFLUXDB"
 )
 
 // Handler defines the interface of a handler backend.
@@ -17,5 +18,12 @@ type IntegrationHandler interface {
 	SendJoinNotification(payload JoinNotification) error   // send join notification
 	SendACKNotification(payload ACKNotification) error     // send ack notification
 	SendErrorNotification(payload ErrorNotification) error // send error notification
+	SendAlertNotification(payload AlertNotification) error // send alert notification
 	Close() error                                          // closes the handler
 }
+
+// GatewayHandler defines the interface of a handler receiving the gateway
+// notifications of an organization.
+type GatewayHandler interface {
+	SendGatewayStatusNotification(payload GatewayStatusNotification) error // send gateway status notification
+}
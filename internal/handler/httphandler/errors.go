@@ -4,5 +4,9 @@ import "errors"
 
 // errors
 var (
-	ErrInvalidHeaderName = errors.New("Invalid header name")
+	ErrInvalidHeaderName     = errors.New("Invalid header name")
+	ErrInvalidSigningSecret  = errors.New("Signing secret must be at least 16 characters long")
+	ErrInvalidCACert         = errors.New("Invalid CA certificate")
+	ErrInvalidTLSCertificate = errors.New("Invalid TLS certificate and / or key")
+	ErrInvalidMarshaler      = errors.New("Invalid marshaler")
 )
@@ -3,26 +3,76 @@ package httphandler
 
 import (
 	"bytes"
-	"encoding/json"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"regexp"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/handler/marshaler"
+)
+
+// Event types (used to resolve the endpoint URL of queued deliveries).
+const (
+	DataUpEvent            = "up"
+	JoinNotificationEvent  = "join"
+	ACKNotificationEvent   = "ack"
+	ErrorNotificationEvent = "error"
+	AlertNotificationEvent = "alert"
+	GatewayStatusEvent     = "gateway_status"
+)
+
+// Default retry policy, used when not set in the HandlerConfig.
+const (
+	DefaultMaxAttempts = 10
+	DefaultMaxAge      = 24 * time.Hour
+)
+
+// httpTimeout defines the timeout of the outbound requests.
+const httpTimeout = 10 * time.Second
+
+// defaultHTTPClient is the HTTP client used when no TLS settings are
+// configured.
+var defaultHTTPClient = &http.Client{Timeout: httpTimeout}
+
+// Signature headers, set when a signing secret is configured.
+const (
+	SignatureHeader          = "X-LoRa-Signature"
+	SignatureTimestampHeader = "X-LoRa-Signature-Timestamp"
 )
 
 var headerNameValidator = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
 
 // HandlerConfig contains the configuration for a HTTP handler.
+// MaxAttempts and MaxAge define the retry policy of failed deliveries.
+// When SigningSecret is set, each request is signed using HMAC-SHA256.
+// CACert, TLSCert and TLSKey (PEM encoded) are used to configure the
+// server certificate verification and client certificate authentication.
+// Marshaler defines the encoding of the posted payloads.
 type HandlerConfig struct {
 	Headers              map[string]string `json:"headers"`
 	DataUpURL            string            `json:"dataUpURL"`
 	JoinNotificationURL  string            `json:"joinNotificationURL"`
 	ACKNotificationURL   string            `json:"ackNotificationURL"`
 	ErrorNotificationURL string            `json:"errorNotificationURL"`
+	AlertNotificationURL string            `json:"alertNotificationURL"`
+	GatewayStatusURL     string            `json:"gatewayStatusURL"`
+	MaxAttempts          int               `json:"maxAttempts"`
+	MaxAge               time.Duration     `json:"maxAge"`
+	SigningSecret        string            `json:"signingSecret"`
+	CACert               string            `json:"caCert"`
+	TLSCert              string            `json:"tlsCert"`
+	TLSKey               string            `json:"tlsKey"`
+	Marshaler            marshaler.Type    `json:"marshaler"`
 }
 
 // Validate validates the HandlerConfig data.
@@ -32,39 +82,200 @@ func (c HandlerConfig) Validate() error {
 			return ErrInvalidHeaderName
 		}
 	}
+
+	if !c.Marshaler.Valid() {
+		return ErrInvalidMarshaler
+	}
+
+	if c.SigningSecret != "" && len(c.SigningSecret) < 16 {
+		return ErrInvalidSigningSecret
+	}
+
+	if _, err := c.tlsConfig(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// tlsConfig returns the TLS configuration for the outbound requests. In
+// case no TLS settings are configured, nil is returned.
+func (c HandlerConfig) tlsConfig() (*tls.Config, error) {
+	if c.CACert == "" && c.TLSCert == "" && c.TLSKey == "" {
+		return nil, nil
+	}
+
+	var conf tls.Config
+
+	if c.CACert != "" {
+		conf.RootCAs = x509.NewCertPool()
+		if !conf.RootCAs.AppendCertsFromPEM([]byte(c.CACert)) {
+			return nil, ErrInvalidCACert
+		}
+	}
+
+	if c.TLSCert != "" || c.TLSKey != "" {
+		cert, err := tls.X509KeyPair([]byte(c.TLSCert), []byte(c.TLSKey))
+		if err != nil {
+			return nil, ErrInvalidTLSCertificate
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+
+	return &conf, nil
+}
+
+// Redacted returns a copy of the configuration without the signing secret
+// and TLS key, which must not be returned by the API.
+func (c HandlerConfig) Redacted() HandlerConfig {
+	c.SigningSecret = ""
+	c.TLSKey = ""
+	return c
+}
+
+// KeepSecrets sets the signing secret and TLS key from the given (stored)
+// configuration when they are not set. As these are not returned by the
+// API, an empty value on update keeps the stored value, unless
+// clearSigningSecret is set. The TLS key is removed together with the TLS
+// certificate.
+func (c *HandlerConfig) KeepSecrets(stored HandlerConfig, clearSigningSecret bool) {
+	if c.SigningSecret == "" && !clearSigningSecret {
+		c.SigningSecret = stored.SigningSecret
+	}
+	if c.TLSKey == "" && c.TLSCert != "" {
+		c.TLSKey = stored.TLSKey
+	}
+}
+
+// httpClient returns the HTTP client for the outbound requests.
+func (c HandlerConfig) httpClient() (*http.Client, error) {
+	tlsConf, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConf == nil {
+		return defaultHTTPClient, nil
+	}
+
+	return &http.Client{
+		Timeout: httpTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConf,
+		},
+	}, nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the given timestamp
+// (unix seconds) and payload, joined by a dot, using the given secret.
+func Sign(secret string, timestamp int64, b []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(b)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// maxAttempts returns the configured max attempts or the default.
+func (c HandlerConfig) maxAttempts() int {
+	if c.MaxAttempts == 0 {
+		return DefaultMaxAttempts
+	}
+	return c.MaxAttempts
+}
+
+// maxAge returns the configured max age or the default.
+func (c HandlerConfig) maxAge() time.Duration {
+	if c.MaxAge == 0 {
+		return DefaultMaxAge
+	}
+	return c.MaxAge
+}
+
+// eventURL returns the endpoint URL for the given event type.
+func (c HandlerConfig) eventURL(event string) string {
+	switch event {
+	case DataUpEvent:
+		return c.DataUpURL
+	case JoinNotificationEvent:
+		return c.JoinNotificationURL
+	case ACKNotificationEvent:
+		return c.ACKNotificationURL
+	case ErrorNotificationEvent:
+		return c.ErrorNotificationURL
+	case AlertNotificationEvent:
+		return c.AlertNotificationURL
+	case GatewayStatusEvent:
+		return c.GatewayStatusURL
+	default:
+		return ""
+	}
+}
+
 // Handler implements a HTTP handler for sending and notifying a HTTP
 // endpoint.
 type Handler struct {
-	config HandlerConfig
+	integrationID int64
+	config        HandlerConfig
+	client        *http.Client
 }
 
-// NewHandler creates a new HTTPHandler.
-func NewHandler(conf HandlerConfig) (*Handler, error) {
+// NewHandler creates a new HTTPHandler. Deliveries are queued using the given
+// integration id and are made (and retried on failure) by the RetryLoop.
+// When the integration id is 0, deliveries are made directly and failed
+// deliveries are not retried.
+func NewHandler(integrationID int64, conf HandlerConfig) (*Handler, error) {
+	client, err := conf.httpClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "get http client error")
+	}
+
 	return &Handler{
-		config: conf,
+		integrationID: integrationID,
+		config:        conf,
+		client:        client,
 	}, nil
 }
 
-func (h *Handler) send(url string, payload interface{}) error {
-	b, err := json.Marshal(payload)
+func (h *Handler) send(event string, payload interface{}) error {
+	b, err := marshaler.Marshal(h.config.Marshaler, payload)
 	if err != nil {
-		return errors.Wrap(err, "marshal json error")
+		return errors.Wrap(err, "marshal payload error")
+	}
+
+	if h.integrationID == 0 {
+		return post(h.client, h.config, h.config.eventURL(event), b)
 	}
 
+	// the delivery is always queued (and made by the RetryLoop), so that
+	// it is made after the deliveries which are already queued for this
+	// integration
+	if err := enqueue(h.integrationID, event, b); err != nil {
+		return errors.Wrap(err, "enqueue error")
+	}
+
+	return nil
+}
+
+// post posts the given (marshaled) payload to the given URL.
+func post(client *http.Client, conf HandlerConfig, url string, b []byte) error {
 	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
 	if err != nil {
 		return errors.Wrap(err, "new request error")
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	for k, v := range h.config.Headers {
+	req.Header.Set("Content-Type", conf.Marshaler.ContentType())
+	for k, v := range conf.Headers {
 		req.Header.Set(k, v)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	if conf.SigningSecret != "" {
+		ts := time.Now().Unix()
+		req.Header.Set(SignatureTimestampHeader, strconv.FormatInt(ts, 10))
+		req.Header.Set(SignatureHeader, Sign(conf.SigningSecret, ts, b))
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "http request error")
 	}
@@ -80,9 +291,21 @@ func (h *Handler) send(url string, payload interface{}) error {
 
 // Close closes the handler.
 func (h *Handler) Close() error {
+	closeIdleConnections(h.client)
 	return nil
 }
 
+// closeIdleConnections closes the idle connections of the given client,
+// unless it is the shared default client.
+func closeIdleConnections(client *http.Client) {
+	if client == defaultHTTPClient {
+		return
+	}
+	if t, ok := client.Transport.(*http.Transport); ok {
+		t.CloseIdleConnections()
+	}
+}
+
 // SendDataUp sends a data-up payload.
 func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
 	if h.config.DataUpURL == "" {
@@ -93,7 +316,7 @@ func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
 		"url":     h.config.DataUpURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing data-up payload")
-	return h.send(h.config.DataUpURL, pl)
+	return h.send(DataUpEvent, pl)
 }
 
 // SendJoinNotification sends a join notification.
@@ -106,7 +329,7 @@ func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
 		"url":     h.config.JoinNotificationURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing join notification")
-	return h.send(h.config.JoinNotificationURL, pl)
+	return h.send(JoinNotificationEvent, pl)
 }
 
 // SendACKNotification sends an ACK notification.
@@ -119,7 +342,7 @@ func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
 		"url":     h.config.ACKNotificationURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing ack notification")
-	return h.send(h.config.ACKNotificationURL, pl)
+	return h.send(ACKNotificationEvent, pl)
 }
 
 // SendErrorNotification sends an error notification.
@@ -132,5 +355,31 @@ func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
 		"url":     h.config.ErrorNotificationURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing error notification")
-	return h.send(h.config.ErrorNotificationURL, pl)
+	return h.send(ErrorNotificationEvent, pl)
+}
+
+// SendAlertNotification sends an alert notification.
+func (h *Handler) SendAlertNotification(pl handler.AlertNotification) error {
+	if h.config.AlertNotificationURL == "" {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"url":     h.config.AlertNotificationURL,
+		"dev_eui": pl.DevEUI,
+	}).Info("handler/http: publishing alert notification")
+	return h.send(AlertNotificationEvent, pl)
+}
+
+// SendGatewayStatusNotification sends a gateway status notification.
+func (h *Handler) SendGatewayStatusNotification(pl handler.GatewayStatusNotification) error {
+	if h.config.GatewayStatusURL == "" {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"url": h.config.GatewayStatusURL,
+		"mac": pl.MAC,
+	}).Info("handler/http: publishing gateway status notification")
+	return h.send(GatewayStatusEvent, pl)
 }
@@ -2,16 +2,29 @@ package httphandler
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/handler/marshaler"
 	"github.com/Frankz/lorawan"
 )
 
@@ -26,8 +39,45 @@ func (h *testHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// generateCert returns a self-signed (PEM encoded) certificate and key.
+func generateCert() (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	tmpl := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "lora-app-server"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	if err != nil {
+		return "", "", err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM), nil
+}
+
 func TestHandlerConfig(t *testing.T) {
 	Convey("Given a set of tests", t, func() {
+		tlsCert, tlsKey, err := generateCert()
+		So(err, ShouldBeNil)
+
 		testTable := []struct {
 			Name          string
 			HandlerConfig HandlerConfig
@@ -52,6 +102,57 @@ func TestHandlerConfig(t *testing.T) {
 				},
 				Valid: false,
 			},
+			{
+				Name: "Valid signing secret",
+				HandlerConfig: HandlerConfig{
+					SigningSecret: "0123456789abcdef",
+				},
+				Valid: true,
+			},
+			{
+				Name: "Signing secret too short",
+				HandlerConfig: HandlerConfig{
+					SigningSecret: "secret",
+				},
+				Valid: false,
+			},
+			{
+				Name: "Valid CA certificate and client certificate",
+				HandlerConfig: HandlerConfig{
+					CACert:  tlsCert,
+					TLSCert: tlsCert,
+					TLSKey:  tlsKey,
+				},
+				Valid: true,
+			},
+			{
+				Name: "Invalid CA certificate",
+				HandlerConfig: HandlerConfig{
+					CACert: "foo",
+				},
+				Valid: false,
+			},
+			{
+				Name: "Valid marshaler",
+				HandlerConfig: HandlerConfig{
+					Marshaler: marshaler.Protobuf,
+				},
+				Valid: true,
+			},
+			{
+				Name: "Invalid marshaler",
+				HandlerConfig: HandlerConfig{
+					Marshaler: marshaler.Type("xml"),
+				},
+				Valid: false,
+			},
+			{
+				Name: "Client certificate without key",
+				HandlerConfig: HandlerConfig{
+					TLSCert: tlsCert,
+				},
+				Valid: false,
+			},
 		}
 
 		for i, test := range testTable {
@@ -84,7 +185,7 @@ func TestHandler(t *testing.T) {
 			ACKNotificationURL:   server.URL + "/ack",
 			ErrorNotificationURL: server.URL + "/error",
 		}
-		h, err := NewHandler(conf)
+		h, err := NewHandler(0, conf)
 		So(err, ShouldBeNil)
 
 		Convey("Then SendDataUp sends the correct notification", func() {
@@ -152,3 +253,122 @@ func TestHandler(t *testing.T) {
 		})
 	})
 }
+
+func TestHandlerProtobuf(t *testing.T) {
+	Convey("Given a test HTTP server and a Handler with protobuf marshaler", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		h, err := NewHandler(0, HandlerConfig{
+			DataUpURL: server.URL + "/dataup",
+			Marshaler: marshaler.Protobuf,
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Then SendDataUp sends the protobuf encoded payload", func() {
+			So(h.SendDataUp(handler.DataUpPayload{
+				DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Data:   []byte{1, 2, 3, 4},
+			}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.Header.Get("Content-Type"), ShouldEqual, "application/octet-stream")
+
+			b, err := ioutil.ReadAll(req.Body)
+			So(err, ShouldBeNil)
+
+			var pl api.DataUpPayload
+			So(proto.Unmarshal(b, &pl), ShouldBeNil)
+			So(pl.DevEUI, ShouldResemble, []byte{1, 2, 3, 4, 5, 6, 7, 8})
+			So(pl.Data, ShouldResemble, []byte{1, 2, 3, 4})
+		})
+	})
+}
+
+func TestHandlerSigning(t *testing.T) {
+	Convey("Given a test HTTP server and a Handler with signing secret", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		h, err := NewHandler(0, HandlerConfig{
+			DataUpURL:     server.URL + "/dataup",
+			SigningSecret: "0123456789abcdef",
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Then SendDataUp sends a valid signature", func() {
+			So(h.SendDataUp(handler.DataUpPayload{Data: []byte{1, 2, 3, 4}}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			b, err := ioutil.ReadAll(req.Body)
+			So(err, ShouldBeNil)
+
+			ts, err := strconv.ParseInt(req.Header.Get(SignatureTimestampHeader), 10, 64)
+			So(err, ShouldBeNil)
+			So(ts, ShouldBeGreaterThan, 0)
+			So(req.Header.Get(SignatureHeader), ShouldEqual, Sign("0123456789abcdef", ts, b))
+		})
+	})
+}
+
+func TestHandlerTLS(t *testing.T) {
+	Convey("Given a test HTTPS server requiring a client certificate", t, func() {
+		tlsCert, tlsKey, err := generateCert()
+		So(err, ShouldBeNil)
+
+		clientCAs := x509.NewCertPool()
+		So(clientCAs.AppendCertsFromPEM([]byte(tlsCert)), ShouldBeTrue)
+
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewUnstartedServer(&httpHandler)
+		server.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+		server.StartTLS()
+		defer server.Close()
+
+		caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+		Convey("Then SendDataUp succeeds when using the CA and client certificate", func() {
+			h, err := NewHandler(0, HandlerConfig{
+				DataUpURL: server.URL + "/dataup",
+				CACert:    caCert,
+				TLSCert:   tlsCert,
+				TLSKey:    tlsKey,
+			})
+			So(err, ShouldBeNil)
+			So(h.SendDataUp(handler.DataUpPayload{}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.TLS.PeerCertificates, ShouldHaveLength, 1)
+		})
+
+		Convey("Then SendDataUp fails without client certificate", func() {
+			h, err := NewHandler(0, HandlerConfig{
+				DataUpURL: server.URL + "/dataup",
+				CACert:    caCert,
+			})
+			So(err, ShouldBeNil)
+			So(h.SendDataUp(handler.DataUpPayload{}), ShouldNotBeNil)
+		})
+
+		Convey("Then SendDataUp fails without CA certificate", func() {
+			h, err := NewHandler(0, HandlerConfig{
+				DataUpURL: server.URL + "/dataup",
+				TLSCert:   tlsCert,
+				TLSKey:    tlsKey,
+			})
+			So(err, ShouldBeNil)
+			So(h.SendDataUp(handler.DataUpPayload{}), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,210 @@
+package httphandler
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+)
+
+// Retry backoff settings. The delay doubles on every failed attempt,
+// starting at retryBaseDelay and capped at retryMaxDelay.
+const (
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// retryClaimDuration defines for how long a queue item is claimed while it
+// is being retried. It must exceed the HTTP timeout, after which the item
+// can be claimed again (e.g. when the instance retrying it has crashed).
+const retryClaimDuration = time.Minute
+
+// deliverySuccessInterval defines the interval in which the last success
+// timestamp of a healthy integration is refreshed.
+const deliverySuccessInterval = time.Minute
+
+// retryWakeup is used to wake up the RetryLoop when a delivery has been
+// queued, so that it does not wait for the next poll.
+var retryWakeup = make(chan struct{}, 1)
+
+// RetryLoop is a never returning function making (and retrying) the queued
+// HTTP integration deliveries.
+func RetryLoop() {
+	for {
+		for {
+			processed, err := retryQueueItem()
+			if err != nil {
+				log.Errorf("handler/http: retry queue item error: %s", err)
+				break
+			}
+			if !processed {
+				break
+			}
+		}
+
+		select {
+		case <-retryWakeup:
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// retryBackoff returns the delay before the next attempt, given the number
+// of attempts made so far.
+func retryBackoff(attempts int) time.Duration {
+	d := retryBaseDelay
+	for i := 1; i < attempts; i++ {
+		d = d * 2
+		if d >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return d
+}
+
+// enqueue stores the given delivery in the queue and wakes up the
+// RetryLoop.
+func enqueue(integrationID int64, event string, b []byte) error {
+	err := storage.CreateIntegrationQueueItem(common.DB, &storage.IntegrationQueueItem{
+		IntegrationID: integrationID,
+		Event:         event,
+		Payload:       b,
+		RetryAfter:    time.Now(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "create integration queue item error")
+	}
+
+	select {
+	case retryWakeup <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+func setDeliverySuccess(integrationID int64) error {
+	if err := storage.SetIntegrationDeliverySuccess(common.DB, integrationID, deliverySuccessInterval); err != nil {
+		return errors.Wrap(err, "set integration delivery success error")
+	}
+	return nil
+}
+
+// retryQueueItem delivers the next queue item which is due. On success the
+// item is removed from the queue. On failure the item is either rescheduled
+// or, when the retry policy of the integration is exhausted, moved to the
+// dead letters. As only the first queue item of an integration is due, a
+// failing item holds back the items queued after it. It returns false when
+// there was no item to deliver.
+//
+// The item is claimed within a transaction, the delivery is made after this
+// transaction has been committed so that no row locks are held during the
+// HTTP request.
+func retryQueueItem() (bool, error) {
+	var qi *storage.IntegrationQueueItem
+	var conf HandlerConfig
+
+	err := storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		var err error
+		qi, err = storage.GetNextIntegrationQueueItem(tx)
+		if err != nil {
+			return errors.Wrap(err, "get next integration queue item error")
+		}
+		if qi == nil {
+			return nil
+		}
+
+		integration, err := storage.GetIntegration(tx, qi.IntegrationID)
+		if err != nil {
+			return errors.Wrap(err, "get integration error")
+		}
+
+		if err = json.Unmarshal(integration.Settings, &conf); err != nil {
+			return errors.Wrap(err, "unmarshal handler config error")
+		}
+
+		claimed := *qi
+		claimed.RetryAfter = time.Now().Add(retryClaimDuration)
+		if err = storage.UpdateIntegrationQueueItem(tx, claimed); err != nil {
+			return errors.Wrap(err, "update integration queue item error")
+		}
+
+		return nil
+	})
+	if err != nil || qi == nil {
+		return false, err
+	}
+
+	logFields := log.Fields{
+		"integration_id": qi.IntegrationID,
+		"event":          qi.Event,
+		"attempts":       qi.Attempts,
+	}
+
+	url := conf.eventURL(qi.Event)
+	if url == "" {
+		// the endpoint has been removed from the integration since
+		log.WithFields(logFields).Info("handler/http: endpoint removed, dropping queued delivery")
+		return true, storage.DeleteIntegrationQueueItem(common.DB, qi.ID)
+	}
+
+	var deliveryErr error
+	client, err := conf.httpClient()
+	if err != nil {
+		deliveryErr = errors.Wrap(err, "get http client error")
+	} else {
+		deliveryErr = post(client, conf, url, qi.Payload)
+		closeIdleConnections(client)
+	}
+
+	if deliveryErr == nil {
+		log.WithFields(logFields).Info("handler/http: delivery succeeded")
+		if err = storage.DeleteIntegrationQueueItem(common.DB, qi.ID); err != nil {
+			return true, errors.Wrap(err, "delete integration queue item error")
+		}
+		return true, setDeliverySuccess(qi.IntegrationID)
+	}
+
+	return true, storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		if err := storage.SetIntegrationDeliveryFailure(tx, qi.IntegrationID, deliveryErr); err != nil {
+			return errors.Wrap(err, "set integration delivery failure error")
+		}
+
+		qi.Attempts++
+		qi.LastError = deliveryErr.Error()
+
+		if qi.Attempts >= conf.maxAttempts() || time.Since(qi.CreatedAt) >= conf.maxAge() {
+			log.WithFields(logFields).Warningf("handler/http: queued delivery failed, moving to dead letters: %s", deliveryErr)
+
+			err := storage.CreateIntegrationDeadLetter(tx, &storage.IntegrationDeadLetter{
+				CreatedAt:     qi.CreatedAt,
+				IntegrationID: qi.IntegrationID,
+				Event:         qi.Event,
+				Payload:       qi.Payload,
+				Attempts:      qi.Attempts,
+				LastError:     qi.LastError,
+			})
+			if err != nil {
+				return errors.Wrap(err, "create integration dead letter error")
+			}
+			if err = storage.DeleteIntegrationQueueItem(tx, qi.ID); err != nil {
+				return errors.Wrap(err, "delete integration queue item error")
+			}
+			return nil
+		}
+
+		log.WithFields(logFields).Warningf("handler/http: queued delivery failed, rescheduling: %s", deliveryErr)
+
+		qi.RetryAfter = time.Now().Add(retryBackoff(qi.Attempts))
+		if err := storage.UpdateIntegrationQueueItem(tx, *qi); err != nil {
+			return errors.Wrap(err, "update integration queue item error")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,233 @@
+package httphandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+type testStatusHandler struct {
+	statusCode int
+	requests   chan *http.Request
+}
+
+func (h *testStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.requests <- r
+	w.WriteHeader(h.statusCode)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Attempts int
+			Expected time.Duration
+		}{
+			{1, 10 * time.Second},
+			{2, 20 * time.Second},
+			{3, 40 * time.Second},
+			{10, time.Hour},
+			{100, time.Hour},
+		}
+
+		for _, test := range tests {
+			So(retryBackoff(test.Attempts), ShouldEqual, test.Expected)
+		}
+	})
+}
+
+func TestQueue(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+
+	Convey("Given a clean database, a failing HTTP endpoint and an application with HTTP integration", t, func() {
+		test.MustResetDB(common.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		httpHandler := testStatusHandler{
+			statusCode: http.StatusInternalServerError,
+			requests:   make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		hc := HandlerConfig{
+			DataUpURL:   server.URL + "/rx",
+			MaxAttempts: 2,
+		}
+		hcJSON, err := json.Marshal(hc)
+		So(err, ShouldBeNil)
+
+		integration := storage.Integration{
+			ApplicationID: app.ID,
+			Kind:          handler.HTTPHandlerKind,
+			Settings:      hcJSON,
+		}
+		So(storage.CreateIntegration(common.DB, &integration), ShouldBeNil)
+
+		h, err := NewHandler(integration.ID, hc)
+		So(err, ShouldBeNil)
+
+		Convey("When calling SendDataUp", func() {
+			So(h.SendDataUp(handler.DataUpPayload{DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}}), ShouldBeNil)
+
+			Convey("Then the delivery has been queued", func() {
+				So(httpHandler.requests, ShouldHaveLength, 0)
+
+				count, err := storage.GetIntegrationQueueItemCount(common.DB, integration.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+			})
+
+			Convey("When the delivery is made and fails", func() {
+				processed, err := retryQueueItem()
+				So(err, ShouldBeNil)
+				So(processed, ShouldBeTrue)
+				So(httpHandler.requests, ShouldHaveLength, 1)
+				<-httpHandler.requests
+
+				Convey("Then the delivery is still queued and the failure recorded", func() {
+					count, err := storage.GetIntegrationQueueItemCount(common.DB, integration.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+
+					status, err := storage.GetIntegrationDeliveryStatus(common.DB, integration.ID)
+					So(err, ShouldBeNil)
+					So(status.FailureCount, ShouldEqual, 1)
+					So(status.LastFailureAt, ShouldNotBeNil)
+					So(status.LastError, ShouldEqual, "expected 2XX response, got: 500")
+				})
+
+				Convey("Then the queue item is not retried before its retry after timestamp", func() {
+					processed, err := retryQueueItem()
+					So(err, ShouldBeNil)
+					So(processed, ShouldBeFalse)
+				})
+
+				Convey("When calling SendDataUp again", func() {
+					So(h.SendDataUp(handler.DataUpPayload{DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}}), ShouldBeNil)
+
+					Convey("Then the new delivery is queued behind the failing delivery", func() {
+						processed, err := retryQueueItem()
+						So(err, ShouldBeNil)
+						So(processed, ShouldBeFalse)
+						So(httpHandler.requests, ShouldHaveLength, 0)
+
+						count, err := storage.GetIntegrationQueueItemCount(common.DB, integration.ID)
+						So(err, ShouldBeNil)
+						So(count, ShouldEqual, 2)
+					})
+				})
+
+				Convey("Given the queue item is due for retry", func() {
+					_, err := common.DB.Exec("update integration_queue_item set retry_after = now()")
+					So(err, ShouldBeNil)
+
+					Convey("When the endpoint has recovered", func() {
+						httpHandler.statusCode = http.StatusOK
+
+						processed, err := retryQueueItem()
+						So(err, ShouldBeNil)
+						So(processed, ShouldBeTrue)
+
+						Convey("Then the delivery was made and removed from the queue", func() {
+							req := <-httpHandler.requests
+							So(req.URL.Path, ShouldEqual, "/rx")
+
+							count, err := storage.GetIntegrationQueueItemCount(common.DB, integration.ID)
+							So(err, ShouldBeNil)
+							So(count, ShouldEqual, 0)
+
+							status, err := storage.GetIntegrationDeliveryStatus(common.DB, integration.ID)
+							So(err, ShouldBeNil)
+							So(status.FailureCount, ShouldEqual, 0)
+							So(status.LastSuccessAt, ShouldNotBeNil)
+						})
+					})
+
+					Convey("When the endpoint is still failing", func() {
+						processed, err := retryQueueItem()
+						So(err, ShouldBeNil)
+						So(processed, ShouldBeTrue)
+
+						Convey("Then the max attempts has been reached and the delivery was moved to the dead letters", func() {
+							count, err := storage.GetIntegrationQueueItemCount(common.DB, integration.ID)
+							So(err, ShouldBeNil)
+							So(count, ShouldEqual, 0)
+
+							dls, err := storage.GetIntegrationDeadLetters(common.DB, integration.ID, 10, 0)
+							So(err, ShouldBeNil)
+							So(dls, ShouldHaveLength, 1)
+							So(dls[0].Event, ShouldEqual, DataUpEvent)
+							So(dls[0].Attempts, ShouldEqual, 2)
+
+							status, err := storage.GetIntegrationDeliveryStatus(common.DB, integration.ID)
+							So(err, ShouldBeNil)
+							So(status.FailureCount, ShouldEqual, 2)
+						})
+					})
+
+					Convey("When the HTTP client can not be configured", func() {
+						hc.CACert = "invalid"
+						hcJSON, err := json.Marshal(hc)
+						So(err, ShouldBeNil)
+						integration.Settings = hcJSON
+						So(storage.UpdateIntegration(common.DB, &integration), ShouldBeNil)
+
+						processed, err := retryQueueItem()
+						So(err, ShouldBeNil)
+						So(processed, ShouldBeTrue)
+
+						Convey("Then the failure is counted as an attempt", func() {
+							dls, err := storage.GetIntegrationDeadLetters(common.DB, integration.ID, 10, 0)
+							So(err, ShouldBeNil)
+							So(dls, ShouldHaveLength, 1)
+							So(dls[0].Attempts, ShouldEqual, 2)
+							So(dls[0].LastError, ShouldStartWith, "get http client error")
+						})
+					})
+				})
+			})
+		})
+	})
+}
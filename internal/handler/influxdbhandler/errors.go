@@ -0,0 +1,10 @@
+package influxdbhandler
+
+import "errors"
+
+// errors
+var (
+	ErrInvalidEndpoint  = errors.New("Invalid endpoint")
+	ErrInvalidDatabase  = errors.New("Invalid database name")
+	ErrInvalidPrecision = errors.New("Invalid precision")
+)
@@ -0,0 +1,352 @@
+// Package influxdbhandler implements an InfluxDB handler.
+package influxdbhandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/handler"
+)
+
+// Available precisions (matching the InfluxDB HTTP API precision parameter).
+var precisions = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"u":  time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+}
+
+// httpTimeout defines the timeout of the write requests.
+const httpTimeout = 10 * time.Second
+
+// httpClient is the HTTP client used for the write requests.
+var httpClient = &http.Client{Timeout: httpTimeout}
+
+var (
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	tagEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	stringEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+// HandlerConfig contains the configuration for an InfluxDB handler.
+type HandlerConfig struct {
+	Endpoint            string `json:"endpoint"`
+	DB                  string `json:"db"`
+	Username            string `json:"username"`
+	Password            string `json:"password"`
+	RetentionPolicyName string `json:"retentionPolicyName"`
+	Precision           string `json:"precision"`
+}
+
+// Validate validates the HandlerConfig data.
+func (c HandlerConfig) Validate() error {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return ErrInvalidEndpoint
+	}
+	if c.DB == "" {
+		return ErrInvalidDatabase
+	}
+	if _, ok := precisions[c.Precision]; c.Precision != "" && !ok {
+		return ErrInvalidPrecision
+	}
+	return nil
+}
+
+// Redacted returns a copy of the configuration without the password, which
+// must not be returned by the API.
+func (c HandlerConfig) Redacted() HandlerConfig {
+	c.Password = ""
+	return c
+}
+
+// KeepSecrets sets the password from the given (stored) configuration when
+// it is not set. As the password is not returned by the API, an empty value
+// on update keeps the stored value, unless clearPassword is set.
+func (c *HandlerConfig) KeepSecrets(stored HandlerConfig, clearPassword bool) {
+	if c.Password == "" && !clearPassword {
+		c.Password = stored.Password
+	}
+}
+
+type measurement struct {
+	Name   string
+	Tags   map[string]string
+	Values map[string]interface{}
+}
+
+// String returns the measurement in InfluxDB line protocol format (without
+// timestamp). Tags with an empty value are omitted, as these are not valid
+// in the line protocol.
+func (m measurement) String() string {
+	var tags []string
+	for k, v := range m.Tags {
+		if v == "" {
+			continue
+		}
+		tags = append(tags, tagEscaper.Replace(k)+"="+tagEscaper.Replace(v))
+	}
+	sort.Strings(tags)
+
+	var values []string
+	for k, v := range m.Values {
+		values = append(values, tagEscaper.Replace(k)+"="+formatValue(v))
+	}
+	sort.Strings(values)
+
+	str := measurementEscaper.Replace(m.Name)
+	if len(tags) != 0 {
+		str += "," + strings.Join(tags, ",")
+	}
+	return str + " " + strings.Join(values, ",")
+}
+
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v) + "i"
+	case bool:
+		return strconv.FormatBool(v)
+	case string:
+		return `"` + stringEscaper.Replace(v) + `"`
+	default:
+		return `"` + stringEscaper.Replace(fmt.Sprintf("%v", v)) + `"`
+	}
+}
+
+// Handler implements an InfluxDB handler for writing the uplink data as
+// measurements to an InfluxDB database.
+type Handler struct {
+	config HandlerConfig
+}
+
+// NewHandler creates a new InfluxDB handler.
+func NewHandler(conf HandlerConfig) (*Handler, error) {
+	if conf.Precision == "" {
+		conf.Precision = "ns"
+	}
+
+	return &Handler{
+		config: conf,
+	}, nil
+}
+
+func (h *Handler) send(measurements []measurement, ts *time.Time) error {
+	var lines []string
+	for _, m := range measurements {
+		line := m.String()
+		if ts != nil {
+			line += " " + strconv.FormatInt(ts.UnixNano()/int64(precisions[h.config.Precision]), 10)
+		}
+		lines = append(lines, line)
+	}
+
+	args := url.Values{}
+	args.Set("db", h.config.DB)
+	args.Set("precision", h.config.Precision)
+	if h.config.RetentionPolicyName != "" {
+		args.Set("rp", h.config.RetentionPolicyName)
+	}
+
+	req, err := http.NewRequest("POST", h.config.Endpoint+"?"+args.Encode(), bytes.NewReader([]byte(strings.Join(lines, "\n"))))
+	if err != nil {
+		return errors.Wrap(err, "new request error")
+	}
+
+	req.Header.Set("Content-Type", "text/plain")
+	if h.config.Username != "" || h.config.Password != "" {
+		req.SetBasicAuth(h.config.Username, h.config.Password)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("expected 2XX response, got: %d (%s)", resp.StatusCode, string(b))
+	}
+
+	return nil
+}
+
+// Close closes the handler.
+func (h *Handler) Close() error {
+	return nil
+}
+
+// SendDataUp writes the uplink meta-data, the device battery status and the
+// decoded object (when available) as measurements to InfluxDB.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	tags := map[string]string{
+		"application_name": pl.ApplicationName,
+		"device_name":      pl.DeviceName,
+		"dev_eui":          pl.DevEUI.String(),
+	}
+
+	var measurements []measurement
+	var ts *time.Time
+
+	if len(pl.RXInfo) != 0 {
+		rssi := pl.RXInfo[0].RSSI
+		snr := pl.RXInfo[0].LoRaSNR
+		for _, rxInfo := range pl.RXInfo {
+			if rxInfo.RSSI > rssi {
+				rssi = rxInfo.RSSI
+			}
+			if rxInfo.LoRaSNR > snr {
+				snr = rxInfo.LoRaSNR
+			}
+			if ts == nil && rxInfo.Time != nil {
+				ts = rxInfo.Time
+			}
+		}
+
+		measurements = append(measurements, measurement{
+			Name: "device_uplink",
+			Tags: map[string]string{
+				"application_name": pl.ApplicationName,
+				"device_name":      pl.DeviceName,
+				"dev_eui":          pl.DevEUI.String(),
+				"frequency":        strconv.Itoa(pl.TXInfo.Frequency),
+				"spread_factor":    strconv.Itoa(pl.TXInfo.DataRate.SpreadFactor),
+			},
+			Values: map[string]interface{}{
+				"rssi":  rssi,
+				"snr":   snr,
+				"f_cnt": int(pl.FCnt),
+			},
+		})
+	}
+
+	if pl.DeviceStatusBattery != nil {
+		measurements = append(measurements, measurement{
+			Name: "device_status_battery",
+			Tags: tags,
+			Values: map[string]interface{}{
+				"value": *pl.DeviceStatusBattery,
+			},
+		})
+	}
+
+	if pl.Object != nil {
+		b, err := json.Marshal(pl.Object)
+		if err != nil {
+			return errors.Wrap(err, "marshal object error")
+		}
+
+		var obj interface{}
+		if err = json.Unmarshal(b, &obj); err != nil {
+			return errors.Wrap(err, "unmarshal object error")
+		}
+
+		values := make(map[string]interface{})
+		flatten("", obj, values)
+		for k, v := range values {
+			measurements = append(measurements, measurement{
+				Name: "device_frmpayload_data_" + k,
+				Tags: tags,
+				Values: map[string]interface{}{
+					"value": v,
+				},
+			})
+		}
+	}
+
+	if len(measurements) == 0 {
+		return nil
+	}
+
+	sort.Slice(measurements, func(i, j int) bool {
+		return measurements[i].Name < measurements[j].Name
+	})
+
+	log.WithFields(log.Fields{
+		"endpoint": h.config.Endpoint,
+		"dev_eui":  pl.DevEUI,
+	}).Info("handler/influxdb: writing data-up measurements")
+	return h.send(measurements, ts)
+}
+
+// SendJoinNotification is not implemented.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return nil
+}
+
+// SendACKNotification is not implemented.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return nil
+}
+
+// SendErrorNotification is not implemented.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return nil
+}
+
+// SendAlertNotification is not implemented.
+func (h *Handler) SendAlertNotification(pl handler.AlertNotification) error {
+	return nil
+}
+
+// flatten flattens the given (JSON decoded) object into the given values
+// map, joining the nested keys with an underscore. Values that can not be
+// represented as an InfluxDB field (null) are skipped.
+func flatten(prefix string, obj interface{}, values map[string]interface{}) {
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		for k, vv := range v {
+			flatten(joinKey(prefix, k), vv, values)
+		}
+	case []interface{}:
+		for i, vv := range v {
+			flatten(joinKey(prefix, strconv.Itoa(i)), vv, values)
+		}
+	case float64, bool, string:
+		if prefix == "" {
+			prefix = "value"
+		}
+		values[prefix] = v
+	}
+}
+
+func joinKey(prefix, key string) string {
+	key = toSnakeCase(key)
+	if prefix == "" {
+		return key
+	}
+	return prefix + "_" + key
+}
+
+// toSnakeCase converts the given camelCase key (as used by the codecs) to
+// snake_case.
+func toSnakeCase(s string) string {
+	var out []rune
+	for i, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			if i != 0 {
+				out = append(out, '_')
+			}
+			r = r + ('a' - 'A')
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
@@ -0,0 +1,199 @@
+package influxdbhandler
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/codec"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lorawan"
+)
+
+type testHTTPHandler struct {
+	requests chan *http.Request
+}
+
+func (h *testHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	h.requests <- r
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestHandlerConfig(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		testTable := []struct {
+			Name          string
+			HandlerConfig HandlerConfig
+			Valid         bool
+		}{
+			{
+				Name: "Valid configuration",
+				HandlerConfig: HandlerConfig{
+					Endpoint:  "http://localhost:8086/write",
+					DB:        "lora",
+					Precision: "s",
+				},
+				Valid: true,
+			},
+			{
+				Name: "Missing endpoint",
+				HandlerConfig: HandlerConfig{
+					DB: "lora",
+				},
+				Valid: false,
+			},
+			{
+				Name: "Missing database",
+				HandlerConfig: HandlerConfig{
+					Endpoint: "http://localhost:8086/write",
+				},
+				Valid: false,
+			},
+			{
+				Name: "Invalid precision",
+				HandlerConfig: HandlerConfig{
+					Endpoint:  "http://localhost:8086/write",
+					DB:        "lora",
+					Precision: "d",
+				},
+				Valid: false,
+			},
+		}
+
+		for i, test := range testTable {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				err := test.HandlerConfig.Validate()
+				if test.Valid {
+					So(err, ShouldBeNil)
+				} else {
+					So(err, ShouldNotBeNil)
+				}
+			})
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	Convey("Given a test HTTP server and a Handler instance", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		h, err := NewHandler(HandlerConfig{
+			Endpoint:            server.URL + "/write",
+			DB:                  "lora",
+			Username:            "user",
+			Password:            "password",
+			RetentionPolicyName: "one_week",
+			Precision:           "s",
+		})
+		So(err, ShouldBeNil)
+
+		Convey("When calling SendDataUp with a Cayenne LPP object", func() {
+			now := time.Unix(1500000000, 0)
+			battery := 128
+			So(h.SendDataUp(handler.DataUpPayload{
+				ApplicationName:     "test-app",
+				DeviceName:          "test-device",
+				DevEUI:              lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				DeviceStatusBattery: &battery,
+				RXInfo: []handler.RXInfo{
+					{RSSI: -60, LoRaSNR: 1, Time: &now},
+					{RSSI: -55, LoRaSNR: 7.5},
+				},
+				TXInfo: handler.TXInfo{
+					Frequency: 868100000,
+					DataRate: handler.DataRate{
+						SpreadFactor: 12,
+					},
+				},
+				FCnt: 10,
+				Object: &codec.CayenneLPP{
+					TemperatureSensor: map[byte]float64{3: 24.3},
+					GPSLocation: map[byte]codec.GPSLocation{
+						1: {Latitude: 1.123, Longitude: 2.123, Altitude: 3.5},
+					},
+				},
+			}), ShouldBeNil)
+
+			Convey("Then the expected measurements were written", func() {
+				req := <-httpHandler.requests
+				So(req.URL.Path, ShouldEqual, "/write")
+				So(req.URL.Query().Get("db"), ShouldEqual, "lora")
+				So(req.URL.Query().Get("rp"), ShouldEqual, "one_week")
+				So(req.URL.Query().Get("precision"), ShouldEqual, "s")
+
+				user, pw, ok := req.BasicAuth()
+				So(ok, ShouldBeTrue)
+				So(user, ShouldEqual, "user")
+				So(pw, ShouldEqual, "password")
+
+				b, err := ioutil.ReadAll(req.Body)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `device_frmpayload_data_gps_location_1_altitude,application_name=test-app,dev_eui=0102030405060708,device_name=test-device value=3.5 1500000000
+device_frmpayload_data_gps_location_1_latitude,application_name=test-app,dev_eui=0102030405060708,device_name=test-device value=1.123 1500000000
+device_frmpayload_data_gps_location_1_longitude,application_name=test-app,dev_eui=0102030405060708,device_name=test-device value=2.123 1500000000
+device_frmpayload_data_temperature_sensor_3,application_name=test-app,dev_eui=0102030405060708,device_name=test-device value=24.3 1500000000
+device_status_battery,application_name=test-app,dev_eui=0102030405060708,device_name=test-device value=128i 1500000000
+device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=test-device,frequency=868100000,spread_factor=12 f_cnt=10i,rssi=-55i,snr=7.5 1500000000`)
+			})
+		})
+
+		Convey("When calling SendDataUp with a custom JS object containing strings and booleans", func() {
+			js := codec.NewCustomJS(1, "", `
+				function Decode(fPort, bytes) {
+					return {
+						"status": "door open",
+						"alarm": true
+					};
+				}
+			`)
+			So(js.UnmarshalBinary([]byte{1}), ShouldBeNil)
+
+			So(h.SendDataUp(handler.DataUpPayload{
+				ApplicationName: "test app",
+				DeviceName:      "test-device",
+				DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Object:          js,
+			}), ShouldBeNil)
+
+			Convey("Then the values and tags are escaped", func() {
+				req := <-httpHandler.requests
+				b, err := ioutil.ReadAll(req.Body)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `device_frmpayload_data_alarm,application_name=test\ app,dev_eui=0102030405060708,device_name=test-device value=true
+device_frmpayload_data_status,application_name=test\ app,dev_eui=0102030405060708,device_name=test-device value="door open"`)
+			})
+		})
+
+		Convey("When calling SendDataUp with an empty application and device name", func() {
+			battery := 128
+			So(h.SendDataUp(handler.DataUpPayload{
+				DevEUI:              lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				DeviceStatusBattery: &battery,
+			}), ShouldBeNil)
+
+			Convey("Then the empty tags are omitted", func() {
+				req := <-httpHandler.requests
+				b, err := ioutil.ReadAll(req.Body)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `device_status_battery,dev_eui=0102030405060708 value=128i`)
+			})
+		})
+
+		Convey("Then SendDataUp without data does not write any measurements", func() {
+			So(h.SendDataUp(handler.DataUpPayload{}), ShouldBeNil)
+			So(httpHandler.requests, ShouldHaveLength, 0)
+		})
+	})
+}
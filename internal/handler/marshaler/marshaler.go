@@ -0,0 +1,250 @@
+// Package marshaler implements the (un)marshaling of the integration
+// payloads, using JSON or the protobuf messages defined in the api package.
+package marshaler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/handler"
+)
+
+// Type defines the marshaler type.
+type Type string
+
+// Available marshaler types. An empty type defaults to JSON.
+const (
+	// JSON marshals the payloads using encoding/json.
+	JSON Type = "json"
+
+	// Protobuf marshals the payloads using the protobuf binary encoding.
+	Protobuf Type = "protobuf"
+
+	// ProtobufJSON marshals the protobuf messages to JSON, using the
+	// protobuf field names.
+	ProtobufJSON Type = "protobuf_json"
+)
+
+// Valid returns true when the marshaler type is known.
+func (t Type) Valid() bool {
+	switch t {
+	case "", JSON, Protobuf, ProtobufJSON:
+		return true
+	default:
+		return false
+	}
+}
+
+// ContentType returns the content-type of the marshaled payloads.
+func (t Type) ContentType() string {
+	if t == Protobuf {
+		return "application/octet-stream"
+	}
+	return "application/json"
+}
+
+// Marshal marshals the given payload, which must be one of
+// handler.DataUpPayload, handler.JoinNotification, handler.ACKNotification,
+// handler.ErrorNotification, handler.AlertNotification or
+// handler.GatewayStatusNotification.
+func Marshal(t Type, payload interface{}) ([]byte, error) {
+	switch t {
+	case "", JSON:
+		return json.Marshal(payload)
+	case Protobuf, ProtobufJSON:
+	default:
+		return nil, fmt.Errorf("unknown marshaler type: %s", t)
+	}
+
+	msg, err := toProto(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if t == Protobuf {
+		return proto.Marshal(msg)
+	}
+
+	var buf bytes.Buffer
+	m := jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	if err := m.Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalDataDown unmarshals the given downlink payload.
+func UnmarshalDataDown(t Type, b []byte, pl *handler.DataDownPayload) error {
+	var msg api.DataDownPayload
+
+	switch t {
+	case "", JSON:
+		return json.Unmarshal(b, pl)
+	case Protobuf:
+		if err := proto.Unmarshal(b, &msg); err != nil {
+			return err
+		}
+	case ProtobufJSON:
+		if err := jsonpb.Unmarshal(bytes.NewReader(b), &msg); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown marshaler type: %s", t)
+	}
+
+	pl.ApplicationID = msg.ApplicationID
+	if len(msg.DevEUI) != 0 {
+		if len(msg.DevEUI) != len(pl.DevEUI) {
+			return fmt.Errorf("invalid devEUI length: %d", len(msg.DevEUI))
+		}
+		copy(pl.DevEUI[:], msg.DevEUI)
+	}
+	if msg.FPort > 255 {
+		return fmt.Errorf("invalid fPort: %d", msg.FPort)
+	}
+	pl.Reference = msg.Reference
+	pl.Confirmed = msg.Confirmed
+	pl.FPort = uint8(msg.FPort)
+	pl.Data = msg.Data
+	if msg.ObjectJSON != "" {
+		pl.Object = json.RawMessage(msg.ObjectJSON)
+	}
+
+	return nil
+}
+
+func toProto(payload interface{}) (proto.Message, error) {
+	switch pl := payload.(type) {
+	case handler.DataUpPayload:
+		return dataUpToProto(pl)
+	case handler.JoinNotification:
+		return &api.JoinNotification{
+			ApplicationID:   pl.ApplicationID,
+			ApplicationName: pl.ApplicationName,
+			DeviceName:      pl.DeviceName,
+			DevEUI:          pl.DevEUI[:],
+			DevAddr:         pl.DevAddr[:],
+		}, nil
+	case handler.ACKNotification:
+		return &api.ACKNotification{
+			ApplicationID:   pl.ApplicationID,
+			ApplicationName: pl.ApplicationName,
+			DeviceName:      pl.DeviceName,
+			DevEUI:          pl.DevEUI[:],
+			Reference:       pl.Reference,
+			Acknowledged:    pl.Acknowledged,
+			FCnt:            pl.FCnt,
+		}, nil
+	case handler.ErrorNotification:
+		return &api.ErrorNotification{
+			ApplicationID:   pl.ApplicationID,
+			ApplicationName: pl.ApplicationName,
+			DeviceName:      pl.DeviceName,
+			DevEUI:          pl.DevEUI[:],
+			Type:            pl.Type,
+			Error:           pl.Error,
+			FCnt:            pl.FCnt,
+		}, nil
+	case handler.AlertNotification:
+		return &api.AlertNotification{
+			ApplicationID:   pl.ApplicationID,
+			ApplicationName: pl.ApplicationName,
+			DeviceName:      pl.DeviceName,
+			DevEUI:          pl.DevEUI[:],
+			AlertID:         pl.AlertID,
+			Type:            pl.Type,
+			State:           pl.State,
+			Message:         pl.Message,
+		}, nil
+	case handler.GatewayStatusNotification:
+		msg := api.GatewayStatusNotification{
+			OrganizationID: pl.OrganizationID,
+			Mac:            pl.MAC[:],
+			Name:           pl.Name,
+			Status:         pl.Status,
+		}
+		if pl.LastSeenAt != nil {
+			msg.LastSeenAt = pl.LastSeenAt.Format(time.RFC3339Nano)
+		}
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("unexpected payload type: %T", payload)
+	}
+}
+
+func dataUpToProto(pl handler.DataUpPayload) (*api.DataUpPayload, error) {
+	msg := api.DataUpPayload{
+		ApplicationID:   pl.ApplicationID,
+		ApplicationName: pl.ApplicationName,
+		DeviceName:      pl.DeviceName,
+		DevEUI:          pl.DevEUI[:],
+		TxInfo: &api.UplinkTXInfo{
+			Frequency: int64(pl.TXInfo.Frequency),
+			DataRate: &api.DataRate{
+				Modulation:   pl.TXInfo.DataRate.Modulation,
+				BandWidth:    uint32(pl.TXInfo.DataRate.Bandwidth),
+				SpreadFactor: uint32(pl.TXInfo.DataRate.SpreadFactor),
+				Bitrate:      uint32(pl.TXInfo.DataRate.Bitrate),
+			},
+			Adr:      pl.TXInfo.ADR,
+			CodeRate: pl.TXInfo.CodeRate,
+		},
+		FCnt:  pl.FCnt,
+		FPort: uint32(pl.FPort),
+		Data:  pl.Data,
+		Tags:  pl.Tags,
+	}
+
+	if pl.DeviceStatusBattery != nil || pl.DeviceStatusMargin != nil {
+		msg.DeviceStatus = &api.UplinkDeviceStatus{}
+		if pl.DeviceStatusBattery != nil {
+			msg.DeviceStatus.Battery = uint32(*pl.DeviceStatusBattery)
+		}
+		if pl.DeviceStatusMargin != nil {
+			msg.DeviceStatus.Margin = int32(*pl.DeviceStatusMargin)
+		}
+	}
+
+	for i := range pl.RXInfo {
+		rxInfo := api.UplinkRXInfo{
+			Mac:       pl.RXInfo[i].MAC[:],
+			Rssi:      int32(pl.RXInfo[i].RSSI),
+			LoRaSNR:   pl.RXInfo[i].LoRaSNR,
+			Name:      pl.RXInfo[i].Name,
+			Latitude:  pl.RXInfo[i].Latitude,
+			Longitude: pl.RXInfo[i].Longitude,
+			Altitude:  pl.RXInfo[i].Altitude,
+		}
+		if pl.RXInfo[i].Time != nil {
+			rxInfo.Time = pl.RXInfo[i].Time.Format(time.RFC3339Nano)
+		}
+		msg.RxInfo = append(msg.RxInfo, &rxInfo)
+	}
+
+	if pl.Location != nil {
+		msg.Location = &api.DeviceLocation{
+			Latitude:  pl.Location.Latitude,
+			Longitude: pl.Location.Longitude,
+			Altitude:  pl.Location.Altitude,
+			Source:    pl.Location.Source,
+			Accuracy:  pl.Location.Accuracy,
+		}
+	}
+
+	if pl.Object != nil {
+		b, err := json.Marshal(pl.Object)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal object error")
+		}
+		msg.ObjectJSON = string(b)
+	}
+
+	return &msg, nil
+}
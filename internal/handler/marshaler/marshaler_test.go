@@ -0,0 +1,276 @@
+package marshaler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/api"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lorawan"
+)
+
+type testObject struct {
+	Temperature float64 `json:"temperature"`
+}
+
+func (o testObject) MarshalBinary() ([]byte, error) {
+	return nil, nil
+}
+
+func (o *testObject) UnmarshalBinary(b []byte) error {
+	return nil
+}
+
+func TestMarshal(t *testing.T) {
+	Convey("Given a DataUpPayload", t, func() {
+		batt := 100
+		now := time.Now().UTC()
+
+		pl := handler.DataUpPayload{
+			ApplicationID:       123,
+			ApplicationName:     "test-app",
+			DeviceName:          "test-device",
+			DevEUI:              lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			DeviceStatusBattery: &batt,
+			RXInfo: []handler.RXInfo{
+				{
+					MAC:     lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					Time:    &now,
+					RSSI:    -60,
+					LoRaSNR: 5.5,
+				},
+			},
+			TXInfo: handler.TXInfo{
+				Frequency: 868100000,
+				DataRate: handler.DataRate{
+					Modulation:   "LORA",
+					Bandwidth:    125,
+					SpreadFactor: 7,
+				},
+			},
+			FCnt:  10,
+			FPort: 20,
+			Data:  []byte{1, 2, 3},
+		}
+
+		Convey("Then the JSON marshaler returns the encoding/json output", func() {
+			expected, err := json.Marshal(pl)
+			So(err, ShouldBeNil)
+
+			for _, typ := range []Type{"", JSON} {
+				b, err := Marshal(typ, pl)
+				So(err, ShouldBeNil)
+				So(b, ShouldResemble, expected)
+			}
+		})
+
+		Convey("Then the Protobuf marshaler returns the expected message", func() {
+			b, err := Marshal(Protobuf, pl)
+			So(err, ShouldBeNil)
+
+			var msg api.DataUpPayload
+			So(proto.Unmarshal(b, &msg), ShouldBeNil)
+			So(msg, ShouldResemble, api.DataUpPayload{
+				ApplicationID:   123,
+				ApplicationName: "test-app",
+				DeviceName:      "test-device",
+				DevEUI:          []byte{1, 2, 3, 4, 5, 6, 7, 8},
+				DeviceStatus: &api.UplinkDeviceStatus{
+					Battery: 100,
+				},
+				RxInfo: []*api.UplinkRXInfo{
+					{
+						Mac:     []byte{8, 7, 6, 5, 4, 3, 2, 1},
+						Time:    now.Format(time.RFC3339Nano),
+						Rssi:    -60,
+						LoRaSNR: 5.5,
+					},
+				},
+				TxInfo: &api.UplinkTXInfo{
+					Frequency: 868100000,
+					DataRate: &api.DataRate{
+						Modulation:   "LORA",
+						BandWidth:    125,
+						SpreadFactor: 7,
+					},
+				},
+				FCnt:  10,
+				FPort: 20,
+				Data:  []byte{1, 2, 3},
+			})
+		})
+
+		Convey("Given the payload contains an object", func() {
+			pl.Object = &testObject{Temperature: 21.5}
+
+			Convey("Then the Protobuf marshaler sets the object as JSON", func() {
+				b, err := Marshal(Protobuf, pl)
+				So(err, ShouldBeNil)
+
+				var msg api.DataUpPayload
+				So(proto.Unmarshal(b, &msg), ShouldBeNil)
+				So(msg.ObjectJSON, ShouldEqual, `{"temperature":21.5}`)
+			})
+		})
+
+		Convey("Given the payload contains a location", func() {
+			pl.Location = &handler.Location{
+				Latitude:  52.1,
+				Longitude: 5.1,
+				Altitude:  10,
+				Source:    "TDOA",
+				Accuracy:  25.5,
+			}
+
+			Convey("Then the Protobuf marshaler sets the location", func() {
+				b, err := Marshal(Protobuf, pl)
+				So(err, ShouldBeNil)
+
+				var msg api.DataUpPayload
+				So(proto.Unmarshal(b, &msg), ShouldBeNil)
+				So(msg.Location, ShouldResemble, &api.DeviceLocation{
+					Latitude:  52.1,
+					Longitude: 5.1,
+					Altitude:  10,
+					Source:    "TDOA",
+					Accuracy:  25.5,
+				})
+			})
+		})
+
+		Convey("Then the ProtobufJSON marshaler uses the protobuf field names", func() {
+			b, err := Marshal(ProtobufJSON, pl)
+			So(err, ShouldBeNil)
+
+			var m map[string]interface{}
+			So(json.Unmarshal(b, &m), ShouldBeNil)
+			So(m["applicationID"], ShouldEqual, "123")
+			So(m["devEUI"], ShouldEqual, "AQIDBAUGBwg=")
+			So(m["deviceStatus"], ShouldResemble, map[string]interface{}{
+				"battery": float64(100),
+				"margin":  float64(0),
+			})
+		})
+	})
+
+	Convey("Given an AlertNotification", t, func() {
+		pl := handler.AlertNotification{
+			ApplicationID:   123,
+			ApplicationName: "test-app",
+			DeviceName:      "test-device",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			AlertID:         "c5a4b9b0-0c4e-4a4e-9a4a-2c8f6a3c1f10",
+			Type:            "LOW_BATTERY",
+			State:           "OPEN",
+			Message:         "battery level 10 is below threshold 50",
+		}
+
+		Convey("Then the Protobuf marshaler returns the expected message", func() {
+			b, err := Marshal(Protobuf, pl)
+			So(err, ShouldBeNil)
+
+			var msg api.AlertNotification
+			So(proto.Unmarshal(b, &msg), ShouldBeNil)
+			So(msg, ShouldResemble, api.AlertNotification{
+				ApplicationID:   123,
+				ApplicationName: "test-app",
+				DeviceName:      "test-device",
+				DevEUI:          []byte{1, 2, 3, 4, 5, 6, 7, 8},
+				AlertID:         "c5a4b9b0-0c4e-4a4e-9a4a-2c8f6a3c1f10",
+				Type:            "LOW_BATTERY",
+				State:           "OPEN",
+				Message:         "battery level 10 is below threshold 50",
+			})
+		})
+	})
+
+	Convey("Given a GatewayStatusNotification", t, func() {
+		lastSeen := time.Date(2018, 3, 1, 12, 30, 0, 0, time.UTC)
+		pl := handler.GatewayStatusNotification{
+			OrganizationID: 123,
+			MAC:            lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Name:           "test-gateway",
+			Status:         "OFFLINE",
+			LastSeenAt:     &lastSeen,
+		}
+
+		Convey("Then the Protobuf marshaler returns the expected message", func() {
+			b, err := Marshal(Protobuf, pl)
+			So(err, ShouldBeNil)
+
+			var msg api.GatewayStatusNotification
+			So(proto.Unmarshal(b, &msg), ShouldBeNil)
+			So(msg, ShouldResemble, api.GatewayStatusNotification{
+				OrganizationID: 123,
+				Mac:            []byte{1, 2, 3, 4, 5, 6, 7, 8},
+				Name:           "test-gateway",
+				Status:         "OFFLINE",
+				LastSeenAt:     "2018-03-01T12:30:00Z",
+			})
+		})
+	})
+
+	Convey("Given an unknown marshaler type", t, func() {
+		typ := Type("xml")
+
+		Convey("Then Valid returns false", func() {
+			So(typ.Valid(), ShouldBeFalse)
+		})
+
+		Convey("Then Marshal returns an error", func() {
+			_, err := Marshal(typ, handler.JoinNotification{})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestUnmarshalDataDown(t *testing.T) {
+	Convey("Given a DataDownPayload protobuf message", t, func() {
+		msg := api.DataDownPayload{
+			DevEUI:     []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			Reference:  "abcd1234",
+			Confirmed:  true,
+			FPort:      10,
+			Data:       []byte{1, 2, 3},
+			ObjectJSON: `{"foo":"bar"}`,
+		}
+		expected := handler.DataDownPayload{
+			DevEUI:    lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Reference: "abcd1234",
+			Confirmed: true,
+			FPort:     10,
+			Data:      []byte{1, 2, 3},
+			Object:    json.RawMessage(`{"foo":"bar"}`),
+		}
+
+		Convey("Then the Protobuf marshaler decodes the binary encoding", func() {
+			b, err := proto.Marshal(&msg)
+			So(err, ShouldBeNil)
+
+			var pl handler.DataDownPayload
+			So(UnmarshalDataDown(Protobuf, b, &pl), ShouldBeNil)
+			So(pl, ShouldResemble, expected)
+		})
+
+		Convey("Then the ProtobufJSON marshaler decodes the JSON encoding", func() {
+			b := []byte(`{"devEUI":"AQIDBAUGBwg=","reference":"abcd1234","confirmed":true,"fPort":10,"data":"AQID","objectJSON":"{\"foo\":\"bar\"}"}`)
+
+			var pl handler.DataDownPayload
+			So(UnmarshalDataDown(ProtobufJSON, b, &pl), ShouldBeNil)
+			So(pl, ShouldResemble, expected)
+		})
+
+		Convey("Then an invalid DevEUI returns an error", func() {
+			msg.DevEUI = []byte{1, 2, 3}
+			b, err := proto.Marshal(&msg)
+			So(err, ShouldBeNil)
+
+			var pl handler.DataDownPayload
+			So(UnmarshalDataDown(Protobuf, b, &pl), ShouldNotBeNil)
+		})
+	})
+}
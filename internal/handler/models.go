@@ -26,6 +26,19 @@ type RXInfo struct {
 	Latitude  float64       `json:"latitude"`
 	Longitude float64       `json:"longitude"`
 	Altitude  float64       `json:"altitude"`
+
+	// FineTimestamp is set when Time is a fine (GPS synchronized) timestamp
+	// of a gateway configured as reporting fine timestamps.
+	FineTimestamp bool `json:"-"`
+}
+
+// Location contains the (resolved) location of a device.
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Altitude  float64 `json:"altitude"`
+	Source    string  `json:"source"`
+	Accuracy  float64 `json:"accuracy"`
 }
 
 // TXInfo contains the TX information.
@@ -38,18 +51,20 @@ type TXInfo struct {
 
 // DataUpPayload represents a data-up payload.
 type DataUpPayload struct {
-	ApplicationID       int64         `json:"applicationID,string"`
-	ApplicationName     string        `json:"applicationName"`
-	DeviceName          string        `json:"deviceName"`
-	DevEUI              lorawan.EUI64 `json:"devEUI"`
-	DeviceStatusBattery *int          `json:"deviceStatusBattery,omitempty"`
-	DeviceStatusMargin  *int          `json:"deviceStatusMargin,omitempty"`
-	RXInfo              []RXInfo      `json:"rxInfo,omitempty"`
-	TXInfo              TXInfo        `json:"txInfo"`
-	FCnt                uint32        `json:"fCnt"`
-	FPort               uint8         `json:"fPort"`
-	Data                []byte        `json:"data"`
-	Object              codec.Payload `json:"object,omitempty"`
+	ApplicationID       int64             `json:"applicationID,string"`
+	ApplicationName     string            `json:"applicationName"`
+	DeviceName          string            `json:"deviceName"`
+	DevEUI              lorawan.EUI64     `json:"devEUI"`
+	DeviceStatusBattery *int              `json:"deviceStatusBattery,omitempty"`
+	DeviceStatusMargin  *int              `json:"deviceStatusMargin,omitempty"`
+	Tags                map[string]string `json:"tags,omitempty"`
+	RXInfo              []RXInfo          `json:"rxInfo,omitempty"`
+	TXInfo              TXInfo            `json:"txInfo"`
+	Location            *Location         `json:"location,omitempty"`
+	FCnt                uint32            `json:"fCnt"`
+	FPort               uint8             `json:"fPort"`
+	Data                []byte            `json:"data"`
+	Object              codec.Payload     `json:"object,omitempty"`
 }
 
 // DataDownPayload represents a data-down payload.
@@ -96,3 +111,26 @@ type ErrorNotification struct {
 	Error           string        `json:"error"`
 	FCnt            uint32        `json:"fCnt"`
 }
+
+// AlertNotification defines the payload sent to the application when a
+// device alert is opened or resolved.
+type AlertNotification struct {
+	ApplicationID   int64         `json:"applicationID,string"`
+	ApplicationName string        `json:"applicationName"`
+	DeviceName      string        `json:"deviceName"`
+	DevEUI          lorawan.EUI64 `json:"devEUI"`
+	AlertID         string        `json:"alertID"`
+	Type            string        `json:"type"`
+	State           string        `json:"state"`
+	Message         string        `json:"message"`
+}
+
+// GatewayStatusNotification defines the payload sent to the organization
+// when a gateway connects or disconnects.
+type GatewayStatusNotification struct {
+	OrganizationID int64         `json:"organizationID,string"`
+	MAC            lorawan.EUI64 `json:"mac"`
+	Name           string        `json:"name"`
+	Status         string        `json:"status"`
+	LastSeenAt     *time.Time    `json:"lastSeenAt"`
+}
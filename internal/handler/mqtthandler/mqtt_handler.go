@@ -5,26 +5,85 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
+	"text/template"
+	"text/template/parse"
 	"time"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/Frankz/lora-app-server/internal/common"
 	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/handler/marshaler"
+	"github.com/Frankz/lorawan"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/garyburd/redigo/redis"
 )
 
-const txTopic = "application/+/node/+/tx"
 const downlinkLockTTL = time.Millisecond * 100
 
-var txTopicRegex = regexp.MustCompile(`application/(\w+)/node/(\w+)/tx`)
+// Default topic templates.
+const (
+	DefaultUplinkTopicTemplate   = "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/rx"
+	DefaultJoinTopicTemplate     = "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/join"
+	DefaultACKTopicTemplate      = "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/ack"
+	DefaultErrorTopicTemplate    = "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/error"
+	DefaultAlertTopicTemplate    = "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/alert"
+	DefaultDownlinkTopicTemplate = "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/tx"
+
+	DefaultGatewayStatusTopicTemplate = "organization/{{ .OrganizationID }}/gateway/{{ .MAC }}/status"
+)
+
+// placeholders used for turning the downlink topic template into a
+// subscription topic and a regular expression.
+const (
+	applicationIDPlaceholder = "APPLICATIONIDPLACEHOLDER"
+	devEUIPlaceholder        = "DEVEUIPLACEHOLDER"
+)
+
+// Config contains the MQTT handler configuration. The topic templates are
+// Go templates which are executed with the ApplicationID and DevEUI of the
+// device, or the OrganizationID and MAC of the gateway for the gateway
+// status topic. Empty topic templates are set to their default. Marshaler
+// defines the encoding of the published payloads and received downlinks.
+type Config struct {
+	Server                string
+	Username              string
+	Password              string
+	CACert                string
+	TLSCert               string
+	TLSKey                string
+	UplinkTopicTemplate   string
+	JoinTopicTemplate     string
+	ACKTopicTemplate      string
+	ErrorTopicTemplate    string
+	AlertTopicTemplate    string
+	DownlinkTopicTemplate string
+	QOS                   uint8
+	Retain                bool
+	Marshaler             marshaler.Type
+
+	GatewayStatusTopicTemplate string
+}
+
+// topicData contains the data available to the topic templates.
+type topicData struct {
+	ApplicationID string
+	DevEUI        string
+}
+
+// gatewayTopicData contains the data available to the gateway topic
+// templates.
+type gatewayTopicData struct {
+	OrganizationID string
+	MAC            string
+}
 
 // MQTTHandler implements a MQTT handler for sending and receiving data by
 // an application.
@@ -33,34 +92,94 @@ type MQTTHandler struct {
 	dataDownChan chan handler.DataDownPayload
 	wg           sync.WaitGroup
 	redisPool    *redis.Pool
+
+	qos       uint8
+	retain    bool
+	marshaler marshaler.Type
+
+	uplinkTemplate *template.Template
+	joinTemplate   *template.Template
+	ackTemplate    *template.Template
+	errorTemplate  *template.Template
+	alertTemplate  *template.Template
+
+	gatewayStatusTemplate *template.Template
+
+	downlinkTopic      string
+	downlinkTopicRegex *regexp.Regexp
 }
 
 // NewHandler creates a new MQTTHandler.
-func NewHandler(server, username, password, cafile, certFile, certKeyFile string) (handler.Handler, error) {
+func NewHandler(conf Config) (handler.Handler, error) {
 	h := MQTTHandler{
 		dataDownChan: make(chan handler.DataDownPayload),
+		qos:          conf.QOS,
+		retain:       conf.Retain,
+		marshaler:    conf.Marshaler,
+	}
+
+	if conf.QOS > 2 {
+		return nil, fmt.Errorf("handler/mqtt: invalid qos %d, must be 0, 1 or 2", conf.QOS)
+	}
+
+	if !conf.Marshaler.Valid() {
+		return nil, fmt.Errorf("handler/mqtt: invalid marshaler %s", conf.Marshaler)
+	}
+
+	var err error
+	for _, t := range []struct {
+		tmpl        **template.Template
+		name        string
+		text        string
+		defaultText string
+	}{
+		{&h.uplinkTemplate, "uplink", conf.UplinkTopicTemplate, DefaultUplinkTopicTemplate},
+		{&h.joinTemplate, "join", conf.JoinTopicTemplate, DefaultJoinTopicTemplate},
+		{&h.ackTemplate, "ack", conf.ACKTopicTemplate, DefaultACKTopicTemplate},
+		{&h.errorTemplate, "error", conf.ErrorTopicTemplate, DefaultErrorTopicTemplate},
+		{&h.alertTemplate, "alert", conf.AlertTopicTemplate, DefaultAlertTopicTemplate},
+		{&h.gatewayStatusTemplate, "gateway status", conf.GatewayStatusTopicTemplate, DefaultGatewayStatusTopicTemplate},
+	} {
+		if t.text == "" {
+			t.text = t.defaultText
+		}
+		*t.tmpl, err = template.New(t.name).Option("missingkey=error").Parse(t.text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "handler/mqtt: parse %s topic template error", t.name)
+		}
+		if err = validateTopicTemplate(*t.tmpl); err != nil {
+			return nil, errors.Wrapf(err, "handler/mqtt: parse %s topic template error", t.name)
+		}
+	}
+
+	if conf.DownlinkTopicTemplate == "" {
+		conf.DownlinkTopicTemplate = DefaultDownlinkTopicTemplate
+	}
+	h.downlinkTopic, h.downlinkTopicRegex, err = parseDownlinkTopicTemplate(conf.DownlinkTopicTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "handler/mqtt: parse downlink topic template error")
 	}
 
 	opts := mqtt.NewClientOptions()
-	opts.AddBroker(server)
-	opts.SetUsername(username)
-	opts.SetPassword(password)
+	opts.AddBroker(conf.Server)
+	opts.SetUsername(conf.Username)
+	opts.SetPassword(conf.Password)
 	opts.SetOnConnectHandler(h.onConnected)
 	opts.SetConnectionLostHandler(h.onConnectionLost)
 
-	tlsconfig, err := newTLSConfig(cafile, certFile, certKeyFile)
+	tlsconfig, err := newTLSConfig(conf.CACert, conf.TLSCert, conf.TLSKey)
 	if err != nil {
 		log.WithError(err).WithFields(log.Fields{
-			"ca_cert":  cafile,
-			"tls_cert": certFile,
-			"tls_key":  certKeyFile,
+			"ca_cert":  conf.CACert,
+			"tls_cert": conf.TLSCert,
+			"tls_key":  conf.TLSKey,
 		}).Fatalf("error loading mqtt certificate files")
 	}
 	if tlsconfig != nil {
 		opts.SetTLSConfig(tlsconfig)
 	}
 
-	log.WithField("server", server).Info("handler/mqtt: connecting to mqtt broker")
+	log.WithField("server", conf.Server).Info("handler/mqtt: connecting to mqtt broker")
 	h.conn = mqtt.NewClient(opts)
 	for {
 		if token := h.conn.Connect(); token.Wait() && token.Error() != nil {
@@ -73,6 +192,118 @@ func NewHandler(server, username, password, cafile, certFile, certKeyFile string
 	return &h, nil
 }
 
+// parseDownlinkTopicTemplate returns the subscription topic (using the +
+// wildcard for the ApplicationID and DevEUI) and the regular expression for
+// extracting the ApplicationID and DevEUI from a received topic.
+func parseDownlinkTopicTemplate(text string) (string, *regexp.Regexp, error) {
+	tmpl, err := template.New("downlink").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := validateTopicTemplate(tmpl); err != nil {
+		return "", nil, err
+	}
+
+	topic, err := executeTopicTemplate(tmpl, topicData{
+		ApplicationID: applicationIDPlaceholder,
+		DevEUI:        devEUIPlaceholder,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	if strings.Count(topic, applicationIDPlaceholder) != 1 || strings.Count(topic, devEUIPlaceholder) != 1 {
+		return "", nil, errors.New("template must contain the ApplicationID and DevEUI exactly once")
+	}
+
+	re := regexp.QuoteMeta(topic)
+	re = strings.Replace(re, applicationIDPlaceholder, `(?P<application_id>\w+)`, 1)
+	re = strings.Replace(re, devEUIPlaceholder, `(?P<dev_eui>\w+)`, 1)
+
+	topicRegex, err := regexp.Compile("^" + re + "$")
+	if err != nil {
+		return "", nil, errors.Wrap(err, "compile regexp error")
+	}
+
+	topic = strings.Replace(topic, applicationIDPlaceholder, "+", 1)
+	topic = strings.Replace(topic, devEUIPlaceholder, "+", 1)
+
+	return topic, topicRegex, nil
+}
+
+// validateTopicTemplate returns an error when the text of the template
+// contains the MQTT + or # wildcard, as these are not allowed in the topics
+// used for publishing and would change the downlink subscription.
+func validateTopicTemplate(tmpl *template.Template) error {
+	var nodes []parse.Node
+	if tmpl.Tree != nil {
+		nodes = append(nodes, tmpl.Tree.Root)
+	}
+
+	for len(nodes) != 0 {
+		n := nodes[0]
+		nodes = nodes[1:]
+
+		switch n := n.(type) {
+		case *parse.ListNode:
+			if n != nil {
+				nodes = append(nodes, n.Nodes...)
+			}
+		case *parse.IfNode:
+			nodes = append(nodes, n.List, n.ElseList)
+		case *parse.RangeNode:
+			nodes = append(nodes, n.List, n.ElseList)
+		case *parse.WithNode:
+			nodes = append(nodes, n.List, n.ElseList)
+		case *parse.TextNode:
+			if bytes.ContainsAny(n.Text, "+#") {
+				return fmt.Errorf("template must not contain the + or # wildcard: %q", n.Text)
+			}
+		}
+	}
+
+	return nil
+}
+
+func executeTopicTemplate(tmpl *template.Template, data interface{}) (string, error) {
+	var topic bytes.Buffer
+	if err := tmpl.Execute(&topic, data); err != nil {
+		return "", errors.Wrap(err, "execute template error")
+	}
+	if strings.ContainsAny(topic.String(), "+#") {
+		return "", fmt.Errorf("topic %s must not contain the + or # wildcard", topic.String())
+	}
+	return topic.String(), nil
+}
+
+// publish publishes the given payload to the topic generated by the given
+// template.
+func (h *MQTTHandler) publish(tmpl *template.Template, applicationID int64, devEUI lorawan.EUI64, b []byte) error {
+	return h.publishTopicData(tmpl, topicData{
+		ApplicationID: strconv.FormatInt(applicationID, 10),
+		DevEUI:        devEUI.String(),
+	}, b)
+}
+
+// publishTopicData publishes the given payload to the topic generated by
+// executing the given template with the given data.
+func (h *MQTTHandler) publishTopicData(tmpl *template.Template, data interface{}, b []byte) error {
+	topic, err := executeTopicTemplate(tmpl, data)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"topic":  topic,
+		"qos":    h.qos,
+		"retain": h.retain,
+	}).Infof("handler/mqtt: publishing %s message", tmpl.Name())
+	if token := h.conn.Publish(topic, h.qos, h.retain, b); token.Wait() && token.Error() != nil {
+		return token.Error()
+	}
+	return nil
+}
+
 func newTLSConfig(cafile, certFile, certKeyFile string) (*tls.Config, error) {
 	// Here are three valid options:
 	//   - Only CA
@@ -115,9 +346,9 @@ func newTLSConfig(cafile, certFile, certKeyFile string) (*tls.Config, error) {
 // Close stops the handler.
 func (h *MQTTHandler) Close() error {
 	log.Info("handler/mqtt: closing handler")
-	log.WithField("topic", txTopic).Info("handler/mqtt: unsubscribing from tx topic")
-	if token := h.conn.Unsubscribe(txTopic); token.Wait() && token.Error() != nil {
-		return fmt.Errorf("handler/mqtt: unsubscribe from %s error: %s", txTopic, token.Error())
+	log.WithField("topic", h.downlinkTopic).Info("handler/mqtt: unsubscribing from tx topic")
+	if token := h.conn.Unsubscribe(h.downlinkTopic); token.Wait() && token.Error() != nil {
+		return fmt.Errorf("handler/mqtt: unsubscribe from %s error: %s", h.downlinkTopic, token.Error())
 	}
 	log.Info("handler/mqtt: handling last items in queue")
 	h.wg.Wait()
@@ -127,14 +358,12 @@ func (h *MQTTHandler) Close() error {
 
 // SendDataUp sends a DataUpPayload.
 func (h *MQTTHandler) SendDataUp(payload handler.DataUpPayload) error {
-	b, err := json.Marshal(payload)
+	b, err := marshaler.Marshal(h.marshaler, payload)
 	if err != nil {
 		return fmt.Errorf("handler/mqtt: data-up payload marshal error: %s", err)
 	}
 
-	topic := fmt.Sprintf("application/%d/node/%s/rx", payload.ApplicationID, payload.DevEUI)
-	log.WithField("topic", topic).Info("handler/mqtt: publishing data-up payload")
-	if token := h.conn.Publish(topic, 0, false, b); token.Wait() && token.Error() != nil {
+	if err := h.publish(h.uplinkTemplate, payload.ApplicationID, payload.DevEUI, b); err != nil {
 		return fmt.Errorf("handler/mqtt: publish data-up payload error: %s", err)
 	}
 	return nil
@@ -142,13 +371,11 @@ func (h *MQTTHandler) SendDataUp(payload handler.DataUpPayload) error {
 
 // SendJoinNotification sends a JoinNotification.
 func (h *MQTTHandler) SendJoinNotification(payload handler.JoinNotification) error {
-	b, err := json.Marshal(payload)
+	b, err := marshaler.Marshal(h.marshaler, payload)
 	if err != nil {
 		return fmt.Errorf("handler/mqtt: join notification marshal error: %s", err)
 	}
-	topic := fmt.Sprintf("application/%d/node/%s/join", payload.ApplicationID, payload.DevEUI)
-	log.WithField("topic", topic).Info("handler/mqtt: publishing join notification")
-	if token := h.conn.Publish(topic, 0, false, b); token.Wait() && token.Error() != nil {
+	if err := h.publish(h.joinTemplate, payload.ApplicationID, payload.DevEUI, b); err != nil {
 		return fmt.Errorf("handler/mqtt: publish join notification error: %s", err)
 	}
 	return nil
@@ -156,13 +383,11 @@ func (h *MQTTHandler) SendJoinNotification(payload handler.JoinNotification) err
 
 // SendACKNotification sends an ACKNotification.
 func (h *MQTTHandler) SendACKNotification(payload handler.ACKNotification) error {
-	b, err := json.Marshal(payload)
+	b, err := marshaler.Marshal(h.marshaler, payload)
 	if err != nil {
 		return fmt.Errorf("handler/mqtt: ack notification marshal error: %s", err)
 	}
-	topic := fmt.Sprintf("application/%d/node/%s/ack", payload.ApplicationID, payload.DevEUI)
-	log.WithField("topic", topic).Info("handler/mqtt: publishing ack notification")
-	if token := h.conn.Publish(topic, 0, false, b); token.Wait() && token.Error() != nil {
+	if err := h.publish(h.ackTemplate, payload.ApplicationID, payload.DevEUI, b); err != nil {
 		return fmt.Errorf("handler/mqtt: publish ack notification error: %s", err)
 	}
 	return nil
@@ -170,18 +395,44 @@ func (h *MQTTHandler) SendACKNotification(payload handler.ACKNotification) error
 
 // SendErrorNotification sends an ErrorNotification.
 func (h *MQTTHandler) SendErrorNotification(payload handler.ErrorNotification) error {
-	b, err := json.Marshal(payload)
+	b, err := marshaler.Marshal(h.marshaler, payload)
 	if err != nil {
 		return fmt.Errorf("handler/mqtt: error notification marshal error: %s", err)
 	}
-	topic := fmt.Sprintf("application/%d/node/%s/error", payload.ApplicationID, payload.DevEUI)
-	log.WithField("topic", topic).Info("handler/mqtt: publishing error notification")
-	if token := h.conn.Publish(topic, 0, false, b); token.Wait() && token.Error() != nil {
+	if err := h.publish(h.errorTemplate, payload.ApplicationID, payload.DevEUI, b); err != nil {
 		return fmt.Errorf("handler/mqtt: publish error notification error: %s", err)
 	}
 	return nil
 }
 
+// SendAlertNotification sends an AlertNotification.
+func (h *MQTTHandler) SendAlertNotification(payload handler.AlertNotification) error {
+	b, err := marshaler.Marshal(h.marshaler, payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: alert notification marshal error: %s", err)
+	}
+	if err := h.publish(h.alertTemplate, payload.ApplicationID, payload.DevEUI, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish alert notification error: %s", err)
+	}
+	return nil
+}
+
+// SendGatewayStatusNotification sends a GatewayStatusNotification.
+func (h *MQTTHandler) SendGatewayStatusNotification(payload handler.GatewayStatusNotification) error {
+	b, err := marshaler.Marshal(h.marshaler, payload)
+	if err != nil {
+		return fmt.Errorf("handler/mqtt: gateway status notification marshal error: %s", err)
+	}
+	data := gatewayTopicData{
+		OrganizationID: strconv.FormatInt(payload.OrganizationID, 10),
+		MAC:            payload.MAC.String(),
+	}
+	if err := h.publishTopicData(h.gatewayStatusTemplate, data, b); err != nil {
+		return fmt.Errorf("handler/mqtt: publish gateway status notification error: %s", err)
+	}
+	return nil
+}
+
 // DataDownChan returns the channel containing the received DataDownPayload.
 func (h *MQTTHandler) DataDownChan() chan handler.DataDownPayload {
 	return h.dataDownChan
@@ -194,15 +445,24 @@ func (h *MQTTHandler) txPayloadHandler(c mqtt.Client, msg mqtt.Message) {
 	log.WithField("topic", msg.Topic()).Info("handler/mqtt: data-down payload received")
 
 	// get the name of the application and node from the topic
-	match := txTopicRegex.FindStringSubmatch(msg.Topic())
+	match := h.downlinkTopicRegex.FindStringSubmatch(msg.Topic())
 	if len(match) != 3 {
 		log.WithField("topic", msg.Topic()).Error("handler/mqtt: topic regex match error")
 		return
 	}
 
+	var applicationID, devEUI string
+	for i, name := range h.downlinkTopicRegex.SubexpNames() {
+		switch name {
+		case "application_id":
+			applicationID = match[i]
+		case "dev_eui":
+			devEUI = match[i]
+		}
+	}
+
 	var pl handler.DataDownPayload
-	dec := json.NewDecoder(bytes.NewReader(msg.Payload()))
-	if err := dec.Decode(&pl); err != nil {
+	if err := marshaler.UnmarshalDataDown(h.marshaler, msg.Payload(), &pl); err != nil {
 		log.WithFields(log.Fields{
 			"data_base64": base64.StdEncoding.EncodeToString(msg.Payload()),
 		}).Errorf("handler/mqtt: tx payload unmarshal error: %s", err)
@@ -211,7 +471,7 @@ func (h *MQTTHandler) txPayloadHandler(c mqtt.Client, msg mqtt.Message) {
 
 	// set ApplicationID and DevEUI from topic
 	var err error
-	pl.ApplicationID, err = strconv.ParseInt(match[1], 10, 64)
+	pl.ApplicationID, err = strconv.ParseInt(applicationID, 10, 64)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"topic": msg.Topic(),
@@ -219,7 +479,7 @@ func (h *MQTTHandler) txPayloadHandler(c mqtt.Client, msg mqtt.Message) {
 		return
 	}
 
-	if err = pl.DevEUI.UnmarshalText([]byte(match[2])); err != nil {
+	if err = pl.DevEUI.UnmarshalText([]byte(devEUI)); err != nil {
 		log.WithFields(log.Fields{
 			"topic": msg.Topic(),
 		}).Errorf("handler/mqtt: parse dev_eui error: %s", err)
@@ -259,9 +519,9 @@ func (h *MQTTHandler) txPayloadHandler(c mqtt.Client, msg mqtt.Message) {
 func (h *MQTTHandler) onConnected(c mqtt.Client) {
 	log.Info("handler/mqtt: connected to mqtt broker")
 	for {
-		log.WithField("topic", txTopic).Info("handler/mqtt: subscribling to tx topic")
-		if token := h.conn.Subscribe(txTopic, 2, h.txPayloadHandler); token.Wait() && token.Error() != nil {
-			log.WithField("topic", txTopic).Errorf("handler/mqtt: subscribe error: %s", token.Error())
+		log.WithField("topic", h.downlinkTopic).Info("handler/mqtt: subscribling to tx topic")
+		if token := h.conn.Subscribe(h.downlinkTopic, 2, h.txPayloadHandler); token.Wait() && token.Error() != nil {
+			log.WithField("topic", h.downlinkTopic).Errorf("handler/mqtt: subscribe error: %s", token.Error())
 			time.Sleep(time.Second)
 			continue
 		}
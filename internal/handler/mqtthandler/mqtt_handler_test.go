@@ -4,14 +4,18 @@ import (
 	"testing"
 
 	"encoding/json"
+	"fmt"
 	"time"
 
+	"github.com/Frankz/lora-app-server/api"
 	"github.com/Frankz/lora-app-server/internal/common"
 	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/handler/marshaler"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/Frankz/lora-app-server/internal/test"
 	"github.com/Frankz/lorawan"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/golang/protobuf/proto"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -29,7 +33,11 @@ func TestMQTTHandler(t *testing.T) {
 		test.MustFlushRedis(common.RedisPool)
 
 		Convey("Given a new MQTTHandler", func() {
-			h, err := NewHandler(conf.MQTTServer, conf.MQTTUsername, conf.MQTTPassword, "", "", "")
+			h, err := NewHandler(Config{
+				Server:   conf.MQTTServer,
+				Username: conf.MQTTUsername,
+				Password: conf.MQTTPassword,
+			})
 			So(err, ShouldBeNil)
 			defer h.Close()
 			time.Sleep(time.Millisecond * 100) // give the backend some time to connect
@@ -193,5 +201,200 @@ func TestMQTTHandler(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("Given a new MQTTHandler with custom topic templates and QoS", func() {
+			h, err := NewHandler(Config{
+				Server:                conf.MQTTServer,
+				Username:              conf.MQTTUsername,
+				Password:              conf.MQTTPassword,
+				UplinkTopicTemplate:   "lora/{{ .DevEUI }}/up",
+				DownlinkTopicTemplate: "lora/{{ .DevEUI }}/down/{{ .ApplicationID }}",
+				QOS:                   1,
+			})
+			So(err, ShouldBeNil)
+			defer h.Close()
+			time.Sleep(time.Millisecond * 100) // give the backend some time to connect
+
+			Convey("When sending a DataUpPayload (from the handler)", func() {
+				msgs := make(chan mqtt.Message, 1)
+				token := c.Subscribe("lora/0102030405060708/up", 1, func(c mqtt.Client, msg mqtt.Message) {
+					msgs <- msg
+				})
+				token.Wait()
+				So(token.Error(), ShouldBeNil)
+
+				So(h.SendDataUp(handler.DataUpPayload{
+					ApplicationID: 123,
+					DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				}), ShouldBeNil)
+
+				Convey("Then the payload is published to the templated topic with the configured QoS", func() {
+					msg := <-msgs
+					So(msg.Qos(), ShouldEqual, 1)
+				})
+			})
+
+			Convey("When publishing a DataDownPayload to the templated topic", func() {
+				b, err := json.Marshal(handler.DataDownPayload{
+					FPort: 1,
+					Data:  []byte("hello"),
+				})
+				So(err, ShouldBeNil)
+				token := c.Publish("lora/0102030405060708/down/123", 0, false, b)
+				token.Wait()
+				So(token.Error(), ShouldBeNil)
+
+				Convey("Then the ApplicationID and DevEUI are parsed from the topic", func() {
+					pl := <-h.DataDownChan()
+					So(pl.ApplicationID, ShouldEqual, 123)
+					So(pl.DevEUI, ShouldEqual, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+				})
+			})
+		})
+
+		Convey("Given a new MQTTHandler with the protobuf marshaler", func() {
+			h, err := NewHandler(Config{
+				Server:    conf.MQTTServer,
+				Username:  conf.MQTTUsername,
+				Password:  conf.MQTTPassword,
+				Marshaler: marshaler.Protobuf,
+			})
+			So(err, ShouldBeNil)
+			defer h.Close()
+			time.Sleep(time.Millisecond * 100) // give the backend some time to connect
+
+			Convey("When sending a DataUpPayload (from the handler)", func() {
+				msgs := make(chan mqtt.Message, 1)
+				token := c.Subscribe("application/123/node/0102030405060708/rx", 0, func(c mqtt.Client, msg mqtt.Message) {
+					msgs <- msg
+				})
+				token.Wait()
+				So(token.Error(), ShouldBeNil)
+
+				So(h.SendDataUp(handler.DataUpPayload{
+					ApplicationID: 123,
+					DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+					FPort:         10,
+				}), ShouldBeNil)
+
+				Convey("Then the payload is published as protobuf message", func() {
+					msg := <-msgs
+					var pl api.DataUpPayload
+					So(proto.Unmarshal(msg.Payload(), &pl), ShouldBeNil)
+					So(pl.ApplicationID, ShouldEqual, 123)
+					So(pl.DevEUI, ShouldResemble, []byte{1, 2, 3, 4, 5, 6, 7, 8})
+					So(pl.FPort, ShouldEqual, 10)
+				})
+			})
+
+			Convey("When publishing a protobuf encoded DataDownPayload", func() {
+				b, err := proto.Marshal(&api.DataDownPayload{
+					Reference: "abcd1234",
+					FPort:     1,
+					Data:      []byte("hello"),
+				})
+				So(err, ShouldBeNil)
+				token := c.Publish("application/123/node/0102030405060708/tx", 0, false, b)
+				token.Wait()
+				So(token.Error(), ShouldBeNil)
+
+				Convey("Then the payload is received by the handler", func() {
+					pl := <-h.DataDownChan()
+					So(pl, ShouldResemble, handler.DataDownPayload{
+						ApplicationID: 123,
+						DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+						Reference:     "abcd1234",
+						FPort:         1,
+						Data:          []byte("hello"),
+					})
+				})
+			})
+		})
+
+		Convey("Then NewHandler returns an error on an invalid marshaler", func() {
+			_, err := NewHandler(Config{
+				Server:    conf.MQTTServer,
+				Marshaler: marshaler.Type("xml"),
+			})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then NewHandler returns an error on a topic template containing a wildcard", func() {
+			_, err := NewHandler(Config{
+				Server:              conf.MQTTServer,
+				UplinkTopicTemplate: "application/+/node/{{ .DevEUI }}/rx",
+			})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then NewHandler returns an error on an invalid QoS", func() {
+			_, err := NewHandler(Config{
+				Server: conf.MQTTServer,
+				QOS:    3,
+			})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestParseDownlinkTopicTemplate(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Template      string
+			Topic         string
+			ReceivedTopic string
+			DevEUI        string
+			ExpectedError bool
+		}{
+			{
+				Template:      DefaultDownlinkTopicTemplate,
+				Topic:         "application/+/node/+/tx",
+				ReceivedTopic: "application/123/node/0102030405060708/tx",
+				DevEUI:        "0102030405060708",
+			},
+			{
+				Template:      "devices/{{ .DevEUI }}/apps/{{ .ApplicationID }}/commands",
+				Topic:         "devices/+/apps/+/commands",
+				ReceivedTopic: "devices/0102030405060708/apps/123/commands",
+				DevEUI:        "0102030405060708",
+			},
+			{
+				Template:      "application/{{ .ApplicationID }}/tx",
+				ExpectedError: true,
+			},
+			{
+				Template:      "application/{{ .ApplicationID }/tx",
+				ExpectedError: true,
+			},
+			{
+				Template:      "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/+",
+				ExpectedError: true,
+			},
+			{
+				Template:      "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/#",
+				ExpectedError: true,
+			},
+			{
+				Template:      `application/{{ .ApplicationID }}/node/{{ .DevEUI }}/{{ "+" }}`,
+				ExpectedError: true,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Template, i), func() {
+				topic, re, err := parseDownlinkTopicTemplate(test.Template)
+				if test.ExpectedError {
+					So(err, ShouldNotBeNil)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(topic, ShouldEqual, test.Topic)
+
+				match := re.FindStringSubmatch(test.ReceivedTopic)
+				So(match, ShouldHaveLength, 3)
+				So(match, ShouldContain, "123")
+				So(match, ShouldContain, test.DevEUI)
+			})
+		}
 	})
 }
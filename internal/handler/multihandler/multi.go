@@ -5,10 +5,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/Frankz/lora-app-server/internal/common"
 	"github.com/Frankz/lora-app-server/internal/handler"
 	"github.com/Frankz/lora-app-server/internal/handler/httphandler"
+	"github.com/Frankz/lora-app-server/internal/handler/influxdbhandler"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -16,14 +19,47 @@ import (
 
 // Handler kinds
 const (
-	HTTPHandlerKind = "HTTP"
+	HTTPHandlerKind     = "HTTP"
+	InfluxDBHandlerKind = "INFLUXDB"
 )
 
 // Handler wraps multiple handlers inside a single handler so that
 // data can be sent to multiple endpoints simultaneously.
 // Note that errors are logged, but not returned.
 type Handler struct {
-	defaultHandler handler.Handler
+	defaultHandler  handler.Handler
+	handlers        *handlerCache
+	gatewayHandlers *gatewayHandlerCache
+}
+
+// handlerCache caches the handlers of the application integrations by
+// integration ID, so that these are not set up for every event.
+type handlerCache struct {
+	sync.Mutex
+	handlers map[int64]cachedHandler
+}
+
+// cachedHandler holds a handler and the application ID and updated at
+// timestamp of the integration it was set up for.
+type cachedHandler struct {
+	applicationID int64
+	updatedAt     time.Time
+	handler       handler.IntegrationHandler
+}
+
+// gatewayHandlerCache caches the handlers of the organization integrations
+// by integration ID, so that these are not set up for every notification.
+type gatewayHandlerCache struct {
+	sync.Mutex
+	handlers map[int64]cachedGatewayHandler
+}
+
+// cachedGatewayHandler holds a handler and the organization ID and updated
+// at timestamp of the integration it was set up for.
+type cachedGatewayHandler struct {
+	organizationID int64
+	updatedAt      time.Time
+	handler        handler.GatewayHandler
 }
 
 // SendDataUp sends a data-up payload.
@@ -90,11 +126,78 @@ func (w Handler) SendErrorNotification(pl handler.ErrorNotification) error {
 	return nil
 }
 
+// SendAlertNotification sends an alert notification.
+func (w Handler) SendAlertNotification(pl handler.AlertNotification) error {
+	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
+	if err != nil {
+		log.Errorf("get handlers for application-id error: %s", err)
+		handlers = []handler.IntegrationHandler{w.defaultHandler}
+	}
+
+	for _, h := range handlers {
+		if err := h.SendAlertNotification(pl); err != nil {
+			log.Errorf("handler %T error: %s", h, err)
+		}
+	}
+	return nil
+}
+
+// SendGatewayStatusNotification sends a gateway status notification to the
+// default handler and the integrations of the organization of the gateway.
+func (w Handler) SendGatewayStatusNotification(pl handler.GatewayStatusNotification) error {
+	var handlers []handler.GatewayHandler
+	if h, ok := w.defaultHandler.(handler.GatewayHandler); ok {
+		handlers = append(handlers, h)
+	}
+
+	orgHandlers, err := w.getGatewayHandlersForOrganizationID(pl.OrganizationID)
+	if err != nil {
+		log.Errorf("get gateway handlers for organization-id error: %s", err)
+	}
+	handlers = append(handlers, orgHandlers...)
+
+	for _, h := range handlers {
+		if err := h.SendGatewayStatusNotification(pl); err != nil {
+			log.Errorf("handler %T error: %s", h, err)
+		}
+	}
+	return nil
+}
+
 // Close closes the handlers.
 func (w Handler) Close() error {
+	w.handlers.Lock()
+	for id, c := range w.handlers.handlers {
+		closeHandler(id, c.handler)
+		delete(w.handlers.handlers, id)
+	}
+	w.handlers.Unlock()
+
+	w.gatewayHandlers.Lock()
+	for id, c := range w.gatewayHandlers.handlers {
+		closeHandler(id, c.handler)
+		delete(w.gatewayHandlers.handlers, id)
+	}
+	w.gatewayHandlers.Unlock()
+
 	return w.defaultHandler.Close()
 }
 
+// closeHandler closes the given integration handler, when it implements
+// a Close method. Errors are logged.
+func closeHandler(integrationID int64, h interface{}) {
+	c, ok := h.(interface {
+		Close() error
+	})
+	if !ok {
+		return
+	}
+
+	if err := c.Close(); err != nil {
+		log.WithField("integration_id", integrationID).Errorf("close handler error: %s", err)
+	}
+}
+
 // getHandlersForApplicationID returns all handlers (including the default
 // handler for the given application ID.
 func (w Handler) getHandlersForApplicationID(id int64) ([]handler.IntegrationHandler, error) {
@@ -106,27 +209,141 @@ func (w Handler) getHandlersForApplicationID(id int64) ([]handler.IntegrationHan
 		return nil, errors.Wrap(err, "get integrtions for application id error")
 	}
 
-	// map integration to handler + config
+	w.handlers.Lock()
+	defer w.handlers.Unlock()
+
+	// remove and close the handlers of deleted integrations
+	ids := make(map[int64]bool)
+	for _, intg := range integrations {
+		ids[intg.ID] = true
+	}
+	for intgID, c := range w.handlers.handlers {
+		if c.applicationID == id && !ids[intgID] {
+			closeHandler(intgID, c.handler)
+			delete(w.handlers.handlers, intgID)
+		}
+	}
+
 	for _, intg := range integrations {
-		switch intg.Kind {
-		case HTTPHandlerKind:
-			var conf httphandler.HandlerConfig
-			if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
-				return nil, errors.Wrap(err, "decode http handler config error")
-			}
-			h, err := httphandler.NewHandler(conf)
-			if err != nil {
-				return nil, err
-			}
-			handlers = append(handlers, h)
-		default:
-			return nil, fmt.Errorf("unknown integration %s", intg.Kind)
+		c, ok := w.handlers.handlers[intg.ID]
+		if ok && c.updatedAt.Equal(intg.UpdatedAt) {
+			handlers = append(handlers, c.handler)
+			continue
+		}
+
+		h, err := newHandler(intg)
+		if err != nil {
+			return nil, err
+		}
+
+		// the integration has been updated, close the previous handler
+		if ok {
+			closeHandler(intg.ID, c.handler)
+		}
+
+		w.handlers.handlers[intg.ID] = cachedHandler{
+			applicationID: id,
+			updatedAt:     intg.UpdatedAt,
+			handler:       h,
 		}
+		handlers = append(handlers, h)
 	}
 
 	return handlers, nil
 }
 
+// newHandler returns a new handler for the given application integration.
+func newHandler(intg storage.Integration) (handler.IntegrationHandler, error) {
+	switch intg.Kind {
+	case HTTPHandlerKind:
+		var conf httphandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode http handler config error")
+		}
+		return httphandler.NewHandler(intg.ID, conf)
+	case InfluxDBHandlerKind:
+		var conf influxdbhandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode influxdb handler config error")
+		}
+		return influxdbhandler.NewHandler(conf)
+	default:
+		return nil, fmt.Errorf("unknown integration %s", intg.Kind)
+	}
+}
+
+// getGatewayHandlersForOrganizationID returns the handlers of the
+// integrations of the given organization ID. Failed deliveries to these
+// integrations are not retried. Integrations of an unknown kind or with an
+// invalid configuration are skipped.
+func (w Handler) getGatewayHandlersForOrganizationID(id int64) ([]handler.GatewayHandler, error) {
+	var handlers []handler.GatewayHandler
+
+	integrations, err := storage.GetOrganizationIntegrationsForOrganizationID(common.DB, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "get integrations for organization id error")
+	}
+
+	w.gatewayHandlers.Lock()
+	defer w.gatewayHandlers.Unlock()
+
+	// remove the handlers of deleted integrations
+	ids := make(map[int64]bool)
+	for _, intg := range integrations {
+		ids[intg.ID] = true
+	}
+	for intgID, c := range w.gatewayHandlers.handlers {
+		if c.organizationID == id && !ids[intgID] {
+			closeHandler(intgID, c.handler)
+			delete(w.gatewayHandlers.handlers, intgID)
+		}
+	}
+
+	for _, intg := range integrations {
+		c, ok := w.gatewayHandlers.handlers[intg.ID]
+		if ok && c.updatedAt.Equal(intg.UpdatedAt) {
+			handlers = append(handlers, c.handler)
+			continue
+		}
+
+		// the integration has been updated, close the previous handler
+		if ok {
+			closeHandler(intg.ID, c.handler)
+			delete(w.gatewayHandlers.handlers, intg.ID)
+		}
+
+		h, err := newGatewayHandler(intg)
+		if err != nil {
+			log.WithField("integration_id", intg.ID).Errorf("setup organization integration error: %s", err)
+			continue
+		}
+
+		w.gatewayHandlers.handlers[intg.ID] = cachedGatewayHandler{
+			organizationID: id,
+			updatedAt:      intg.UpdatedAt,
+			handler:        h,
+		}
+		handlers = append(handlers, h)
+	}
+
+	return handlers, nil
+}
+
+// newGatewayHandler returns a new handler for the given organization
+// integration.
+func newGatewayHandler(intg storage.OrganizationIntegration) (handler.GatewayHandler, error) {
+	switch intg.Kind {
+	case HTTPHandlerKind:
+		var conf httphandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode http handler config error")
+		}
+		return httphandler.NewHandler(0, conf)
+	default:
+		return nil, fmt.Errorf("unknown organization integration %s", intg.Kind)
+	}
+}
+
 // DataDownChan returns the channel containing the received DataDownPayload.
 func (w Handler) DataDownChan() chan handler.DataDownPayload {
 	return w.defaultHandler.DataDownChan()
@@ -136,5 +353,11 @@ func (w Handler) DataDownChan() chan handler.DataDownPayload {
 func NewHandler(defaultHandler handler.Handler) handler.Handler {
 	return Handler{
 		defaultHandler: defaultHandler,
+		handlers: &handlerCache{
+			handlers: make(map[int64]cachedHandler),
+		},
+		gatewayHandlers: &gatewayHandlerCache{
+			handlers: make(map[int64]cachedGatewayHandler),
+		},
 	}
 }
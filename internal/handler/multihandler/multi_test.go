@@ -40,6 +40,9 @@ func TestHandler(t *testing.T) {
 	common.DB = db
 	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
 
+	// the HTTP integration deliveries are made by the retry loop
+	go httphandler.RetryLoop()
+
 	Convey("Given an MQTT client and handler, Redis and PostgreSQL databases and test http handler", t, func() {
 		opts := mqtt.NewClientOptions().AddBroker(conf.MQTTServer).SetUsername(conf.MQTTUsername).SetPassword(conf.MQTTPassword)
 		c := mqtt.NewClient(opts)
@@ -66,7 +69,11 @@ func TestHandler(t *testing.T) {
 		token.Wait()
 		So(token.Error(), ShouldBeNil)
 
-		mqttHandler, err := mqtthandler.NewHandler(conf.MQTTServer, conf.MQTTUsername, conf.MQTTPassword, "", "", "")
+		mqttHandler, err := mqtthandler.NewHandler(mqtthandler.Config{
+			Server:   conf.MQTTServer,
+			Username: conf.MQTTUsername,
+			Password: conf.MQTTPassword,
+		})
 		So(err, ShouldBeNil)
 
 		Convey("Given an organization, application with http integration and node", func() {
@@ -194,3 +201,147 @@ func TestHandler(t *testing.T) {
 		})
 	})
 }
+
+func TestGatewayHandlers(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+
+	Convey("Given an organization with an HTTP and an unknown integration", t, func() {
+		test.MustResetDB(common.DB)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(db, &org), ShouldBeNil)
+
+		configJSON, err := json.Marshal(httphandler.HandlerConfig{
+			GatewayStatusURL: "http://localhost/gateway",
+		})
+		So(err, ShouldBeNil)
+
+		intg := storage.OrganizationIntegration{
+			OrganizationID: org.ID,
+			Kind:           HTTPHandlerKind,
+			Settings:       configJSON,
+		}
+		So(storage.CreateOrganizationIntegration(db, &intg), ShouldBeNil)
+		So(storage.CreateOrganizationIntegration(db, &storage.OrganizationIntegration{
+			OrganizationID: org.ID,
+			Kind:           "UNKNOWN",
+			Settings:       json.RawMessage("{}"),
+		}), ShouldBeNil)
+
+		w := NewHandler(nil).(Handler)
+
+		Convey("Then only the unknown integration is skipped", func() {
+			handlers, err := w.getGatewayHandlersForOrganizationID(org.ID)
+			So(err, ShouldBeNil)
+			So(handlers, ShouldHaveLength, 1)
+
+			Convey("Then the handler is reused for the next notification", func() {
+				handlers2, err := w.getGatewayHandlersForOrganizationID(org.ID)
+				So(err, ShouldBeNil)
+				So(handlers2, ShouldHaveLength, 1)
+				So(handlers2[0], ShouldEqual, handlers[0])
+			})
+
+			Convey("Then the handler is set up again after updating the integration", func() {
+				So(storage.UpdateOrganizationIntegration(db, &intg), ShouldBeNil)
+
+				handlers2, err := w.getGatewayHandlersForOrganizationID(org.ID)
+				So(err, ShouldBeNil)
+				So(handlers2, ShouldHaveLength, 1)
+				So(handlers2[0], ShouldNotEqual, handlers[0])
+			})
+		})
+	})
+}
+
+func TestApplicationHandlers(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+
+	Convey("Given an application with an HTTP integration", t, func() {
+		test.MustResetDB(common.DB)
+		common.NetworkServerPool = test.NewNetworkServerPool(test.NewNetworkServerClient())
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(db, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(db, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(db, &sp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(db, &app), ShouldBeNil)
+
+		configJSON, err := json.Marshal(httphandler.HandlerConfig{
+			DataUpURL: "http://localhost/rx",
+		})
+		So(err, ShouldBeNil)
+
+		intg := storage.Integration{
+			ApplicationID: app.ID,
+			Kind:          HTTPHandlerKind,
+			Settings:      configJSON,
+		}
+		So(storage.CreateIntegration(db, &intg), ShouldBeNil)
+
+		w := NewHandler(nil).(Handler)
+
+		Convey("Then the default and the HTTP handler are returned", func() {
+			handlers, err := w.getHandlersForApplicationID(app.ID)
+			So(err, ShouldBeNil)
+			So(handlers, ShouldHaveLength, 2)
+
+			Convey("Then the handler is reused for the next event", func() {
+				handlers2, err := w.getHandlersForApplicationID(app.ID)
+				So(err, ShouldBeNil)
+				So(handlers2, ShouldHaveLength, 2)
+				So(handlers2[1], ShouldEqual, handlers[1])
+			})
+
+			Convey("Then the handler is set up again after updating the integration", func() {
+				So(storage.UpdateIntegration(db, &intg), ShouldBeNil)
+
+				handlers2, err := w.getHandlersForApplicationID(app.ID)
+				So(err, ShouldBeNil)
+				So(handlers2, ShouldHaveLength, 2)
+				So(handlers2[1], ShouldNotEqual, handlers[1])
+				So(w.handlers.handlers, ShouldHaveLength, 1)
+			})
+
+			Convey("Then the handler is removed after deleting the integration", func() {
+				So(storage.DeleteIntegration(db, intg.ID), ShouldBeNil)
+
+				handlers2, err := w.getHandlersForApplicationID(app.ID)
+				So(err, ShouldBeNil)
+				So(handlers2, ShouldHaveLength, 1)
+				So(w.handlers.handlers, ShouldHaveLength, 0)
+			})
+		})
+	})
+}
@@ -0,0 +1,232 @@
+// Package health evaluates the alert rules of the devices and opens or
+// resolves the device alerts accordingly.
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/eventlog"
+	"github.com/Frankz/lora-app-server/internal/handler"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lorawan"
+)
+
+const (
+	checkLockKey   = "lora:as:device_health:lock"
+	checkBatchSize = 100
+)
+
+// batteryUnavailable is reported by devices which are not able to measure
+// their battery level.
+const batteryUnavailable = 255
+
+// condition defines the evaluated condition of a single alert type. When
+// State is empty, there was not enough data to evaluate the condition and
+// the current state of the alert is kept.
+type condition struct {
+	Type    storage.DeviceAlertType
+	State   storage.DeviceAlertState
+	Message string
+}
+
+// CheckLoop is a never returning function evaluating the alert rules of
+// the devices every common.DeviceHealthCheckInterval. When running multiple
+// instances, only one of them evaluates the rules per interval.
+func CheckLoop() {
+	for {
+		locked, err := acquireCheckLock()
+		if err != nil {
+			log.Errorf("acquire device health check lock error: %s", err)
+		} else if locked {
+			if err := checkDevices(time.Now()); err != nil {
+				log.Errorf("check device health error: %s", err)
+			}
+		}
+		time.Sleep(common.DeviceHealthCheckInterval)
+	}
+}
+
+func acquireCheckLock() (bool, error) {
+	c := common.RedisPool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", checkLockKey, "lock", "PX", int64(common.DeviceHealthCheckInterval/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// checkDevices evaluates the alert rules of all devices having alert rules
+// configured or having open alerts.
+func checkDevices(now time.Time) error {
+	var after lorawan.EUI64
+
+	for {
+		items, err := storage.GetDeviceHealthAfterDevEUI(common.DB, after, checkBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "get device health error")
+		}
+
+		for _, item := range items {
+			if err := checkDevice(item, now); err != nil {
+				log.WithField("dev_eui", item.DevEUI).Errorf("check device health error: %s", err)
+			}
+		}
+
+		if len(items) < checkBatchSize {
+			return nil
+		}
+		after = items[len(items)-1].DevEUI
+	}
+}
+
+// checkDevice opens and resolves the alerts of the given device and sends
+// the notifications for the changed alerts. The alert rules are evaluated
+// against the open alerts first, so that a transaction is only started when
+// an alert must be opened or resolved.
+func checkDevice(d storage.DeviceHealth, now time.Time) error {
+	conditions := evaluate(d, now)
+
+	alerts, err := storage.GetOpenDeviceAlertsForDevEUI(common.DB, d.DevEUI, false)
+	if err != nil {
+		return errors.Wrap(err, "get open device alerts error")
+	}
+
+	if len(alertChanges(d, alerts, conditions, now)) == 0 {
+		return nil
+	}
+
+	var changed []storage.DeviceAlert
+
+	err = storage.Transaction(common.DB, func(tx sqlx.Ext) error {
+		alerts, err := storage.GetOpenDeviceAlertsForDevEUI(tx, d.DevEUI, true)
+		if err != nil {
+			return errors.Wrap(err, "get open device alerts error")
+		}
+
+		changed = alertChanges(d, alerts, conditions, now)
+		for i := range changed {
+			switch changed[i].State {
+			case storage.DeviceAlertOpen:
+				if err := storage.CreateDeviceAlert(tx, &changed[i]); err != nil {
+					return errors.Wrap(err, "create device alert error")
+				}
+			case storage.DeviceAlertResolved:
+				if err := storage.UpdateDeviceAlert(tx, &changed[i]); err != nil {
+					return errors.Wrap(err, "update device alert error")
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range changed {
+		pl := handler.AlertNotification{
+			ApplicationID:   d.ApplicationID,
+			ApplicationName: d.ApplicationName,
+			DeviceName:      d.DeviceName,
+			DevEUI:          d.DevEUI,
+			AlertID:         a.ID,
+			Type:            string(a.Type),
+			State:           string(a.State),
+			Message:         a.Message,
+		}
+
+		if err := common.Handler.SendAlertNotification(pl); err != nil {
+			log.WithField("dev_eui", d.DevEUI).Errorf("send alert notification error: %s", err)
+		}
+
+		if err := eventlog.LogEventForDevice(d.ApplicationID, d.DevEUI, storage.DeviceEventAlert, nil, pl); err != nil {
+			log.WithField("dev_eui", d.DevEUI).Errorf("log device event error: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// alertChanges returns the alerts to open and the open alerts to resolve,
+// given the open alerts of the device and the evaluated conditions.
+func alertChanges(d storage.DeviceHealth, alerts []storage.DeviceAlert, conditions []condition, now time.Time) []storage.DeviceAlert {
+	open := make(map[storage.DeviceAlertType]storage.DeviceAlert)
+	for _, a := range alerts {
+		open[a.Type] = a
+	}
+
+	var out []storage.DeviceAlert
+	for _, c := range conditions {
+		a, isOpen := open[c.Type]
+
+		switch {
+		case c.State == storage.DeviceAlertOpen && !isOpen:
+			out = append(out, storage.DeviceAlert{
+				DevEUI:  d.DevEUI,
+				Type:    c.Type,
+				State:   storage.DeviceAlertOpen,
+				Message: c.Message,
+			})
+		case c.State == storage.DeviceAlertResolved && isOpen:
+			a.State = storage.DeviceAlertResolved
+			a.ResolvedAt = &now
+			out = append(out, a)
+		}
+	}
+
+	return out
+}
+
+// evaluate returns the conditions of the alert rules for the given device.
+// Disabled rules resolve the alerts of their type.
+func evaluate(d storage.DeviceHealth, now time.Time) []condition {
+	inactive := condition{Type: storage.DeviceAlertInactive, State: storage.DeviceAlertResolved}
+	if d.AlertUplinkInterval > 0 {
+		lastSeen := d.CreatedAt
+		if d.LastSeenAt != nil {
+			lastSeen = *d.LastSeenAt
+		}
+
+		if now.Sub(lastSeen) > d.AlertUplinkInterval {
+			inactive.State = storage.DeviceAlertOpen
+			inactive.Message = fmt.Sprintf("no uplink received since %s", lastSeen.Format(time.RFC3339))
+		}
+	}
+
+	battery := condition{Type: storage.DeviceAlertLowBattery, State: storage.DeviceAlertResolved}
+	if d.AlertBatteryThreshold > 0 {
+		switch {
+		case d.DeviceStatusBattery == nil || *d.DeviceStatusBattery == batteryUnavailable:
+			battery.State = ""
+		case *d.DeviceStatusBattery != 0 && *d.DeviceStatusBattery < d.AlertBatteryThreshold:
+			// 0 = connected to an external power source
+			battery.State = storage.DeviceAlertOpen
+			battery.Message = fmt.Sprintf("battery level %d is below threshold %d", *d.DeviceStatusBattery, d.AlertBatteryThreshold)
+		}
+	}
+
+	margin := condition{Type: storage.DeviceAlertLowMargin, State: storage.DeviceAlertResolved}
+	if d.AlertMarginThreshold != nil {
+		switch {
+		case d.DeviceStatusMargin == nil:
+			margin.State = ""
+		case *d.DeviceStatusMargin < *d.AlertMarginThreshold:
+			margin.State = storage.DeviceAlertOpen
+			margin.Message = fmt.Sprintf("link margin %d dB is below threshold %d dB", *d.DeviceStatusMargin, *d.AlertMarginThreshold)
+		}
+	}
+
+	return []condition{inactive, battery, margin}
+}
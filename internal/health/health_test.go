@@ -0,0 +1,231 @@
+package health
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/storage"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lora-app-server/internal/test/testhandler"
+	"github.com/Frankz/lorawan"
+	"github.com/Frankz/lorawan/backend"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Now()
+	lastSeen := now.Add(-2 * time.Hour)
+	battery := func(i int) *int { return &i }
+
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name     string
+			Health   storage.DeviceHealth
+			Expected []storage.DeviceAlertState
+		}{
+			{
+				Name: "all rules disabled",
+				Health: storage.DeviceHealth{
+					LastSeenAt:          &lastSeen,
+					DeviceStatusBattery: battery(10),
+					DeviceStatusMargin:  battery(-5),
+				},
+				Expected: []storage.DeviceAlertState{storage.DeviceAlertResolved, storage.DeviceAlertResolved, storage.DeviceAlertResolved},
+			},
+			{
+				Name: "all rules violated",
+				Health: storage.DeviceHealth{
+					LastSeenAt:            &lastSeen,
+					DeviceStatusBattery:   battery(10),
+					DeviceStatusMargin:    battery(-5),
+					AlertUplinkInterval:   time.Hour,
+					AlertBatteryThreshold: 50,
+					AlertMarginThreshold:  battery(5),
+				},
+				Expected: []storage.DeviceAlertState{storage.DeviceAlertOpen, storage.DeviceAlertOpen, storage.DeviceAlertOpen},
+			},
+			{
+				Name: "all rules met",
+				Health: storage.DeviceHealth{
+					LastSeenAt:            &lastSeen,
+					DeviceStatusBattery:   battery(100),
+					DeviceStatusMargin:    battery(10),
+					AlertUplinkInterval:   3 * time.Hour,
+					AlertBatteryThreshold: 50,
+					AlertMarginThreshold:  battery(5),
+				},
+				Expected: []storage.DeviceAlertState{storage.DeviceAlertResolved, storage.DeviceAlertResolved, storage.DeviceAlertResolved},
+			},
+			{
+				Name: "margin below a threshold of 0",
+				Health: storage.DeviceHealth{
+					LastSeenAt:           &lastSeen,
+					DeviceStatusMargin:   battery(-1),
+					AlertMarginThreshold: battery(0),
+				},
+				Expected: []storage.DeviceAlertState{storage.DeviceAlertResolved, storage.DeviceAlertResolved, storage.DeviceAlertOpen},
+			},
+			{
+				Name: "never seen device uses created at",
+				Health: storage.DeviceHealth{
+					CreatedAt:           lastSeen,
+					AlertUplinkInterval: time.Hour,
+				},
+				Expected: []storage.DeviceAlertState{storage.DeviceAlertOpen, storage.DeviceAlertResolved, storage.DeviceAlertResolved},
+			},
+			{
+				Name: "no device-status available",
+				Health: storage.DeviceHealth{
+					LastSeenAt:            &lastSeen,
+					AlertBatteryThreshold: 50,
+					AlertMarginThreshold:  battery(5),
+				},
+				Expected: []storage.DeviceAlertState{storage.DeviceAlertResolved, "", ""},
+			},
+			{
+				Name: "battery level unavailable",
+				Health: storage.DeviceHealth{
+					LastSeenAt:            &lastSeen,
+					DeviceStatusBattery:   battery(255),
+					AlertBatteryThreshold: 50,
+				},
+				Expected: []storage.DeviceAlertState{storage.DeviceAlertResolved, "", storage.DeviceAlertResolved},
+			},
+			{
+				Name: "external power source",
+				Health: storage.DeviceHealth{
+					LastSeenAt:            &lastSeen,
+					DeviceStatusBattery:   battery(0),
+					AlertBatteryThreshold: 50,
+				},
+				Expected: []storage.DeviceAlertState{storage.DeviceAlertResolved, storage.DeviceAlertResolved, storage.DeviceAlertResolved},
+			},
+		}
+
+		for _, test := range tests {
+			Convey(test.Name, func() {
+				conditions := evaluate(test.Health, now)
+				So(conditions, ShouldHaveLength, 3)
+				So(conditions[0].Type, ShouldEqual, storage.DeviceAlertInactive)
+				So(conditions[1].Type, ShouldEqual, storage.DeviceAlertLowBattery)
+				So(conditions[2].Type, ShouldEqual, storage.DeviceAlertLowMargin)
+
+				for j := range conditions {
+					So(conditions[j].State, ShouldEqual, test.Expected[j])
+					if conditions[j].State == storage.DeviceAlertOpen {
+						So(conditions[j].Message, ShouldNotEqual, "")
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestCheckDevices(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with a device and an application with an inactivity rule", t, func() {
+		test.MustResetDB(common.DB)
+		test.MustFlushRedis(common.RedisPool)
+
+		h := testhandler.NewTestHandler()
+		common.Handler = h
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:      org.ID,
+			Name:                "test-app",
+			ServiceProfileID:    sp.ServiceProfile.ServiceProfileID,
+			AlertUplinkInterval: time.Hour,
+		}
+		So(storage.CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(common.DB, &d), ShouldBeNil)
+
+		Convey("When checking the devices two hours later", func() {
+			now := time.Now().Add(2 * time.Hour)
+			So(checkDevices(now), ShouldBeNil)
+
+			Convey("Then an inactive alert was opened", func() {
+				alerts, err := storage.GetOpenDeviceAlertsForDevEUI(common.DB, d.DevEUI, false)
+				So(err, ShouldBeNil)
+				So(alerts, ShouldHaveLength, 1)
+				So(alerts[0].Type, ShouldEqual, storage.DeviceAlertInactive)
+			})
+
+			Convey("Then an alert notification was sent", func() {
+				So(h.SendAlertNotificationChan, ShouldHaveLength, 1)
+				pl := <-h.SendAlertNotificationChan
+				So(pl.ApplicationID, ShouldEqual, app.ID)
+				So(pl.DevEUI, ShouldEqual, d.DevEUI)
+				So(pl.Type, ShouldEqual, string(storage.DeviceAlertInactive))
+				So(pl.State, ShouldEqual, string(storage.DeviceAlertOpen))
+			})
+
+			Convey("Then checking again does not open a second alert", func() {
+				So(checkDevices(now), ShouldBeNil)
+				So(h.SendAlertNotificationChan, ShouldHaveLength, 1)
+			})
+
+			Convey("When the device has been seen again", func() {
+				<-h.SendAlertNotificationChan
+				d.LastSeenAt = &now
+				So(storage.UpdateDevice(common.DB, &d), ShouldBeNil)
+				So(checkDevices(now), ShouldBeNil)
+
+				Convey("Then the alert was resolved", func() {
+					alerts, err := storage.GetOpenDeviceAlertsForDevEUI(common.DB, d.DevEUI, false)
+					So(err, ShouldBeNil)
+					So(alerts, ShouldHaveLength, 0)
+
+					So(h.SendAlertNotificationChan, ShouldHaveLength, 1)
+					pl := <-h.SendAlertNotificationChan
+					So(pl.State, ShouldEqual, string(storage.DeviceAlertResolved))
+				})
+			})
+		})
+	})
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/Frankz/lora-app-server/internal/handler"
 
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/eventlog"
 	"github.com/Frankz/lora-app-server/internal/storage"
 	"github.com/Frankz/lorawan"
 	"github.com/Frankz/lorawan/backend"
@@ -59,7 +61,7 @@ var joinFlow = &flow{
 		setNetID,
 		setSessionKeys,
 		createDeviceActivationRecord,
-		flushDeviceQueueMapping,
+		expireQueuedDownlinks,
 		sendJoinNotification,
 		createJoinAnsPayload,
 	},
@@ -209,24 +211,30 @@ func createDeviceActivationRecord(ctx *context) error {
 	return nil
 }
 
-func flushDeviceQueueMapping(ctx *context) error {
-	if err := storage.FlushDeviceQueueMappingForDevEUI(common.DB, ctx.device.DevEUI); err != nil {
-		return errors.Wrap(err, "flush device-queue mapping error")
+func expireQueuedDownlinks(ctx *context) error {
+	if err := storage.ExpireQueuedDownlinksForDevEUI(common.DB, ctx.device.DevEUI); err != nil {
+		return errors.Wrap(err, "expire queued downlinks error")
 	}
 	return nil
 }
 
 func sendJoinNotification(ctx *context) error {
-	err := common.Handler.SendJoinNotification(handler.JoinNotification{
+	pl := handler.JoinNotification{
 		ApplicationID:   ctx.device.ApplicationID,
 		ApplicationName: ctx.application.Name,
 		DeviceName:      ctx.device.Name,
 		DevEUI:          ctx.device.DevEUI,
 		DevAddr:         ctx.joinReqPayload.DevAddr,
-	})
+	}
+
+	err := common.Handler.SendJoinNotification(pl)
 	if err != nil {
 		return errors.Wrap(err, "send join notification error")
 	}
+
+	if err := eventlog.LogEventForDevice(ctx.device.ApplicationID, ctx.device.DevEUI, storage.DeviceEventJoin, nil, pl); err != nil {
+		log.WithField("dev_eui", ctx.device.DevEUI).Errorf("log device event error: %s", err)
+	}
 	return nil
 }
 
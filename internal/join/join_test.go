@@ -22,6 +22,7 @@ func TestJoin(t *testing.T) {
 	}
 
 	common.DB = db
+	common.RedisPool = storage.NewRedisPool(conf.RedisURL)
 
 	Convey("Given a clean database with node", t, func() {
 		test.MustResetDB(common.DB)
@@ -104,7 +104,7 @@ func StartProfilesMigration(nsServer string) error {
 		return errors.Wrap(err, "get network-server count error")
 	}
 
-	appCount, err := storage.GetApplicationCount(common.DB)
+	appCount, err := storage.GetApplicationCount(common.DB, nil)
 	if err != nil {
 		return errors.Wrap(err, "get applications count error")
 	}
@@ -52,7 +52,7 @@ func migrateQueueItem(qi DeviceQueueItem) error {
 			return errors.Wrap(err, "delete device-queue item error")
 		}
 
-		if err := downlink.EnqueueDownlinkPayload(tx, qi.DevEUI, qi.Reference, qi.Confirmed, qi.FPort, qi.Data); err != nil {
+		if _, err := downlink.EnqueueDownlinkPayload(tx, qi.DevEUI, qi.Reference, qi.Confirmed, qi.FPort, qi.Data); err != nil {
 			if grpc.Code(errors.Cause(err)) == codes.NotFound {
 				return nil
 			}
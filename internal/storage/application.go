@@ -3,6 +3,7 @@ package storage
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/Frankz/lora-app-server/internal/codec"
 	"github.com/jmoiron/sqlx"
@@ -14,14 +15,20 @@ var applicationNameRegexp = regexp.MustCompile(`^[\w-]+$`)
 
 // Application represents an application.
 type Application struct {
-	ID                   int64      `db:"id"`
-	Name                 string     `db:"name"`
-	Description          string     `db:"description"`
-	OrganizationID       int64      `db:"organization_id"`
-	ServiceProfileID     string     `db:"service_profile_id"`
-	PayloadCodec         codec.Type `db:"payload_codec"`
-	PayloadEncoderScript string     `db:"payload_encoder_script"`
-	PayloadDecoderScript string     `db:"payload_decoder_script"`
+	ID                      int64         `db:"id"`
+	Name                    string        `db:"name"`
+	Description             string        `db:"description"`
+	OrganizationID          int64         `db:"organization_id"`
+	ServiceProfileID        string        `db:"service_profile_id"`
+	PayloadCodec            codec.Type    `db:"payload_codec"`
+	PayloadEncoderScript    string        `db:"payload_encoder_script"`
+	PayloadDecoderScript    string        `db:"payload_decoder_script"`
+	Tags                    Tags          `db:"tags"`
+	ClockSyncEnabled        bool          `db:"clock_sync_enabled"`
+	ClockSyncResyncInterval time.Duration `db:"clock_sync_resync_interval"`
+	AlertUplinkInterval     time.Duration `db:"alert_uplink_interval"`
+	AlertBatteryThreshold   int           `db:"alert_battery_threshold"`
+	AlertMarginThreshold    *int          `db:"alert_margin_threshold"`
 }
 
 // ApplicationListItem devices the application as a list item.
@@ -36,7 +43,15 @@ func (a Application) Validate() error {
 		return ErrApplicationInvalidName
 	}
 
-	return nil
+	if a.ClockSyncResyncInterval < 0 {
+		return ErrApplicationInvalidClockSyncResyncInterval
+	}
+
+	if err := validateAlertRules(a.AlertUplinkInterval, a.AlertBatteryThreshold, a.AlertMarginThreshold); err != nil {
+		return err
+	}
+
+	return a.Tags.Validate()
 }
 
 // CreateApplication creates the given Application.
@@ -53,8 +68,14 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 			service_profile_id,
 			payload_codec,
 			payload_encoder_script,
-			payload_decoder_script
-		) values ($1, $2, $3, $4, $5, $6, $7) returning id`,
+			payload_decoder_script,
+			tags,
+			clock_sync_enabled,
+			clock_sync_resync_interval,
+			alert_uplink_interval,
+			alert_battery_threshold,
+			alert_margin_threshold
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) returning id`,
 		item.Name,
 		item.Description,
 		item.OrganizationID,
@@ -62,6 +83,12 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.Tags,
+		item.ClockSyncEnabled,
+		item.ClockSyncResyncInterval,
+		item.AlertUplinkInterval,
+		item.AlertBatteryThreshold,
+		item.AlertMarginThreshold,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -86,10 +113,11 @@ func GetApplication(db sqlx.Queryer, id int64) (Application, error) {
 	return app, nil
 }
 
-// GetApplicationCount returns the total number of applications.
-func GetApplicationCount(db sqlx.Queryer) (int, error) {
+// GetApplicationCount returns the total number of applications matching
+// the given tag selector.
+func GetApplicationCount(db sqlx.Queryer, selector TagSelector) (int, error) {
 	var count int
-	err := sqlx.Get(db, &count, "select count(*) from application")
+	err := sqlx.Get(db, &count, "select count(*) from application where "+tagsFilter("tags", 1), selector)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
 	}
@@ -99,8 +127,7 @@ func GetApplicationCount(db sqlx.Queryer) (int, error) {
 // GetApplicationCountForUser returns the total number of applications
 // available for the given user.
 // When an organizationID is given, the results will be filtered by this
-//
-func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID int64) (int, error) {
+func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID int64, selector TagSelector) (int, error) {
 	var count int
 	err := sqlx.Get(db, &count, `
 		select
@@ -117,7 +144,8 @@ func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID
 				$2 = 0
 				or a.organization_id = $2
 			)
-	`, username, organizationID)
+			and `+tagsFilter("a.tags", 3)+`
+	`, username, organizationID, selector)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
 	}
@@ -125,15 +153,17 @@ func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID
 }
 
 // GetApplicationCountForOrganizationID returns the total number of
-// applications for the given organization.
-func GetApplicationCountForOrganizationID(db sqlx.Queryer, organizationID int64) (int, error) {
+// applications for the given organization, matching the given tag selector.
+func GetApplicationCountForOrganizationID(db sqlx.Queryer, organizationID int64, selector TagSelector) (int, error) {
 	var count int
 	err := sqlx.Get(db, &count, `
 		select count(*)
 		from application
 		where
-			organization_id = $1`,
+			organization_id = $1
+			and `+tagsFilter("tags", 2),
 		organizationID,
+		selector,
 	)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
@@ -142,8 +172,8 @@ func GetApplicationCountForOrganizationID(db sqlx.Queryer, organizationID int64)
 }
 
 // GetApplications returns a slice of applications, sorted by name and
-// respecting the given limit and offset.
-func GetApplications(db sqlx.Queryer, limit, offset int) ([]ApplicationListItem, error) {
+// respecting the given limit, offset and tag selector.
+func GetApplications(db sqlx.Queryer, limit, offset int, selector TagSelector) ([]ApplicationListItem, error) {
 	var apps []ApplicationListItem
 	err := sqlx.Select(db, &apps, `
 		select
@@ -152,12 +182,15 @@ func GetApplications(db sqlx.Queryer, limit, offset int) ([]ApplicationListItem,
 		from application a
 		inner join service_profile sp
 			on sp.service_profile_id = a.service_profile_id
+		where
+			`+tagsFilter("a.tags", 3)+`
 		order by
 			name
 		limit $1
 		offset $2`,
 		limit,
 		offset,
+		selector,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -166,8 +199,8 @@ func GetApplications(db sqlx.Queryer, limit, offset int) ([]ApplicationListItem,
 }
 
 // GetApplicationsForUser returns a slice of application of which the given
-// user is a member of.
-func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int64, limit, offset int) ([]ApplicationListItem, error) {
+// user is a member of, matching the given tag selector.
+func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int64, limit, offset int, selector TagSelector) ([]ApplicationListItem, error) {
 	var apps []ApplicationListItem
 	err := sqlx.Select(db, &apps, `
 		select
@@ -187,9 +220,10 @@ func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int
 				$2 = 0
 				or a.organization_id = $2
 			)
+			and `+tagsFilter("a.tags", 5)+`
 		order by a.name
 		limit $3 offset $4
-	`, username, organizationID, limit, offset)
+	`, username, organizationID, limit, offset, selector)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
 	}
@@ -198,8 +232,8 @@ func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int
 }
 
 // GetApplicationsForOrganizationID returns a slice of applications for the given
-// organization.
-func GetApplicationsForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int) ([]ApplicationListItem, error) {
+// organization, matching the given tag selector.
+func GetApplicationsForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int, selector TagSelector) ([]ApplicationListItem, error) {
 	var apps []ApplicationListItem
 	err := sqlx.Select(db, &apps, `
 		select
@@ -210,11 +244,13 @@ func GetApplicationsForOrganizationID(db sqlx.Queryer, organizationID int64, lim
 			on sp.service_profile_id = a.service_profile_id
 		where
 			a.organization_id = $1
+			and `+tagsFilter("a.tags", 4)+`
 		order by a.name
 		limit $2 offset $3`,
 		organizationID,
 		limit,
 		offset,
+		selector,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -238,7 +274,13 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 			service_profile_id = $5,
 			payload_codec = $6,
 			payload_encoder_script = $7,
-			payload_decoder_script = $8
+			payload_decoder_script = $8,
+			tags = $9,
+			clock_sync_enabled = $10,
+			clock_sync_resync_interval = $11,
+			alert_uplink_interval = $12,
+			alert_battery_threshold = $13,
+			alert_margin_threshold = $14
 		where id = $1`,
 		item.ID,
 		item.Name,
@@ -248,6 +290,12 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.Tags,
+		item.ClockSyncEnabled,
+		item.ClockSyncResyncInterval,
+		item.AlertUplinkInterval,
+		item.AlertBatteryThreshold,
+		item.AlertMarginThreshold,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
@@ -55,15 +56,36 @@ func TestApplication(t *testing.T) {
 			})
 		})
 
+		Convey("When creating an application with an invalid battery threshold", func() {
+			app := Application{
+				OrganizationID:        org.ID,
+				ServiceProfileID:      sp.ServiceProfile.ServiceProfileID,
+				Name:                  "test-application",
+				AlertBatteryThreshold: 255,
+			}
+			err := CreateApplication(db, &app)
+
+			Convey("Then an error is returned", func() {
+				So(errors.Cause(err), ShouldResemble, ErrAlertInvalidBatteryThreshold)
+			})
+		})
+
 		Convey("When creating an application", func() {
+			marginThreshold := -5
 			app := Application{
-				OrganizationID:       org.ID,
-				ServiceProfileID:     sp.ServiceProfile.ServiceProfileID,
-				Name:                 "test-application",
-				Description:          "A test application",
-				PayloadCodec:         "CUSTOM_JS",
-				PayloadEncoderScript: "Encode() {}",
-				PayloadDecoderScript: "Decode() {}",
+				OrganizationID:          org.ID,
+				ServiceProfileID:        sp.ServiceProfile.ServiceProfileID,
+				Name:                    "test-application",
+				Description:             "A test application",
+				PayloadCodec:            "CUSTOM_JS",
+				PayloadEncoderScript:    "Encode() {}",
+				PayloadDecoderScript:    "Decode() {}",
+				Tags:                    Tags{"site": "berlin"},
+				ClockSyncEnabled:        true,
+				ClockSyncResyncInterval: 24 * time.Hour,
+				AlertUplinkInterval:     2 * time.Hour,
+				AlertBatteryThreshold:   50,
+				AlertMarginThreshold:    &marginThreshold,
 			}
 			So(CreateApplication(db, &app), ShouldBeNil)
 
@@ -74,7 +96,7 @@ func TestApplication(t *testing.T) {
 			})
 
 			Convey("Then get applications returns a single application", func() {
-				apps, err := GetApplications(db, 10, 0)
+				apps, err := GetApplications(db, 10, 0, nil)
 				So(err, ShouldBeNil)
 				So(apps, ShouldHaveLength, 1)
 				So(apps[0].ID, ShouldEqual, app.ID)
@@ -83,19 +105,39 @@ func TestApplication(t *testing.T) {
 			})
 
 			Convey("Then get application count returns 1", func() {
-				count, err := GetApplicationCount(db)
+				count, err := GetApplicationCount(db, nil)
 				So(err, ShouldBeNil)
 				So(count, ShouldEqual, 1)
 			})
 
+			Convey("Then the applications are filtered by the given tag selector", func() {
+				for sel, expected := range map[string]int{
+					"site=berlin":  1,
+					"site=hamburg": 0,
+					"site,!floor":  1,
+					"site!=berlin": 0,
+				} {
+					selector, err := ParseTagSelector(sel)
+					So(err, ShouldBeNil)
+
+					apps, err := GetApplicationsForOrganizationID(db, org.ID, 10, 0, selector)
+					So(err, ShouldBeNil)
+					So(apps, ShouldHaveLength, expected)
+
+					count, err := GetApplicationCountForOrganizationID(db, org.ID, selector)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, expected)
+				}
+			})
+
 			Convey("Then the application count for the organization returns 1", func() {
-				count, err := GetApplicationCountForOrganizationID(db, org.ID)
+				count, err := GetApplicationCountForOrganizationID(db, org.ID, nil)
 				So(err, ShouldBeNil)
 				So(count, ShouldEqual, 1)
 			})
 
 			Convey("Then listing the applications for the organization returns the expected application", func() {
-				apps, err := GetApplicationsForOrganizationID(db, org.ID, 10, 0)
+				apps, err := GetApplicationsForOrganizationID(db, org.ID, 10, 0, nil)
 				So(err, ShouldBeNil)
 				So(apps, ShouldHaveLength, 1)
 				So(apps[0].ID, ShouldEqual, app.ID)
@@ -117,7 +159,7 @@ func TestApplication(t *testing.T) {
 				So(DeleteApplication(db, app.ID), ShouldBeNil)
 
 				Convey("Then the application count returns 0", func() {
-					count, err := GetApplicationCount(db)
+					count, err := GetApplicationCount(db, nil)
 					So(err, ShouldBeNil)
 					So(count, ShouldEqual, 0)
 				})
@@ -29,6 +29,13 @@ type Device struct {
 	Description         string        `db:"description"`
 	DeviceStatusBattery *int          `db:"device_status_battery"`
 	DeviceStatusMargin  *int          `db:"device_status_margin"`
+	Tags                Tags          `db:"tags"`
+	Latitude            *float64      `db:"latitude"`
+	Longitude           *float64      `db:"longitude"`
+	Altitude            *float64      `db:"altitude"`
+	LocationAccuracy    *float64      `db:"location_accuracy"`
+	LocationSource      string        `db:"location_source"`
+	LocationUpdatedAt   *time.Time    `db:"location_updated_at"`
 }
 
 // DeviceListItem defines the Device as list item.
@@ -39,7 +46,7 @@ type DeviceListItem struct {
 
 // Validate validates the device data.
 func (d Device) Validate() error {
-	return nil
+	return d.Tags.Validate()
 }
 
 // DeviceKeys defines the keys for a LoRaWAN device.
@@ -67,6 +74,38 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 		return errors.Wrap(err, "validate error")
 	}
 
+	if err := insertDevice(db, d); err != nil {
+		return err
+	}
+
+	app, err := GetApplication(db, d.ApplicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	n, err := GetNetworkServerForDevEUI(db, d.DevEUI)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	if err := createNetworkServerDevice(nsClient, app, d); err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": d.DevEUI,
+	}).Info("device created")
+
+	return nil
+}
+
+// insertDevice inserts the given device into the database.
+func insertDevice(db sqlx.Execer, d *Device) error {
 	now := time.Now()
 	d.CreatedAt = now
 	d.UpdatedAt = now
@@ -82,8 +121,9 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 			description,
 			device_status_battery,
 			device_status_margin,
-			last_seen_at
-        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			last_seen_at,
+			tags
+        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
 		d.DevEUI[:],
 		d.CreatedAt,
 		d.UpdatedAt,
@@ -94,27 +134,18 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 		d.DeviceStatusBattery,
 		d.DeviceStatusMargin,
 		d.LastSeenAt,
+		d.Tags,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
 	}
 
-	app, err := GetApplication(db, d.ApplicationID)
-	if err != nil {
-		return errors.Wrap(err, "get application error")
-	}
-
-	n, err := GetNetworkServerForDevEUI(db, d.DevEUI)
-	if err != nil {
-		return errors.Wrap(err, "get network-server error")
-	}
-
-	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
-	if err != nil {
-		return errors.Wrap(err, "get network-server client error")
-	}
+	return nil
+}
 
-	_, err = nsClient.CreateDevice(context.Background(), &ns.CreateDeviceRequest{
+// createNetworkServerDevice creates the given device at the network-server.
+func createNetworkServerDevice(nsClient ns.NetworkServerClient, app Application, d *Device) error {
+	_, err := nsClient.CreateDevice(context.Background(), &ns.CreateDeviceRequest{
 		Device: &ns.Device{
 			DevEUI:           d.DevEUI[:],
 			DeviceProfileID:  d.DeviceProfileID,
@@ -127,10 +158,6 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 		return handleGrpcError(err, "create device error")
 	}
 
-	log.WithFields(log.Fields{
-		"dev_eui": d.DevEUI,
-	}).Info("device created")
-
 	return nil
 }
 
@@ -146,8 +173,8 @@ func GetDevice(db sqlx.Queryer, devEUI lorawan.EUI64) (Device, error) {
 }
 
 // GetDevicesForApplicationID returns a slice of devices for the given
-// application id.
-func GetDevicesForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int, search string) ([]DeviceListItem, error) {
+// application id, matching the given search and tag selector.
+func GetDevicesForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int, search string, selector TagSelector) ([]DeviceListItem, error) {
 	var devices []DeviceListItem
 	if search != "" {
 		search = search + "%"
@@ -162,6 +189,7 @@ func GetDevicesForApplicationID(db sqlx.Queryer, applicationID int64, limit, off
 		where
 			d.application_id = $1
 			and ( ($4 = '') or ($4 != '' and (d.name ilike $4 or encode(d.dev_eui, 'hex') ilike $4)) )
+			and `+tagsFilter("d.tags", 5)+`
 		order by d.name
 		limit $2
 		offset $3`,
@@ -169,6 +197,7 @@ func GetDevicesForApplicationID(db sqlx.Queryer, applicationID int64, limit, off
 		limit,
 		offset,
 		search,
+		selector,
 	)
 	if err != nil {
 		return nil, handlePSQLError(Select, err, "select error")
@@ -178,8 +207,8 @@ func GetDevicesForApplicationID(db sqlx.Queryer, applicationID int64, limit, off
 }
 
 // GetDeviceCountForApplicationID returns the total number of devices for the
-// given application id.
-func GetDeviceCountForApplicationID(db sqlx.Queryer, applicationID int64, search string) (int, error) {
+// given application id, matching the given search and tag selector.
+func GetDeviceCountForApplicationID(db sqlx.Queryer, applicationID int64, search string, selector TagSelector) (int, error) {
 	var count int
 	if search != "" {
 		search = search + "%"
@@ -190,9 +219,11 @@ func GetDeviceCountForApplicationID(db sqlx.Queryer, applicationID int64, search
 		from device
 		where
 			application_id = $1
-			and ( ($2 = '') or ($2 != '' and (name ilike $2 or encode(dev_eui, 'hex') ilike $2)) )`,
+			and ( ($2 = '') or ($2 != '' and (name ilike $2 or encode(dev_eui, 'hex') ilike $2)) )
+			and `+tagsFilter("tags", 3),
 		applicationID,
 		search,
+		selector,
 	)
 	if err != nil {
 		return count, handlePSQLError(Select, err, "select error")
@@ -219,7 +250,8 @@ func UpdateDevice(db sqlx.Ext, d *Device) error {
 			description = $6,
 			device_status_battery = $7,
 			device_status_margin = $8,
-			last_seen_at = $9
+			last_seen_at = $9,
+			tags = $10
         where
             dev_eui = $1`,
 		d.DevEUI[:],
@@ -231,6 +263,7 @@ func UpdateDevice(db sqlx.Ext, d *Device) error {
 		d.DeviceStatusBattery,
 		d.DeviceStatusMargin,
 		d.LastSeenAt,
+		d.Tags,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -278,6 +311,48 @@ func UpdateDevice(db sqlx.Ext, d *Device) error {
 	return nil
 }
 
+// UpdateDeviceLocation updates the (resolved) location of the given device.
+// Unlike UpdateDevice, this only updates the location fields and does not
+// update the device at the network-server.
+func UpdateDeviceLocation(db sqlx.Execer, d *Device) error {
+	res, err := db.Exec(`
+		update device
+		set
+			latitude = $2,
+			longitude = $3,
+			altitude = $4,
+			location_accuracy = $5,
+			location_source = $6,
+			location_updated_at = $7
+		where
+			dev_eui = $1`,
+		d.DevEUI[:],
+		d.Latitude,
+		d.Longitude,
+		d.Altitude,
+		d.LocationAccuracy,
+		d.LocationSource,
+		d.LocationUpdatedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": d.DevEUI,
+		"source":  d.LocationSource,
+	}).Info("device location updated")
+
+	return nil
+}
+
 // DeleteDevice deletes the device matching the given DevEUI.
 func DeleteDevice(db sqlx.Ext, devEUI lorawan.EUI64) error {
 	n, err := GetNetworkServerForDevEUI(db, devEUI)
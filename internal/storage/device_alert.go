@@ -0,0 +1,340 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lorawan"
+)
+
+// DeviceAlertType defines the device alert type.
+type DeviceAlertType string
+
+// Available device alert types.
+const (
+	DeviceAlertInactive   DeviceAlertType = "INACTIVE"
+	DeviceAlertLowBattery DeviceAlertType = "LOW_BATTERY"
+	DeviceAlertLowMargin  DeviceAlertType = "LOW_MARGIN"
+)
+
+// DeviceAlertState defines the device alert state.
+type DeviceAlertState string
+
+// Available device alert states.
+const (
+	DeviceAlertOpen     DeviceAlertState = "OPEN"
+	DeviceAlertResolved DeviceAlertState = "RESOLVED"
+)
+
+// DeviceAlert defines an alert raised for a device. Per device and type,
+// only a single alert can be open at a time.
+type DeviceAlert struct {
+	ID         string           `db:"id"`
+	CreatedAt  time.Time        `db:"created_at"`
+	UpdatedAt  time.Time        `db:"updated_at"`
+	DevEUI     lorawan.EUI64    `db:"dev_eui"`
+	Type       DeviceAlertType  `db:"type"`
+	State      DeviceAlertState `db:"state"`
+	Message    string           `db:"message"`
+	ResolvedAt *time.Time       `db:"resolved_at"`
+}
+
+// DeviceAlertFilters defines the filters that can be applied when listing
+// device alerts. Zero values are ignored.
+type DeviceAlertFilters struct {
+	DevEUI *lorawan.EUI64
+	State  DeviceAlertState
+}
+
+// DeviceHealth defines the health related data of a device, together with
+// the alert rules which apply to the device. The alert rules of the
+// device-profile take precedence over the rules of the application.
+type DeviceHealth struct {
+	DevEUI                lorawan.EUI64 `db:"dev_eui"`
+	DeviceName            string        `db:"device_name"`
+	ApplicationID         int64         `db:"application_id"`
+	ApplicationName       string        `db:"application_name"`
+	CreatedAt             time.Time     `db:"created_at"`
+	LastSeenAt            *time.Time    `db:"last_seen_at"`
+	DeviceStatusBattery   *int          `db:"device_status_battery"`
+	DeviceStatusMargin    *int          `db:"device_status_margin"`
+	AlertUplinkInterval   time.Duration `db:"alert_uplink_interval"`
+	AlertBatteryThreshold int           `db:"alert_battery_threshold"`
+	AlertMarginThreshold  *int          `db:"alert_margin_threshold"`
+}
+
+// Validate validates the device alert data.
+func (a DeviceAlert) Validate() error {
+	switch a.Type {
+	case DeviceAlertInactive, DeviceAlertLowBattery, DeviceAlertLowMargin:
+	default:
+		return ErrDeviceAlertInvalidType
+	}
+
+	switch a.State {
+	case DeviceAlertOpen, DeviceAlertResolved:
+	default:
+		return ErrDeviceAlertInvalidState
+	}
+
+	return nil
+}
+
+// validateAlertRules validates the alert rules as configured for an
+// application or device-profile. Zero values (or a nil margin threshold)
+// disable the rule.
+func validateAlertRules(uplinkInterval time.Duration, batteryThreshold int, marginThreshold *int) error {
+	if uplinkInterval < 0 {
+		return ErrAlertInvalidUplinkInterval
+	}
+
+	if batteryThreshold < 0 || batteryThreshold > 254 {
+		return ErrAlertInvalidBatteryThreshold
+	}
+
+	if marginThreshold != nil && (*marginThreshold < -32 || *marginThreshold > 32) {
+		return ErrAlertInvalidMarginThreshold
+	}
+
+	return nil
+}
+
+// CreateDeviceAlert creates the given device alert. ErrAlreadyExists is
+// returned when an alert of the same type is already open for the device.
+func CreateDeviceAlert(db sqlx.Execer, a *DeviceAlert) error {
+	if err := a.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	a.ID = uuid.NewV4().String()
+	a.CreatedAt = now
+	a.UpdatedAt = now
+
+	_, err := db.Exec(`
+		insert into device_alert (
+			id,
+			created_at,
+			updated_at,
+			dev_eui,
+			type,
+			state,
+			message,
+			resolved_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		a.ID,
+		a.CreatedAt,
+		a.UpdatedAt,
+		a.DevEUI[:],
+		a.Type,
+		a.State,
+		a.Message,
+		a.ResolvedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":      a.ID,
+		"dev_eui": a.DevEUI,
+		"type":    a.Type,
+	}).Info("device alert created")
+
+	return nil
+}
+
+// UpdateDeviceAlert updates the given device alert.
+func UpdateDeviceAlert(db sqlx.Execer, a *DeviceAlert) error {
+	if err := a.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	a.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update device_alert
+		set
+			updated_at = $2,
+			state = $3,
+			message = $4,
+			resolved_at = $5
+		where
+			id = $1`,
+		a.ID,
+		a.UpdatedAt,
+		a.State,
+		a.Message,
+		a.ResolvedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":      a.ID,
+		"dev_eui": a.DevEUI,
+		"type":    a.Type,
+		"state":   a.State,
+	}).Info("device alert updated")
+
+	return nil
+}
+
+// GetOpenDeviceAlertsForDevEUI returns the open alerts for the given DevEUI.
+// When forUpdate is set, the alerts are locked for update.
+func GetOpenDeviceAlertsForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64, forUpdate bool) ([]DeviceAlert, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var alerts []DeviceAlert
+	err := sqlx.Select(db, &alerts, `
+		select
+			*
+		from device_alert
+		where
+			dev_eui = $1
+			and state = $2
+		order by created_at`+fu,
+		devEUI[:],
+		DeviceAlertOpen,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return alerts, nil
+}
+
+// GetDeviceAlertsForApplicationID returns the alerts of the devices of the
+// given application matching the given filters, sorted by the most recent
+// alert first.
+func GetDeviceAlertsForApplicationID(db sqlx.Queryer, applicationID int64, filters DeviceAlertFilters, limit, offset int) ([]DeviceAlert, error) {
+	var devEUI interface{}
+	if filters.DevEUI != nil {
+		devEUI = filters.DevEUI[:]
+	}
+
+	var alerts []DeviceAlert
+	err := sqlx.Select(db, &alerts, `
+		select
+			da.*
+		from device_alert da
+		inner join device d
+			on d.dev_eui = da.dev_eui
+		where
+			d.application_id = $1
+			and ($2::bytea is null or da.dev_eui = $2)
+			and ($3 = '' or da.state = $3)
+		order by da.created_at desc, da.id
+		limit $4
+		offset $5`,
+		applicationID,
+		devEUI,
+		filters.State,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return alerts, nil
+}
+
+// GetDeviceAlertCountForApplicationID returns the total number of alerts of
+// the devices of the given application matching the given filters.
+func GetDeviceAlertCountForApplicationID(db sqlx.Queryer, applicationID int64, filters DeviceAlertFilters) (int, error) {
+	var devEUI interface{}
+	if filters.DevEUI != nil {
+		devEUI = filters.DevEUI[:]
+	}
+
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from device_alert da
+		inner join device d
+			on d.dev_eui = da.dev_eui
+		where
+			d.application_id = $1
+			and ($2::bytea is null or da.dev_eui = $2)
+			and ($3 = '' or da.state = $3)`,
+		applicationID,
+		devEUI,
+		filters.State,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetDeviceHealthAfterDevEUI returns the health data of the devices having
+// at least one alert rule configured or having open alerts, with a DevEUI
+// greater than the given DevEUI. The result is sorted by DevEUI so that all
+// devices can be iterated in batches.
+func GetDeviceHealthAfterDevEUI(db sqlx.Queryer, after lorawan.EUI64, limit int) ([]DeviceHealth, error) {
+	var items []DeviceHealth
+	err := sqlx.Select(db, &items, `
+		select
+			d.dev_eui,
+			d.name as device_name,
+			a.id as application_id,
+			a.name as application_name,
+			d.created_at,
+			d.last_seen_at,
+			d.device_status_battery,
+			d.device_status_margin,
+			case when dp.alert_uplink_interval != 0 then dp.alert_uplink_interval else a.alert_uplink_interval end as alert_uplink_interval,
+			case when dp.alert_battery_threshold != 0 then dp.alert_battery_threshold else a.alert_battery_threshold end as alert_battery_threshold,
+			coalesce(dp.alert_margin_threshold, a.alert_margin_threshold) as alert_margin_threshold
+		from device d
+		inner join device_profile dp
+			on dp.device_profile_id = d.device_profile_id
+		inner join application a
+			on a.id = d.application_id
+		where
+			d.dev_eui > $1
+			and (
+				dp.alert_uplink_interval != 0
+				or dp.alert_battery_threshold != 0
+				or dp.alert_margin_threshold is not null
+				or a.alert_uplink_interval != 0
+				or a.alert_battery_threshold != 0
+				or a.alert_margin_threshold is not null
+				or exists (
+					select 1
+					from device_alert da
+					where
+						da.dev_eui = d.dev_eui
+						and da.state = $3
+				)
+			)
+		order by d.dev_eui
+		limit $2`,
+		after[:],
+		limit,
+		DeviceAlertOpen,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
@@ -0,0 +1,208 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Frankz/lorawan"
+	"github.com/pkg/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+)
+
+func TestDeviceAlert(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	nsClient := test.NewNetworkServerClient()
+	common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and two devices", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		devices := []Device{
+			{
+				Name:            "test-device-1",
+				DevEUI:          lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+				ApplicationID:   app.ID,
+				DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			},
+			{
+				Name:            "test-device-2",
+				DevEUI:          lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2},
+				ApplicationID:   app.ID,
+				DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			},
+		}
+		for i := range devices {
+			So(CreateDevice(common.DB, &devices[i]), ShouldBeNil)
+		}
+
+		Convey("Then CreateDeviceAlert returns an error on an invalid type", func() {
+			err := CreateDeviceAlert(common.DB, &DeviceAlert{
+				DevEUI: devices[0].DevEUI,
+				Type:   "foo",
+				State:  DeviceAlertOpen,
+			})
+			So(errors.Cause(err), ShouldEqual, ErrDeviceAlertInvalidType)
+		})
+
+		Convey("Then GetDeviceHealthAfterDevEUI returns no devices", func() {
+			items, err := GetDeviceHealthAfterDevEUI(common.DB, lorawan.EUI64{}, 10)
+			So(err, ShouldBeNil)
+			So(items, ShouldHaveLength, 0)
+		})
+
+		Convey("Given alert rules on the application and device-profile", func() {
+			app.AlertUplinkInterval = time.Hour
+			app.AlertBatteryThreshold = 20
+			So(UpdateApplication(common.DB, app), ShouldBeNil)
+
+			dp.AlertBatteryThreshold = 50
+			marginThreshold := 5
+			dp.AlertMarginThreshold = &marginThreshold
+			So(UpdateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+			Convey("Then GetDeviceHealthAfterDevEUI returns the effective rules", func() {
+				items, err := GetDeviceHealthAfterDevEUI(common.DB, lorawan.EUI64{}, 10)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+				So(items[0].DevEUI, ShouldEqual, devices[0].DevEUI)
+				So(items[0].DeviceName, ShouldEqual, "test-device-1")
+				So(items[0].ApplicationID, ShouldEqual, app.ID)
+				So(items[0].ApplicationName, ShouldEqual, "test-app")
+				So(items[0].AlertUplinkInterval, ShouldEqual, time.Hour)
+				So(items[0].AlertBatteryThreshold, ShouldEqual, 50)
+				So(*items[0].AlertMarginThreshold, ShouldEqual, 5)
+			})
+
+			Convey("Then GetDeviceHealthAfterDevEUI pages by DevEUI", func() {
+				items, err := GetDeviceHealthAfterDevEUI(common.DB, devices[0].DevEUI, 10)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].DevEUI, ShouldEqual, devices[1].DevEUI)
+			})
+		})
+
+		Convey("When creating an open alert", func() {
+			a := DeviceAlert{
+				DevEUI:  devices[0].DevEUI,
+				Type:    DeviceAlertInactive,
+				State:   DeviceAlertOpen,
+				Message: "no uplink received",
+			}
+			So(CreateDeviceAlert(common.DB, &a), ShouldBeNil)
+			So(a.ID, ShouldNotEqual, "")
+
+			Convey("Then a second open alert of the same type can not be created", func() {
+				err := CreateDeviceAlert(common.DB, &DeviceAlert{
+					DevEUI: devices[0].DevEUI,
+					Type:   DeviceAlertInactive,
+					State:  DeviceAlertOpen,
+				})
+				So(errors.Cause(err), ShouldEqual, ErrAlreadyExists)
+			})
+
+			Convey("Then GetOpenDeviceAlertsForDevEUI returns the alert", func() {
+				alerts, err := GetOpenDeviceAlertsForDevEUI(common.DB, devices[0].DevEUI, false)
+				So(err, ShouldBeNil)
+				So(alerts, ShouldHaveLength, 1)
+				So(alerts[0].ID, ShouldEqual, a.ID)
+				So(alerts[0].Message, ShouldEqual, "no uplink received")
+			})
+
+			Convey("Then GetDeviceHealthAfterDevEUI returns the device", func() {
+				items, err := GetDeviceHealthAfterDevEUI(common.DB, lorawan.EUI64{}, 10)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].DevEUI, ShouldEqual, devices[0].DevEUI)
+			})
+
+			Convey("When resolving the alert and creating a low margin alert", func() {
+				now := time.Now()
+				a.State = DeviceAlertResolved
+				a.ResolvedAt = &now
+				So(UpdateDeviceAlert(common.DB, &a), ShouldBeNil)
+
+				b := DeviceAlert{
+					DevEUI: devices[1].DevEUI,
+					Type:   DeviceAlertLowMargin,
+					State:  DeviceAlertOpen,
+				}
+				So(CreateDeviceAlert(common.DB, &b), ShouldBeNil)
+
+				Convey("Then GetOpenDeviceAlertsForDevEUI returns no alerts", func() {
+					alerts, err := GetOpenDeviceAlertsForDevEUI(common.DB, devices[0].DevEUI, false)
+					So(err, ShouldBeNil)
+					So(alerts, ShouldHaveLength, 0)
+				})
+
+				Convey("Then the alerts can be listed and filtered", func() {
+					devEUI := devices[0].DevEUI
+
+					tests := []struct {
+						Filters DeviceAlertFilters
+						IDs     []string
+					}{
+						{DeviceAlertFilters{}, []string{b.ID, a.ID}},
+						{DeviceAlertFilters{State: DeviceAlertOpen}, []string{b.ID}},
+						{DeviceAlertFilters{State: DeviceAlertResolved}, []string{a.ID}},
+						{DeviceAlertFilters{DevEUI: &devEUI}, []string{a.ID}},
+					}
+
+					for _, test := range tests {
+						alerts, err := GetDeviceAlertsForApplicationID(common.DB, app.ID, test.Filters, 10, 0)
+						So(err, ShouldBeNil)
+						var ids []string
+						for _, al := range alerts {
+							ids = append(ids, al.ID)
+						}
+						So(ids, ShouldResemble, test.IDs)
+
+						count, err := GetDeviceAlertCountForApplicationID(common.DB, app.ID, test.Filters)
+						So(err, ShouldBeNil)
+						So(count, ShouldEqual, len(test.IDs))
+					}
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Frankz/lorawan"
+)
+
+// DeviceClockSync defines the clock synchronization state of a device.
+type DeviceClockSync struct {
+	DevEUI         lorawan.EUI64 `db:"dev_eui"`
+	SyncedAt       *time.Time    `db:"synced_at"`
+	TimeCorrection int32         `db:"time_correction"`
+	ForcedAt       *time.Time    `db:"forced_at"`
+}
+
+// GetDeviceClockSync returns the clock synchronization state for the given
+// DevEUI. ErrDoesNotExist is returned when the device never synchronized
+// its clock.
+func GetDeviceClockSync(db sqlx.Queryer, devEUI lorawan.EUI64) (DeviceClockSync, error) {
+	var dcs DeviceClockSync
+	err := sqlx.Get(db, &dcs, "select * from device_clock_sync where dev_eui = $1", devEUI[:])
+	if err != nil {
+		return dcs, handlePSQLError(Select, err, "select error")
+	}
+	return dcs, nil
+}
+
+// SetDeviceClockSynced stores the time and the time correction (in seconds)
+// of the last clock synchronization of the given DevEUI.
+func SetDeviceClockSynced(db sqlx.Execer, devEUI lorawan.EUI64, t time.Time, timeCorrection int32) error {
+	_, err := db.Exec(`
+		insert into device_clock_sync (
+			dev_eui,
+			synced_at,
+			time_correction
+		) values ($1, $2, $3)
+		on conflict (dev_eui) do update
+		set
+			synced_at = excluded.synced_at,
+			time_correction = excluded.time_correction`,
+		devEUI[:],
+		t,
+		timeCorrection,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// SetDeviceClockResyncForced stores the time of the last forced clock
+// re-synchronization of the given DevEUI.
+func SetDeviceClockResyncForced(db sqlx.Execer, devEUI lorawan.EUI64, t time.Time) error {
+	_, err := db.Exec(`
+		insert into device_clock_sync (
+			dev_eui,
+			forced_at
+		) values ($1, $2)
+		on conflict (dev_eui) do update
+		set
+			forced_at = excluded.forced_at`,
+		devEUI[:],
+		t,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// GetDevEUIsForClockResync returns the DevEUIs of the activated devices
+// which must be forced to re-synchronize their clock, as the last
+// synchronization (or forced re-synchronization) is older than the resync
+// interval of the application. The devices are locked for update and
+// devices locked by other transactions are skipped. This function must be
+// called within a transaction.
+func GetDevEUIsForClockResync(db sqlx.Queryer, t time.Time, limit int) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		select d.dev_eui
+		from device d
+		inner join application a
+			on a.id = d.application_id
+		left join device_clock_sync dcs
+			on dcs.dev_eui = d.dev_eui
+		where
+			a.clock_sync_enabled = true
+			and a.clock_sync_resync_interval > 0
+			and exists (
+				select 1
+				from device_activation da
+				where
+					da.dev_eui = d.dev_eui
+			)
+			and (
+				greatest(dcs.synced_at, dcs.forced_at) is null
+				or greatest(dcs.synced_at, dcs.forced_at) < $1 - (a.clock_sync_resync_interval / 1000) * interval '1 microsecond'
+			)
+		order by d.dev_eui
+		limit $2
+		for update of d skip locked`,
+		t,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devEUIs, nil
+}
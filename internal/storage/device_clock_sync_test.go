@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+func TestDeviceClockSync(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	nsClient := test.NewNetworkServerClient()
+	common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and two devices of an application with clock sync enabled", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:          org.ID,
+			ServiceProfileID:        sp.ServiceProfile.ServiceProfileID,
+			Name:                    "test-app",
+			ClockSyncEnabled:        true,
+			ClockSyncResyncInterval: time.Hour,
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		devices := []Device{
+			{Name: "test-device-1", DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, ApplicationID: app.ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID},
+			{Name: "test-device-2", DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, ApplicationID: app.ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID},
+		}
+		for i := range devices {
+			So(CreateDevice(common.DB, &devices[i]), ShouldBeNil)
+		}
+
+		// only the first device has been activated
+		So(CreateDeviceActivation(common.DB, &DeviceActivation{
+			DevEUI:  devices[0].DevEUI,
+			DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+		}), ShouldBeNil)
+
+		getDevEUIs := func(t time.Time) []lorawan.EUI64 {
+			var devEUIs []lorawan.EUI64
+			So(Transaction(common.DB, func(tx sqlx.Ext) error {
+				var err error
+				devEUIs, err = GetDevEUIsForClockResync(tx, t, 10)
+				return err
+			}), ShouldBeNil)
+			return devEUIs
+		}
+
+		Convey("Then GetDeviceClockSync returns ErrDoesNotExist", func() {
+			_, err := GetDeviceClockSync(common.DB, devices[0].DevEUI)
+			So(err, ShouldEqual, ErrDoesNotExist)
+		})
+
+		Convey("Then GetDevEUIsForClockResync returns the activated device", func() {
+			So(getDevEUIs(time.Now()), ShouldResemble, []lorawan.EUI64{devices[0].DevEUI})
+		})
+
+		Convey("When the clock of the device has been synchronized", func() {
+			syncedAt := time.Now().Truncate(time.Millisecond)
+			So(SetDeviceClockSynced(common.DB, devices[0].DevEUI, syncedAt, -10), ShouldBeNil)
+
+			Convey("Then GetDeviceClockSync returns the synchronization", func() {
+				dcs, err := GetDeviceClockSync(common.DB, devices[0].DevEUI)
+				So(err, ShouldBeNil)
+				So(dcs.SyncedAt.Equal(syncedAt), ShouldBeTrue)
+				So(dcs.TimeCorrection, ShouldEqual, -10)
+				So(dcs.ForcedAt, ShouldBeNil)
+			})
+
+			Convey("Then the device is only returned after the resync interval", func() {
+				So(getDevEUIs(time.Now()), ShouldHaveLength, 0)
+				So(getDevEUIs(time.Now().Add(time.Hour)), ShouldResemble, []lorawan.EUI64{devices[0].DevEUI})
+			})
+
+			Convey("When the device has been forced to resync", func() {
+				forcedAt := syncedAt.Add(30 * time.Minute)
+				So(SetDeviceClockResyncForced(common.DB, devices[0].DevEUI, forcedAt), ShouldBeNil)
+
+				Convey("Then the synchronization is not overwritten", func() {
+					dcs, err := GetDeviceClockSync(common.DB, devices[0].DevEUI)
+					So(err, ShouldBeNil)
+					So(dcs.SyncedAt.Equal(syncedAt), ShouldBeTrue)
+					So(dcs.ForcedAt.Equal(forcedAt), ShouldBeTrue)
+				})
+
+				Convey("Then the device is only returned after the resync interval since it was forced", func() {
+					So(getDevEUIs(time.Now().Add(time.Hour)), ShouldHaveLength, 0)
+					So(getDevEUIs(forcedAt.Add(time.Hour+time.Second)), ShouldResemble, []lorawan.EUI64{devices[0].DevEUI})
+				})
+			})
+		})
+	})
+}
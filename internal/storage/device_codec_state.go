@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/Frankz/lorawan"
+)
+
+// GetDeviceCodecState returns the persisted payload codec state for the
+// given DevEUI. ErrDoesNotExist is returned when no state has been stored
+// yet. When forUpdate is set, the device is locked until the end of the
+// transaction (also when no state has been stored yet), so that concurrent
+// uplinks of the same device are decoded one after the other.
+func GetDeviceCodecState(db sqlx.Queryer, devEUI lorawan.EUI64, forUpdate bool) (json.RawMessage, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update of d"
+	}
+
+	var state *[]byte
+	err := sqlx.Get(db, &state, `
+		select
+			s.state
+		from device d
+		left join device_codec_state s
+			on s.dev_eui = d.dev_eui
+		where
+			d.dev_eui = $1`+fu,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	if state == nil {
+		return nil, ErrDoesNotExist
+	}
+	return json.RawMessage(*state), nil
+}
+
+// SetDeviceCodecState creates or updates the payload codec state for the
+// given DevEUI.
+func SetDeviceCodecState(db sqlx.Execer, devEUI lorawan.EUI64, state json.RawMessage) error {
+	_, err := db.Exec(`
+		insert into device_codec_state (
+			dev_eui,
+			updated_at,
+			state
+		) values ($1, $2, $3)
+		on conflict (dev_eui) do update
+		set
+			updated_at = excluded.updated_at,
+			state = excluded.state`,
+		devEUI[:],
+		time.Now(),
+		[]byte(state),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
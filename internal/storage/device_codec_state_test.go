@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+func TestDeviceCodecState(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	nsClient := test.NewNetworkServerClient()
+	common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and a device", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := Device{
+			Name:            "test-device",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(common.DB, &d), ShouldBeNil)
+
+		Convey("Then GetDeviceCodecState returns ErrDoesNotExist", func() {
+			_, err := GetDeviceCodecState(common.DB, d.DevEUI, false)
+			So(err, ShouldEqual, ErrDoesNotExist)
+
+			err = Transaction(common.DB, func(tx sqlx.Ext) error {
+				_, err := GetDeviceCodecState(tx, d.DevEUI, true)
+				return err
+			})
+			So(err, ShouldEqual, ErrDoesNotExist)
+		})
+
+		Convey("When setting the codec state", func() {
+			So(SetDeviceCodecState(common.DB, d.DevEUI, json.RawMessage(`{"count":1}`)), ShouldBeNil)
+
+			Convey("Then GetDeviceCodecState returns the state", func() {
+				state, err := GetDeviceCodecState(common.DB, d.DevEUI, false)
+				So(err, ShouldBeNil)
+				So(string(state), ShouldEqual, `{"count": 1}`)
+			})
+
+			Convey("Then setting it again updates the state", func() {
+				So(SetDeviceCodecState(common.DB, d.DevEUI, json.RawMessage(`{"count":2}`)), ShouldBeNil)
+
+				state, err := GetDeviceCodecState(common.DB, d.DevEUI, false)
+				So(err, ShouldBeNil)
+				So(string(state), ShouldEqual, `{"count": 2}`)
+			})
+
+			Convey("Then deleting the device removes the state", func() {
+				So(DeleteDevice(common.DB, d.DevEUI), ShouldBeNil)
+
+				_, err := GetDeviceCodecState(common.DB, d.DevEUI, false)
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+		})
+	})
+}
@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/Frankz/lorawan"
+)
+
+// DeviceEventType defines the device event type.
+type DeviceEventType string
+
+// Available device event types.
+const (
+	DeviceEventUplink DeviceEventType = "uplink"
+	DeviceEventJoin   DeviceEventType = "join"
+	DeviceEventACK    DeviceEventType = "ack"
+	DeviceEventError  DeviceEventType = "error"
+	DeviceEventAlert  DeviceEventType = "alert"
+)
+
+// DeviceEvent defines a persisted device event (e.g. a decoded uplink).
+type DeviceEvent struct {
+	ID        int64           `db:"id"`
+	CreatedAt time.Time       `db:"created_at"`
+	DevEUI    lorawan.EUI64   `db:"dev_eui"`
+	Type      DeviceEventType `db:"type"`
+	FPort     *int            `db:"f_port"`
+	Payload   json.RawMessage `db:"payload"`
+}
+
+// DeviceEventFilters defines the filters that can be applied when listing
+// device events. Zero values are ignored.
+type DeviceEventFilters struct {
+	StartTime *time.Time
+	EndTime   *time.Time
+	FPort     int
+	Type      DeviceEventType
+}
+
+// Validate validates the device event data.
+func (e DeviceEvent) Validate() error {
+	switch e.Type {
+	case DeviceEventUplink, DeviceEventJoin, DeviceEventACK, DeviceEventError, DeviceEventAlert:
+		return nil
+	default:
+		return ErrDeviceEventInvalidType
+	}
+}
+
+// CreateDeviceEvent creates the given device event.
+func CreateDeviceEvent(db sqlx.Queryer, e *DeviceEvent) error {
+	if err := e.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	e.CreatedAt = time.Now()
+
+	err := sqlx.Get(db, &e.ID, `
+		insert into device_event (
+			created_at,
+			dev_eui,
+			type,
+			f_port,
+			payload
+		) values ($1, $2, $3, $4, $5)
+		returning id`,
+		e.CreatedAt,
+		e.DevEUI[:],
+		e.Type,
+		e.FPort,
+		e.Payload,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetDeviceEvents returns the events for the given DevEUI matching the
+// given filters, sorted by the most recent event first.
+func GetDeviceEvents(db sqlx.Queryer, devEUI lorawan.EUI64, filters DeviceEventFilters, limit, offset int) ([]DeviceEvent, error) {
+	var events []DeviceEvent
+	err := sqlx.Select(db, &events, `
+		select
+			*
+		from device_event
+		where
+			dev_eui = $1
+			and ($2::timestamp with time zone is null or created_at >= $2)
+			and ($3::timestamp with time zone is null or created_at < $3)
+			and ($4 = 0 or f_port = $4)
+			and ($5 = '' or type = $5)
+		order by created_at desc, id desc
+		limit $6
+		offset $7`,
+		devEUI[:],
+		filters.StartTime,
+		filters.EndTime,
+		filters.FPort,
+		filters.Type,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return events, nil
+}
+
+// GetDeviceEventCount returns the total number of events for the given
+// DevEUI matching the given filters.
+func GetDeviceEventCount(db sqlx.Queryer, devEUI lorawan.EUI64, filters DeviceEventFilters) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from device_event
+		where
+			dev_eui = $1
+			and ($2::timestamp with time zone is null or created_at >= $2)
+			and ($3::timestamp with time zone is null or created_at < $3)
+			and ($4 = 0 or f_port = $4)
+			and ($5 = '' or type = $5)`,
+		devEUI[:],
+		filters.StartTime,
+		filters.EndTime,
+		filters.FPort,
+		filters.Type,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// PruneDeviceEvents removes the events which are older than maxAge or
+// which exceed maxCount per device (keeping the most recent events). A zero
+// maxAge or maxCount disables the corresponding limit.
+func PruneDeviceEvents(db sqlx.Execer, maxAge time.Duration, maxCount int) error {
+	if maxAge > 0 {
+		_, err := db.Exec(`
+			delete from device_event
+			where
+				created_at < $1`,
+			time.Now().Add(-maxAge),
+		)
+		if err != nil {
+			return handlePSQLError(Delete, err, "delete error")
+		}
+	}
+
+	if maxCount > 0 {
+		_, err := db.Exec(`
+			delete from device_event
+			where
+				id in (
+					select id
+					from (
+						select
+							id,
+							row_number() over (partition by dev_eui order by created_at desc, id desc) as rn
+						from device_event
+					) e
+					where
+						e.rn > $1
+				)`,
+			maxCount,
+		)
+		if err != nil {
+			return handlePSQLError(Delete, err, "delete error")
+		}
+	}
+
+	return nil
+}
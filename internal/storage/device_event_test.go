@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Frankz/lorawan"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+)
+
+func TestDeviceEvent(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	nsClient := test.NewNetworkServerClient()
+	common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and a device", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := Device{
+			Name:            "test-device",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(common.DB, &d), ShouldBeNil)
+
+		Convey("Then CreateDeviceEvent returns an error on an invalid type", func() {
+			err := CreateDeviceEvent(common.DB, &DeviceEvent{
+				DevEUI:  d.DevEUI,
+				Type:    "foo",
+				Payload: []byte(`{}`),
+			})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("When creating uplink and join events", func() {
+			fPort10 := 10
+			fPort20 := 20
+
+			events := []DeviceEvent{
+				{DevEUI: d.DevEUI, Type: DeviceEventJoin, Payload: []byte(`{"devAddr":"01020304"}`)},
+				{DevEUI: d.DevEUI, Type: DeviceEventUplink, FPort: &fPort10, Payload: []byte(`{"fCnt":1}`)},
+				{DevEUI: d.DevEUI, Type: DeviceEventUplink, FPort: &fPort20, Payload: []byte(`{"fCnt":2}`)},
+			}
+			for i := range events {
+				So(CreateDeviceEvent(common.DB, &events[i]), ShouldBeNil)
+			}
+
+			Convey("Then GetDeviceEvents returns the events, most recent first", func() {
+				items, err := GetDeviceEvents(common.DB, d.DevEUI, DeviceEventFilters{}, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 3)
+				So(items[0].ID, ShouldEqual, events[2].ID)
+				So(items[0].Type, ShouldEqual, DeviceEventUplink)
+				So(*items[0].FPort, ShouldEqual, 20)
+				So(string(items[0].Payload), ShouldEqual, `{"fCnt": 2}`)
+				So(items[2].ID, ShouldEqual, events[0].ID)
+				So(items[2].FPort, ShouldBeNil)
+
+				count, err := GetDeviceEventCount(common.DB, d.DevEUI, DeviceEventFilters{})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 3)
+			})
+
+			Convey("Then the events can be filtered by type and fPort", func() {
+				items, err := GetDeviceEvents(common.DB, d.DevEUI, DeviceEventFilters{Type: DeviceEventUplink}, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+
+				items, err = GetDeviceEvents(common.DB, d.DevEUI, DeviceEventFilters{FPort: 10}, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].ID, ShouldEqual, events[1].ID)
+
+				count, err := GetDeviceEventCount(common.DB, d.DevEUI, DeviceEventFilters{Type: DeviceEventJoin})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+			})
+
+			Convey("Then the events can be filtered by time range", func() {
+				start := events[1].CreatedAt
+				end := events[2].CreatedAt
+
+				items, err := GetDeviceEvents(common.DB, d.DevEUI, DeviceEventFilters{StartTime: &start, EndTime: &end}, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].ID, ShouldEqual, events[1].ID)
+
+				future := time.Now().Add(time.Hour)
+				count, err := GetDeviceEventCount(common.DB, d.DevEUI, DeviceEventFilters{StartTime: &future})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+
+			Convey("Then limit and offset are applied", func() {
+				items, err := GetDeviceEvents(common.DB, d.DevEUI, DeviceEventFilters{}, 1, 1)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].ID, ShouldEqual, events[1].ID)
+			})
+
+			Convey("Then PruneDeviceEvents with a max count keeps the most recent events", func() {
+				So(PruneDeviceEvents(common.DB, 0, 2), ShouldBeNil)
+				items, err := GetDeviceEvents(common.DB, d.DevEUI, DeviceEventFilters{}, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+				So(items[1].ID, ShouldEqual, events[1].ID)
+			})
+
+			Convey("Then PruneDeviceEvents with a max age removes the older events", func() {
+				_, err := common.DB.Exec("update device_event set created_at = $1 where id = $2", time.Now().Add(-2*time.Hour), events[0].ID)
+				So(err, ShouldBeNil)
+
+				So(PruneDeviceEvents(common.DB, time.Hour, 0), ShouldBeNil)
+				count, err := GetDeviceEventCount(common.DB, d.DevEUI, DeviceEventFilters{})
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+			})
+		})
+	})
+}
@@ -0,0 +1,402 @@
+package storage
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+// importBatchSize defines the number of devices of which the network-server
+// API calls are made concurrently on import.
+const importBatchSize = 50
+
+// errImportAborted is used to roll back the import transaction after a
+// device failed to import (the error is returned per device).
+var errImportAborted = errors.New("import aborted")
+
+// DeviceImport defines a device to import, including its keys (OTAA) or
+// activation (ABP).
+type DeviceImport struct {
+	Device        Device
+	DeviceKeys    *DeviceKeys
+	Activation    *DeviceActivation
+	FCntUp        uint32
+	FCntDown      uint32
+	SkipFCntCheck bool
+}
+
+// ValidateDeviceImports validates the given devices before importing them
+// into the given application. It returns an error for each device (nil
+// when valid).
+func ValidateDeviceImports(db sqlx.Queryer, applicationID int64, imports []DeviceImport) ([]error, error) {
+	app, err := GetApplication(db, applicationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get application error")
+	}
+
+	devEUIs := make([]lorawan.EUI64, 0, len(imports))
+	for _, imp := range imports {
+		devEUIs = append(devEUIs, imp.Device.DevEUI)
+	}
+
+	var existingDevEUIs []lorawan.EUI64
+	err = sqlx.Select(db, &existingDevEUIs, "select dev_eui from device where dev_eui = any($1::bytea[])", EUI64Slice(devEUIs))
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	existing := make(map[lorawan.EUI64]struct{})
+	for _, devEUI := range existingDevEUIs {
+		existing[devEUI] = struct{}{}
+	}
+
+	profiles := make(map[string]DeviceProfile)
+	seen := make(map[lorawan.EUI64]struct{})
+	out := make([]error, len(imports))
+
+	for i, imp := range imports {
+		if _, ok := seen[imp.Device.DevEUI]; ok {
+			out[i] = errors.New("duplicate devEUI")
+			continue
+		}
+		seen[imp.Device.DevEUI] = struct{}{}
+
+		if err := imp.Device.Validate(); err != nil {
+			out[i] = err
+			continue
+		}
+
+		if _, ok := existing[imp.Device.DevEUI]; ok {
+			out[i] = ErrAlreadyExists
+			continue
+		}
+
+		dp, ok := profiles[imp.Device.DeviceProfileID]
+		if !ok {
+			dp, err = GetDeviceProfile(db, imp.Device.DeviceProfileID)
+			if err != nil {
+				if errors.Cause(err) != ErrDoesNotExist {
+					return nil, errors.Wrap(err, "get device-profile error")
+				}
+				out[i] = errors.New("device-profile does not exist")
+				continue
+			}
+			profiles[imp.Device.DeviceProfileID] = dp
+		}
+
+		if dp.OrganizationID != app.OrganizationID {
+			out[i] = errors.New("device-profile does not belong to the organization of the application")
+			continue
+		}
+
+		if dp.DeviceProfile.SupportsJoin {
+			if imp.DeviceKeys == nil {
+				out[i] = errors.New("appKey is required for OTAA devices")
+			} else if imp.Activation != nil {
+				out[i] = errors.New("activation is not allowed for OTAA devices")
+			}
+		} else if imp.Activation == nil {
+			out[i] = errors.New("devAddr, appSKey and nwkSKey are required for ABP devices")
+		}
+	}
+
+	return out, nil
+}
+
+// ImportDevices creates the given devices, including their keys and
+// activation, for the given application. It returns an error for each
+// device (nil when imported).
+//
+// Unless partial is set, the devices are imported within a single
+// transaction: when one of the devices fails to import, the import is
+// aborted, the devices created at the network-server are removed again and
+// no devices are imported. When partial is set, each device is imported
+// within its own transaction and a failing device does not abort the
+// import of the other devices.
+//
+// The network-server API calls are made concurrently, in batches of
+// importBatchSize devices. The network-server client is resolved once per
+// device-profile.
+func ImportDevices(db *common.DBLogger, applicationID int64, imports []DeviceImport, partial bool) ([]error, error) {
+	app, err := GetApplication(db, applicationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get application error")
+	}
+
+	out := make([]error, len(imports))
+	clients := make([]ns.NetworkServerClient, len(imports))
+	profileClients := make(map[string]ns.NetworkServerClient)
+
+	for i := range imports {
+		imp := &imports[i]
+		imp.Device.ApplicationID = applicationID
+
+		nsClient, ok := profileClients[imp.Device.DeviceProfileID]
+		if !ok {
+			nsClient, err = getImportNetworkServerClient(db, imp.Device.DeviceProfileID)
+			if err != nil {
+				out[i] = err
+				if !partial {
+					return out, nil
+				}
+				continue
+			}
+			profileClients[imp.Device.DeviceProfileID] = nsClient
+		}
+		clients[i] = nsClient
+	}
+
+	if partial {
+		importDevicesPartial(db, app, imports, clients, out)
+	} else if err := importDevices(db, app, imports, clients, out); err != nil {
+		return nil, err
+	}
+
+	var count int
+	if partial || !hasImportError(out) {
+		for _, err := range out {
+			if err == nil {
+				count++
+			}
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"application_id": applicationID,
+		"partial":        partial,
+		"count":          count,
+		"failed":         len(imports) - count,
+	}).Info("devices imported")
+
+	return out, nil
+}
+
+// getImportNetworkServerClient returns the network-server client for the
+// given device-profile.
+func getImportNetworkServerClient(db sqlx.Queryer, deviceProfileID string) (ns.NetworkServerClient, error) {
+	n, err := GetNetworkServerForDeviceProfileID(db, deviceProfileID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server client error")
+	}
+
+	return nsClient, nil
+}
+
+// importDevices imports all the given devices within a single transaction.
+// When one of the devices fails to import, its error is set in out and the
+// transaction is rolled back. An error is only returned when the import
+// failed for an other reason than a failing device.
+func importDevices(db *common.DBLogger, app Application, imports []DeviceImport, clients []ns.NetworkServerClient, out []error) error {
+	var nsCreated []int
+
+	err := Transaction(db, func(tx sqlx.Ext) error {
+		for start := 0; start < len(imports); start += importBatchSize {
+			end := start + importBatchSize
+			if end > len(imports) {
+				end = len(imports)
+			}
+
+			for i := start; i < end; i++ {
+				if err := insertDevice(tx, &imports[i].Device); err != nil {
+					out[i] = err
+					return errImportAborted
+				}
+			}
+
+			forEachImport(start, end, func(i int) {
+				out[i] = createNetworkServerDevice(clients[i], app, &imports[i].Device)
+			})
+			for i := start; i < end; i++ {
+				if out[i] == nil {
+					nsCreated = append(nsCreated, i)
+				}
+			}
+			if hasImportError(out[start:end]) {
+				return errImportAborted
+			}
+
+			forEachImport(start, end, func(i int) {
+				out[i] = activateNetworkServerImportDevice(clients[i], &imports[i])
+			})
+			if hasImportError(out[start:end]) {
+				return errImportAborted
+			}
+
+			for i := start; i < end; i++ {
+				if err := createImportDeviceKeysOrActivation(tx, &imports[i]); err != nil {
+					out[i] = err
+					return errImportAborted
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		forEachImportIndex(nsCreated, func(i int) {
+			deleteNetworkServerImportDevice(clients[i], imports[i].Device.DevEUI)
+		})
+
+		if err == errImportAborted {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// importDevicesPartial imports each of the given devices within its own
+// transaction. The devices for which no network-server client could be
+// resolved (having an error set in out) are skipped.
+func importDevicesPartial(db *common.DBLogger, app Application, imports []DeviceImport, clients []ns.NetworkServerClient, out []error) {
+	for start := 0; start < len(imports); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(imports) {
+			end = len(imports)
+		}
+
+		forEachImport(start, end, func(i int) {
+			if out[i] != nil {
+				return
+			}
+			out[i] = importDevice(db, clients[i], app, &imports[i])
+		})
+	}
+}
+
+// forEachImport calls f concurrently for each index in the range
+// [start, end) and waits until all calls have returned.
+func forEachImport(start, end int, f func(i int)) {
+	var wg sync.WaitGroup
+	for i := start; i < end; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			f(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// forEachImportIndex calls f concurrently for the given indices, in batches
+// of importBatchSize.
+func forEachImportIndex(indices []int, f func(i int)) {
+	for start := 0; start < len(indices); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(indices) {
+			end = len(indices)
+		}
+
+		forEachImport(start, end, func(i int) {
+			f(indices[i])
+		})
+	}
+}
+
+// hasImportError returns true when one of the given errors is set.
+func hasImportError(errs []error) bool {
+	for _, err := range errs {
+		if err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// importDevice creates the device, including its keys or activation,
+// within a transaction. When the transaction fails after the device has
+// been created at the network-server, it is removed again.
+func importDevice(db *common.DBLogger, nsClient ns.NetworkServerClient, app Application, imp *DeviceImport) error {
+	var nsCreated bool
+
+	err := Transaction(db, func(tx sqlx.Ext) error {
+		if err := insertDevice(tx, &imp.Device); err != nil {
+			return err
+		}
+
+		if err := createNetworkServerDevice(nsClient, app, &imp.Device); err != nil {
+			return err
+		}
+		nsCreated = true
+
+		if err := activateNetworkServerImportDevice(nsClient, imp); err != nil {
+			return err
+		}
+
+		return createImportDeviceKeysOrActivation(tx, imp)
+	})
+	if err != nil {
+		if nsCreated {
+			deleteNetworkServerImportDevice(nsClient, imp.Device.DevEUI)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// deleteNetworkServerImportDevice removes the device created at the
+// network-server after its import failed. Errors are logged.
+func deleteNetworkServerImportDevice(nsClient ns.NetworkServerClient, devEUI lorawan.EUI64) {
+	_, err := nsClient.DeleteDevice(context.Background(), &ns.DeleteDeviceRequest{
+		DevEUI: devEUI[:],
+	})
+	if err != nil {
+		log.WithError(err).WithField("dev_eui", devEUI).Error("network-server delete device api error")
+	}
+}
+
+// activateNetworkServerImportDevice activates the device at the
+// network-server (ABP).
+func activateNetworkServerImportDevice(nsClient ns.NetworkServerClient, imp *DeviceImport) error {
+	if imp.Activation == nil {
+		return nil
+	}
+
+	_, err := nsClient.ActivateDevice(context.Background(), &ns.ActivateDeviceRequest{
+		DevEUI:        imp.Device.DevEUI[:],
+		DevAddr:       imp.Activation.DevAddr[:],
+		NwkSKey:       imp.Activation.NwkSKey[:],
+		FCntUp:        imp.FCntUp,
+		FCntDown:      imp.FCntDown,
+		SkipFCntCheck: imp.SkipFCntCheck,
+	})
+	if err != nil {
+		return handleGrpcError(err, "activate device error")
+	}
+
+	return nil
+}
+
+// createImportDeviceKeysOrActivation creates the device-keys (OTAA) or the
+// device-activation (ABP).
+func createImportDeviceKeysOrActivation(db sqlx.Ext, imp *DeviceImport) error {
+	if imp.DeviceKeys != nil {
+		imp.DeviceKeys.DevEUI = imp.Device.DevEUI
+		if err := CreateDeviceKeys(db, imp.DeviceKeys); err != nil {
+			return errors.Wrap(err, "create device-keys error")
+		}
+	}
+
+	if imp.Activation != nil {
+		imp.Activation.DevEUI = imp.Device.DevEUI
+		if err := CreateDeviceActivation(db, imp.Activation); err != nil {
+			return errors.Wrap(err, "create device-activation error")
+		}
+	}
+
+	return nil
+}
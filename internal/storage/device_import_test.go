@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/satori/go.uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+func TestDeviceImport(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+
+	Convey("Given a clean database with an application and device-profile", t, func() {
+		test.MustResetDB(db)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+			DeviceProfile: &ns.DeviceProfile{
+				SupportsJoin: true,
+			},
+		}
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		org2 := Organization{
+			Name: "test-org-2",
+		}
+		So(CreateOrganization(common.DB, &org2), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		dp2 := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org2.ID,
+			Name:            "test-dp-2",
+		}
+		So(CreateDeviceProfile(common.DB, &dp2), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		newImport := func(devEUI lorawan.EUI64, dpID string) DeviceImport {
+			return DeviceImport{
+				Device: Device{
+					DevEUI:          devEUI,
+					Name:            devEUI.String(),
+					DeviceProfileID: dpID,
+				},
+				DeviceKeys: &DeviceKeys{
+					AppKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+				},
+			}
+		}
+
+		Convey("Then ValidateDeviceImports returns an error per invalid device", func() {
+			withActivation := newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 5}, dp.DeviceProfile.DeviceProfileID)
+			withActivation.Activation = &DeviceActivation{}
+
+			errs, err := ValidateDeviceImports(common.DB, app.ID, []DeviceImport{
+				newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, dp.DeviceProfile.DeviceProfileID),
+				newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, dp.DeviceProfile.DeviceProfileID),
+				newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 3}, dp2.DeviceProfile.DeviceProfileID),
+				newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 4}, uuid.NewV4().String()),
+				withActivation,
+			})
+			So(err, ShouldBeNil)
+			So(errs, ShouldHaveLength, 5)
+			So(errs[0], ShouldBeNil)
+			So(errs[1].Error(), ShouldEqual, "duplicate devEUI")
+			So(errs[2].Error(), ShouldEqual, "device-profile does not belong to the organization of the application")
+			So(errs[3].Error(), ShouldEqual, "device-profile does not exist")
+			So(errs[4].Error(), ShouldEqual, "activation is not allowed for OTAA devices")
+		})
+
+		Convey("When importing devices", func() {
+			errs, err := ImportDevices(common.DB, app.ID, []DeviceImport{
+				newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, dp.DeviceProfile.DeviceProfileID),
+				newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 2}, dp.DeviceProfile.DeviceProfileID),
+			}, false)
+			So(err, ShouldBeNil)
+			So(errs, ShouldResemble, []error{nil, nil})
+
+			Convey("Then the devices and keys have been created", func() {
+				So(nsClient.CreateDeviceChan, ShouldHaveLength, 2)
+
+				count, err := GetDeviceCountForApplicationID(common.DB, app.ID, "", nil)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				_, err = GetDeviceKeys(common.DB, lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 2})
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then ValidateDeviceImports reports the existing devices", func() {
+				errs, err := ValidateDeviceImports(common.DB, app.ID, []DeviceImport{
+					newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, dp.DeviceProfile.DeviceProfileID),
+				})
+				So(err, ShouldBeNil)
+				So(errs[0], ShouldEqual, ErrAlreadyExists)
+			})
+
+			Convey("Then a failing device aborts the import of the other devices", func() {
+				errs, err := ImportDevices(common.DB, app.ID, []DeviceImport{
+					newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 3}, dp.DeviceProfile.DeviceProfileID),
+					newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, dp.DeviceProfile.DeviceProfileID),
+				}, false)
+				So(err, ShouldBeNil)
+				So(errs, ShouldHaveLength, 2)
+				So(errs[0], ShouldBeNil)
+				So(errors.Cause(errs[1]), ShouldEqual, ErrAlreadyExists)
+
+				count, err := GetDeviceCountForApplicationID(common.DB, app.ID, "", nil)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				_, err = GetDevice(common.DB, lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 3})
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+
+			Convey("Then with partial, a failing device does not abort the import of the other devices", func() {
+				errs, err := ImportDevices(common.DB, app.ID, []DeviceImport{
+					newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, dp.DeviceProfile.DeviceProfileID),
+					newImport(lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 3}, dp.DeviceProfile.DeviceProfileID),
+				}, true)
+				So(err, ShouldBeNil)
+				So(errs, ShouldHaveLength, 2)
+				So(errors.Cause(errs[0]), ShouldEqual, ErrAlreadyExists)
+				So(errs[1], ShouldBeNil)
+
+				count, err := GetDeviceCountForApplicationID(common.DB, app.ID, "", nil)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 3)
+			})
+		})
+	})
+}
@@ -13,19 +13,29 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/Frankz/lora-app-server/internal/codec"
 	"github.com/Frankz/lora-app-server/internal/common"
 	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
 	"github.com/Frankz/lorawan/backend"
 )
 
 // DeviceProfile defines the device-profile.
+// When PayloadCodec is set, it overrides the codec of the application.
+// The same applies to each of the alert rules which is set (non-zero).
 type DeviceProfile struct {
-	NetworkServerID int64                 `db:"network_server_id"`
-	OrganizationID  int64                 `db:"organization_id"`
-	CreatedAt       time.Time             `db:"created_at"`
-	UpdatedAt       time.Time             `db:"updated_at"`
-	Name            string                `db:"name"`
-	DeviceProfile   backend.DeviceProfile `db:"-"`
+	NetworkServerID       int64                 `db:"network_server_id"`
+	OrganizationID        int64                 `db:"organization_id"`
+	CreatedAt             time.Time             `db:"created_at"`
+	UpdatedAt             time.Time             `db:"updated_at"`
+	Name                  string                `db:"name"`
+	PayloadCodec          codec.Type            `db:"payload_codec"`
+	PayloadEncoderScript  string                `db:"payload_encoder_script"`
+	PayloadDecoderScript  string                `db:"payload_decoder_script"`
+	AlertUplinkInterval   time.Duration         `db:"alert_uplink_interval"`
+	AlertBatteryThreshold int                   `db:"alert_battery_threshold"`
+	AlertMarginThreshold  *int                  `db:"alert_margin_threshold"`
+	DeviceProfile         backend.DeviceProfile `db:"-"`
 }
 
 // DeviceProfileMeta defines the device-profile meta record.
@@ -38,9 +48,18 @@ type DeviceProfileMeta struct {
 	Name            string    `db:"name"`
 }
 
+// PayloadCodecSettings defines the payload codec and scripts to use for a
+// device.
+type PayloadCodecSettings struct {
+	ApplicationID        int64      `db:"application_id"`
+	PayloadCodec         codec.Type `db:"payload_codec"`
+	PayloadEncoderScript string     `db:"payload_encoder_script"`
+	PayloadDecoderScript string     `db:"payload_decoder_script"`
+}
+
 // Validate validates the device-profile data.
 func (dp DeviceProfile) Validate() error {
-	return nil
+	return validateAlertRules(dp.AlertUplinkInterval, dp.AlertBatteryThreshold, dp.AlertMarginThreshold)
 }
 
 // CreateDeviceProfile creates the given device-profile.
@@ -63,14 +82,26 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
             organization_id,
             created_at,
             updated_at,
-            name
-        ) values ($1, $2, $3, $4, $5, $6)`,
+            name,
+            payload_codec,
+            payload_encoder_script,
+            payload_decoder_script,
+            alert_uplink_interval,
+            alert_battery_threshold,
+            alert_margin_threshold
+        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
 		dp.DeviceProfile.DeviceProfileID,
 		dp.NetworkServerID,
 		dp.OrganizationID,
 		dp.CreatedAt,
 		dp.UpdatedAt,
 		dp.Name,
+		dp.PayloadCodec,
+		dp.PayloadEncoderScript,
+		dp.PayloadDecoderScript,
+		dp.AlertUplinkInterval,
+		dp.AlertBatteryThreshold,
+		dp.AlertMarginThreshold,
 	)
 	if err != nil {
 		log.WithField("device_profile_id", dp.DeviceProfile.DeviceProfileID).Errorf("create device-profile error: %s", err)
@@ -137,7 +168,13 @@ func GetDeviceProfile(db sqlx.Queryer, id string) (DeviceProfile, error) {
 			organization_id,
 			created_at,
 			updated_at,
-			name
+			name,
+			payload_codec,
+			payload_encoder_script,
+			payload_decoder_script,
+			alert_uplink_interval,
+			alert_battery_threshold,
+			alert_margin_threshold
 		from device_profile
 		where
 			device_profile_id = $1`,
@@ -147,7 +184,7 @@ func GetDeviceProfile(db sqlx.Queryer, id string) (DeviceProfile, error) {
 		return dp, handlePSQLError(Select, err, "select error")
 	}
 
-	err := row.Scan(&dp.DeviceProfile.DeviceProfileID, &dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name)
+	err := row.Scan(&dp.DeviceProfile.DeviceProfileID, &dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name, &dp.PayloadCodec, &dp.PayloadEncoderScript, &dp.PayloadDecoderScript, &dp.AlertUplinkInterval, &dp.AlertBatteryThreshold, &dp.AlertMarginThreshold)
 	if err != nil {
 		return dp, handlePSQLError(Scan, err, "scan error")
 	}
@@ -258,11 +295,23 @@ func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
         update device_profile
         set
             updated_at = $2,
-            name = $3
+            name = $3,
+            payload_codec = $4,
+            payload_encoder_script = $5,
+            payload_decoder_script = $6,
+            alert_uplink_interval = $7,
+            alert_battery_threshold = $8,
+            alert_margin_threshold = $9
         where device_profile_id = $1`,
 		dp.DeviceProfile.DeviceProfileID,
 		dp.UpdatedAt,
 		dp.Name,
+		dp.PayloadCodec,
+		dp.PayloadEncoderScript,
+		dp.PayloadDecoderScript,
+		dp.AlertUplinkInterval,
+		dp.AlertBatteryThreshold,
+		dp.AlertMarginThreshold,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -394,7 +443,13 @@ func GetDeviceProfileCountForApplicationID(db sqlx.Queryer, applicationID int64)
 func GetDeviceProfiles(db sqlx.Queryer, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
-		select *
+		select
+			device_profile_id,
+			network_server_id,
+			organization_id,
+			created_at,
+			updated_at,
+			name
 		from device_profile
 		order by name
 		limit $1 offset $2`,
@@ -412,7 +467,13 @@ func GetDeviceProfiles(db sqlx.Queryer, limit, offset int) ([]DeviceProfileMeta,
 func GetDeviceProfilesForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
-		select *
+		select
+			device_profile_id,
+			network_server_id,
+			organization_id,
+			created_at,
+			updated_at,
+			name
 		from device_profile
 		where
 			organization_id = $1
@@ -433,7 +494,13 @@ func GetDeviceProfilesForOrganizationID(db sqlx.Queryer, organizationID int64, l
 func GetDeviceProfilesForUser(db sqlx.Queryer, username string, limit, offset int) ([]DeviceProfileMeta, error) {
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
-		select dp.*
+		select
+			dp.device_profile_id,
+			dp.network_server_id,
+			dp.organization_id,
+			dp.created_at,
+			dp.updated_at,
+			dp.name
 		from device_profile dp
 		inner join organization o
 			on o.id = dp.organization_id
@@ -463,7 +530,12 @@ func GetDeviceProfilesForApplicationID(db sqlx.Queryer, applicationID int64, lim
 	var dps []DeviceProfileMeta
 	err := sqlx.Select(db, &dps, `
 		select
-			dp.*
+			dp.device_profile_id,
+			dp.network_server_id,
+			dp.organization_id,
+			dp.created_at,
+			dp.updated_at,
+			dp.name
 		from device_profile dp
 		inner join network_server ns
 			on ns.id = dp.network_server_id
@@ -490,7 +562,7 @@ func GetDeviceProfilesForApplicationID(db sqlx.Queryer, applicationID int64, lim
 // given an organization id.
 func DeleteAllDeviceProfilesForOrganizationID(db sqlx.Ext, organizationID int64) error {
 	var dps []DeviceProfileMeta
-	err := sqlx.Select(db, &dps, "select * from device_profile where organization_id = $1", organizationID)
+	err := sqlx.Select(db, &dps, "select device_profile_id from device_profile where organization_id = $1", organizationID)
 	if err != nil {
 		return handlePSQLError(Select, err, "select error")
 	}
@@ -504,3 +576,29 @@ func DeleteAllDeviceProfilesForOrganizationID(db sqlx.Ext, organizationID int64)
 
 	return nil
 }
+
+// GetPayloadCodecSettingsForDevEUI returns the payload codec settings for
+// the given DevEUI. When the device-profile of the device has a codec
+// configured, it takes precedence over the codec of the application.
+func GetPayloadCodecSettingsForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (PayloadCodecSettings, error) {
+	var pcs PayloadCodecSettings
+	err := sqlx.Get(db, &pcs, `
+		select
+			a.id as application_id,
+			case when dp.payload_codec != '' then dp.payload_codec else a.payload_codec end as payload_codec,
+			case when dp.payload_codec != '' then dp.payload_encoder_script else a.payload_encoder_script end as payload_encoder_script,
+			case when dp.payload_codec != '' then dp.payload_decoder_script else a.payload_decoder_script end as payload_decoder_script
+		from device d
+		inner join device_profile dp
+			on dp.device_profile_id = d.device_profile_id
+		inner join application a
+			on a.id = d.application_id
+		where
+			d.dev_eui = $1`,
+		devEUI[:],
+	)
+	if err != nil {
+		return pcs, handlePSQLError(Select, err, "select error")
+	}
+	return pcs, nil
+}
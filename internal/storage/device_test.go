@@ -10,6 +10,7 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/Frankz/lora-app-server/internal/codec"
 	"github.com/Frankz/lora-app-server/internal/common"
 	"github.com/Frankz/lora-app-server/internal/test"
 	"github.com/Frankz/lorawan/backend"
@@ -113,6 +114,7 @@ func TestDevice(t *testing.T) {
 				Description:         "test device",
 				DeviceStatusBattery: &ten,
 				DeviceStatusMargin:  &eleven,
+				Tags:                Tags{"site": "berlin"},
 			}
 			So(CreateDevice(common.DB, &d), ShouldBeNil)
 			d.CreatedAt = d.CreatedAt.UTC().Truncate(time.Millisecond)
@@ -128,6 +130,38 @@ func TestDevice(t *testing.T) {
 				},
 			})
 
+			Convey("Given the application has a payload codec configured", func() {
+				app.PayloadCodec = codec.CustomJSType
+				app.PayloadEncoderScript = "app encoder"
+				app.PayloadDecoderScript = "app decoder"
+				So(UpdateApplication(common.DB, app), ShouldBeNil)
+
+				Convey("Then GetPayloadCodecSettingsForDevEUI returns the application codec", func() {
+					pcs, err := GetPayloadCodecSettingsForDevEUI(common.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					So(pcs, ShouldResemble, PayloadCodecSettings{
+						ApplicationID:        app.ID,
+						PayloadCodec:         codec.CustomJSType,
+						PayloadEncoderScript: "app encoder",
+						PayloadDecoderScript: "app decoder",
+					})
+				})
+
+				Convey("Given the device-profile has a payload codec configured", func() {
+					dp.PayloadCodec = codec.CayenneLPPType
+					So(UpdateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+					Convey("Then GetPayloadCodecSettingsForDevEUI returns the device-profile codec", func() {
+						pcs, err := GetPayloadCodecSettingsForDevEUI(common.DB, d.DevEUI)
+						So(err, ShouldBeNil)
+						So(pcs, ShouldResemble, PayloadCodecSettings{
+							ApplicationID: app.ID,
+							PayloadCodec:  codec.CayenneLPPType,
+						})
+					})
+				})
+			})
+
 			Convey("Then GetDevice returns the device", func() {
 				nsClient.GetDeviceResponse = ns.GetDeviceResponse{
 					Device: &ns.Device{
@@ -176,6 +210,31 @@ func TestDevice(t *testing.T) {
 					So(dGet, ShouldResemble, d)
 				})
 
+				Convey("Then UpdateDeviceLocation updates the device location", func() {
+					lat := 52.1
+					lon := 5.1
+					alt := 10.5
+					acc := 150.0
+					now := time.Now().UTC().Truncate(time.Millisecond)
+
+					d.Latitude = &lat
+					d.Longitude = &lon
+					d.Altitude = &alt
+					d.LocationAccuracy = &acc
+					d.LocationSource = "RSSI"
+					d.LocationUpdatedAt = &now
+					So(UpdateDeviceLocation(common.DB, &d), ShouldBeNil)
+					So(nsClient.UpdateDeviceChan, ShouldHaveLength, 0)
+
+					dGet, err := GetDevice(common.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					dGet.CreatedAt = dGet.CreatedAt.UTC().Truncate(time.Millisecond)
+					dGet.UpdatedAt = dGet.UpdatedAt.UTC().Truncate(time.Millisecond)
+					locUpdatedAt := dGet.LocationUpdatedAt.UTC()
+					dGet.LocationUpdatedAt = &locUpdatedAt
+					So(dGet, ShouldResemble, d)
+				})
+
 				Convey("Then DeleteDevice deletes the device", func() {
 					So(DeleteDevice(common.DB, d.DevEUI), ShouldBeNil)
 					So(nsClient.DeleteDeviceChan, ShouldHaveLength, 1)
@@ -258,7 +317,7 @@ func TestDevice(t *testing.T) {
 			})
 
 			Convey("Then GetDevicesForApplicationID returns the device", func() {
-				devices, err := GetDevicesForApplicationID(common.DB, app.ID, 10, 0, "")
+				devices, err := GetDevicesForApplicationID(common.DB, app.ID, 10, 0, "", nil)
 				So(err, ShouldBeNil)
 				So(devices, ShouldHaveLength, 1)
 				So(devices[0].DevEUI, ShouldEqual, d.DevEUI)
@@ -266,10 +325,31 @@ func TestDevice(t *testing.T) {
 			})
 
 			Convey("Then GetDeviceCountForApplicationID returns 1", func() {
-				count, err := GetDeviceCountForApplicationID(common.DB, app.ID, "")
+				count, err := GetDeviceCountForApplicationID(common.DB, app.ID, "", nil)
 				So(err, ShouldBeNil)
 				So(count, ShouldEqual, 1)
 			})
+
+			Convey("Then the device is filtered by the given tag selector", func() {
+				for sel, expected := range map[string]int{
+					"site=berlin":  1,
+					"site!=berlin": 0,
+					"site":         1,
+					"!site":        0,
+					"floor=3":      0,
+				} {
+					selector, err := ParseTagSelector(sel)
+					So(err, ShouldBeNil)
+
+					devices, err := GetDevicesForApplicationID(common.DB, app.ID, 10, 0, "", selector)
+					So(err, ShouldBeNil)
+					So(devices, ShouldHaveLength, expected)
+
+					count, err := GetDeviceCountForApplicationID(common.DB, app.ID, "", selector)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, expected)
+				}
+			})
 		})
 	})
 }
@@ -0,0 +1,403 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lorawan"
+)
+
+// DownlinkStatus defines the status of a downlink device-queue item.
+type DownlinkStatus string
+
+// Available downlink statuses.
+const (
+	DownlinkQueued  DownlinkStatus = "QUEUED"
+	DownlinkSent    DownlinkStatus = "SENT"
+	DownlinkAcked   DownlinkStatus = "ACKED"
+	DownlinkNacked  DownlinkStatus = "NACKED"
+	DownlinkExpired DownlinkStatus = "EXPIRED"
+)
+
+// Downlink tracks the lifecycle of a downlink device-queue item, from
+// being enqueued until it has been sent and (for confirmed downlinks)
+// acknowledged.
+type Downlink struct {
+	ID        int64          `db:"id"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+	DevEUI    lorawan.EUI64  `db:"dev_eui"`
+	Reference string         `db:"reference"`
+	FCnt      uint32         `db:"f_cnt"`
+	FPort     uint8          `db:"f_port"`
+	Confirmed bool           `db:"confirmed"`
+	Status    DownlinkStatus `db:"status"`
+	SentAt    *time.Time     `db:"sent_at"`
+	AckedAt   *time.Time     `db:"acked_at"`
+	ExpiredAt *time.Time     `db:"expired_at"`
+}
+
+// SetStatus sets the given status and the timestamp belonging to it.
+func (d *Downlink) SetStatus(status DownlinkStatus, t time.Time) {
+	d.Status = status
+
+	switch status {
+	case DownlinkSent:
+		d.SentAt = &t
+	case DownlinkAcked, DownlinkNacked:
+		d.AckedAt = &t
+	case DownlinkExpired:
+		d.ExpiredAt = &t
+	}
+}
+
+// CreateDownlink creates the given downlink (with status QUEUED).
+func CreateDownlink(db sqlx.Queryer, d *Downlink) error {
+	now := time.Now()
+	d.CreatedAt = now
+	d.UpdatedAt = now
+	d.Status = DownlinkQueued
+
+	err := sqlx.Get(db, &d.ID, `
+		insert into downlink (
+			created_at,
+			updated_at,
+			dev_eui,
+			reference,
+			f_cnt,
+			f_port,
+			confirmed,
+			status
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		returning id`,
+		d.CreatedAt,
+		d.UpdatedAt,
+		d.DevEUI[:],
+		d.Reference,
+		d.FCnt,
+		d.FPort,
+		d.Confirmed,
+		d.Status,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":        d.ID,
+		"dev_eui":   d.DevEUI,
+		"f_cnt":     d.FCnt,
+		"reference": d.Reference,
+	}).Info("downlink created")
+
+	return nil
+}
+
+// DeleteDownlink deletes the downlink matching the given id.
+func DeleteDownlink(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from downlink where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("downlink deleted")
+	return nil
+}
+
+// PruneDownlinks removes the downlinks which are older than maxAge or which
+// exceed maxCount per device (keeping the most recent downlinks). Queued
+// and sent downlinks (which might still be acknowledged) are not removed
+// for exceeding maxCount. A zero maxAge or maxCount disables the
+// corresponding limit.
+func PruneDownlinks(db sqlx.Execer, maxAge time.Duration, maxCount int) error {
+	if maxAge > 0 {
+		_, err := db.Exec(`
+			delete from downlink
+			where
+				created_at < $1`,
+			time.Now().Add(-maxAge),
+		)
+		if err != nil {
+			return handlePSQLError(Delete, err, "delete error")
+		}
+	}
+
+	if maxCount > 0 {
+		_, err := db.Exec(`
+			delete from downlink
+			where
+				id in (
+					select id
+					from (
+						select
+							id,
+							row_number() over (partition by dev_eui order by id desc) as rn
+						from downlink
+					) d
+					where
+						d.rn > $1
+				)
+				and status not in ($2, $3)`,
+			maxCount,
+			DownlinkQueued,
+			DownlinkSent,
+		)
+		if err != nil {
+			return handlePSQLError(Delete, err, "delete error")
+		}
+	}
+
+	return nil
+}
+
+// UpdateDownlink updates the status (and status timestamps) of the given
+// downlink.
+func UpdateDownlink(db sqlx.Execer, d *Downlink) error {
+	d.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update downlink
+		set
+			updated_at = $2,
+			status = $3,
+			sent_at = $4,
+			acked_at = $5,
+			expired_at = $6
+		where
+			id = $1`,
+		d.ID,
+		d.UpdatedAt,
+		d.Status,
+		d.SentAt,
+		d.AckedAt,
+		d.ExpiredAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":     d.ID,
+		"status": d.Status,
+	}).Info("downlink updated")
+
+	return nil
+}
+
+// GetDownlinkForDevEUIAndFCnt returns the most recent downlink for the
+// given DevEUI and FCnt.
+func GetDownlinkForDevEUIAndFCnt(db sqlx.Queryer, devEUI lorawan.EUI64, fCnt uint32) (Downlink, error) {
+	var d Downlink
+	err := sqlx.Get(db, &d, `
+		select *
+		from downlink
+		where
+			dev_eui = $1
+			and f_cnt = $2
+		order by id desc
+		limit 1`,
+		devEUI[:],
+		fCnt,
+	)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+
+	return d, nil
+}
+
+// GetPendingDownlinkForDevEUIAndFCnt returns the confirmed downlink for
+// the given DevEUI and FCnt which is awaiting an acknowledgement. As an
+// acknowledgement might arrive after the timeout, expired downlinks are
+// included.
+func GetPendingDownlinkForDevEUIAndFCnt(db sqlx.Queryer, devEUI lorawan.EUI64, fCnt uint32) (Downlink, error) {
+	var d Downlink
+	err := sqlx.Get(db, &d, `
+		select *
+		from downlink
+		where
+			dev_eui = $1
+			and f_cnt = $2
+			and confirmed = true
+			and status in ($3, $4, $5)
+		order by id desc
+		limit 1`,
+		devEUI[:],
+		fCnt,
+		DownlinkQueued,
+		DownlinkSent,
+		DownlinkExpired,
+	)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+
+	return d, nil
+}
+
+// GetDownlinkCountForDevEUI returns the total number of downlinks for the
+// given DevEUI.
+func GetDownlinkCountForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from downlink where dev_eui = $1", devEUI[:])
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetDownlinksForDevEUI returns a slice of downlinks for the given DevEUI,
+// most recent first.
+func GetDownlinksForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64, limit, offset int) ([]Downlink, error) {
+	var downlinks []Downlink
+	err := sqlx.Select(db, &downlinks, `
+		select *
+		from downlink
+		where
+			dev_eui = $1
+		order by id desc
+		limit $2 offset $3`,
+		devEUI[:],
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return downlinks, nil
+}
+
+// GetDevEUIsWithQueuedDownlinks returns the DevEUIs of the devices having
+// queued downlinks which have not been updated since the given time.
+func GetDevEUIsWithQueuedDownlinks(db sqlx.Queryer, updatedBefore time.Time, limit int) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		select dev_eui
+		from downlink
+		where
+			status = $1
+			and updated_at <= $2
+		group by dev_eui
+		order by min(updated_at)
+		limit $3`,
+		DownlinkQueued,
+		updatedBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devEUIs, nil
+}
+
+// GetQueuedDownlinksForDevEUI returns the queued downlinks for the given
+// DevEUI.
+func GetQueuedDownlinksForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) ([]Downlink, error) {
+	var downlinks []Downlink
+	err := sqlx.Select(db, &downlinks, `
+		select *
+		from downlink
+		where
+			dev_eui = $1
+			and status = $2
+		order by id`,
+		devEUI[:],
+		DownlinkQueued,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return downlinks, nil
+}
+
+// GetTimedOutDownlinks returns the confirmed downlinks which have not been
+// acknowledged and were sent (or queued when not yet sent) before the given
+// time. The returned rows are locked for update.
+func GetTimedOutDownlinks(db sqlx.Queryer, before time.Time, limit int) ([]Downlink, error) {
+	var downlinks []Downlink
+	err := sqlx.Select(db, &downlinks, `
+		select *
+		from downlink
+		where
+			confirmed = true
+			and status in ($1, $2)
+			and coalesce(sent_at, created_at) <= $3
+		order by id
+		limit $4
+		for update`,
+		DownlinkQueued,
+		DownlinkSent,
+		before,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return downlinks, nil
+}
+
+// ExpireQueuedDownlinksForDevEUI sets the status of all the queued
+// downlinks of the given DevEUI to EXPIRED (e.g. on flushing the
+// device-queue).
+func ExpireQueuedDownlinksForDevEUI(db sqlx.Execer, devEUI lorawan.EUI64) error {
+	now := time.Now()
+	_, err := db.Exec(`
+		update downlink
+		set
+			updated_at = $3,
+			expired_at = $3,
+			status = $4
+		where
+			dev_eui = $1
+			and status = $2`,
+		devEUI[:],
+		DownlinkQueued,
+		now,
+		DownlinkExpired,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	return nil
+}
+
+// TouchQueuedDownlinksForDevEUI updates the updated_at timestamp of the
+// queued downlinks of the given DevEUI.
+func TouchQueuedDownlinksForDevEUI(db sqlx.Execer, devEUI lorawan.EUI64) error {
+	_, err := db.Exec(`
+		update downlink
+		set
+			updated_at = $3
+		where
+			dev_eui = $1
+			and status = $2`,
+		devEUI[:],
+		DownlinkQueued,
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Frankz/lorawan"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+)
+
+func TestDownlink(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	nsClient := test.NewNetworkServerClient()
+	common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and a device", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := Device{
+			Name:            "test-device",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(common.DB, &d), ShouldBeNil)
+
+		Convey("When creating a confirmed downlink", func() {
+			dl := Downlink{
+				Reference: "test-123",
+				DevEUI:    d.DevEUI,
+				FCnt:      10,
+				FPort:     2,
+				Confirmed: true,
+				Status:    DownlinkAcked,
+			}
+			So(CreateDownlink(common.DB, &dl), ShouldBeNil)
+			dl.CreatedAt = dl.CreatedAt.UTC().Truncate(time.Millisecond)
+			dl.UpdatedAt = dl.UpdatedAt.UTC().Truncate(time.Millisecond)
+
+			Convey("Then it has been created with status QUEUED", func() {
+				So(dl.Status, ShouldEqual, DownlinkQueued)
+			})
+
+			Convey("Then GetDownlinkForDevEUIAndFCnt returns it", func() {
+				dlGet, err := GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+				So(err, ShouldBeNil)
+				dlGet.CreatedAt = dlGet.CreatedAt.UTC().Truncate(time.Millisecond)
+				dlGet.UpdatedAt = dlGet.UpdatedAt.UTC().Truncate(time.Millisecond)
+				So(dlGet, ShouldResemble, dl)
+			})
+
+			Convey("Then GetDownlinkForDevEUIAndFCnt with a different FCnt returns an error", func() {
+				_, err := GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 11)
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+
+			Convey("Then GetDownlinksForDevEUI returns it", func() {
+				count, err := GetDownlinkCountForDevEUI(common.DB, d.DevEUI)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				downlinks, err := GetDownlinksForDevEUI(common.DB, d.DevEUI, 10, 0)
+				So(err, ShouldBeNil)
+				So(downlinks, ShouldHaveLength, 1)
+				So(downlinks[0].ID, ShouldEqual, dl.ID)
+			})
+
+			Convey("Then GetQueuedDownlinksForDevEUI returns it", func() {
+				downlinks, err := GetQueuedDownlinksForDevEUI(common.DB, d.DevEUI)
+				So(err, ShouldBeNil)
+				So(downlinks, ShouldHaveLength, 1)
+				So(downlinks[0].ID, ShouldEqual, dl.ID)
+			})
+
+			Convey("Then GetDevEUIsWithQueuedDownlinks only returns the device when not recently updated", func() {
+				devEUIs, err := GetDevEUIsWithQueuedDownlinks(common.DB, time.Now().Add(-time.Minute), 10)
+				So(err, ShouldBeNil)
+				So(devEUIs, ShouldHaveLength, 0)
+
+				devEUIs, err = GetDevEUIsWithQueuedDownlinks(common.DB, time.Now().Add(time.Minute), 10)
+				So(err, ShouldBeNil)
+				So(devEUIs, ShouldResemble, []lorawan.EUI64{d.DevEUI})
+			})
+
+			Convey("Then GetTimedOutDownlinks only returns it after the given time", func() {
+				downlinks, err := GetTimedOutDownlinks(common.DB, time.Now().Add(-time.Minute), 10)
+				So(err, ShouldBeNil)
+				So(downlinks, ShouldHaveLength, 0)
+
+				downlinks, err = GetTimedOutDownlinks(common.DB, time.Now().Add(time.Minute), 10)
+				So(err, ShouldBeNil)
+				So(downlinks, ShouldHaveLength, 1)
+			})
+
+			Convey("When updating the status to ACKED", func() {
+				dl.SetStatus(DownlinkAcked, time.Now())
+				So(UpdateDownlink(common.DB, &dl), ShouldBeNil)
+
+				Convey("Then the status and acked at timestamp have been updated", func() {
+					dlGet, err := GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+					So(err, ShouldBeNil)
+					So(dlGet.Status, ShouldEqual, DownlinkAcked)
+					So(dlGet.AckedAt, ShouldNotBeNil)
+				})
+
+				Convey("Then it is no longer pending", func() {
+					_, err := GetPendingDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+					So(err, ShouldEqual, ErrDoesNotExist)
+				})
+
+				Convey("Then it does not time out", func() {
+					downlinks, err := GetTimedOutDownlinks(common.DB, time.Now().Add(time.Minute), 10)
+					So(err, ShouldBeNil)
+					So(downlinks, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When calling ExpireQueuedDownlinksForDevEUI", func() {
+				So(ExpireQueuedDownlinksForDevEUI(common.DB, d.DevEUI), ShouldBeNil)
+
+				Convey("Then the downlink has expired", func() {
+					dlGet, err := GetDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+					So(err, ShouldBeNil)
+					So(dlGet.Status, ShouldEqual, DownlinkExpired)
+					So(dlGet.ExpiredAt, ShouldNotBeNil)
+				})
+
+				Convey("Then it is still pending (an ack might arrive late)", func() {
+					dlGet, err := GetPendingDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+					So(err, ShouldBeNil)
+					So(dlGet.ID, ShouldEqual, dl.ID)
+				})
+			})
+		})
+
+		Convey("Given an unconfirmed downlink", func() {
+			dl := Downlink{
+				DevEUI: d.DevEUI,
+				FCnt:   10,
+				FPort:  2,
+			}
+			So(CreateDownlink(common.DB, &dl), ShouldBeNil)
+
+			Convey("Then GetPendingDownlinkForDevEUIAndFCnt does not return it", func() {
+				_, err := GetPendingDownlinkForDevEUIAndFCnt(common.DB, d.DevEUI, 10)
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+
+			Convey("Then GetTimedOutDownlinks does not return it", func() {
+				downlinks, err := GetTimedOutDownlinks(common.DB, time.Now().Add(time.Minute), 10)
+				So(err, ShouldBeNil)
+				So(downlinks, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given three downlinks", func() {
+			for i := 0; i < 3; i++ {
+				So(CreateDownlink(common.DB, &Downlink{
+					DevEUI: d.DevEUI,
+					FCnt:   uint32(i),
+					FPort:  2,
+				}), ShouldBeNil)
+			}
+
+			Convey("When pruning with a max count of 2", func() {
+				So(PruneDownlinks(common.DB, 0, 2), ShouldBeNil)
+
+				Convey("Then the queued downlinks have not been removed", func() {
+					count, err := GetDownlinkCountForDevEUI(common.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 3)
+				})
+			})
+
+			Convey("When pruning acknowledged downlinks with a max count of 2", func() {
+				_, err := common.DB.Exec("update downlink set status = $1", DownlinkAcked)
+				So(err, ShouldBeNil)
+				So(PruneDownlinks(common.DB, 0, 2), ShouldBeNil)
+
+				Convey("Then the oldest downlink has been removed", func() {
+					downlinks, err := GetDownlinksForDevEUI(common.DB, d.DevEUI, 10, 0)
+					So(err, ShouldBeNil)
+					So(downlinks, ShouldHaveLength, 2)
+					So(downlinks[0].FCnt, ShouldEqual, 2)
+					So(downlinks[1].FCnt, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When pruning with a max age", func() {
+				_, err := common.DB.Exec("update downlink set created_at = created_at - interval '2 hours' where f_cnt = 0")
+				So(err, ShouldBeNil)
+				So(PruneDownlinks(common.DB, time.Hour, 0), ShouldBeNil)
+
+				Convey("Then the expired downlink has been removed", func() {
+					count, err := GetDownlinkCountForDevEUI(common.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 2)
+				})
+			})
+		})
+	})
+}
@@ -21,19 +21,46 @@ const (
 
 // errors
 var (
-	ErrAlreadyExists             = errors.New("object already exists")
-	ErrDoesNotExist              = errors.New("object does not exist")
-	ErrUsedByOtherObjects        = errors.New("this object is used by other objects, remove them first")
-	ErrApplicationInvalidName    = errors.New("invalid application name")
-	ErrNodeInvalidName           = errors.New("invalid node name")
-	ErrNodeMaxRXDelay            = errors.New("max value of RXDelay is 15")
-	ErrCFListTooManyChannels     = errors.New("too many channels in channel-list")
-	ErrUserInvalidUsername       = errors.New("username name may only be composed of upper and lower case characters and digits")
-	ErrUserPasswordLength        = errors.New("passwords must be at least 6 characters long")
-	ErrInvalidUsernameOrPassword = errors.New("invalid username or password")
-	ErrOrganizationInvalidName   = errors.New("invalid organization name")
-	ErrGatewayInvalidName        = errors.New("invalid gateway name")
-	ErrInvalidEmail              = errors.New("invalid e-mail")
+	ErrAlreadyExists                              = errors.New("object already exists")
+	ErrDoesNotExist                               = errors.New("object does not exist")
+	ErrUsedByOtherObjects                         = errors.New("this object is used by other objects, remove them first")
+	ErrApplicationInvalidName                     = errors.New("invalid application name")
+	ErrApplicationInvalidClockSyncResyncInterval  = errors.New("clock sync resync interval must not be negative")
+	ErrNodeInvalidName                            = errors.New("invalid node name")
+	ErrNodeMaxRXDelay                             = errors.New("max value of RXDelay is 15")
+	ErrCFListTooManyChannels                      = errors.New("too many channels in channel-list")
+	ErrUserInvalidUsername                        = errors.New("username name may only be composed of upper and lower case characters and digits")
+	ErrUserPasswordLength                         = errors.New("passwords must be at least 6 characters long")
+	ErrInvalidUsernameOrPassword                  = errors.New("invalid username or password")
+	ErrOrganizationInvalidName                    = errors.New("invalid organization name")
+	ErrGatewayInvalidName                         = errors.New("invalid gateway name")
+	ErrInvalidEmail                               = errors.New("invalid e-mail")
+	ErrDeviceEventInvalidType                     = errors.New("invalid device event type")
+	ErrInvalidTag                                 = errors.New("invalid tag")
+	ErrInvalidTagSelector                         = errors.New("invalid tag selector")
+	ErrMulticastGroupInvalidName                  = errors.New("invalid multicast-group name")
+	ErrMulticastGroupServiceProfileMismatch       = errors.New("the device and multicast-group must use the same service-profile")
+	ErrMulticastGroupDeviceNotClassC              = errors.New("the device-profile of the device must support Class-C")
+	ErrScheduledDownlinkInvalidFPort              = errors.New("fPort must be between 1 - 224")
+	ErrScheduledDownlinkInvalidSchedule           = errors.New("either scheduleAt or cron must be set")
+	ErrScheduledDownlinkInvalidCron               = errors.New("invalid cron expression")
+	ErrScheduledDownlinkDeviceApplicationMismatch = errors.New("the device does not belong to the application of the scheduled downlink")
+	ErrScheduledDownlinkInvalidTarget             = errors.New("devEUIs must be set for target DEVICES and must be empty for target APPLICATION")
+	ErrFUOTASessionInvalidTarget                  = errors.New("either devEUI or multicastGroupID must be set")
+	ErrFUOTASessionInvalidFragIndex               = errors.New("fragIndex must be between 0 - 3")
+	ErrFUOTASessionInvalidBlockAckDelay           = errors.New("blockAckDelay must be between 0 - 7")
+	ErrFUOTASessionInvalidFragSize                = errors.New("fragSize must be between 1 - 239")
+	ErrFUOTASessionInvalidDescriptor              = errors.New("descriptor must be exactly 4 bytes")
+	ErrFUOTASessionInvalidPayload                 = errors.New("payload must not be empty and must not exceed 16383 fragments (including redundancy)")
+	ErrFUOTASessionInvalidTimeout                 = errors.New("unicastTimeout and sessionTimeout must be > 0")
+	ErrFUOTASessionNoDevices                      = errors.New("the fuota session does not target any devices")
+	ErrFUOTASessionDeviceBusy                     = errors.New("a device is already part of an active fuota session")
+	ErrFUOTASessionNotActive                      = errors.New("the fuota session is not active")
+	ErrAlertInvalidUplinkInterval                 = errors.New("alert uplink interval must not be negative")
+	ErrAlertInvalidBatteryThreshold               = errors.New("alert battery threshold must be between 0 - 254")
+	ErrAlertInvalidMarginThreshold                = errors.New("alert margin threshold must be between -32 - 32")
+	ErrDeviceAlertInvalidType                     = errors.New("invalid device alert type")
+	ErrDeviceAlertInvalidState                    = errors.New("invalid device alert state")
 )
 
 func handlePSQLError(action Action, err error, description string) error {
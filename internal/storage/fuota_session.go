@@ -0,0 +1,558 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lorawan"
+)
+
+// fuotaMaxNbFrag defines the max number of fragments (including the
+// redundant fragments) of a fragmentation session.
+const fuotaMaxNbFrag = 16383
+
+// FUOTASessionState defines the state of a FUOTA session.
+type FUOTASessionState string
+
+// Available FUOTA session states.
+const (
+	FUOTASessionSetup     FUOTASessionState = "SETUP"
+	FUOTASessionEnqueue   FUOTASessionState = "ENQUEUE"
+	FUOTASessionStatus    FUOTASessionState = "STATUS"
+	FUOTASessionDone      FUOTASessionState = "DONE"
+	FUOTASessionCancelled FUOTASessionState = "CANCELLED"
+)
+
+// FUOTASessionDeviceState defines the state of a device within a FUOTA
+// session.
+type FUOTASessionDeviceState string
+
+// Available FUOTA session device states.
+const (
+	FUOTASessionDevicePending    FUOTASessionDeviceState = "PENDING"
+	FUOTASessionDeviceSetup      FUOTASessionDeviceState = "SETUP"
+	FUOTASessionDeviceComplete   FUOTASessionDeviceState = "COMPLETE"
+	FUOTASessionDeviceIncomplete FUOTASessionDeviceState = "INCOMPLETE"
+	FUOTASessionDeviceError      FUOTASessionDeviceState = "ERROR"
+)
+
+// FUOTASession defines a firmware update over the air session, transferring
+// the payload to a single device or to the devices of a multicast-group
+// using the fragmented data block transport.
+type FUOTASession struct {
+	ID               string            `db:"id"`
+	CreatedAt        time.Time         `db:"created_at"`
+	UpdatedAt        time.Time         `db:"updated_at"`
+	Name             string            `db:"name"`
+	OrganizationID   int64             `db:"organization_id"`
+	DevEUI           *lorawan.EUI64    `db:"dev_eui"`
+	MulticastGroupID *string           `db:"multicast_group_id"`
+	FragIndex        uint8             `db:"frag_index"`
+	FragSize         uint8             `db:"frag_size"`
+	Redundancy       int               `db:"redundancy"`
+	BlockAckDelay    uint8             `db:"block_ack_delay"`
+	Descriptor       []byte            `db:"descriptor"`
+	Payload          []byte            `db:"payload"`
+	UnicastTimeout   time.Duration     `db:"unicast_timeout"`
+	SessionTimeout   time.Duration     `db:"session_timeout"`
+	State            FUOTASessionState `db:"state"`
+	NextStepAt       *time.Time        `db:"next_step_at"`
+}
+
+// FUOTASessionListItem defines the FUOTA session for listing.
+type FUOTASessionListItem struct {
+	ID               string            `db:"id"`
+	CreatedAt        time.Time         `db:"created_at"`
+	UpdatedAt        time.Time         `db:"updated_at"`
+	Name             string            `db:"name"`
+	DevEUI           *lorawan.EUI64    `db:"dev_eui"`
+	MulticastGroupID *string           `db:"multicast_group_id"`
+	State            FUOTASessionState `db:"state"`
+	NextStepAt       *time.Time        `db:"next_step_at"`
+}
+
+// FUOTASessionDevice defines the state of a device within a FUOTA session.
+type FUOTASessionDevice struct {
+	FUOTASessionID string                  `db:"fuota_session_id"`
+	DevEUI         lorawan.EUI64           `db:"dev_eui"`
+	CreatedAt      time.Time               `db:"created_at"`
+	UpdatedAt      time.Time               `db:"updated_at"`
+	State          FUOTASessionDeviceState `db:"state"`
+	ErrorMessage   string                  `db:"error_message"`
+	NbFragReceived int                     `db:"nb_frag_received"`
+	MissingFrag    int                     `db:"missing_frag"`
+}
+
+// Validate validates the FUOTA session data.
+func (s FUOTASession) Validate() error {
+	if (s.DevEUI == nil) == (s.MulticastGroupID == nil) {
+		return ErrFUOTASessionInvalidTarget
+	}
+	if s.FragIndex > 3 {
+		return ErrFUOTASessionInvalidFragIndex
+	}
+	if s.BlockAckDelay > 7 {
+		return ErrFUOTASessionInvalidBlockAckDelay
+	}
+	// the DataFragment command adds 3 bytes to the fragment
+	if s.FragSize == 0 || s.FragSize > 239 {
+		return ErrFUOTASessionInvalidFragSize
+	}
+	if len(s.Descriptor) != 4 {
+		return ErrFUOTASessionInvalidDescriptor
+	}
+	if len(s.Payload) == 0 || s.Redundancy < 0 || s.NbFrag()+s.Redundancy > fuotaMaxNbFrag {
+		return ErrFUOTASessionInvalidPayload
+	}
+	if s.UnicastTimeout <= 0 || s.SessionTimeout <= 0 {
+		return ErrFUOTASessionInvalidTimeout
+	}
+	return nil
+}
+
+// NbFrag returns the number of uncoded fragments of the payload.
+func (s FUOTASession) NbFrag() int {
+	if s.FragSize == 0 {
+		return 0
+	}
+	return (len(s.Payload) + int(s.FragSize) - 1) / int(s.FragSize)
+}
+
+// Active returns true when the session is neither done nor cancelled.
+func (s FUOTASession) Active() bool {
+	return s.State != FUOTASessionDone && s.State != FUOTASessionCancelled
+}
+
+// getFUOTASessionTarget returns the organization id and the DevEUIs of the
+// devices targeted by the given session.
+func getFUOTASessionTarget(db sqlx.Queryer, s FUOTASession) (int64, []lorawan.EUI64, error) {
+	if s.DevEUI != nil {
+		d, err := GetDevice(db, *s.DevEUI)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "get device error")
+		}
+		app, err := GetApplication(db, d.ApplicationID)
+		if err != nil {
+			return 0, nil, errors.Wrap(err, "get application error")
+		}
+		return app.OrganizationID, []lorawan.EUI64{d.DevEUI}, nil
+	}
+
+	mg, err := GetMulticastGroup(db, *s.MulticastGroupID, false)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "get multicast-group error")
+	}
+	sp, err := GetServiceProfile(db, mg.ServiceProfileID)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "get service-profile error")
+	}
+	devEUIs, err := GetDevEUIsForMulticastGroup(db, mg.ID)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "get multicast-group devices error")
+	}
+	return sp.OrganizationID, devEUIs, nil
+}
+
+// CreateFUOTASession creates the given FUOTA session (with state SETUP)
+// and a FUOTA session device for each targeted device. A device can only
+// be part of a single active FUOTA session.
+func CreateFUOTASession(db sqlx.Ext, s *FUOTASession) error {
+	if err := s.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	orgID, devEUIs, err := getFUOTASessionTarget(db, *s)
+	if err != nil {
+		return err
+	}
+	if len(devEUIs) == 0 {
+		return ErrFUOTASessionNoDevices
+	}
+
+	var busy int
+	err = sqlx.Get(db, &busy, `
+		select count(*)
+		from fuota_session_device fsd
+		inner join fuota_session fs
+			on fs.id = fsd.fuota_session_id
+		where
+			fsd.dev_eui = any($1::bytea[])
+			and fs.state not in ($2, $3)`,
+		EUI64Slice(devEUIs),
+		FUOTASessionDone,
+		FUOTASessionCancelled,
+	)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	if busy != 0 {
+		return ErrFUOTASessionDeviceBusy
+	}
+
+	now := time.Now()
+	s.ID = uuid.NewV4().String()
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	s.OrganizationID = orgID
+	s.State = FUOTASessionSetup
+	s.NextStepAt = &now
+
+	var devEUI []byte
+	if s.DevEUI != nil {
+		devEUI = s.DevEUI[:]
+	}
+
+	_, err = db.Exec(`
+		insert into fuota_session (
+			id,
+			created_at,
+			updated_at,
+			name,
+			organization_id,
+			dev_eui,
+			multicast_group_id,
+			frag_index,
+			frag_size,
+			redundancy,
+			block_ack_delay,
+			descriptor,
+			payload,
+			unicast_timeout,
+			session_timeout,
+			state,
+			next_step_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`,
+		s.ID,
+		s.CreatedAt,
+		s.UpdatedAt,
+		s.Name,
+		s.OrganizationID,
+		devEUI,
+		s.MulticastGroupID,
+		s.FragIndex,
+		s.FragSize,
+		s.Redundancy,
+		s.BlockAckDelay,
+		s.Descriptor,
+		s.Payload,
+		s.UnicastTimeout,
+		s.SessionTimeout,
+		s.State,
+		s.NextStepAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	for _, devEUI := range devEUIs {
+		_, err = db.Exec(`
+			insert into fuota_session_device (
+				fuota_session_id,
+				dev_eui,
+				created_at,
+				updated_at,
+				state
+			) values ($1, $2, $3, $4, $5)`,
+			s.ID,
+			devEUI[:],
+			now,
+			now,
+			FUOTASessionDevicePending,
+		)
+		if err != nil {
+			return handlePSQLError(Insert, err, "insert error")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"id":           s.ID,
+		"device_count": len(devEUIs),
+	}).Info("fuota session created")
+
+	return nil
+}
+
+// GetFUOTASession returns the FUOTA session matching the given id.
+func GetFUOTASession(db sqlx.Queryer, id string, forUpdate bool) (FUOTASession, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var s FUOTASession
+	err := sqlx.Get(db, &s, "select * from fuota_session where id = $1"+fu, id)
+	if err != nil {
+		return s, handlePSQLError(Select, err, "select error")
+	}
+
+	return s, nil
+}
+
+// UpdateFUOTASession updates the state and next step of the given FUOTA
+// session.
+func UpdateFUOTASession(db sqlx.Execer, s *FUOTASession) error {
+	s.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update fuota_session
+		set
+			updated_at = $2,
+			state = $3,
+			next_step_at = $4
+		where
+			id = $1`,
+		s.ID,
+		s.UpdatedAt,
+		s.State,
+		s.NextStepAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":           s.ID,
+		"state":        s.State,
+		"next_step_at": s.NextStepAt,
+	}).Info("fuota session updated")
+
+	return nil
+}
+
+// GetFUOTASessionCountForOrganizationID returns the total number of FUOTA
+// sessions for the given organization id.
+func GetFUOTASessionCountForOrganizationID(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from fuota_session where organization_id = $1", organizationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetFUOTASessionsForOrganizationID returns a slice of FUOTA sessions for
+// the given organization id, most recent first.
+func GetFUOTASessionsForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int) ([]FUOTASessionListItem, error) {
+	var items []FUOTASessionListItem
+	err := sqlx.Select(db, &items, `
+		select
+			id,
+			created_at,
+			updated_at,
+			name,
+			dev_eui,
+			multicast_group_id,
+			state,
+			next_step_at
+		from fuota_session
+		where
+			organization_id = $1
+		order by created_at desc
+		limit $2 offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// GetDueFUOTASessionIDs returns the ids of the FUOTA sessions of which the
+// next step must be handled at the given time.
+func GetDueFUOTASessionIDs(db sqlx.Queryer, t time.Time, limit int) ([]string, error) {
+	var ids []string
+	err := sqlx.Select(db, &ids, `
+		select id
+		from fuota_session
+		where
+			next_step_at <= $1
+		order by next_step_at
+		limit $2`,
+		t,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return ids, nil
+}
+
+// GetFUOTASessionDevice returns the FUOTA session device for the given
+// session id and DevEUI.
+func GetFUOTASessionDevice(db sqlx.Queryer, fuotaSessionID string, devEUI lorawan.EUI64, forUpdate bool) (FUOTASessionDevice, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var d FUOTASessionDevice
+	err := sqlx.Get(db, &d, `
+		select *
+		from fuota_session_device
+		where
+			fuota_session_id = $1
+			and dev_eui = $2`+fu,
+		fuotaSessionID,
+		devEUI[:],
+	)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+
+	return d, nil
+}
+
+// GetActiveFUOTASessionDeviceForDevEUI returns the FUOTA session device of
+// the active FUOTA session for the given DevEUI.
+func GetActiveFUOTASessionDeviceForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (FUOTASessionDevice, error) {
+	var d FUOTASessionDevice
+	err := sqlx.Get(db, &d, `
+		select fsd.*
+		from fuota_session_device fsd
+		inner join fuota_session fs
+			on fs.id = fsd.fuota_session_id
+		where
+			fsd.dev_eui = $1
+			and fs.state not in ($2, $3)
+		order by fs.created_at desc
+		limit 1`,
+		devEUI[:],
+		FUOTASessionDone,
+		FUOTASessionCancelled,
+	)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+
+	return d, nil
+}
+
+// UpdateFUOTASessionDevice updates the given FUOTA session device.
+func UpdateFUOTASessionDevice(db sqlx.Execer, d *FUOTASessionDevice) error {
+	d.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update fuota_session_device
+		set
+			updated_at = $3,
+			state = $4,
+			error_message = $5,
+			nb_frag_received = $6,
+			missing_frag = $7
+		where
+			fuota_session_id = $1
+			and dev_eui = $2`,
+		d.FUOTASessionID,
+		d.DevEUI[:],
+		d.UpdatedAt,
+		d.State,
+		d.ErrorMessage,
+		d.NbFragReceived,
+		d.MissingFrag,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"fuota_session_id": d.FUOTASessionID,
+		"dev_eui":          d.DevEUI,
+		"state":            d.State,
+	}).Info("fuota session device updated")
+
+	return nil
+}
+
+// GetFUOTASessionDeviceCount returns the number of devices of the given
+// FUOTA session. When states are given, only the devices in one of the
+// given states are counted.
+func GetFUOTASessionDeviceCount(db sqlx.Queryer, fuotaSessionID string, states ...FUOTASessionDeviceState) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from fuota_session_device
+		where
+			fuota_session_id = $1
+			and (cardinality($2::text[]) = 0 or state = any($2::text[]))`,
+		fuotaSessionID,
+		pq.StringArray(fuotaSessionDeviceStateStrings(states)),
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetFUOTASessionDevices returns a slice of devices of the given FUOTA
+// session.
+func GetFUOTASessionDevices(db sqlx.Queryer, fuotaSessionID string, limit, offset int) ([]FUOTASessionDevice, error) {
+	var items []FUOTASessionDevice
+	err := sqlx.Select(db, &items, `
+		select *
+		from fuota_session_device
+		where
+			fuota_session_id = $1
+		order by dev_eui
+		limit $2 offset $3`,
+		fuotaSessionID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// GetFUOTASessionDevicesForState returns all the devices of the given FUOTA
+// session in the given state.
+func GetFUOTASessionDevicesForState(db sqlx.Queryer, fuotaSessionID string, state FUOTASessionDeviceState) ([]FUOTASessionDevice, error) {
+	var items []FUOTASessionDevice
+	err := sqlx.Select(db, &items, `
+		select *
+		from fuota_session_device
+		where
+			fuota_session_id = $1
+			and state = $2
+		order by dev_eui`,
+		fuotaSessionID,
+		state,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+func fuotaSessionDeviceStateStrings(states []FUOTASessionDeviceState) []string {
+	out := make([]string, 0, len(states))
+	for _, s := range states {
+		out = append(out, string(s))
+	}
+	return out
+}
@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+func TestFUOTASession(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+
+	Convey("Given a clean database with a multicast-group and devices", t, func() {
+		test.MustResetDB(common.DB)
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+			DeviceProfile: &ns.DeviceProfile{
+				SupportsClassC: true,
+			},
+		}
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		devices := []Device{
+			{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, ApplicationID: app.ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, Name: "test-device-1"},
+			{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, ApplicationID: app.ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, Name: "test-device-2"},
+		}
+		for i := range devices {
+			So(CreateDevice(common.DB, &devices[i]), ShouldBeNil)
+		}
+
+		mg := MulticastGroup{
+			Name:             "test-mg",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(CreateMulticastGroup(common.DB, &mg), ShouldBeNil)
+
+		emptyMG := MulticastGroup{
+			Name:             "test-mg-empty",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(CreateMulticastGroup(common.DB, &emptyMG), ShouldBeNil)
+
+		for _, d := range devices {
+			So(AddDeviceToMulticastGroup(common.DB, mg.ID, d.DevEUI), ShouldBeNil)
+		}
+
+		Convey("Then CreateFUOTASession validates the FUOTA session", func() {
+			tests := []struct {
+				Name          string
+				Session       FUOTASession
+				ExpectedError error
+			}{
+				{"no target", FUOTASession{FragSize: 10, Descriptor: make([]byte, 4), Payload: make([]byte, 100), UnicastTimeout: time.Minute, SessionTimeout: time.Hour}, ErrFUOTASessionInvalidTarget},
+				{"invalid frag index", FUOTASession{DevEUI: &devices[0].DevEUI, FragIndex: 4, FragSize: 10, Descriptor: make([]byte, 4), Payload: make([]byte, 100), UnicastTimeout: time.Minute, SessionTimeout: time.Hour}, ErrFUOTASessionInvalidFragIndex},
+				{"invalid block ack delay", FUOTASession{DevEUI: &devices[0].DevEUI, BlockAckDelay: 8, FragSize: 10, Descriptor: make([]byte, 4), Payload: make([]byte, 100), UnicastTimeout: time.Minute, SessionTimeout: time.Hour}, ErrFUOTASessionInvalidBlockAckDelay},
+				{"invalid frag size", FUOTASession{DevEUI: &devices[0].DevEUI, FragSize: 240, Descriptor: make([]byte, 4), Payload: make([]byte, 100), UnicastTimeout: time.Minute, SessionTimeout: time.Hour}, ErrFUOTASessionInvalidFragSize},
+				{"invalid descriptor", FUOTASession{DevEUI: &devices[0].DevEUI, FragSize: 10, Payload: make([]byte, 100), UnicastTimeout: time.Minute, SessionTimeout: time.Hour}, ErrFUOTASessionInvalidDescriptor},
+				{"too many fragments", FUOTASession{DevEUI: &devices[0].DevEUI, FragSize: 1, Descriptor: make([]byte, 4), Payload: make([]byte, 16384), UnicastTimeout: time.Minute, SessionTimeout: time.Hour}, ErrFUOTASessionInvalidPayload},
+				{"no timeout", FUOTASession{DevEUI: &devices[0].DevEUI, FragSize: 10, Descriptor: make([]byte, 4), Payload: make([]byte, 100)}, ErrFUOTASessionInvalidTimeout},
+				{"empty multicast-group", FUOTASession{MulticastGroupID: &emptyMG.ID, FragSize: 10, Descriptor: make([]byte, 4), Payload: make([]byte, 100), UnicastTimeout: time.Minute, SessionTimeout: time.Hour}, ErrFUOTASessionNoDevices},
+			}
+
+			for _, test := range tests {
+				s := test.Session
+				s.Name = test.Name
+				So(errors.Cause(CreateFUOTASession(common.DB, &s)), ShouldEqual, test.ExpectedError)
+			}
+		})
+
+		Convey("When creating a FUOTA session for the multicast-group", func() {
+			s := FUOTASession{
+				Name:             "test-fuota",
+				MulticastGroupID: &mg.ID,
+				FragSize:         10,
+				Redundancy:       5,
+				Descriptor:       []byte{1, 2, 3, 4},
+				Payload:          make([]byte, 95),
+				UnicastTimeout:   time.Minute,
+				SessionTimeout:   time.Hour,
+			}
+			So(CreateFUOTASession(common.DB, &s), ShouldBeNil)
+
+			Convey("Then GetFUOTASession returns the FUOTA session", func() {
+				s2, err := GetFUOTASession(common.DB, s.ID, false)
+				So(err, ShouldBeNil)
+				So(s2.OrganizationID, ShouldEqual, org.ID)
+				So(s2.State, ShouldEqual, FUOTASessionSetup)
+				So(s2.NbFrag(), ShouldEqual, 10)
+				So(s2.UnicastTimeout, ShouldEqual, time.Minute)
+				So(s2.SessionTimeout, ShouldEqual, time.Hour)
+				So(s2.Descriptor, ShouldResemble, []byte{1, 2, 3, 4})
+				So(s2.Payload, ShouldResemble, s.Payload)
+				So(*s2.MulticastGroupID, ShouldEqual, mg.ID)
+				So(s2.DevEUI, ShouldBeNil)
+			})
+
+			Convey("Then a FUOTA session device was created for each device", func() {
+				count, err := GetFUOTASessionDeviceCount(common.DB, s.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				count, err = GetFUOTASessionDeviceCount(common.DB, s.ID, FUOTASessionDevicePending)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				items, err := GetFUOTASessionDevices(common.DB, s.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+				So(items[0].DevEUI, ShouldEqual, devices[0].DevEUI)
+				So(items[1].DevEUI, ShouldEqual, devices[1].DevEUI)
+			})
+
+			Convey("Then the session is due", func() {
+				ids, err := GetDueFUOTASessionIDs(common.DB, time.Now(), 10)
+				So(err, ShouldBeNil)
+				So(ids, ShouldResemble, []string{s.ID})
+			})
+
+			Convey("Then the sessions are listed for the organization", func() {
+				count, err := GetFUOTASessionCountForOrganizationID(common.DB, org.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				items, err := GetFUOTASessionsForOrganizationID(common.DB, org.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].ID, ShouldEqual, s.ID)
+				So(items[0].Name, ShouldEqual, "test-fuota")
+			})
+
+			Convey("Then a second session for one of the devices can not be created", func() {
+				s2 := FUOTASession{
+					Name:           "test-fuota-2",
+					DevEUI:         &devices[0].DevEUI,
+					FragSize:       10,
+					Descriptor:     make([]byte, 4),
+					Payload:        make([]byte, 95),
+					UnicastTimeout: time.Minute,
+					SessionTimeout: time.Hour,
+				}
+				So(errors.Cause(CreateFUOTASession(common.DB, &s2)), ShouldEqual, ErrFUOTASessionDeviceBusy)
+			})
+
+			Convey("Then GetActiveFUOTASessionDeviceForDevEUI returns the device", func() {
+				d, err := GetActiveFUOTASessionDeviceForDevEUI(common.DB, devices[0].DevEUI)
+				So(err, ShouldBeNil)
+				So(d.FUOTASessionID, ShouldEqual, s.ID)
+			})
+
+			Convey("When updating a FUOTA session device", func() {
+				d, err := GetFUOTASessionDevice(common.DB, s.ID, devices[0].DevEUI, false)
+				So(err, ShouldBeNil)
+				d.State = FUOTASessionDeviceIncomplete
+				d.NbFragReceived = 12
+				d.MissingFrag = 3
+				So(UpdateFUOTASessionDevice(common.DB, &d), ShouldBeNil)
+
+				Convey("Then the device has been updated", func() {
+					d2, err := GetFUOTASessionDevice(common.DB, s.ID, devices[0].DevEUI, false)
+					So(err, ShouldBeNil)
+					So(d2.State, ShouldEqual, FUOTASessionDeviceIncomplete)
+					So(d2.NbFragReceived, ShouldEqual, 12)
+					So(d2.MissingFrag, ShouldEqual, 3)
+
+					items, err := GetFUOTASessionDevicesForState(common.DB, s.ID, FUOTASessionDeviceIncomplete)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("When the session is done", func() {
+				s.State = FUOTASessionDone
+				s.NextStepAt = nil
+				So(UpdateFUOTASession(common.DB, &s), ShouldBeNil)
+
+				Convey("Then it is no longer due", func() {
+					ids, err := GetDueFUOTASessionIDs(common.DB, time.Now(), 10)
+					So(err, ShouldBeNil)
+					So(ids, ShouldHaveLength, 0)
+				})
+
+				Convey("Then the devices are no longer part of an active session", func() {
+					_, err := GetActiveFUOTASessionDeviceForDevEUI(common.DB, devices[0].DevEUI)
+					So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+				})
+			})
+		})
+	})
+}
@@ -31,9 +31,17 @@ type Gateway struct {
 	Description     string        `db:"description"`
 	OrganizationID  int64         `db:"organization_id"`
 	Ping            bool          `db:"ping"`
+	PingInterval    time.Duration `db:"ping_interval"`
+	PingFrequency   int           `db:"ping_frequency"`
+	PingDR          *int          `db:"ping_dr"`
+	FineTimestamp   bool          `db:"fine_timestamp"`
 	LastPingID      *int64        `db:"last_ping_id"`
 	LastPingSentAt  *time.Time    `db:"last_ping_sent_at"`
 	NetworkServerID int64         `db:"network_server_id"`
+	Tags            Tags          `db:"tags"`
+	LastSeenAt      *time.Time    `db:"last_seen_at"`
+	Status          GatewayStatus `db:"status"`
+	StatusChangedAt *time.Time    `db:"status_changed_at"`
 }
 
 // GatewayPing represents a gateway ping.
@@ -85,7 +93,7 @@ func (g Gateway) Validate() error {
 	if !gatewayNameRegexp.MatchString(g.Name) {
 		return ErrGatewayInvalidName
 	}
-	return nil
+	return g.Tags.Validate()
 }
 
 // CreateGateway creates the given Gateway.
@@ -97,6 +105,9 @@ func CreateGateway(db sqlx.Execer, gw *Gateway) error {
 	now := time.Now()
 	gw.CreatedAt = now
 	gw.UpdatedAt = now
+	if gw.Status == "" {
+		gw.Status = GatewayStatusUnknown
+	}
 
 	_, err := db.Exec(`
 		insert into gateway (
@@ -109,8 +120,14 @@ func CreateGateway(db sqlx.Execer, gw *Gateway) error {
 			ping,
 			last_ping_id,
 			last_ping_sent_at,
-			network_server_id
-		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			network_server_id,
+			tags,
+			status,
+			ping_interval,
+			ping_frequency,
+			ping_dr,
+			fine_timestamp
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
 		gw.MAC[:],
 		gw.CreatedAt,
 		gw.UpdatedAt,
@@ -121,6 +138,12 @@ func CreateGateway(db sqlx.Execer, gw *Gateway) error {
 		gw.LastPingID,
 		gw.LastPingSentAt,
 		gw.NetworkServerID,
+		gw.Tags,
+		gw.Status,
+		gw.PingInterval,
+		gw.PingFrequency,
+		gw.PingDR,
+		gw.FineTimestamp,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -150,7 +173,12 @@ func UpdateGateway(db sqlx.Execer, gw *Gateway) error {
 			ping = $6,
 			last_ping_id = $7,
 			last_ping_sent_at = $8,
-			network_server_id = $9
+			network_server_id = $9,
+			tags = $10,
+			ping_interval = $11,
+			ping_frequency = $12,
+			ping_dr = $13,
+			fine_timestamp = $14
 		where
 			mac = $1`,
 		gw.MAC[:],
@@ -162,6 +190,11 @@ func UpdateGateway(db sqlx.Execer, gw *Gateway) error {
 		gw.LastPingID,
 		gw.LastPingSentAt,
 		gw.NetworkServerID,
+		gw.Tags,
+		gw.PingInterval,
+		gw.PingFrequency,
+		gw.PingDR,
+		gw.FineTimestamp,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -234,26 +267,42 @@ func GetGateway(db sqlx.Queryer, mac lorawan.EUI64, forUpdate bool) (Gateway, er
 	return gw, nil
 }
 
-// GetGatewayCount returns the total number of gateways.
-func GetGatewayCount(db sqlx.Queryer) (int, error) {
+// GetGatewaysForMACs returns the gateways matching the given MACs. Unknown
+// MACs are ignored.
+func GetGatewaysForMACs(db sqlx.Queryer, macs []lorawan.EUI64) ([]Gateway, error) {
+	var gws []Gateway
+	err := sqlx.Select(db, &gws, "select * from gateway where mac = any($1::bytea[])", EUI64Slice(macs))
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return gws, nil
+}
+
+// GetGatewayCount returns the total number of gateways matching the given
+// tag selector.
+func GetGatewayCount(db sqlx.Queryer, selector TagSelector) (int, error) {
 	var count int
-	err := sqlx.Get(db, &count, "select count(*) from gateway")
+	err := sqlx.Get(db, &count, "select count(*) from gateway where "+tagsFilter("tags", 1), selector)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
 	}
 	return count, nil
 }
 
-// GetGateways returns a slice of gateways sorted by name.
-func GetGateways(db sqlx.Queryer, limit, offset int) ([]Gateway, error) {
+// GetGateways returns a slice of gateways sorted by name, matching the
+// given tag selector.
+func GetGateways(db sqlx.Queryer, limit, offset int, selector TagSelector) ([]Gateway, error) {
 	var gws []Gateway
 	err := sqlx.Select(db, &gws, `
 		select *
 		from gateway
+		where
+			`+tagsFilter("tags", 3)+`
 		order by name
 		limit $1 offset $2`,
 		limit,
 		offset,
+		selector,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -262,15 +311,17 @@ func GetGateways(db sqlx.Queryer, limit, offset int) ([]Gateway, error) {
 }
 
 // GetGatewayCountForOrganizationID returns the total number of gateways
-// given an organization ID.
-func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64) (int, error) {
+// given an organization ID, matching the given tag selector.
+func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, selector TagSelector) (int, error) {
 	var count int
 	err := sqlx.Get(db, &count, `
 		select count(*)
 		from gateway
 		where
-			organization_id = $1`,
+			organization_id = $1
+			and `+tagsFilter("tags", 2),
 		organizationID,
+		selector,
 	)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
@@ -279,19 +330,21 @@ func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64) (in
 }
 
 // GetGatewaysForOrganizationID returns a slice of gateways sorted by name
-// for the given organization ID.
-func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int) ([]Gateway, error) {
+// for the given organization ID, matching the given tag selector.
+func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int, selector TagSelector) ([]Gateway, error) {
 	var gws []Gateway
 	err := sqlx.Select(db, &gws, `
 		select *
 		from gateway
 		where
 			organization_id = $1
+			and `+tagsFilter("tags", 4)+`
 		order by name
 		limit $2 offset $3`,
 		organizationID,
 		limit,
 		offset,
+		selector,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -300,8 +353,8 @@ func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit,
 }
 
 // GetGatewayCountForUser returns the total number of gateways to which the
-// given user has access.
-func GetGatewayCountForUser(db sqlx.Queryer, username string) (int, error) {
+// given user has access, matching the given tag selector.
+func GetGatewayCountForUser(db sqlx.Queryer, username string, selector TagSelector) (int, error) {
 	var count int
 	err := sqlx.Get(db, &count, `
 		select count(g.*)
@@ -313,8 +366,10 @@ func GetGatewayCountForUser(db sqlx.Queryer, username string) (int, error) {
 		inner join "user" u
 			on u.id = ou.user_id
 		where
-			u.username = $1`,
+			u.username = $1
+			and `+tagsFilter("g.tags", 2),
 		username,
+		selector,
 	)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
@@ -323,8 +378,8 @@ func GetGatewayCountForUser(db sqlx.Queryer, username string) (int, error) {
 }
 
 // GetGatewaysForUser returns a slice of gateways sorted by name to which the
-// given user has access.
-func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int) ([]Gateway, error) {
+// given user has access, matching the given tag selector.
+func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, selector TagSelector) ([]Gateway, error) {
 	var gws []Gateway
 	err := sqlx.Select(db, &gws, `
 		select g.*
@@ -337,11 +392,13 @@ func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int) ([]
 			on u.id = ou.user_id
 		where
 			u.username = $1
+			and `+tagsFilter("g.tags", 4)+`
 		order by g.name
 		limit $2 offset $3`,
 		username,
 		limit,
 		offset,
+		selector,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Frankz/lorawan"
+)
+
+// GatewayCoverage contains the aggregated RSSI and SNR of the transmissions
+// within a geohash cell, received by a gateway.
+type GatewayCoverage struct {
+	Geohash    string        `db:"geohash"`
+	GatewayMAC lorawan.EUI64 `db:"gateway_mac"`
+	Count      int64         `db:"count"`
+	RSSIMin    int           `db:"rssi_min"`
+	RSSIMax    int           `db:"rssi_max"`
+	RSSISum    int64         `db:"rssi_sum"`
+	LoRaSNRMin float64       `db:"lora_snr_min"`
+	LoRaSNRMax float64       `db:"lora_snr_max"`
+	LoRaSNRSum float64       `db:"lora_snr_sum"`
+}
+
+// GatewayCoverageRX contains the RSSI and SNR of a transmission received
+// by a gateway.
+type GatewayCoverageRX struct {
+	GatewayMAC lorawan.EUI64
+	RSSI       int
+	LoRaSNR    float64
+}
+
+// GatewayPingCoverage contains the aggregated RSSI and SNR of the pings
+// sent by a gateway and received at a location (of the receiving gateway).
+type GatewayPingCoverage struct {
+	GatewayMAC lorawan.EUI64 `db:"gateway_mac"`
+	Latitude   float64       `db:"latitude"`
+	Longitude  float64       `db:"longitude"`
+	Count      int64         `db:"count"`
+	RSSIMin    int           `db:"rssi_min"`
+	RSSIMax    int           `db:"rssi_max"`
+	RSSISum    int64         `db:"rssi_sum"`
+	LoRaSNRMin float64       `db:"lora_snr_min"`
+	LoRaSNRMax float64       `db:"lora_snr_max"`
+	LoRaSNRSum float64       `db:"lora_snr_sum"`
+}
+
+// AddGatewayCoverage adds the given receptions to the coverage of the given
+// geohash cell, using a single statement. Each gateway must be given only
+// once, unknown gateways are ignored.
+func AddGatewayCoverage(db sqlx.Execer, geohash string, rxs []GatewayCoverageRX) error {
+	if len(rxs) == 0 {
+		return nil
+	}
+
+	macs := make([]lorawan.EUI64, 0, len(rxs))
+	rssis := make([]int64, 0, len(rxs))
+	snrs := make([]float64, 0, len(rxs))
+	for _, rx := range rxs {
+		macs = append(macs, rx.GatewayMAC)
+		rssis = append(rssis, int64(rx.RSSI))
+		snrs = append(snrs, rx.LoRaSNR)
+	}
+
+	_, err := db.Exec(`
+		insert into gateway_coverage (
+			geohash,
+			gateway_mac,
+			updated_at,
+			count,
+			rssi_min,
+			rssi_max,
+			rssi_sum,
+			lora_snr_min,
+			lora_snr_max,
+			lora_snr_sum
+		)
+		select $1, rx.mac, $2, 1, rx.rssi, rx.rssi, rx.rssi, rx.snr, rx.snr, rx.snr
+		from unnest($3::bytea[], $4::integer[], $5::double precision[]) as rx(mac, rssi, snr)
+		where exists (select 1 from gateway where mac = rx.mac)
+		on conflict (geohash, gateway_mac) do update
+		set
+			updated_at = excluded.updated_at,
+			count = gateway_coverage.count + 1,
+			rssi_min = least(gateway_coverage.rssi_min, excluded.rssi_min),
+			rssi_max = greatest(gateway_coverage.rssi_max, excluded.rssi_max),
+			rssi_sum = gateway_coverage.rssi_sum + excluded.rssi_sum,
+			lora_snr_min = least(gateway_coverage.lora_snr_min, excluded.lora_snr_min),
+			lora_snr_max = greatest(gateway_coverage.lora_snr_max, excluded.lora_snr_max),
+			lora_snr_sum = gateway_coverage.lora_snr_sum + excluded.lora_snr_sum`,
+		geohash,
+		time.Now(),
+		EUI64Slice(macs),
+		pq.Int64Array(rssis),
+		pq.Float64Array(snrs),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// GetGatewayCoverageForOrganizationID returns the coverage of the gateways
+// of the given organization, aggregated per geohash cell of the given
+// precision (geohash length).
+func GetGatewayCoverageForOrganizationID(db sqlx.Queryer, organizationID int64, precision int) ([]GatewayCoverage, error) {
+	var gcs []GatewayCoverage
+	err := sqlx.Select(db, &gcs, `
+		select
+			left(c.geohash, $2) as geohash,
+			c.gateway_mac,
+			sum(c.count) as count,
+			min(c.rssi_min) as rssi_min,
+			max(c.rssi_max) as rssi_max,
+			sum(c.rssi_sum) as rssi_sum,
+			min(c.lora_snr_min) as lora_snr_min,
+			max(c.lora_snr_max) as lora_snr_max,
+			sum(c.lora_snr_sum) as lora_snr_sum
+		from gateway_coverage c
+		inner join gateway g
+			on g.mac = c.gateway_mac
+		where
+			g.organization_id = $1
+		group by 1, 2
+		order by 1, 2`,
+		organizationID,
+		precision,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return gcs, nil
+}
+
+// GetGatewayPingCoverageForOrganizationID returns the ping receptions with
+// a known location, for the pings sent by the gateways of the given
+// organization, aggregated per sending gateway and receiving location.
+func GetGatewayPingCoverageForOrganizationID(db sqlx.Queryer, organizationID int64) ([]GatewayPingCoverage, error) {
+	var pcs []GatewayPingCoverage
+	err := sqlx.Select(db, &pcs, `
+		select
+			p.gateway_mac,
+			rx.location[0] as latitude,
+			rx.location[1] as longitude,
+			count(*) as count,
+			min(rx.rssi) as rssi_min,
+			max(rx.rssi) as rssi_max,
+			sum(rx.rssi) as rssi_sum,
+			min(rx.lora_snr) as lora_snr_min,
+			max(rx.lora_snr) as lora_snr_max,
+			sum(rx.lora_snr) as lora_snr_sum
+		from gateway_ping_rx rx
+		inner join gateway_ping p
+			on p.id = rx.ping_id
+		inner join gateway g
+			on g.mac = p.gateway_mac
+		where
+			g.organization_id = $1
+			and rx.location is not null
+			and not (rx.location[0] = 0 and rx.location[1] = 0)
+		group by 1, 2, 3
+		order by 1, 2, 3`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return pcs, nil
+}
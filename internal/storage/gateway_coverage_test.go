@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+func TestGatewayCoverage(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with a gateway", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(db, &n), ShouldBeNil)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		gw := Gateway{
+			MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			Name:            "test-gw",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(CreateGateway(db, &gw), ShouldBeNil)
+
+		Convey("When adding coverage for two cells", func() {
+			So(AddGatewayCoverage(db, "u173zq37x", []GatewayCoverageRX{{GatewayMAC: gw.MAC, RSSI: -80, LoRaSNR: 7.5}}), ShouldBeNil)
+			So(AddGatewayCoverage(db, "u173zq37x", []GatewayCoverageRX{{GatewayMAC: gw.MAC, RSSI: -100, LoRaSNR: -2.5}}), ShouldBeNil)
+			So(AddGatewayCoverage(db, "u173zq37y", []GatewayCoverageRX{{GatewayMAC: gw.MAC, RSSI: -90, LoRaSNR: 1}}), ShouldBeNil)
+
+			Convey("Then the coverage is aggregated per cell", func() {
+				gcs, err := GetGatewayCoverageForOrganizationID(db, org.ID, 9)
+				So(err, ShouldBeNil)
+				So(gcs, ShouldResemble, []GatewayCoverage{
+					{Geohash: "u173zq37x", GatewayMAC: gw.MAC, Count: 2, RSSIMin: -100, RSSIMax: -80, RSSISum: -180, LoRaSNRMin: -2.5, LoRaSNRMax: 7.5, LoRaSNRSum: 5},
+					{Geohash: "u173zq37y", GatewayMAC: gw.MAC, Count: 1, RSSIMin: -90, RSSIMax: -90, RSSISum: -90, LoRaSNRMin: 1, LoRaSNRMax: 1, LoRaSNRSum: 1},
+				})
+			})
+
+			Convey("Then the coverage is aggregated per cell of a lower precision", func() {
+				gcs, err := GetGatewayCoverageForOrganizationID(db, org.ID, 8)
+				So(err, ShouldBeNil)
+				So(gcs, ShouldResemble, []GatewayCoverage{
+					{Geohash: "u173zq37", GatewayMAC: gw.MAC, Count: 3, RSSIMin: -100, RSSIMax: -80, RSSISum: -270, LoRaSNRMin: -2.5, LoRaSNRMax: 7.5, LoRaSNRSum: 6},
+				})
+			})
+
+			Convey("Then no coverage is returned for an other organization", func() {
+				gcs, err := GetGatewayCoverageForOrganizationID(db, org.ID+1, 9)
+				So(err, ShouldBeNil)
+				So(gcs, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given a second gateway which received three pings of the first at two locations", func() {
+			gw2 := Gateway{
+				MAC:             lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2},
+				Name:            "test-gw-2",
+				OrganizationID:  org.ID,
+				NetworkServerID: n.ID,
+			}
+			So(CreateGateway(db, &gw2), ShouldBeNil)
+
+			rxs := []GatewayPingRX{
+				{GatewayMAC: gw2.MAC, RSSI: -80, LoRaSNR: 5, Location: GPSPoint{Latitude: 52.3740, Longitude: 4.8897}},
+				{GatewayMAC: gw2.MAC, RSSI: -100, LoRaSNR: -1, Location: GPSPoint{Latitude: 52.3740, Longitude: 4.8897}},
+				{GatewayMAC: gw2.MAC, RSSI: -90, LoRaSNR: 2, Location: GPSPoint{Latitude: 52.3750, Longitude: 4.8897}},
+				{GatewayMAC: gw2.MAC, RSSI: -70, LoRaSNR: 9},
+			}
+			for i := range rxs {
+				ping := GatewayPing{
+					GatewayMAC: gw.MAC,
+					Frequency:  868100000,
+					DR:         5,
+				}
+				So(CreateGatewayPing(db, &ping), ShouldBeNil)
+				rxs[i].PingID = ping.ID
+				So(CreateGatewayPingRX(db, &rxs[i]), ShouldBeNil)
+			}
+
+			Convey("Then the ping coverage is aggregated per location, ignoring unknown locations", func() {
+				pcs, err := GetGatewayPingCoverageForOrganizationID(db, org.ID)
+				So(err, ShouldBeNil)
+				So(pcs, ShouldResemble, []GatewayPingCoverage{
+					{GatewayMAC: gw.MAC, Latitude: 52.3740, Longitude: 4.8897, Count: 2, RSSIMin: -100, RSSIMax: -80, RSSISum: -180, LoRaSNRMin: -1, LoRaSNRMax: 5, LoRaSNRSum: 4},
+					{GatewayMAC: gw.MAC, Latitude: 52.3750, Longitude: 4.8897, Count: 1, RSSIMin: -90, RSSIMax: -90, RSSISum: -90, LoRaSNRMin: 2, LoRaSNRMax: 2, LoRaSNRSum: 2},
+				})
+			})
+		})
+
+		Convey("Then adding coverage for an unknown gateway is ignored", func() {
+			So(AddGatewayCoverage(db, "u173zq37x", []GatewayCoverageRX{{GatewayMAC: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, RSSI: -80, LoRaSNR: 7.5}}), ShouldBeNil)
+			gcs, err := GetGatewayCoverageForOrganizationID(db, org.ID, 9)
+			So(err, ShouldBeNil)
+			So(gcs, ShouldHaveLength, 0)
+		})
+	})
+}
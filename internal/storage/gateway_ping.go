@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/Frankz/lorawan"
+)
+
+// GatewayPingOverlap defines two gateways of which one has received a ping
+// of the other.
+type GatewayPingOverlap struct {
+	GatewayMAC            lorawan.EUI64 `db:"gateway_mac"`
+	OverlappingGatewayMAC lorawan.EUI64 `db:"overlapping_gateway_mac"`
+}
+
+// GetGatewaysForPing returns the gateways for which a ping is due, least
+// recently pinged first. The given default interval is used for gateways
+// without ping interval.
+func GetGatewaysForPing(db sqlx.Queryer, now time.Time, defaultInterval time.Duration, limit int) ([]Gateway, error) {
+	var gws []Gateway
+	err := sqlx.Select(db, &gws, `
+		select *
+		from gateway
+		where
+			ping = true
+			and (
+				last_ping_sent_at is null
+				or last_ping_sent_at <= $1 - ((case when ping_interval != 0 then ping_interval else $2 end) / 1000) * interval '1 microsecond'
+			)
+		order by last_ping_sent_at nulls first
+		limit $3`,
+		now,
+		defaultInterval,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return gws, nil
+}
+
+// GetGatewayMACsWithPingSentAfter returns the MACs of the gateways which
+// have sent a ping after the given timestamp.
+func GetGatewayMACsWithPingSentAfter(db sqlx.Queryer, ts time.Time) ([]lorawan.EUI64, error) {
+	var macs []lorawan.EUI64
+	err := sqlx.Select(db, &macs, "select mac from gateway where last_ping_sent_at > $1", ts)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return macs, nil
+}
+
+// GetGatewayPingOverlaps returns the overlapping gateways (in both
+// directions) for the given MACs, based on the pings received after the
+// given timestamp.
+func GetGatewayPingOverlaps(db sqlx.Queryer, macs []lorawan.EUI64, since time.Time) ([]GatewayPingOverlap, error) {
+	var overlaps []GatewayPingOverlap
+	err := sqlx.Select(db, &overlaps, `
+		select distinct
+			p.gateway_mac,
+			rx.gateway_mac as overlapping_gateway_mac
+		from gateway_ping p
+		inner join gateway_ping_rx rx
+			on rx.ping_id = p.id
+		where
+			rx.created_at > $2
+			and (
+				p.gateway_mac = any($1::bytea[])
+				or rx.gateway_mac = any($1::bytea[])
+			)`,
+		EUI64Slice(macs),
+		since,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return overlaps, nil
+}
+
+// GetGatewayMACsWithPingHistory returns the given MACs of the gateways
+// which have sent a ping within the given time range (since exclusive,
+// until inclusive).
+func GetGatewayMACsWithPingHistory(db sqlx.Queryer, macs []lorawan.EUI64, since, until time.Time) ([]lorawan.EUI64, error) {
+	var out []lorawan.EUI64
+	err := sqlx.Select(db, &out, `
+		select distinct
+			gateway_mac
+		from gateway_ping
+		where
+			gateway_mac = any($1::bytea[])
+			and created_at > $2
+			and created_at <= $3`,
+		EUI64Slice(macs),
+		since,
+		until,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return out, nil
+}
+
+// GetGatewayPingCountForGatewayMAC returns the number of pings sent by the
+// given gateway.
+func GetGatewayPingCountForGatewayMAC(db sqlx.Queryer, mac lorawan.EUI64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from gateway_ping where gateway_mac = $1", mac[:])
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetGatewayPingsForGatewayMAC returns the pings sent by the given gateway,
+// most recent first.
+func GetGatewayPingsForGatewayMAC(db sqlx.Queryer, mac lorawan.EUI64, limit, offset int) ([]GatewayPing, error) {
+	var pings []GatewayPing
+	err := sqlx.Select(db, &pings, `
+		select *
+		from gateway_ping
+		where
+			gateway_mac = $1
+		order by created_at desc, id desc
+		limit $2
+		offset $3`,
+		mac[:],
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return pings, nil
+}
+
+// GetGatewayPingRXForPingIDs returns the received gateway pings for the
+// given ping IDs.
+func GetGatewayPingRXForPingIDs(db sqlx.Queryer, ids []int64) ([]GatewayPingRX, error) {
+	var rx []GatewayPingRX
+	err := sqlx.Select(db, &rx, `
+		select *
+		from gateway_ping_rx
+		where
+			ping_id = any($1::bigint[])
+		order by ping_id, id`,
+		pq.Int64Array(ids),
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return rx, nil
+}
@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+func TestGatewayPingScheduling(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with three gateways", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(db, &n), ShouldBeNil)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		now := time.Now()
+		tenMinutesAgo := now.Add(-10 * time.Minute)
+		twoHoursAgo := now.Add(-2 * time.Hour)
+
+		gws := []Gateway{
+			{
+				MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Name:            "test-gw-1",
+				OrganizationID:  org.ID,
+				NetworkServerID: n.ID,
+				Ping:            true,
+				LastPingSentAt:  &tenMinutesAgo,
+			},
+			{
+				MAC:             lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+				Name:            "test-gw-2",
+				OrganizationID:  org.ID,
+				NetworkServerID: n.ID,
+				Ping:            true,
+				LastPingSentAt:  &twoHoursAgo,
+			},
+			{
+				MAC:             lorawan.EUI64{3, 2, 3, 4, 5, 6, 7, 8},
+				Name:            "test-gw-3",
+				OrganizationID:  org.ID,
+				NetworkServerID: n.ID,
+				Ping:            true,
+			},
+		}
+		for i := range gws {
+			So(CreateGateway(db, &gws[i]), ShouldBeNil)
+			So(UpdateGateway(db, &gws[i]), ShouldBeNil)
+		}
+
+		Convey("Then GetGatewaysForPing returns the gateways for which a ping is due", func() {
+			due, err := GetGatewaysForPing(db, now, time.Hour, 10)
+			So(err, ShouldBeNil)
+			So(due, ShouldHaveLength, 2)
+			So(due[0].MAC, ShouldEqual, gws[2].MAC)
+			So(due[1].MAC, ShouldEqual, gws[1].MAC)
+
+			due, err = GetGatewaysForPing(db, now, time.Hour, 1)
+			So(err, ShouldBeNil)
+			So(due, ShouldHaveLength, 1)
+		})
+
+		Convey("Then GetGatewaysForPing takes the ping interval of the gateway into account", func() {
+			gws[0].PingInterval = 5 * time.Minute
+			So(UpdateGateway(db, &gws[0]), ShouldBeNil)
+			gws[1].PingInterval = 3 * time.Hour
+			So(UpdateGateway(db, &gws[1]), ShouldBeNil)
+
+			due, err := GetGatewaysForPing(db, now, time.Hour, 10)
+			So(err, ShouldBeNil)
+			So(due, ShouldHaveLength, 2)
+			So(due[0].MAC, ShouldEqual, gws[2].MAC)
+			So(due[1].MAC, ShouldEqual, gws[0].MAC)
+		})
+
+		Convey("Then GetGatewayMACsWithPingSentAfter returns the recently pinged gateways", func() {
+			macs, err := GetGatewayMACsWithPingSentAfter(db, now.Add(-time.Hour))
+			So(err, ShouldBeNil)
+			So(macs, ShouldResemble, []lorawan.EUI64{gws[0].MAC})
+		})
+
+		Convey("Given two pings of the first gateway, received by the second", func() {
+			var pings []GatewayPing
+			for i := 0; i < 2; i++ {
+				ping := GatewayPing{
+					GatewayMAC: gws[0].MAC,
+					Frequency:  868100000,
+					DR:         5,
+				}
+				So(CreateGatewayPing(db, &ping), ShouldBeNil)
+				So(CreateGatewayPingRX(db, &GatewayPingRX{
+					PingID:     ping.ID,
+					GatewayMAC: gws[1].MAC,
+					RSSI:       -10,
+					LoRaSNR:    5.5,
+				}), ShouldBeNil)
+				pings = append(pings, ping)
+			}
+
+			Convey("Then GetGatewayPingOverlaps returns the overlap for both gateways", func() {
+				expected := []GatewayPingOverlap{
+					{GatewayMAC: gws[0].MAC, OverlappingGatewayMAC: gws[1].MAC},
+				}
+
+				since := time.Now().Add(-time.Hour)
+
+				overlaps, err := GetGatewayPingOverlaps(db, []lorawan.EUI64{gws[0].MAC}, since)
+				So(err, ShouldBeNil)
+				So(overlaps, ShouldResemble, expected)
+
+				overlaps, err = GetGatewayPingOverlaps(db, []lorawan.EUI64{gws[1].MAC}, since)
+				So(err, ShouldBeNil)
+				So(overlaps, ShouldResemble, expected)
+
+				overlaps, err = GetGatewayPingOverlaps(db, []lorawan.EUI64{gws[2].MAC}, since)
+				So(err, ShouldBeNil)
+				So(overlaps, ShouldHaveLength, 0)
+			})
+
+			Convey("Then GetGatewayPingOverlaps ignores pings received before the given timestamp", func() {
+				overlaps, err := GetGatewayPingOverlaps(db, []lorawan.EUI64{gws[0].MAC}, time.Now())
+				So(err, ShouldBeNil)
+				So(overlaps, ShouldHaveLength, 0)
+			})
+
+			Convey("Then GetGatewayMACsWithPingHistory returns the gateways which have sent a ping", func() {
+				macs, err := GetGatewayMACsWithPingHistory(db, []lorawan.EUI64{gws[0].MAC, gws[1].MAC}, time.Now().Add(-time.Hour), time.Now())
+				So(err, ShouldBeNil)
+				So(macs, ShouldResemble, []lorawan.EUI64{gws[0].MAC})
+
+				macs, err = GetGatewayMACsWithPingHistory(db, []lorawan.EUI64{gws[0].MAC}, time.Now().Add(-time.Hour), time.Now().Add(-time.Minute))
+				So(err, ShouldBeNil)
+				So(macs, ShouldHaveLength, 0)
+			})
+
+			Convey("Then GetGatewayPingRXForPingIDs returns the received pings", func() {
+				rx, err := GetGatewayPingRXForPingIDs(db, []int64{pings[0].ID, pings[1].ID})
+				So(err, ShouldBeNil)
+				So(rx, ShouldHaveLength, 2)
+				So(rx[0].PingID, ShouldEqual, pings[0].ID)
+				So(rx[1].PingID, ShouldEqual, pings[1].ID)
+			})
+
+			Convey("Then GetGatewayPingCountForGatewayMAC returns the number of pings", func() {
+				count, err := GetGatewayPingCountForGatewayMAC(db, gws[0].MAC)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				count, err = GetGatewayPingCountForGatewayMAC(db, gws[1].MAC)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+
+			Convey("Then GetGatewayPingsForGatewayMAC returns the pings, most recent first", func() {
+				result, err := GetGatewayPingsForGatewayMAC(db, gws[0].MAC, 10, 0)
+				So(err, ShouldBeNil)
+				So(result, ShouldHaveLength, 2)
+				So(result[0].ID, ShouldEqual, pings[1].ID)
+				So(result[1].ID, ShouldEqual, pings[0].ID)
+
+				result, err = GetGatewayPingsForGatewayMAC(db, gws[0].MAC, 1, 1)
+				So(err, ShouldBeNil)
+				So(result, ShouldHaveLength, 1)
+				So(result[0].ID, ShouldEqual, pings[0].ID)
+			})
+		})
+	})
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lorawan"
+)
+
+// GatewayStatus defines the gateway status.
+type GatewayStatus string
+
+// Available gateway statuses.
+const (
+	GatewayStatusUnknown GatewayStatus = "UNKNOWN"
+	GatewayStatusOnline  GatewayStatus = "ONLINE"
+	GatewayStatusOffline GatewayStatus = "OFFLINE"
+)
+
+// UpdateGatewayLastSeenAt sets the last-seen timestamp of the gateway
+// matching the given MAC, unless the stored timestamp is more recent.
+// Unknown gateways are ignored.
+func UpdateGatewayLastSeenAt(db sqlx.Execer, mac lorawan.EUI64, ts time.Time) error {
+	_, err := db.Exec(`
+		update gateway
+		set
+			last_seen_at = $2
+		where
+			mac = $1
+			and (last_seen_at is null or last_seen_at < $2)`,
+		mac[:],
+		ts,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	return nil
+}
+
+// UpdateGatewayStatus updates the status of the given gateway.
+func UpdateGatewayStatus(db sqlx.Execer, gw *Gateway) error {
+	res, err := db.Exec(`
+		update gateway
+		set
+			status = $2,
+			status_changed_at = $3
+		where
+			mac = $1`,
+		gw.MAC[:],
+		gw.Status,
+		gw.StatusChangedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"mac":    gw.MAC,
+		"status": gw.Status,
+	}).Info("gateway status updated")
+
+	return nil
+}
+
+// GetGatewaysAfterMAC returns the gateways with a MAC greater than the given
+// MAC, sorted by MAC so that all gateways can be iterated in batches.
+func GetGatewaysAfterMAC(db sqlx.Queryer, after lorawan.EUI64, limit int) ([]Gateway, error) {
+	var gws []Gateway
+	err := sqlx.Select(db, &gws, `
+		select *
+		from gateway
+		where
+			mac > $1
+		order by mac
+		limit $2`,
+		after[:],
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return gws, nil
+}
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+func TestGatewayStatus(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with two gateways", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		nsClient := test.NewNetworkServerClient()
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(db, &n), ShouldBeNil)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		gws := []Gateway{
+			{
+				MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Name:            "test-gw-1",
+				OrganizationID:  org.ID,
+				NetworkServerID: n.ID,
+			},
+			{
+				MAC:             lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+				Name:            "test-gw-2",
+				OrganizationID:  org.ID,
+				NetworkServerID: n.ID,
+			},
+		}
+		for i := range gws {
+			So(CreateGateway(db, &gws[i]), ShouldBeNil)
+		}
+
+		Convey("Then the status of a new gateway is unknown", func() {
+			gw, err := GetGateway(db, gws[0].MAC, false)
+			So(err, ShouldBeNil)
+			So(gw.Status, ShouldEqual, GatewayStatusUnknown)
+			So(gw.LastSeenAt, ShouldBeNil)
+			So(gw.StatusChangedAt, ShouldBeNil)
+		})
+
+		Convey("When updating the last-seen timestamp", func() {
+			now := time.Now().Truncate(time.Millisecond)
+			So(UpdateGatewayLastSeenAt(db, gws[0].MAC, now), ShouldBeNil)
+
+			Convey("Then the last-seen timestamp was set", func() {
+				gw, err := GetGateway(db, gws[0].MAC, false)
+				So(err, ShouldBeNil)
+				So(gw.LastSeenAt, ShouldNotBeNil)
+				So(gw.LastSeenAt.Equal(now), ShouldBeTrue)
+			})
+
+			Convey("Then an older timestamp does not overwrite it", func() {
+				So(UpdateGatewayLastSeenAt(db, gws[0].MAC, now.Add(-time.Minute)), ShouldBeNil)
+				gw, err := GetGateway(db, gws[0].MAC, false)
+				So(err, ShouldBeNil)
+				So(gw.LastSeenAt.Equal(now), ShouldBeTrue)
+			})
+		})
+
+		Convey("Then updating the last-seen timestamp of an unknown gateway does not fail", func() {
+			So(UpdateGatewayLastSeenAt(db, lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, time.Now()), ShouldBeNil)
+		})
+
+		Convey("When updating the gateway status", func() {
+			now := time.Now().Truncate(time.Millisecond)
+			gws[0].Status = GatewayStatusOnline
+			gws[0].StatusChangedAt = &now
+			So(UpdateGatewayStatus(db, &gws[0]), ShouldBeNil)
+
+			Convey("Then the status was updated", func() {
+				gw, err := GetGateway(db, gws[0].MAC, false)
+				So(err, ShouldBeNil)
+				So(gw.Status, ShouldEqual, GatewayStatusOnline)
+				So(gw.StatusChangedAt.Equal(now), ShouldBeTrue)
+			})
+		})
+
+		Convey("Then updating the status of an unknown gateway returns an error", func() {
+			err := UpdateGatewayStatus(db, &Gateway{MAC: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}})
+			So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+		})
+
+		Convey("Then the gateways can be iterated in batches", func() {
+			result, err := GetGatewaysAfterMAC(db, lorawan.EUI64{}, 1)
+			So(err, ShouldBeNil)
+			So(result, ShouldHaveLength, 1)
+			So(result[0].MAC, ShouldEqual, gws[0].MAC)
+
+			result, err = GetGatewaysAfterMAC(db, result[0].MAC, 1)
+			So(err, ShouldBeNil)
+			So(result, ShouldHaveLength, 1)
+			So(result[0].MAC, ShouldEqual, gws[1].MAC)
+
+			result, err = GetGatewaysAfterMAC(db, result[0].MAC, 1)
+			So(err, ShouldBeNil)
+			So(result, ShouldHaveLength, 0)
+		})
+	})
+}
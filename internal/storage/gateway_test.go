@@ -54,6 +54,7 @@ func TestGateway(t *testing.T) {
 				OrganizationID:  org.ID,
 				Ping:            true,
 				NetworkServerID: n.ID,
+				Tags:            Tags{"site": "berlin"},
 			}
 			So(CreateGateway(db, &gw), ShouldBeNil)
 			gw.CreatedAt = gw.CreatedAt.Truncate(time.Millisecond).UTC()
@@ -71,6 +72,7 @@ func TestGateway(t *testing.T) {
 				gw.Name = "test-gw2"
 				gw.Description = "updated test gateway"
 				gw.Ping = false
+				gw.FineTimestamp = true
 				So(UpdateGateway(db, &gw), ShouldBeNil)
 				gw.CreatedAt = gw.CreatedAt.Truncate(time.Millisecond).UTC()
 				gw.UpdatedAt = gw.UpdatedAt.Truncate(time.Millisecond).UTC()
@@ -88,27 +90,59 @@ func TestGateway(t *testing.T) {
 				So(errors.Cause(err), ShouldResemble, ErrDoesNotExist)
 			})
 
+			Convey("Then getting the gateways for a list of MACs ignores unknown MACs", func() {
+				gws, err := GetGatewaysForMACs(db, []lorawan.EUI64{gw.MAC, {8, 7, 6, 5, 4, 3, 2, 1}})
+				So(err, ShouldBeNil)
+				So(gws, ShouldHaveLength, 1)
+				So(gws[0].MAC, ShouldEqual, gw.MAC)
+			})
+
 			Convey("Then getting the total gateway count returns 1", func() {
-				c, err := GetGatewayCount(db)
+				c, err := GetGatewayCount(db, nil)
 				So(err, ShouldBeNil)
 				So(c, ShouldEqual, 1)
 			})
 
 			Convey("Then getting all gateways returns the expected gateway", func() {
-				gws, err := GetGateways(db, 10, 0)
+				gws, err := GetGateways(db, 10, 0, nil)
 				So(err, ShouldBeNil)
 				So(gws, ShouldHaveLength, 1)
 				So(gws[0].MAC, ShouldEqual, gw.MAC)
 			})
 
+			Convey("Then the gateways are filtered by the given tag selector", func() {
+				for sel, expected := range map[string]int{
+					"":                         1,
+					"site=berlin":              1,
+					"site=hamburg":             0,
+					"site!=berlin":             0,
+					"site=berlin, floor!=4":    1,
+					"site=berlin,site=hamburg": 0,
+					"site,!room":               1,
+					"room":                     0,
+					"!site":                    0,
+				} {
+					selector, err := ParseTagSelector(sel)
+					So(err, ShouldBeNil)
+
+					gws, err := GetGateways(db, 10, 0, selector)
+					So(err, ShouldBeNil)
+					So(gws, ShouldHaveLength, expected)
+
+					c, err := GetGatewayCount(db, selector)
+					So(err, ShouldBeNil)
+					So(c, ShouldEqual, expected)
+				}
+			})
+
 			Convey("Then getting the total gateway count for the organization returns 1", func() {
-				c, err := GetGatewayCountForOrganizationID(db, org.ID)
+				c, err := GetGatewayCountForOrganizationID(db, org.ID, nil)
 				So(err, ShouldBeNil)
 				So(c, ShouldEqual, 1)
 			})
 
 			Convey("Then getting all gateways for the organization returns the exepected gateway", func() {
-				gws, err := GetGatewaysForOrganizationID(db, org.ID, 10, 0)
+				gws, err := GetGatewaysForOrganizationID(db, org.ID, 10, 0, nil)
 				So(err, ShouldBeNil)
 				So(gws, ShouldHaveLength, 1)
 				So(gws[0].MAC, ShouldEqual, gw.MAC)
@@ -124,13 +158,13 @@ func TestGateway(t *testing.T) {
 				So(err, ShouldBeNil)
 
 				Convey("Getting the gateway count for this user returns 0", func() {
-					c, err := GetGatewayCountForUser(db, user.Username)
+					c, err := GetGatewayCountForUser(db, user.Username, nil)
 					So(err, ShouldBeNil)
 					So(c, ShouldEqual, 0)
 				})
 
 				Convey("Then getting the gateways for this user returns 0 items", func() {
-					gws, err := GetGatewaysForUser(db, user.Username, 10, 0)
+					gws, err := GetGatewaysForUser(db, user.Username, 10, 0, nil)
 					So(err, ShouldBeNil)
 					So(gws, ShouldHaveLength, 0)
 				})
@@ -139,13 +173,13 @@ func TestGateway(t *testing.T) {
 					So(CreateOrganizationUser(db, org.ID, user.ID, false), ShouldBeNil)
 
 					Convey("Getting the gateway count for this user returns 1", func() {
-						c, err := GetGatewayCountForUser(db, user.Username)
+						c, err := GetGatewayCountForUser(db, user.Username, nil)
 						So(err, ShouldBeNil)
 						So(c, ShouldEqual, 1)
 					})
 
 					Convey("Then getting the gateways for this user returns 1 item", func() {
-						gws, err := GetGatewaysForUser(db, user.Username, 10, 0)
+						gws, err := GetGatewaysForUser(db, user.Username, 10, 0, nil)
 						So(err, ShouldBeNil)
 						So(gws, ShouldHaveLength, 1)
 						So(gws[0].MAC, ShouldEqual, gw.MAC)
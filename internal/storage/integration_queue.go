@@ -0,0 +1,337 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// IntegrationQueueItem defines an integration delivery waiting to be
+// (re)tried.
+type IntegrationQueueItem struct {
+	ID            int64     `db:"id"`
+	CreatedAt     time.Time `db:"created_at"`
+	IntegrationID int64     `db:"integration_id"`
+	Event         string    `db:"event"`
+	Payload       []byte    `db:"payload"`
+	Attempts      int       `db:"attempts"`
+	RetryAfter    time.Time `db:"retry_after"`
+	LastError     string    `db:"last_error"`
+}
+
+// IntegrationDeadLetter defines an integration delivery which failed after
+// the retry policy of the integration was exhausted.
+type IntegrationDeadLetter struct {
+	ID            int64     `db:"id"`
+	CreatedAt     time.Time `db:"created_at"`
+	FailedAt      time.Time `db:"failed_at"`
+	IntegrationID int64     `db:"integration_id"`
+	Event         string    `db:"event"`
+	Payload       []byte    `db:"payload"`
+	Attempts      int       `db:"attempts"`
+	LastError     string    `db:"last_error"`
+}
+
+// IntegrationDeliveryStatus defines the delivery status of an integration.
+// FailureCount contains the number of consecutive failed deliveries.
+type IntegrationDeliveryStatus struct {
+	IntegrationID int64      `db:"integration_id"`
+	LastSuccessAt *time.Time `db:"last_success_at"`
+	LastFailureAt *time.Time `db:"last_failure_at"`
+	LastError     string     `db:"last_error"`
+	FailureCount  int        `db:"failure_count"`
+}
+
+// CreateIntegrationQueueItem creates the given integration queue item.
+func CreateIntegrationQueueItem(db sqlx.Queryer, qi *IntegrationQueueItem) error {
+	if qi.CreatedAt.IsZero() {
+		qi.CreatedAt = time.Now()
+	}
+
+	err := sqlx.Get(db, &qi.ID, `
+		insert into integration_queue_item (
+			created_at,
+			integration_id,
+			event,
+			payload,
+			attempts,
+			retry_after,
+			last_error
+		) values ($1, $2, $3, $4, $5, $6, $7)
+		returning id`,
+		qi.CreatedAt,
+		qi.IntegrationID,
+		qi.Event,
+		qi.Payload,
+		qi.Attempts,
+		qi.RetryAfter,
+		qi.LastError,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetNextIntegrationQueueItem returns the next integration queue item
+// which is due and locks it for update. To preserve the order of the
+// deliveries, only the first (oldest) queue item of each integration is
+// considered. Items locked by other transactions are skipped. This function
+// must be called within a transaction. In case no item is due, nil is
+// returned.
+func GetNextIntegrationQueueItem(db sqlx.Queryer) (*IntegrationQueueItem, error) {
+	var items []IntegrationQueueItem
+	err := sqlx.Select(db, &items, `
+		select *
+		from integration_queue_item q
+		where
+			q.retry_after <= $1
+			and not exists (
+				select 1
+				from integration_queue_item p
+				where
+					p.integration_id = q.integration_id
+					and p.id < q.id
+			)
+		order by q.retry_after
+		limit 1
+		for update skip locked`,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+	return &items[0], nil
+}
+
+// UpdateIntegrationQueueItem updates the given integration queue item.
+func UpdateIntegrationQueueItem(db sqlx.Execer, qi IntegrationQueueItem) error {
+	res, err := db.Exec(`
+		update integration_queue_item
+		set
+			attempts = $2,
+			retry_after = $3,
+			last_error = $4
+		where
+			id = $1`,
+		qi.ID,
+		qi.Attempts,
+		qi.RetryAfter,
+		qi.LastError,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Update, err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// DeleteIntegrationQueueItem deletes the integration queue item matching
+// the given id.
+func DeleteIntegrationQueueItem(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from integration_queue_item where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Delete, err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// GetIntegrationQueueItemCount returns the number of queue items for the
+// given integration id.
+func GetIntegrationQueueItemCount(db sqlx.Queryer, integrationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from integration_queue_item where integration_id = $1", integrationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// CreateIntegrationDeadLetter creates the given integration dead letter.
+func CreateIntegrationDeadLetter(db sqlx.Queryer, dl *IntegrationDeadLetter) error {
+	if dl.FailedAt.IsZero() {
+		dl.FailedAt = time.Now()
+	}
+
+	err := sqlx.Get(db, &dl.ID, `
+		insert into integration_dead_letter (
+			created_at,
+			failed_at,
+			integration_id,
+			event,
+			payload,
+			attempts,
+			last_error
+		) values ($1, $2, $3, $4, $5, $6, $7)
+		returning id`,
+		dl.CreatedAt,
+		dl.FailedAt,
+		dl.IntegrationID,
+		dl.Event,
+		dl.Payload,
+		dl.Attempts,
+		dl.LastError,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetIntegrationDeadLetter returns the integration dead letter matching the
+// given id. When forUpdate is set to true, the row will be locked for update.
+func GetIntegrationDeadLetter(db sqlx.Queryer, id int64, forUpdate bool) (IntegrationDeadLetter, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var dl IntegrationDeadLetter
+	err := sqlx.Get(db, &dl, "select * from integration_dead_letter where id = $1"+fu, id)
+	if err != nil {
+		return dl, handlePSQLError(Select, err, "select error")
+	}
+	return dl, nil
+}
+
+// GetIntegrationDeadLetters returns the dead letters for the given
+// integration id, sorted by the most recent failure first.
+func GetIntegrationDeadLetters(db sqlx.Queryer, integrationID int64, limit, offset int) ([]IntegrationDeadLetter, error) {
+	var dls []IntegrationDeadLetter
+	err := sqlx.Select(db, &dls, `
+		select *
+		from integration_dead_letter
+		where
+			integration_id = $1
+		order by failed_at desc, id desc
+		limit $2
+		offset $3`,
+		integrationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return dls, nil
+}
+
+// GetIntegrationDeadLetterCount returns the number of dead letters for the
+// given integration id.
+func GetIntegrationDeadLetterCount(db sqlx.Queryer, integrationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from integration_dead_letter where integration_id = $1", integrationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// DeleteIntegrationDeadLetter deletes the integration dead letter matching
+// the given id.
+func DeleteIntegrationDeadLetter(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from integration_dead_letter where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return handlePSQLError(Delete, err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// SetIntegrationDeliverySuccess records a successful delivery for the given
+// integration id and resets its failure count. To avoid a write on every
+// delivery, the status is only updated when the integration was failing or
+// when the last success was recorded more than the given interval ago.
+func SetIntegrationDeliverySuccess(db sqlx.Execer, integrationID int64, interval time.Duration) error {
+	now := time.Now()
+	_, err := db.Exec(`
+		insert into integration_delivery_status (
+			integration_id,
+			last_success_at
+		) values ($1, $2)
+		on conflict (integration_id) do update
+		set
+			last_success_at = excluded.last_success_at,
+			failure_count = 0
+		where
+			integration_delivery_status.failure_count <> 0
+			or integration_delivery_status.last_success_at is null
+			or integration_delivery_status.last_success_at < $3`,
+		integrationID,
+		now,
+		now.Add(-interval),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// SetIntegrationDeliveryFailure records a failed delivery for the given
+// integration id and increments its failure count.
+func SetIntegrationDeliveryFailure(db sqlx.Execer, integrationID int64, deliveryErr error) error {
+	_, err := db.Exec(`
+		insert into integration_delivery_status (
+			integration_id,
+			last_failure_at,
+			last_error,
+			failure_count
+		) values ($1, $2, $3, 1)
+		on conflict (integration_id) do update
+		set
+			last_failure_at = excluded.last_failure_at,
+			last_error = excluded.last_error,
+			failure_count = integration_delivery_status.failure_count + 1`,
+		integrationID,
+		time.Now(),
+		deliveryErr.Error(),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// GetIntegrationDeliveryStatus returns the delivery status for the given
+// integration id. In case no delivery has been made yet, an empty status
+// is returned.
+func GetIntegrationDeliveryStatus(db sqlx.Queryer, integrationID int64) (IntegrationDeliveryStatus, error) {
+	var statuses []IntegrationDeliveryStatus
+	err := sqlx.Select(db, &statuses, "select * from integration_delivery_status where integration_id = $1", integrationID)
+	if err != nil {
+		return IntegrationDeliveryStatus{}, handlePSQLError(Select, err, "select error")
+	}
+
+	if len(statuses) == 0 {
+		return IntegrationDeliveryStatus{IntegrationID: integrationID}, nil
+	}
+	return statuses[0], nil
+}
@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+)
+
+func TestIntegrationQueue(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+	nsClient := test.NewNetworkServerClient()
+	common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database with an application and integration", t, func() {
+		test.MustResetDB(common.DB)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		intgr := Integration{
+			ApplicationID: app.ID,
+			Kind:          "HTTP",
+			Settings:      []byte(`{}`),
+		}
+		So(CreateIntegration(common.DB, &intgr), ShouldBeNil)
+
+		Convey("When creating a queue item which is due and one which is not", func() {
+			due := IntegrationQueueItem{
+				IntegrationID: intgr.ID,
+				Event:         "up",
+				Payload:       []byte(`{"fCnt":1}`),
+				Attempts:      1,
+				RetryAfter:    time.Now().Add(-time.Second),
+			}
+			So(CreateIntegrationQueueItem(common.DB, &due), ShouldBeNil)
+
+			notDue := IntegrationQueueItem{
+				IntegrationID: intgr.ID,
+				Event:         "join",
+				Payload:       []byte(`{}`),
+				Attempts:      1,
+				RetryAfter:    time.Now().Add(time.Hour),
+			}
+			So(CreateIntegrationQueueItem(common.DB, &notDue), ShouldBeNil)
+
+			Convey("Then GetIntegrationQueueItemCount returns 2", func() {
+				count, err := GetIntegrationQueueItemCount(common.DB, intgr.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+			})
+
+			Convey("Then GetNextIntegrationQueueItem returns the due item", func() {
+				qi, err := GetNextIntegrationQueueItem(common.DB)
+				So(err, ShouldBeNil)
+				So(qi, ShouldNotBeNil)
+				So(qi.ID, ShouldEqual, due.ID)
+				So(qi.Payload, ShouldResemble, due.Payload)
+			})
+
+			Convey("Then the due item can be rescheduled", func() {
+				due.Attempts = 2
+				due.RetryAfter = time.Now().Add(time.Hour)
+				due.LastError = "BOOM"
+				So(UpdateIntegrationQueueItem(common.DB, due), ShouldBeNil)
+
+				qi, err := GetNextIntegrationQueueItem(common.DB)
+				So(err, ShouldBeNil)
+				So(qi, ShouldBeNil)
+			})
+
+			Convey("Then a due item queued after an item which is not due is not returned", func() {
+				So(DeleteIntegrationQueueItem(common.DB, due.ID), ShouldBeNil)
+
+				later := IntegrationQueueItem{
+					IntegrationID: intgr.ID,
+					Event:         "up",
+					Payload:       []byte(`{"fCnt":2}`),
+					RetryAfter:    time.Now().Add(-time.Second),
+				}
+				So(CreateIntegrationQueueItem(common.DB, &later), ShouldBeNil)
+
+				qi, err := GetNextIntegrationQueueItem(common.DB)
+				So(err, ShouldBeNil)
+				So(qi, ShouldBeNil)
+			})
+
+			Convey("Then the items can be deleted", func() {
+				So(DeleteIntegrationQueueItem(common.DB, due.ID), ShouldBeNil)
+				So(DeleteIntegrationQueueItem(common.DB, due.ID), ShouldEqual, ErrDoesNotExist)
+			})
+		})
+
+		Convey("When creating a dead letter", func() {
+			dl := IntegrationDeadLetter{
+				CreatedAt:     time.Now(),
+				IntegrationID: intgr.ID,
+				Event:         "up",
+				Payload:       []byte(`{"fCnt":1}`),
+				Attempts:      10,
+				LastError:     "BOOM",
+			}
+			So(CreateIntegrationDeadLetter(common.DB, &dl), ShouldBeNil)
+
+			Convey("Then it can be retrieved and listed", func() {
+				dl2, err := GetIntegrationDeadLetter(common.DB, dl.ID, false)
+				So(err, ShouldBeNil)
+				So(dl2.LastError, ShouldEqual, "BOOM")
+				So(dl2.Attempts, ShouldEqual, 10)
+
+				dls, err := GetIntegrationDeadLetters(common.DB, intgr.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(dls, ShouldHaveLength, 1)
+
+				count, err := GetIntegrationDeadLetterCount(common.DB, intgr.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+			})
+
+			Convey("Then it can be deleted", func() {
+				So(DeleteIntegrationDeadLetter(common.DB, dl.ID), ShouldBeNil)
+				_, err := GetIntegrationDeadLetter(common.DB, dl.ID, false)
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+		})
+
+		Convey("Then GetIntegrationDeliveryStatus returns an empty status", func() {
+			status, err := GetIntegrationDeliveryStatus(common.DB, intgr.ID)
+			So(err, ShouldBeNil)
+			So(status, ShouldResemble, IntegrationDeliveryStatus{IntegrationID: intgr.ID})
+		})
+
+		Convey("When recording two failed deliveries", func() {
+			So(SetIntegrationDeliveryFailure(common.DB, intgr.ID, errors.New("BOOM")), ShouldBeNil)
+			So(SetIntegrationDeliveryFailure(common.DB, intgr.ID, errors.New("BOOM2")), ShouldBeNil)
+
+			Convey("Then the failure count and last error are set", func() {
+				status, err := GetIntegrationDeliveryStatus(common.DB, intgr.ID)
+				So(err, ShouldBeNil)
+				So(status.FailureCount, ShouldEqual, 2)
+				So(status.LastError, ShouldEqual, "BOOM2")
+				So(status.LastFailureAt, ShouldNotBeNil)
+				So(status.LastSuccessAt, ShouldBeNil)
+			})
+
+			Convey("Then a successful delivery resets the failure count", func() {
+				So(SetIntegrationDeliverySuccess(common.DB, intgr.ID, time.Minute), ShouldBeNil)
+
+				status, err := GetIntegrationDeliveryStatus(common.DB, intgr.ID)
+				So(err, ShouldBeNil)
+				So(status.FailureCount, ShouldEqual, 0)
+				So(status.LastSuccessAt, ShouldNotBeNil)
+				So(status.LastError, ShouldEqual, "BOOM2")
+
+				Convey("Then a successful delivery within the interval does not update the status", func() {
+					So(SetIntegrationDeliverySuccess(common.DB, intgr.ID, time.Minute), ShouldBeNil)
+
+					status2, err := GetIntegrationDeliveryStatus(common.DB, intgr.ID)
+					So(err, ShouldBeNil)
+					So(status2.LastSuccessAt.Equal(*status.LastSuccessAt), ShouldBeTrue)
+				})
+
+				Convey("Then a successful delivery after the interval updates the status", func() {
+					So(SetIntegrationDeliverySuccess(common.DB, intgr.ID, 0), ShouldBeNil)
+
+					status2, err := GetIntegrationDeliveryStatus(common.DB, intgr.ID)
+					So(err, ShouldBeNil)
+					So(status2.LastSuccessAt.After(*status.LastSuccessAt), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
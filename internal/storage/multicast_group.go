@@ -0,0 +1,541 @@
+package storage
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+var multicastGroupNameRegexp = regexp.MustCompile(`^[\w-]+$`)
+
+// MulticastGroup defines a multicast-group. All the devices within the
+// group share the same multicast address and session keys. FCnt is the
+// frame-counter used for the next multicast downlink.
+type MulticastGroup struct {
+	ID               string            `db:"id"`
+	CreatedAt        time.Time         `db:"created_at"`
+	UpdatedAt        time.Time         `db:"updated_at"`
+	Name             string            `db:"name"`
+	ServiceProfileID string            `db:"service_profile_id"`
+	MCAddr           lorawan.DevAddr   `db:"mc_addr"`
+	MCNwkSKey        lorawan.AES128Key `db:"mc_nwk_s_key"`
+	MCAppSKey        lorawan.AES128Key `db:"mc_app_s_key"`
+	FCnt             uint32            `db:"f_cnt"`
+}
+
+// nsMulticastGroup returns the multicast-group session as known by the
+// network-server.
+func (mg MulticastGroup) nsMulticastGroup() *ns.MulticastGroup {
+	return &ns.MulticastGroup{
+		MulticastGroupID: mg.ID,
+		McAddr:           mg.MCAddr[:],
+		McNwkSKey:        mg.MCNwkSKey[:],
+		ServiceProfileID: mg.ServiceProfileID,
+		RoutingProfileID: common.ApplicationServerID,
+	}
+}
+
+// MulticastGroupListItem defines the multicast-group for listing.
+type MulticastGroupListItem struct {
+	ID                 string    `db:"id"`
+	CreatedAt          time.Time `db:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at"`
+	Name               string    `db:"name"`
+	ServiceProfileID   string    `db:"service_profile_id"`
+	ServiceProfileName string    `db:"service_profile_name"`
+}
+
+// Validate validates the multicast-group data.
+func (mg MulticastGroup) Validate() error {
+	if !multicastGroupNameRegexp.MatchString(mg.Name) {
+		return ErrMulticastGroupInvalidName
+	}
+	return nil
+}
+
+// CreateMulticastGroup creates the given multicast-group.
+// This will create the multicast-group at the network-server side and will
+// create a local reference record.
+func CreateMulticastGroup(db sqlx.Ext, mg *MulticastGroup) error {
+	if err := mg.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	mg.ID = uuid.NewV4().String()
+	mg.CreatedAt = now
+	mg.UpdatedAt = now
+
+	_, err := db.Exec(`
+		insert into multicast_group (
+			id,
+			created_at,
+			updated_at,
+			name,
+			service_profile_id,
+			mc_addr,
+			mc_nwk_s_key,
+			mc_app_s_key
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		mg.ID,
+		mg.CreatedAt,
+		mg.UpdatedAt,
+		mg.Name,
+		mg.ServiceProfileID,
+		mg.MCAddr[:],
+		mg.MCNwkSKey[:],
+		mg.MCAppSKey[:],
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	n, err := GetNetworkServerForServiceProfileID(db, mg.ServiceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	_, err = nsClient.CreateMulticastGroup(context.Background(), &ns.CreateMulticastGroupRequest{
+		MulticastGroup: mg.nsMulticastGroup(),
+	})
+	if err != nil {
+		return handleGrpcError(err, "create multicast-group error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":   mg.ID,
+		"name": mg.Name,
+	}).Info("multicast-group created")
+
+	return nil
+}
+
+// GetMulticastGroup returns the multicast-group given an id.
+func GetMulticastGroup(db sqlx.Queryer, id string, forUpdate bool) (MulticastGroup, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var mg MulticastGroup
+	err := sqlx.Get(db, &mg, "select * from multicast_group where id = $1"+fu, id)
+	if err != nil {
+		return mg, handlePSQLError(Select, err, "select error")
+	}
+
+	return mg, nil
+}
+
+// IncrementMulticastGroupFCnt increments the frame-counter of the given
+// multicast-group and returns the frame-counter to use for the next
+// multicast downlink. The multicast-group row is locked until the end of
+// the transaction.
+func IncrementMulticastGroupFCnt(db sqlx.Queryer, id string) (uint32, error) {
+	var fCnt uint32
+	err := sqlx.Get(db, &fCnt, `
+		update multicast_group
+		set
+			f_cnt = f_cnt + 1
+		where
+			id = $1
+		returning f_cnt - 1`,
+		id,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Update, err, "update error")
+	}
+	return fCnt, nil
+}
+
+// UpdateMulticastGroup updates the given multicast-group.
+// This will update the multicast-group at the network-server side.
+func UpdateMulticastGroup(db sqlx.Ext, mg *MulticastGroup) error {
+	if err := mg.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	mg.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update multicast_group
+		set
+			updated_at = $2,
+			name = $3,
+			mc_addr = $4,
+			mc_nwk_s_key = $5,
+			mc_app_s_key = $6
+		where
+			id = $1`,
+		mg.ID,
+		mg.UpdatedAt,
+		mg.Name,
+		mg.MCAddr[:],
+		mg.MCNwkSKey[:],
+		mg.MCAppSKey[:],
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	n, err := GetNetworkServerForServiceProfileID(db, mg.ServiceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	_, err = nsClient.UpdateMulticastGroup(context.Background(), &ns.UpdateMulticastGroupRequest{
+		MulticastGroup: mg.nsMulticastGroup(),
+	})
+	if err != nil {
+		return handleGrpcError(err, "update multicast-group error")
+	}
+
+	log.WithField("id", mg.ID).Info("multicast-group updated")
+
+	return nil
+}
+
+// DeleteMulticastGroup deletes the multicast-group matching the given id.
+// This will delete the multicast-group at the network-server side.
+func DeleteMulticastGroup(db sqlx.Ext, id string) error {
+	mg, err := GetMulticastGroup(db, id, false)
+	if err != nil {
+		return errors.Wrap(err, "get multicast-group error")
+	}
+
+	n, err := GetNetworkServerForServiceProfileID(db, mg.ServiceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	res, err := db.Exec("delete from multicast_group where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	_, err = nsClient.DeleteMulticastGroup(context.Background(), &ns.DeleteMulticastGroupRequest{
+		MulticastGroupID: id,
+	})
+	if err != nil && grpc.Code(err) != codes.NotFound {
+		return handleGrpcError(err, "delete multicast-group error")
+	}
+
+	log.WithField("id", id).Info("multicast-group deleted")
+
+	return nil
+}
+
+// GetMulticastGroupCount returns the total number of multicast-groups.
+func GetMulticastGroupCount(db sqlx.Queryer) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from multicast_group")
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetMulticastGroups returns a slice of multicast-groups.
+func GetMulticastGroups(db sqlx.Queryer, limit, offset int) ([]MulticastGroupListItem, error) {
+	var items []MulticastGroupListItem
+	err := sqlx.Select(db, &items, `
+		select
+			mg.id,
+			mg.created_at,
+			mg.updated_at,
+			mg.name,
+			mg.service_profile_id,
+			sp.name as service_profile_name
+		from multicast_group mg
+		inner join service_profile sp
+			on sp.service_profile_id = mg.service_profile_id
+		order by mg.name
+		limit $1 offset $2`,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// GetMulticastGroupCountForOrganizationID returns the total number of
+// multicast-groups for the given organization id.
+func GetMulticastGroupCountForOrganizationID(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(mg.*)
+		from multicast_group mg
+		inner join service_profile sp
+			on sp.service_profile_id = mg.service_profile_id
+		where
+			sp.organization_id = $1`,
+		organizationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetMulticastGroupsForOrganizationID returns a slice of multicast-groups
+// for the given organization id.
+func GetMulticastGroupsForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int) ([]MulticastGroupListItem, error) {
+	var items []MulticastGroupListItem
+	err := sqlx.Select(db, &items, `
+		select
+			mg.id,
+			mg.created_at,
+			mg.updated_at,
+			mg.name,
+			mg.service_profile_id,
+			sp.name as service_profile_name
+		from multicast_group mg
+		inner join service_profile sp
+			on sp.service_profile_id = mg.service_profile_id
+		where
+			sp.organization_id = $1
+		order by mg.name
+		limit $2 offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// AddDeviceToMulticastGroup adds the given device to the multicast-group.
+// The device must be under an application using the same service-profile
+// as the multicast-group and its device-profile must support Class-C.
+func AddDeviceToMulticastGroup(db sqlx.Ext, multicastGroupID string, devEUI lorawan.EUI64) error {
+	mg, err := GetMulticastGroup(db, multicastGroupID, false)
+	if err != nil {
+		return errors.Wrap(err, "get multicast-group error")
+	}
+
+	d, err := GetDevice(db, devEUI)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	app, err := GetApplication(db, d.ApplicationID)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	if app.ServiceProfileID != mg.ServiceProfileID {
+		return ErrMulticastGroupServiceProfileMismatch
+	}
+
+	dp, err := GetDeviceProfile(db, d.DeviceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get device-profile error")
+	}
+
+	if !dp.DeviceProfile.SupportsClassC {
+		return ErrMulticastGroupDeviceNotClassC
+	}
+
+	_, err = db.Exec(`
+		insert into device_multicast_group (
+			multicast_group_id,
+			dev_eui,
+			created_at
+		) values ($1, $2, $3)`,
+		multicastGroupID,
+		devEUI[:],
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	n, err := GetNetworkServerForServiceProfileID(db, mg.ServiceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	_, err = nsClient.AddDeviceToMulticastGroup(context.Background(), &ns.AddDeviceToMulticastGroupRequest{
+		DevEUI:           devEUI[:],
+		MulticastGroupID: multicastGroupID,
+	})
+	if err != nil {
+		return handleGrpcError(err, "add device to multicast-group error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":            devEUI,
+		"multicast_group_id": multicastGroupID,
+	}).Info("device added to multicast-group")
+
+	return nil
+}
+
+// RemoveDeviceFromMulticastGroup removes the given device from the
+// multicast-group.
+func RemoveDeviceFromMulticastGroup(db sqlx.Ext, multicastGroupID string, devEUI lorawan.EUI64) error {
+	mg, err := GetMulticastGroup(db, multicastGroupID, false)
+	if err != nil {
+		return errors.Wrap(err, "get multicast-group error")
+	}
+
+	n, err := GetNetworkServerForServiceProfileID(db, mg.ServiceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	nsClient, err := common.NetworkServerPool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errors.Wrap(err, "get network-server client error")
+	}
+
+	res, err := db.Exec(`
+		delete from device_multicast_group
+		where
+			multicast_group_id = $1
+			and dev_eui = $2`,
+		multicastGroupID,
+		devEUI[:],
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	_, err = nsClient.RemoveDeviceFromMulticastGroup(context.Background(), &ns.RemoveDeviceFromMulticastGroupRequest{
+		DevEUI:           devEUI[:],
+		MulticastGroupID: multicastGroupID,
+	})
+	if err != nil && grpc.Code(err) != codes.NotFound {
+		return handleGrpcError(err, "remove device from multicast-group error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":            devEUI,
+		"multicast_group_id": multicastGroupID,
+	}).Info("device removed from multicast-group")
+
+	return nil
+}
+
+// GetDeviceCountForMulticastGroup returns the number of devices in the
+// given multicast-group.
+func GetDeviceCountForMulticastGroup(db sqlx.Queryer, multicastGroupID string) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from device_multicast_group
+		where
+			multicast_group_id = $1`,
+		multicastGroupID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetDevicesForMulticastGroup returns a slice of devices in the given
+// multicast-group.
+func GetDevicesForMulticastGroup(db sqlx.Queryer, multicastGroupID string, limit, offset int) ([]DeviceListItem, error) {
+	var devices []DeviceListItem
+	err := sqlx.Select(db, &devices, `
+		select
+			d.*,
+			dp.name as device_profile_name
+		from device d
+		inner join device_profile dp
+			on dp.device_profile_id = d.device_profile_id
+		inner join device_multicast_group dmg
+			on dmg.dev_eui = d.dev_eui
+		where
+			dmg.multicast_group_id = $1
+		order by d.name
+		limit $2
+		offset $3`,
+		multicastGroupID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devices, nil
+}
+
+// GetDevEUIsForMulticastGroup returns the DevEUIs of all the devices in the
+// given multicast-group.
+func GetDevEUIsForMulticastGroup(db sqlx.Queryer, multicastGroupID string) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		select dev_eui
+		from device_multicast_group
+		where
+			multicast_group_id = $1
+		order by dev_eui`,
+		multicastGroupID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devEUIs, nil
+}
@@ -0,0 +1,233 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/loraserver/api/ns"
+	"github.com/Frankz/lorawan"
+)
+
+func TestMulticastGroup(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+
+	Convey("Given a clean database with an organization, service-profile and device", t, func() {
+		test.MustResetDB(common.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+			DeviceProfile: &ns.DeviceProfile{
+				SupportsClassC: true,
+			},
+		}
+		common.NetworkServerPool = test.NewNetworkServerPool(nsClient)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		sp2 := ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp-2",
+		}
+		So(CreateServiceProfile(common.DB, &sp2), ShouldBeNil)
+
+		dp := DeviceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(common.DB, &app), ShouldBeNil)
+
+		d := Device{
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-device",
+		}
+		So(CreateDevice(common.DB, &d), ShouldBeNil)
+
+		Convey("Then CreateMulticastGroup returns an error on an invalid name", func() {
+			mg := MulticastGroup{
+				Name:             "i contain spaces",
+				ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			}
+			So(errors.Cause(CreateMulticastGroup(common.DB, &mg)), ShouldEqual, ErrMulticastGroupInvalidName)
+		})
+
+		Convey("When creating a multicast-group", func() {
+			mg := MulticastGroup{
+				Name:             "test-mg",
+				ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+				MCAddr:           lorawan.DevAddr{1, 2, 3, 4},
+				MCNwkSKey:        lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+				MCAppSKey:        lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+			}
+			So(CreateMulticastGroup(common.DB, &mg), ShouldBeNil)
+			mg.CreatedAt = mg.CreatedAt.UTC().Truncate(time.Millisecond)
+			mg.UpdatedAt = mg.UpdatedAt.UTC().Truncate(time.Millisecond)
+			So(nsClient.CreateMulticastGroupChan, ShouldHaveLength, 1)
+			So(<-nsClient.CreateMulticastGroupChan, ShouldResemble, ns.CreateMulticastGroupRequest{
+				MulticastGroup: &ns.MulticastGroup{
+					MulticastGroupID: mg.ID,
+					McAddr:           []byte{1, 2, 3, 4},
+					McNwkSKey:        []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+					ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+					RoutingProfileID: common.ApplicationServerID,
+				},
+			})
+
+			Convey("Then GetMulticastGroup returns the multicast-group", func() {
+				mgGet, err := GetMulticastGroup(common.DB, mg.ID, false)
+				So(err, ShouldBeNil)
+				mgGet.CreatedAt = mgGet.CreatedAt.UTC().Truncate(time.Millisecond)
+				mgGet.UpdatedAt = mgGet.UpdatedAt.UTC().Truncate(time.Millisecond)
+				So(mgGet, ShouldResemble, mg)
+			})
+
+			Convey("Then UpdateMulticastGroup updates the multicast-group", func() {
+				mg.Name = "test-mg-updated"
+				mg.MCAddr = lorawan.DevAddr{4, 3, 2, 1}
+				So(UpdateMulticastGroup(common.DB, &mg), ShouldBeNil)
+				mg.UpdatedAt = mg.UpdatedAt.UTC().Truncate(time.Millisecond)
+
+				mgGet, err := GetMulticastGroup(common.DB, mg.ID, false)
+				So(err, ShouldBeNil)
+				mgGet.CreatedAt = mgGet.CreatedAt.UTC().Truncate(time.Millisecond)
+				mgGet.UpdatedAt = mgGet.UpdatedAt.UTC().Truncate(time.Millisecond)
+				So(mgGet, ShouldResemble, mg)
+
+				So(nsClient.UpdateMulticastGroupChan, ShouldHaveLength, 1)
+				So((<-nsClient.UpdateMulticastGroupChan).MulticastGroup.McAddr, ShouldResemble, []byte{4, 3, 2, 1})
+			})
+
+			Convey("Then IncrementMulticastGroupFCnt returns and increments the frame-counter", func() {
+				for i := 0; i < 2; i++ {
+					fCnt, err := IncrementMulticastGroupFCnt(common.DB, mg.ID)
+					So(err, ShouldBeNil)
+					So(fCnt, ShouldEqual, i)
+				}
+
+				mgGet, err := GetMulticastGroup(common.DB, mg.ID, false)
+				So(err, ShouldBeNil)
+				So(mgGet.FCnt, ShouldEqual, 2)
+
+				_, err = IncrementMulticastGroupFCnt(common.DB, "2e4c7e30-7dab-4d50-a3b0-4ed9a6a1b1b0")
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+
+			Convey("Then DeleteMulticastGroup deletes the multicast-group", func() {
+				So(DeleteMulticastGroup(common.DB, mg.ID), ShouldBeNil)
+				So(errors.Cause(DeleteMulticastGroup(common.DB, mg.ID)), ShouldEqual, ErrDoesNotExist)
+				So(nsClient.DeleteMulticastGroupChan, ShouldHaveLength, 1)
+				_, err := GetMulticastGroup(common.DB, mg.ID, false)
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+
+			Convey("Then the multicast-group is listed for the organization", func() {
+				count, err := GetMulticastGroupCountForOrganizationID(common.DB, org.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				items, err := GetMulticastGroupsForOrganizationID(common.DB, org.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].ID, ShouldEqual, mg.ID)
+				So(items[0].ServiceProfileName, ShouldEqual, sp.Name)
+
+				count, err = GetMulticastGroupCount(common.DB)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				items, err = GetMulticastGroups(common.DB, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+			})
+
+			Convey("When adding the device to the multicast-group", func() {
+				So(AddDeviceToMulticastGroup(common.DB, mg.ID, d.DevEUI), ShouldBeNil)
+				So(nsClient.AddDeviceToMulticastGroupChan, ShouldHaveLength, 1)
+				So(<-nsClient.AddDeviceToMulticastGroupChan, ShouldResemble, ns.AddDeviceToMulticastGroupRequest{
+					DevEUI:           d.DevEUI[:],
+					MulticastGroupID: mg.ID,
+				})
+
+				Convey("Then the device is a member of the multicast-group", func() {
+					count, err := GetDeviceCountForMulticastGroup(common.DB, mg.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+
+					devices, err := GetDevicesForMulticastGroup(common.DB, mg.ID, 10, 0)
+					So(err, ShouldBeNil)
+					So(devices, ShouldHaveLength, 1)
+					So(devices[0].DevEUI, ShouldEqual, d.DevEUI)
+					So(devices[0].DeviceProfileName, ShouldEqual, dp.Name)
+
+					devEUIs, err := GetDevEUIsForMulticastGroup(common.DB, mg.ID)
+					So(err, ShouldBeNil)
+					So(devEUIs, ShouldResemble, []lorawan.EUI64{d.DevEUI})
+				})
+
+				Convey("Then adding the device twice returns ErrAlreadyExists", func() {
+					So(AddDeviceToMulticastGroup(common.DB, mg.ID, d.DevEUI), ShouldEqual, ErrAlreadyExists)
+				})
+
+				Convey("Then RemoveDeviceFromMulticastGroup removes the device", func() {
+					So(RemoveDeviceFromMulticastGroup(common.DB, mg.ID, d.DevEUI), ShouldBeNil)
+					So(RemoveDeviceFromMulticastGroup(common.DB, mg.ID, d.DevEUI), ShouldEqual, ErrDoesNotExist)
+					So(nsClient.RemoveDeviceFromMulticastGroupChan, ShouldHaveLength, 1)
+
+					count, err := GetDeviceCountForMulticastGroup(common.DB, mg.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+
+			Convey("Then a device which does not support Class-C can not be added", func() {
+				nsClient.GetDeviceProfileResponse.DeviceProfile.SupportsClassC = false
+				So(errors.Cause(AddDeviceToMulticastGroup(common.DB, mg.ID, d.DevEUI)), ShouldEqual, ErrMulticastGroupDeviceNotClassC)
+			})
+		})
+
+		Convey("Then a device can not be added to a multicast-group using a different service-profile", func() {
+			mg := MulticastGroup{
+				Name:             "test-mg",
+				ServiceProfileID: sp2.ServiceProfile.ServiceProfileID,
+			}
+			So(CreateMulticastGroup(common.DB, &mg), ShouldBeNil)
+			So(errors.Cause(AddDeviceToMulticastGroup(common.DB, mg.ID, d.DevEUI)), ShouldEqual, ErrMulticastGroupServiceProfileMismatch)
+		})
+	})
+}
@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// OrganizationIntegration represents an integration of an organization,
+// receiving the organization level (e.g. gateway) notifications.
+type OrganizationIntegration struct {
+	ID             int64           `db:"id"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+	OrganizationID int64           `db:"organization_id"`
+	Kind           string          `db:"kind"`
+	Settings       json.RawMessage `db:"settings"`
+}
+
+// CreateOrganizationIntegration creates the given organization integration.
+func CreateOrganizationIntegration(db sqlx.Queryer, i *OrganizationIntegration) error {
+	now := time.Now()
+	err := sqlx.Get(db, &i.ID, `
+		insert into organization_integration (
+			created_at,
+			updated_at,
+			organization_id,
+			kind,
+			settings
+		) values ($1, $2, $3, $4, $5) returning id`,
+		now,
+		now,
+		i.OrganizationID,
+		i.Kind,
+		i.Settings,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	i.CreatedAt = now
+	i.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":              i.ID,
+		"kind":            i.Kind,
+		"organization_id": i.OrganizationID,
+	}).Info("organization integration created")
+	return nil
+}
+
+// GetOrganizationIntegrationByOrganizationID returns the organization
+// integration for the given organization id and kind.
+func GetOrganizationIntegrationByOrganizationID(db sqlx.Queryer, organizationID int64, kind string) (OrganizationIntegration, error) {
+	var i OrganizationIntegration
+	err := sqlx.Get(db, &i, "select * from organization_integration where organization_id = $1 and kind = $2", organizationID, kind)
+	if err != nil {
+		return i, handlePSQLError(Select, err, "select error")
+	}
+	return i, nil
+}
+
+// GetOrganizationIntegrationsForOrganizationID returns the integrations for
+// the given organization id.
+func GetOrganizationIntegrationsForOrganizationID(db sqlx.Queryer, organizationID int64) ([]OrganizationIntegration, error) {
+	var is []OrganizationIntegration
+	err := sqlx.Select(db, &is, `
+		select *
+		from organization_integration
+		where organization_id = $1
+		order by kind`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return is, nil
+}
+
+// UpdateOrganizationIntegration updates the given organization integration.
+func UpdateOrganizationIntegration(db sqlx.Execer, i *OrganizationIntegration) error {
+	now := time.Now()
+	res, err := db.Exec(`
+		update organization_integration
+		set
+			updated_at = $2,
+			settings = $3
+		where
+			id = $1`,
+		i.ID,
+		now,
+		i.Settings,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	i.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":              i.ID,
+		"kind":            i.Kind,
+		"organization_id": i.OrganizationID,
+	}).Info("organization integration updated")
+	return nil
+}
+
+// DeleteOrganizationIntegration deletes the organization integration
+// matching the given id.
+func DeleteOrganizationIntegration(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from organization_integration where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("organization integration deleted")
+	return nil
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/test"
+)
+
+func TestOrganizationIntegration(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Given a clean database with an organization", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		Convey("When creating an organization integration", func() {
+			settings := testIntegrationSettings{
+				URL: "http://foo.bar/",
+				Key: 12345,
+			}
+			intgr := OrganizationIntegration{
+				OrganizationID: org.ID,
+				Kind:           "REST",
+			}
+			intgr.Settings, err = json.Marshal(settings)
+			So(err, ShouldBeNil)
+			So(CreateOrganizationIntegration(db, &intgr), ShouldBeNil)
+
+			Convey("Then it can be retrieved by organization id and kind", func() {
+				i, err := GetOrganizationIntegrationByOrganizationID(db, org.ID, "REST")
+				So(err, ShouldBeNil)
+				So(i.ID, ShouldEqual, intgr.ID)
+
+				var s testIntegrationSettings
+				So(json.Unmarshal(i.Settings, &s), ShouldBeNil)
+				So(s, ShouldResemble, settings)
+			})
+
+			Convey("Then a second integration of the same kind can not be created", func() {
+				i := OrganizationIntegration{
+					OrganizationID: org.ID,
+					Kind:           "REST",
+					Settings:       intgr.Settings,
+				}
+				err := CreateOrganizationIntegration(db, &i)
+				So(errors.Cause(err), ShouldEqual, ErrAlreadyExists)
+			})
+
+			Convey("Then the integrations for the organization can be listed", func() {
+				is, err := GetOrganizationIntegrationsForOrganizationID(db, org.ID)
+				So(err, ShouldBeNil)
+				So(is, ShouldHaveLength, 1)
+				So(is[0].Kind, ShouldEqual, "REST")
+			})
+
+			Convey("Then it can be updated", func() {
+				settings.URL = "http://bar.foo/"
+				intgr.Settings, err = json.Marshal(settings)
+				So(err, ShouldBeNil)
+				So(UpdateOrganizationIntegration(db, &intgr), ShouldBeNil)
+
+				i, err := GetOrganizationIntegrationByOrganizationID(db, org.ID, "REST")
+				So(err, ShouldBeNil)
+				var s testIntegrationSettings
+				So(json.Unmarshal(i.Settings, &s), ShouldBeNil)
+				So(s, ShouldResemble, settings)
+			})
+
+			Convey("Then it can be deleted", func() {
+				So(DeleteOrganizationIntegration(db, intgr.ID), ShouldBeNil)
+				_, err := GetOrganizationIntegrationByOrganizationID(db, org.ID, "REST")
+				So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+			})
+		})
+	})
+}
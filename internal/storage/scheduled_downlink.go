@@ -0,0 +1,443 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/Frankz/lora-app-server/internal/cron"
+	"github.com/Frankz/lorawan"
+)
+
+// EUI64Slice defines a slice of EUI64s, stored as bytea[].
+type EUI64Slice []lorawan.EUI64
+
+// Value implements the driver.Valuer interface.
+func (s EUI64Slice) Value() (driver.Value, error) {
+	arr := make(pq.ByteaArray, 0, len(s))
+	for i := range s {
+		arr = append(arr, s[i][:])
+	}
+	return arr.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *EUI64Slice) Scan(src interface{}) error {
+	var arr pq.ByteaArray
+	if err := arr.Scan(src); err != nil {
+		return errors.Wrap(err, "scan bytea array error")
+	}
+
+	out := make(EUI64Slice, len(arr))
+	for i := range arr {
+		copy(out[i][:], arr[i])
+	}
+	if len(out) == 0 {
+		out = nil
+	}
+	*s = out
+	return nil
+}
+
+// ScheduledDownlinkTarget defines the devices targeted by a scheduled
+// downlink.
+type ScheduledDownlinkTarget string
+
+// Available scheduled downlink targets.
+const (
+	ScheduledDownlinkTargetDevices     ScheduledDownlinkTarget = "DEVICES"
+	ScheduledDownlinkTargetApplication ScheduledDownlinkTarget = "APPLICATION"
+)
+
+// ScheduledDownlink defines a downlink payload which is enqueued at the
+// given timestamp (ScheduleAt) or repeatedly, following the given cron
+// expression (in UTC). Depending the Target, the payload is enqueued for
+// the given DevEUIs or for all devices of the application.
+type ScheduledDownlink struct {
+	ID            string                  `db:"id"`
+	CreatedAt     time.Time               `db:"created_at"`
+	UpdatedAt     time.Time               `db:"updated_at"`
+	Name          string                  `db:"name"`
+	ApplicationID int64                   `db:"application_id"`
+	Target        ScheduledDownlinkTarget `db:"target"`
+	DevEUIs       EUI64Slice              `db:"dev_euis"`
+	FPort         uint8                   `db:"f_port"`
+	Data          []byte                  `db:"data"`
+	Confirmed     bool                    `db:"confirmed"`
+	ScheduleAt    *time.Time              `db:"schedule_at"`
+	Cron          string                  `db:"cron"`
+	NextRunAt     *time.Time              `db:"next_run_at"`
+	LastRunAt     *time.Time              `db:"last_run_at"`
+}
+
+// Validate validates the scheduled downlink data.
+func (sd ScheduledDownlink) Validate() error {
+	if sd.FPort == 0 || sd.FPort > 224 {
+		return ErrScheduledDownlinkInvalidFPort
+	}
+
+	if (sd.ScheduleAt == nil) == (sd.Cron == "") {
+		return ErrScheduledDownlinkInvalidSchedule
+	}
+
+	switch sd.Target {
+	case ScheduledDownlinkTargetDevices:
+		if len(sd.DevEUIs) == 0 {
+			return ErrScheduledDownlinkInvalidTarget
+		}
+	case ScheduledDownlinkTargetApplication:
+		if len(sd.DevEUIs) != 0 {
+			return ErrScheduledDownlinkInvalidTarget
+		}
+	default:
+		return ErrScheduledDownlinkInvalidTarget
+	}
+
+	if sd.Cron != "" {
+		if _, err := cron.Parse(sd.Cron); err != nil {
+			return errors.Wrap(ErrScheduledDownlinkInvalidCron, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// SetNextRunAt sets the next time the scheduled downlink must be enqueued,
+// after the given time. It is set to nil when it will not run again.
+func (sd *ScheduledDownlink) SetNextRunAt(after time.Time) error {
+	sd.NextRunAt = nil
+
+	if sd.ScheduleAt != nil {
+		// a one-time schedule runs once, even when scheduled in the past,
+		// unless it has been re-scheduled after its last run
+		if sd.LastRunAt == nil || sd.ScheduleAt.After(*sd.LastRunAt) {
+			t := *sd.ScheduleAt
+			sd.NextRunAt = &t
+		}
+		return nil
+	}
+
+	s, err := cron.Parse(sd.Cron)
+	if err != nil {
+		return errors.Wrap(ErrScheduledDownlinkInvalidCron, err.Error())
+	}
+
+	if t := s.Next(after.UTC()); !t.IsZero() {
+		sd.NextRunAt = &t
+	}
+
+	return nil
+}
+
+// validateScheduledDownlinkDevices validates that all the devices of
+// the scheduled downlink exist and belong to its application.
+func validateScheduledDownlinkDevices(db sqlx.Queryer, sd ScheduledDownlink) error {
+	if len(sd.DevEUIs) == 0 {
+		return nil
+	}
+
+	var devices []struct {
+		DevEUI        lorawan.EUI64 `db:"dev_eui"`
+		ApplicationID int64         `db:"application_id"`
+	}
+	err := sqlx.Select(db, &devices, `
+		select dev_eui, application_id
+		from device
+		where
+			dev_eui = any($1::bytea[])`,
+		sd.DevEUIs,
+	)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+
+	applicationIDs := make(map[lorawan.EUI64]int64)
+	for _, d := range devices {
+		applicationIDs[d.DevEUI] = d.ApplicationID
+	}
+
+	for _, devEUI := range sd.DevEUIs {
+		applicationID, ok := applicationIDs[devEUI]
+		if !ok {
+			return errors.Wrapf(ErrDoesNotExist, "get device %s error", devEUI)
+		}
+		if applicationID != sd.ApplicationID {
+			return ErrScheduledDownlinkDeviceApplicationMismatch
+		}
+	}
+	return nil
+}
+
+// CreateScheduledDownlink creates the given scheduled downlink.
+func CreateScheduledDownlink(db sqlx.Ext, sd *ScheduledDownlink) error {
+	if err := sd.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	if err := validateScheduledDownlinkDevices(db, *sd); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	sd.ID = uuid.NewV4().String()
+	sd.CreatedAt = now
+	sd.UpdatedAt = now
+	sd.LastRunAt = nil
+
+	if err := sd.SetNextRunAt(now); err != nil {
+		return err
+	}
+
+	_, err := db.Exec(`
+		insert into scheduled_downlink (
+			id,
+			created_at,
+			updated_at,
+			name,
+			application_id,
+			dev_euis,
+			f_port,
+			data,
+			confirmed,
+			schedule_at,
+			cron,
+			next_run_at,
+			last_run_at,
+			target
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		sd.ID,
+		sd.CreatedAt,
+		sd.UpdatedAt,
+		sd.Name,
+		sd.ApplicationID,
+		sd.DevEUIs,
+		sd.FPort,
+		sd.Data,
+		sd.Confirmed,
+		sd.ScheduleAt,
+		sd.Cron,
+		sd.NextRunAt,
+		sd.LastRunAt,
+		sd.Target,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":             sd.ID,
+		"application_id": sd.ApplicationID,
+		"next_run_at":    sd.NextRunAt,
+	}).Info("scheduled downlink created")
+
+	return nil
+}
+
+// GetScheduledDownlink returns the scheduled downlink matching the given id.
+func GetScheduledDownlink(db sqlx.Queryer, id string, forUpdate bool) (ScheduledDownlink, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var sd ScheduledDownlink
+	err := sqlx.Get(db, &sd, "select * from scheduled_downlink where id = $1"+fu, id)
+	if err != nil {
+		return sd, handlePSQLError(Select, err, "select error")
+	}
+
+	return sd, nil
+}
+
+// UpdateScheduledDownlink updates the given scheduled downlink. When the
+// schedule has been changed, the next run is re-calculated.
+func UpdateScheduledDownlink(db sqlx.Ext, sd *ScheduledDownlink) error {
+	if err := sd.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	if err := validateScheduledDownlinkDevices(db, *sd); err != nil {
+		return err
+	}
+
+	sd.UpdatedAt = time.Now()
+
+	if err := sd.SetNextRunAt(sd.UpdatedAt); err != nil {
+		return err
+	}
+
+	res, err := db.Exec(`
+		update scheduled_downlink
+		set
+			updated_at = $2,
+			name = $3,
+			dev_euis = $4,
+			f_port = $5,
+			data = $6,
+			confirmed = $7,
+			schedule_at = $8,
+			cron = $9,
+			next_run_at = $10,
+			target = $11
+		where
+			id = $1`,
+		sd.ID,
+		sd.UpdatedAt,
+		sd.Name,
+		sd.DevEUIs,
+		sd.FPort,
+		sd.Data,
+		sd.Confirmed,
+		sd.ScheduleAt,
+		sd.Cron,
+		sd.NextRunAt,
+		sd.Target,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":          sd.ID,
+		"next_run_at": sd.NextRunAt,
+	}).Info("scheduled downlink updated")
+
+	return nil
+}
+
+// UpdateScheduledDownlinkRun stores the last and next run of the given
+// scheduled downlink.
+func UpdateScheduledDownlinkRun(db sqlx.Execer, sd ScheduledDownlink) error {
+	res, err := db.Exec(`
+		update scheduled_downlink
+		set
+			last_run_at = $2,
+			next_run_at = $3
+		where
+			id = $1`,
+		sd.ID,
+		sd.LastRunAt,
+		sd.NextRunAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	return nil
+}
+
+// DeleteScheduledDownlink deletes the scheduled downlink matching the
+// given id.
+func DeleteScheduledDownlink(db sqlx.Execer, id string) error {
+	res, err := db.Exec("delete from scheduled_downlink where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("scheduled downlink deleted")
+
+	return nil
+}
+
+// GetScheduledDownlinkCountForApplicationID returns the total number of
+// scheduled downlinks for the given application id.
+func GetScheduledDownlinkCountForApplicationID(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from scheduled_downlink where application_id = $1", applicationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetScheduledDownlinksForApplicationID returns a slice of scheduled
+// downlinks for the given application id.
+func GetScheduledDownlinksForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int) ([]ScheduledDownlink, error) {
+	var items []ScheduledDownlink
+	err := sqlx.Select(db, &items, `
+		select *
+		from scheduled_downlink
+		where
+			application_id = $1
+		order by name
+		limit $2 offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// GetDueScheduledDownlinkIDs returns the ids of the scheduled downlinks
+// which must be enqueued at the given time.
+func GetDueScheduledDownlinkIDs(db sqlx.Queryer, t time.Time, limit int) ([]string, error) {
+	var ids []string
+	err := sqlx.Select(db, &ids, `
+		select id
+		from scheduled_downlink
+		where
+			next_run_at <= $1
+		order by next_run_at
+		limit $2`,
+		t,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return ids, nil
+}
+
+// GetDevEUIsForScheduledDownlink returns the DevEUIs of the devices
+// targeted by the given scheduled downlink. Devices which have been removed
+// since the scheduled downlink was created are ignored.
+func GetDevEUIsForScheduledDownlink(db sqlx.Queryer, sd ScheduledDownlink) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		select dev_eui
+		from device
+		where
+			application_id = $1
+			and ($2 or dev_eui = any($3::bytea[]))
+		order by dev_eui`,
+		sd.ApplicationID,
+		sd.Target == ScheduledDownlinkTargetApplication,
+		sd.DevEUIs,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devEUIs, nil
+}
@@ -0,0 +1,210 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/Frankz/lora-app-server/internal/common"
+	"github.com/Frankz/lora-app-server/internal/test"
+	"github.com/Frankz/lorawan"
+)
+
+func TestScheduledDownlink(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.DB = db
+
+	Convey("Given a clean database with two applications and devices", t, func() {
+		test.MustResetDB(common.DB)
+		common.NetworkServerPool = test.NewNetworkServerPool(test.NewNetworkServerClient())
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(common.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(common.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(common.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(common.DB, &dp), ShouldBeNil)
+
+		apps := []Application{
+			{OrganizationID: org.ID, ServiceProfileID: sp.ServiceProfile.ServiceProfileID, Name: "test-app-1"},
+			{OrganizationID: org.ID, ServiceProfileID: sp.ServiceProfile.ServiceProfileID, Name: "test-app-2"},
+		}
+		for i := range apps {
+			So(CreateApplication(common.DB, &apps[i]), ShouldBeNil)
+		}
+
+		devices := []Device{
+			{DevEUI: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1}, ApplicationID: apps[0].ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, Name: "test-device-1"},
+			{DevEUI: lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2}, ApplicationID: apps[0].ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, Name: "test-device-2"},
+			{DevEUI: lorawan.EUI64{3, 3, 3, 3, 3, 3, 3, 3}, ApplicationID: apps[1].ID, DeviceProfileID: dp.DeviceProfile.DeviceProfileID, Name: "test-device-3"},
+		}
+		for i := range devices {
+			So(CreateDevice(common.DB, &devices[i]), ShouldBeNil)
+		}
+
+		scheduleAt := time.Now().Add(time.Hour).UTC().Truncate(time.Millisecond)
+
+		Convey("Then CreateScheduledDownlink validates the scheduled downlink", func() {
+			tests := []struct {
+				Name          string
+				Target        ScheduledDownlinkTarget
+				DevEUIs       EUI64Slice
+				FPort         uint8
+				ScheduleAt    *time.Time
+				Cron          string
+				ExpectedError error
+			}{
+				{"invalid fPort", ScheduledDownlinkTargetApplication, nil, 0, &scheduleAt, "", ErrScheduledDownlinkInvalidFPort},
+				{"no schedule", ScheduledDownlinkTargetApplication, nil, 10, nil, "", ErrScheduledDownlinkInvalidSchedule},
+				{"timestamp and cron", ScheduledDownlinkTargetApplication, nil, 10, &scheduleAt, "* * * * *", ErrScheduledDownlinkInvalidSchedule},
+				{"invalid cron", ScheduledDownlinkTargetApplication, nil, 10, nil, "* * *", ErrScheduledDownlinkInvalidCron},
+				{"no target", "", nil, 10, &scheduleAt, "", ErrScheduledDownlinkInvalidTarget},
+				{"devices target without devices", ScheduledDownlinkTargetDevices, nil, 10, &scheduleAt, "", ErrScheduledDownlinkInvalidTarget},
+				{"application target with devices", ScheduledDownlinkTargetApplication, EUI64Slice{devices[0].DevEUI}, 10, &scheduleAt, "", ErrScheduledDownlinkInvalidTarget},
+				{"device of other application", ScheduledDownlinkTargetDevices, EUI64Slice{devices[0].DevEUI, devices[2].DevEUI}, 10, &scheduleAt, "", ErrScheduledDownlinkDeviceApplicationMismatch},
+				{"unknown device", ScheduledDownlinkTargetDevices, EUI64Slice{devices[0].DevEUI, {8, 8, 8, 8, 8, 8, 8, 8}}, 10, &scheduleAt, "", ErrDoesNotExist},
+			}
+
+			for _, test := range tests {
+				sd := ScheduledDownlink{
+					Name:          test.Name,
+					ApplicationID: apps[0].ID,
+					Target:        test.Target,
+					DevEUIs:       test.DevEUIs,
+					FPort:         test.FPort,
+					ScheduleAt:    test.ScheduleAt,
+					Cron:          test.Cron,
+				}
+				So(errors.Cause(CreateScheduledDownlink(common.DB, &sd)), ShouldEqual, test.ExpectedError)
+			}
+		})
+
+		Convey("When creating a one-time scheduled downlink for a device", func() {
+			sd := ScheduledDownlink{
+				Name:          "test-sd",
+				ApplicationID: apps[0].ID,
+				Target:        ScheduledDownlinkTargetDevices,
+				DevEUIs:       EUI64Slice{devices[1].DevEUI},
+				FPort:         10,
+				Data:          []byte{1, 2, 3},
+				Confirmed:     true,
+				ScheduleAt:    &scheduleAt,
+			}
+			So(CreateScheduledDownlink(common.DB, &sd), ShouldBeNil)
+			So(sd.NextRunAt, ShouldResemble, &scheduleAt)
+
+			Convey("Then GetScheduledDownlink returns the scheduled downlink", func() {
+				sdGet, err := GetScheduledDownlink(common.DB, sd.ID, false)
+				So(err, ShouldBeNil)
+				So(sdGet.Name, ShouldEqual, sd.Name)
+				So(sdGet.Target, ShouldEqual, ScheduledDownlinkTargetDevices)
+				So(sdGet.DevEUIs, ShouldResemble, sd.DevEUIs)
+				So(sdGet.FPort, ShouldEqual, 10)
+				So(sdGet.Data, ShouldResemble, []byte{1, 2, 3})
+				So(sdGet.Confirmed, ShouldBeTrue)
+				So(sdGet.ScheduleAt.Equal(scheduleAt), ShouldBeTrue)
+				So(sdGet.NextRunAt.Equal(scheduleAt), ShouldBeTrue)
+				So(sdGet.LastRunAt, ShouldBeNil)
+			})
+
+			Convey("Then GetDevEUIsForScheduledDownlink returns the device", func() {
+				devEUIs, err := GetDevEUIsForScheduledDownlink(common.DB, sd)
+				So(err, ShouldBeNil)
+				So(devEUIs, ShouldResemble, []lorawan.EUI64{devices[1].DevEUI})
+			})
+
+			Convey("Then it is only due at the scheduled time", func() {
+				ids, err := GetDueScheduledDownlinkIDs(common.DB, time.Now(), 10)
+				So(err, ShouldBeNil)
+				So(ids, ShouldHaveLength, 0)
+
+				ids, err = GetDueScheduledDownlinkIDs(common.DB, scheduleAt, 10)
+				So(err, ShouldBeNil)
+				So(ids, ShouldResemble, []string{sd.ID})
+			})
+
+			Convey("Then the scheduled downlinks are listed for the application", func() {
+				count, err := GetScheduledDownlinkCountForApplicationID(common.DB, apps[0].ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				items, err := GetScheduledDownlinksForApplicationID(common.DB, apps[0].ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].ID, ShouldEqual, sd.ID)
+
+				count, err = GetScheduledDownlinkCountForApplicationID(common.DB, apps[1].ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+
+			Convey("When updating it to a recurring scheduled downlink for the application", func() {
+				sd.ScheduleAt = nil
+				sd.Cron = "0 2 * * *"
+				sd.Target = ScheduledDownlinkTargetApplication
+				sd.DevEUIs = nil
+				So(UpdateScheduledDownlink(common.DB, &sd), ShouldBeNil)
+
+				Convey("Then the next run is re-calculated", func() {
+					sdGet, err := GetScheduledDownlink(common.DB, sd.ID, false)
+					So(err, ShouldBeNil)
+					So(sdGet.Cron, ShouldEqual, "0 2 * * *")
+					So(sdGet.NextRunAt, ShouldNotBeNil)
+					So(sdGet.NextRunAt.UTC().Hour(), ShouldEqual, 2)
+				})
+
+				Convey("Then GetDevEUIsForScheduledDownlink returns all devices of the application", func() {
+					devEUIs, err := GetDevEUIsForScheduledDownlink(common.DB, sd)
+					So(err, ShouldBeNil)
+					So(devEUIs, ShouldResemble, []lorawan.EUI64{devices[0].DevEUI, devices[1].DevEUI})
+				})
+			})
+
+			Convey("When the scheduled downlink has run", func() {
+				now := time.Now()
+				sd.LastRunAt = &now
+				So(sd.SetNextRunAt(now), ShouldBeNil)
+				So(sd.NextRunAt, ShouldBeNil)
+				So(UpdateScheduledDownlinkRun(common.DB, sd), ShouldBeNil)
+
+				Convey("Then re-scheduling it sets the next run", func() {
+					later := now.Add(time.Hour)
+					sd.ScheduleAt = &later
+					So(UpdateScheduledDownlink(common.DB, &sd), ShouldBeNil)
+					So(sd.NextRunAt, ShouldResemble, &later)
+				})
+			})
+
+			Convey("Then DeleteScheduledDownlink deletes the scheduled downlink", func() {
+				So(DeleteScheduledDownlink(common.DB, sd.ID), ShouldBeNil)
+				So(DeleteScheduledDownlink(common.DB, sd.ID), ShouldEqual, ErrDoesNotExist)
+				_, err := GetScheduledDownlink(common.DB, sd.ID, false)
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+		})
+	})
+}
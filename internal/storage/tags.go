@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var tagKeyRegexp = regexp.MustCompile(`^[\w./-]{1,64}$`)
+
+// maxTagValueLength defines the max. length of a tag value.
+const maxTagValueLength = 256
+
+// Tag selector operators.
+const (
+	TagEqual     = "="
+	TagNotEqual  = "!="
+	TagExists    = "exists"
+	TagNotExists = "!exists"
+)
+
+// Tags defines a set of key / value tags of a device, gateway or
+// application.
+type Tags map[string]string
+
+// Value implements the driver.Valuer interface.
+func (t Tags) Value() (driver.Value, error) {
+	if t == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(t)
+}
+
+// Scan implements the sql.Scanner interface. Empty tags are scanned as
+// nil.
+func (t *Tags) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+
+	var tags Tags
+	if err := json.Unmarshal(b, &tags); err != nil {
+		return errors.Wrap(err, "unmarshal tags error")
+	}
+	if len(tags) == 0 {
+		tags = nil
+	}
+	*t = tags
+	return nil
+}
+
+// Validate validates the tags.
+func (t Tags) Validate() error {
+	for k, v := range t {
+		if !tagKeyRegexp.MatchString(k) {
+			return errors.Wrapf(ErrInvalidTag, "key: %s", k)
+		}
+		if len(v) > maxTagValueLength || strings.Contains(v, ",") {
+			return errors.Wrapf(ErrInvalidTag, "value of key: %s", k)
+		}
+	}
+	return nil
+}
+
+// Merge returns the union of both sets of tags. On conflicting keys,
+// the value of the given tags takes precedence. Nil is returned when
+// both sets are empty.
+func (t Tags) Merge(tags Tags) Tags {
+	if len(t) == 0 && len(tags) == 0 {
+		return nil
+	}
+
+	out := make(Tags, len(t)+len(tags))
+	for k, v := range t {
+		out[k] = v
+	}
+	for k, v := range tags {
+		out[k] = v
+	}
+	return out
+}
+
+// TagRequirement defines a single requirement of a tag selector.
+type TagRequirement struct {
+	Key      string `json:"key"`
+	Operator string `json:"operator"`
+	Value    string `json:"value,omitempty"`
+}
+
+// TagSelector defines a set of tag requirements which must all be met.
+// An empty selector matches everything.
+type TagSelector []TagRequirement
+
+// ParseTagSelector parses the given selector expression. Requirements
+// are separated by a comma and are formatted as key=value, key!=value,
+// key (tag exists) or !key (tag does not exist),
+// e.g. "site=berlin,floor!=3".
+func ParseTagSelector(s string) (TagSelector, error) {
+	var sel TagSelector
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var req TagRequirement
+		switch {
+		case strings.Contains(part, TagNotEqual):
+			kv := strings.SplitN(part, TagNotEqual, 2)
+			req = TagRequirement{Key: kv[0], Operator: TagNotEqual, Value: kv[1]}
+		case strings.Contains(part, TagEqual):
+			kv := strings.SplitN(part, TagEqual, 2)
+			req = TagRequirement{Key: kv[0], Operator: TagEqual, Value: kv[1]}
+		case strings.HasPrefix(part, "!"):
+			req = TagRequirement{Key: part[1:], Operator: TagNotExists}
+		default:
+			req = TagRequirement{Key: part, Operator: TagExists}
+		}
+
+		req.Key = strings.TrimSpace(req.Key)
+		req.Value = strings.TrimSpace(req.Value)
+		if !tagKeyRegexp.MatchString(req.Key) {
+			return nil, errors.Wrapf(ErrInvalidTagSelector, "key: %s", req.Key)
+		}
+
+		sel = append(sel, req)
+	}
+
+	return sel, nil
+}
+
+// tagSelectorFilter holds the requirements of a tag selector, grouped by
+// operator, as used by the SQL filter returned by tagsFilter.
+type tagSelectorFilter struct {
+	Equal     map[string]string   `json:"equal"`
+	NotEqual  []map[string]string `json:"notEqual"`
+	Exists    []string            `json:"exists"`
+	NotExists []string            `json:"notExists"`
+}
+
+// Value implements the driver.Valuer interface. The selector is matched
+// by the SQL filter returned by tagsFilter.
+func (s TagSelector) Value() (driver.Value, error) {
+	f := tagSelectorFilter{
+		Equal:     make(map[string]string),
+		NotEqual:  []map[string]string{},
+		Exists:    []string{},
+		NotExists: []string{},
+	}
+
+	for _, req := range s {
+		switch req.Operator {
+		case TagEqual:
+			if v, ok := f.Equal[req.Key]; ok && v != req.Value {
+				// a tag can not have two values, nothing matches
+				f.Exists = append(f.Exists, req.Key)
+				f.NotExists = append(f.NotExists, req.Key)
+				continue
+			}
+			f.Equal[req.Key] = req.Value
+		case TagNotEqual:
+			f.NotEqual = append(f.NotEqual, map[string]string{req.Key: req.Value})
+		case TagExists:
+			f.Exists = append(f.Exists, req.Key)
+		case TagNotExists:
+			f.NotExists = append(f.NotExists, req.Key)
+		default:
+			return nil, errors.Wrapf(ErrInvalidTagSelector, "operator: %s", req.Operator)
+		}
+	}
+
+	return json.Marshal(f)
+}
+
+// tagsFilter returns the SQL filter matching the given jsonb tags column
+// against the tag selector passed as the given query parameter. The filter
+// only uses the @>, ?& and ?| operators, so that the GIN index on the tags
+// column can be used.
+func tagsFilter(column string, param int) string {
+	return fmt.Sprintf(`%[1]s @> ($%[2]d::jsonb->'equal')
+			and %[1]s ?& array(select jsonb_array_elements_text($%[2]d::jsonb->'exists'))
+			and not %[1]s ?| array(select jsonb_array_elements_text($%[2]d::jsonb->'notExists'))
+			and not %[1]s @> any(array(select jsonb_array_elements($%[2]d::jsonb->'notEqual')))`, column, param)
+}
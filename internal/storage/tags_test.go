@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTags(t *testing.T) {
+	Convey("Given a set of tags", t, func() {
+		tags := Tags{
+			"site":  "berlin",
+			"floor": "3",
+		}
+
+		Convey("Then Validate returns nil", func() {
+			So(tags.Validate(), ShouldBeNil)
+		})
+
+		Convey("Then Validate returns ErrInvalidTag for invalid keys or values", func() {
+			for _, invalid := range []Tags{
+				{"": "a"},
+				{"a b": "a"},
+				{strings.Repeat("a", 65): "a"},
+				{"a": "b,c"},
+				{"a": strings.Repeat("a", 257)},
+			} {
+				So(errors.Cause(invalid.Validate()), ShouldEqual, ErrInvalidTag)
+			}
+		})
+
+		Convey("Then Merge returns the union with the given tags taking precedence", func() {
+			So(tags.Merge(Tags{"floor": "4", "room": "a"}), ShouldResemble, Tags{
+				"site":  "berlin",
+				"floor": "4",
+				"room":  "a",
+			})
+			So(Tags(nil).Merge(nil), ShouldBeNil)
+		})
+	})
+
+	Convey("Given a set of tag selector tests", t, func() {
+		tests := []struct {
+			Selector string
+			Expected TagSelector
+		}{
+			{
+				Selector: "",
+			},
+			{
+				Selector: "site=berlin, floor!=4",
+				Expected: TagSelector{
+					{Key: "site", Operator: TagEqual, Value: "berlin"},
+					{Key: "floor", Operator: TagNotEqual, Value: "4"},
+				},
+			},
+			{
+				Selector: "floor!=3",
+				Expected: TagSelector{
+					{Key: "floor", Operator: TagNotEqual, Value: "3"},
+				},
+			},
+			{
+				Selector: "site,!room",
+				Expected: TagSelector{
+					{Key: "site", Operator: TagExists},
+					{Key: "room", Operator: TagNotExists},
+				},
+			},
+			{
+				Selector: "room",
+				Expected: TagSelector{
+					{Key: "room", Operator: TagExists},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Selector, func() {
+				sel, err := ParseTagSelector(test.Selector)
+				So(err, ShouldBeNil)
+				So(sel, ShouldResemble, test.Expected)
+			})
+		}
+
+		Convey("Then Value groups the requirements by operator", func() {
+			sel, err := ParseTagSelector("site=berlin,floor!=3,room,!rack")
+			So(err, ShouldBeNil)
+			v, err := sel.Value()
+			So(err, ShouldBeNil)
+			So(string(v.([]byte)), ShouldEqual, `{"equal":{"site":"berlin"},"notEqual":[{"floor":"3"}],"exists":["room"],"notExists":["rack"]}`)
+		})
+
+		Convey("Then Value of an empty selector matches everything", func() {
+			v, err := TagSelector(nil).Value()
+			So(err, ShouldBeNil)
+			So(string(v.([]byte)), ShouldEqual, `{"equal":{},"notEqual":[],"exists":[],"notExists":[]}`)
+		})
+
+		Convey("Then an invalid key returns ErrInvalidTagSelector", func() {
+			_, err := ParseTagSelector("site=berlin,=3")
+			So(errors.Cause(err), ShouldEqual, ErrInvalidTagSelector)
+		})
+	})
+}
@@ -227,6 +227,7 @@ type NetworkServerClient struct {
 
 	CreateDeviceQueueItemChan     chan ns.CreateDeviceQueueItemRequest
 	CreateDeviceQueueItemResponse ns.CreateDeviceQueueItemResponse
+	CreateDeviceQueueItemError    error
 
 	FlushDeviceQueueForDevEUIChan     chan ns.FlushDeviceQueueForDevEUIRequest
 	FlushDeviceQueueForDevEUIResponse ns.FlushDeviceQueueForDevEUIResponse
@@ -236,6 +237,25 @@ type NetworkServerClient struct {
 
 	GetNextDownlinkFCntForDevEUIChan     chan ns.GetNextDownlinkFCntForDevEUIRequest
 	GetNextDownlinkFCntForDevEUIResponse ns.GetNextDownlinkFCntForDevEUIResponse
+
+	CreateMulticastGroupChan     chan ns.CreateMulticastGroupRequest
+	CreateMulticastGroupResponse ns.CreateMulticastGroupResponse
+
+	UpdateMulticastGroupChan     chan ns.UpdateMulticastGroupRequest
+	UpdateMulticastGroupResponse ns.UpdateMulticastGroupResponse
+
+	DeleteMulticastGroupChan     chan ns.DeleteMulticastGroupRequest
+	DeleteMulticastGroupResponse ns.DeleteMulticastGroupResponse
+
+	AddDeviceToMulticastGroupChan     chan ns.AddDeviceToMulticastGroupRequest
+	AddDeviceToMulticastGroupResponse ns.AddDeviceToMulticastGroupResponse
+
+	RemoveDeviceFromMulticastGroupChan     chan ns.RemoveDeviceFromMulticastGroupRequest
+	RemoveDeviceFromMulticastGroupResponse ns.RemoveDeviceFromMulticastGroupResponse
+
+	EnqueueMulticastQueueItemChan     chan ns.EnqueueMulticastQueueItemRequest
+	EnqueueMulticastQueueItemResponse ns.EnqueueMulticastQueueItemResponse
+	EnqueueMulticastQueueItemError    error
 }
 
 // NewNetworkServerClient creates a new NetworkServerClient.
@@ -284,6 +304,12 @@ func NewNetworkServerClient() *NetworkServerClient {
 		CreateDeviceQueueItemChan:                     make(chan ns.CreateDeviceQueueItemRequest, 100),
 		FlushDeviceQueueForDevEUIChan:                 make(chan ns.FlushDeviceQueueForDevEUIRequest, 100),
 		GetDeviceQueueItemsForDevEUIChan:              make(chan ns.GetDeviceQueueItemsForDevEUIRequest, 100),
+		CreateMulticastGroupChan:                      make(chan ns.CreateMulticastGroupRequest, 100),
+		UpdateMulticastGroupChan:                      make(chan ns.UpdateMulticastGroupRequest, 100),
+		DeleteMulticastGroupChan:                      make(chan ns.DeleteMulticastGroupRequest, 100),
+		AddDeviceToMulticastGroupChan:                 make(chan ns.AddDeviceToMulticastGroupRequest, 100),
+		RemoveDeviceFromMulticastGroupChan:            make(chan ns.RemoveDeviceFromMulticastGroupRequest, 100),
+		EnqueueMulticastQueueItemChan:                 make(chan ns.EnqueueMulticastQueueItemRequest, 100),
 	}
 }
 
@@ -529,7 +555,7 @@ func (n *NetworkServerClient) MigrateNodeToDeviceSession(ctx context.Context, in
 // CreateDeviceQueueItem method.
 func (n NetworkServerClient) CreateDeviceQueueItem(ctx context.Context, in *ns.CreateDeviceQueueItemRequest, opts ...grpc.CallOption) (*ns.CreateDeviceQueueItemResponse, error) {
 	n.CreateDeviceQueueItemChan <- *in
-	return &n.CreateDeviceQueueItemResponse, nil
+	return &n.CreateDeviceQueueItemResponse, n.CreateDeviceQueueItemError
 }
 
 // FlushDeviceQueueForDevEUI method.
@@ -549,3 +575,39 @@ func (n NetworkServerClient) GetNextDownlinkFCntForDevEUI(ctx context.Context, i
 	n.GetNextDownlinkFCntForDevEUIChan <- *in
 	return &n.GetNextDownlinkFCntForDevEUIResponse, nil
 }
+
+// CreateMulticastGroup method.
+func (n *NetworkServerClient) CreateMulticastGroup(ctx context.Context, in *ns.CreateMulticastGroupRequest, opts ...grpc.CallOption) (*ns.CreateMulticastGroupResponse, error) {
+	n.CreateMulticastGroupChan <- *in
+	return &n.CreateMulticastGroupResponse, nil
+}
+
+// UpdateMulticastGroup method.
+func (n *NetworkServerClient) UpdateMulticastGroup(ctx context.Context, in *ns.UpdateMulticastGroupRequest, opts ...grpc.CallOption) (*ns.UpdateMulticastGroupResponse, error) {
+	n.UpdateMulticastGroupChan <- *in
+	return &n.UpdateMulticastGroupResponse, nil
+}
+
+// DeleteMulticastGroup method.
+func (n *NetworkServerClient) DeleteMulticastGroup(ctx context.Context, in *ns.DeleteMulticastGroupRequest, opts ...grpc.CallOption) (*ns.DeleteMulticastGroupResponse, error) {
+	n.DeleteMulticastGroupChan <- *in
+	return &n.DeleteMulticastGroupResponse, nil
+}
+
+// AddDeviceToMulticastGroup method.
+func (n *NetworkServerClient) AddDeviceToMulticastGroup(ctx context.Context, in *ns.AddDeviceToMulticastGroupRequest, opts ...grpc.CallOption) (*ns.AddDeviceToMulticastGroupResponse, error) {
+	n.AddDeviceToMulticastGroupChan <- *in
+	return &n.AddDeviceToMulticastGroupResponse, nil
+}
+
+// RemoveDeviceFromMulticastGroup method.
+func (n *NetworkServerClient) RemoveDeviceFromMulticastGroup(ctx context.Context, in *ns.RemoveDeviceFromMulticastGroupRequest, opts ...grpc.CallOption) (*ns.RemoveDeviceFromMulticastGroupResponse, error) {
+	n.RemoveDeviceFromMulticastGroupChan <- *in
+	return &n.RemoveDeviceFromMulticastGroupResponse, nil
+}
+
+// EnqueueMulticastQueueItem method.
+func (n *NetworkServerClient) EnqueueMulticastQueueItem(ctx context.Context, in *ns.EnqueueMulticastQueueItemRequest, opts ...grpc.CallOption) (*ns.EnqueueMulticastQueueItemResponse, error) {
+	n.EnqueueMulticastQueueItemChan <- *in
+	return &n.EnqueueMulticastQueueItemResponse, n.EnqueueMulticastQueueItemError
+}
@@ -8,7 +8,10 @@ type TestHandler struct {
 	SendJoinNotificationChan  chan handler.JoinNotification
 	SendACKNotificationChan   chan handler.ACKNotification
 	SendErrorNotificationChan chan handler.ErrorNotification
+	SendAlertNotificationChan chan handler.AlertNotification
 	DataDownPayloadChan       chan handler.DataDownPayload
+
+	SendGatewayStatusNotificationChan chan handler.GatewayStatusNotification
 }
 
 func NewTestHandler() *TestHandler {
@@ -17,7 +20,10 @@ func NewTestHandler() *TestHandler {
 		SendJoinNotificationChan:  make(chan handler.JoinNotification, 100),
 		SendACKNotificationChan:   make(chan handler.ACKNotification, 100),
 		SendErrorNotificationChan: make(chan handler.ErrorNotification, 100),
+		SendAlertNotificationChan: make(chan handler.AlertNotification, 100),
 		DataDownPayloadChan:       make(chan handler.DataDownPayload, 100),
+
+		SendGatewayStatusNotificationChan: make(chan handler.GatewayStatusNotification, 100),
 	}
 }
 
@@ -45,6 +51,16 @@ func (t *TestHandler) SendErrorNotification(payload handler.ErrorNotification) e
 	return nil
 }
 
+func (t *TestHandler) SendAlertNotification(payload handler.AlertNotification) error {
+	t.SendAlertNotificationChan <- payload
+	return nil
+}
+
+func (t *TestHandler) SendGatewayStatusNotification(payload handler.GatewayStatusNotification) error {
+	t.SendGatewayStatusNotificationChan <- payload
+	return nil
+}
+
 func (t *TestHandler) DataDownChan() chan handler.DataDownPayload {
 	return t.DataDownPayloadChan
 }
@@ -0,0 +1,15 @@
+-- +migrate Up
+create table device_event (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    dev_eui bytea references device on delete cascade not null,
+    type varchar(10) not null,
+    f_port int null,
+    payload jsonb not null
+);
+
+create index device_event_dev_eui_created_at on device_event(dev_eui, created_at);
+
+-- +migrate Down
+drop index device_event_dev_eui_created_at;
+drop table device_event;
@@ -0,0 +1,45 @@
+-- +migrate Up
+create table integration_queue_item (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	integration_id bigint not null references integration on delete cascade,
+	event varchar(10) not null,
+	payload bytea not null,
+	attempts integer not null default 0,
+	retry_after timestamp with time zone not null,
+	last_error text not null default ''
+);
+
+create index idx_integration_queue_item_integration_id on integration_queue_item(integration_id);
+create index idx_integration_queue_item_retry_after on integration_queue_item(retry_after);
+
+create table integration_dead_letter (
+	id bigserial primary key,
+	created_at timestamp with time zone not null,
+	failed_at timestamp with time zone not null,
+	integration_id bigint not null references integration on delete cascade,
+	event varchar(10) not null,
+	payload bytea not null,
+	attempts integer not null,
+	last_error text not null
+);
+
+create index idx_integration_dead_letter_integration_id_failed_at on integration_dead_letter(integration_id, failed_at);
+
+create table integration_delivery_status (
+	integration_id bigint primary key references integration on delete cascade,
+	last_success_at timestamp with time zone null,
+	last_failure_at timestamp with time zone null,
+	last_error text not null default '',
+	failure_count integer not null default 0
+);
+
+-- +migrate Down
+drop table integration_delivery_status;
+
+drop index idx_integration_dead_letter_integration_id_failed_at;
+drop table integration_dead_letter;
+
+drop index idx_integration_queue_item_retry_after;
+drop index idx_integration_queue_item_integration_id;
+drop table integration_queue_item;
@@ -0,0 +1,9 @@
+-- +migrate Up
+create table device_codec_state (
+    dev_eui bytea primary key references device on delete cascade,
+    updated_at timestamp with time zone not null,
+    state jsonb not null
+);
+
+-- +migrate Down
+drop table device_codec_state;
@@ -0,0 +1,27 @@
+-- +migrate Up
+alter table device
+    add column tags jsonb not null default '{}';
+
+alter table gateway
+    add column tags jsonb not null default '{}';
+
+alter table application
+    add column tags jsonb not null default '{}';
+
+create index idx_device_tags on device using gin (tags);
+create index idx_gateway_tags on gateway using gin (tags);
+create index idx_application_tags on application using gin (tags);
+
+-- +migrate Down
+drop index idx_application_tags;
+drop index idx_gateway_tags;
+drop index idx_device_tags;
+
+alter table application
+    drop column tags;
+
+alter table gateway
+    drop column tags;
+
+alter table device
+    drop column tags;
@@ -0,0 +1,35 @@
+-- +migrate Up
+create table multicast_group (
+    id uuid primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    name varchar(100) not null,
+    service_profile_id uuid not null references service_profile on delete cascade,
+    mc_addr bytea not null,
+    mc_nwk_s_key bytea not null,
+    mc_app_s_key bytea not null,
+    f_cnt bigint not null default 0
+);
+
+create index idx_multicast_group_service_profile_id on multicast_group(service_profile_id);
+create index idx_multicast_group_created_at on multicast_group(created_at);
+create index idx_multicast_group_updated_at on multicast_group(updated_at);
+
+create table device_multicast_group (
+    multicast_group_id uuid not null references multicast_group on delete cascade,
+    dev_eui bytea not null references device on delete cascade,
+    created_at timestamp with time zone not null,
+
+    primary key (multicast_group_id, dev_eui)
+);
+
+create index idx_device_multicast_group_dev_eui on device_multicast_group(dev_eui);
+
+-- +migrate Down
+drop index idx_device_multicast_group_dev_eui;
+drop table device_multicast_group;
+
+drop index idx_multicast_group_updated_at;
+drop index idx_multicast_group_created_at;
+drop index idx_multicast_group_service_profile_id;
+drop table multicast_group;
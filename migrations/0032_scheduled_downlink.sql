@@ -0,0 +1,29 @@
+-- +migrate Up
+create table scheduled_downlink (
+    id uuid primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    name varchar(100) not null,
+    application_id bigint not null references application on delete cascade,
+    target varchar(20) not null,
+    dev_euis bytea[] not null default '{}',
+    f_port smallint not null,
+    data bytea not null,
+    confirmed boolean not null default false,
+    schedule_at timestamp with time zone,
+    cron varchar(100) not null default '',
+    next_run_at timestamp with time zone,
+    last_run_at timestamp with time zone
+);
+
+create index idx_scheduled_downlink_application_id on scheduled_downlink(application_id);
+create index idx_scheduled_downlink_next_run_at on scheduled_downlink(next_run_at);
+create index idx_scheduled_downlink_created_at on scheduled_downlink(created_at);
+create index idx_scheduled_downlink_updated_at on scheduled_downlink(updated_at);
+
+-- +migrate Down
+drop index idx_scheduled_downlink_updated_at;
+drop index idx_scheduled_downlink_created_at;
+drop index idx_scheduled_downlink_next_run_at;
+drop index idx_scheduled_downlink_application_id;
+drop table scheduled_downlink;
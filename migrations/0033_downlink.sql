@@ -0,0 +1,80 @@
+-- +migrate Up
+create table downlink (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    dev_eui bytea not null references device on delete cascade,
+    reference text not null,
+    f_cnt bigint not null,
+    f_port smallint not null,
+    confirmed boolean not null,
+    status varchar(10) not null,
+    sent_at timestamp with time zone,
+    acked_at timestamp with time zone,
+    expired_at timestamp with time zone
+);
+
+create index idx_downlink_dev_eui_f_cnt on downlink(dev_eui, f_cnt);
+create index idx_downlink_status on downlink(status);
+create index idx_downlink_created_at on downlink(created_at);
+create index idx_downlink_updated_at on downlink(updated_at);
+
+-- the device-queue mappings only existed for pending confirmed downlinks
+insert into downlink (
+    created_at,
+    updated_at,
+    dev_eui,
+    reference,
+    f_cnt,
+    f_port,
+    confirmed,
+    status
+)
+select
+    created_at,
+    created_at,
+    dev_eui,
+    reference,
+    f_cnt,
+    0,
+    true,
+    'QUEUED'
+from device_queue_mapping;
+
+drop index device_queue_mapping_dev_eui;
+drop index device_queue_mapping_created_at;
+drop table device_queue_mapping;
+
+-- +migrate Down
+create table device_queue_mapping (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    reference text not null,
+    dev_eui bytea references device on delete cascade not null,
+    f_cnt int not null
+);
+
+create index device_queue_mapping_created_at on device_queue_mapping(created_at);
+create index device_queue_mapping_dev_eui on device_queue_mapping(dev_eui);
+
+insert into device_queue_mapping (
+    created_at,
+    reference,
+    dev_eui,
+    f_cnt
+)
+select
+    created_at,
+    reference,
+    dev_eui,
+    f_cnt
+from downlink
+where
+    confirmed = true
+    and status in ('QUEUED', 'SENT');
+
+drop index idx_downlink_updated_at;
+drop index idx_downlink_created_at;
+drop index idx_downlink_status;
+drop index idx_downlink_dev_eui_f_cnt;
+drop table downlink;
@@ -0,0 +1,55 @@
+-- +migrate Up
+create table fuota_session (
+    id uuid primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    name varchar(100) not null,
+    organization_id bigint not null references organization on delete cascade,
+    dev_eui bytea references device on delete cascade,
+    multicast_group_id uuid references multicast_group on delete cascade,
+    frag_index smallint not null,
+    frag_size smallint not null,
+    redundancy integer not null,
+    block_ack_delay smallint not null,
+    descriptor bytea not null,
+    payload bytea not null,
+    unicast_timeout bigint not null,
+    session_timeout bigint not null,
+    state varchar(20) not null,
+    next_step_at timestamp with time zone
+);
+
+create index idx_fuota_session_organization_id on fuota_session(organization_id);
+create index idx_fuota_session_dev_eui on fuota_session(dev_eui);
+create index idx_fuota_session_multicast_group_id on fuota_session(multicast_group_id);
+create index idx_fuota_session_next_step_at on fuota_session(next_step_at);
+create index idx_fuota_session_created_at on fuota_session(created_at);
+create index idx_fuota_session_updated_at on fuota_session(updated_at);
+
+create table fuota_session_device (
+    fuota_session_id uuid not null references fuota_session on delete cascade,
+    dev_eui bytea not null references device on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    state varchar(20) not null,
+    error_message text not null default '',
+    nb_frag_received integer not null default 0,
+    missing_frag integer not null default 0,
+    primary key (fuota_session_id, dev_eui)
+);
+
+create index idx_fuota_session_device_dev_eui on fuota_session_device(dev_eui);
+create index idx_fuota_session_device_state on fuota_session_device(state);
+
+-- +migrate Down
+drop index idx_fuota_session_device_state;
+drop index idx_fuota_session_device_dev_eui;
+drop table fuota_session_device;
+
+drop index idx_fuota_session_updated_at;
+drop index idx_fuota_session_created_at;
+drop index idx_fuota_session_next_step_at;
+drop index idx_fuota_session_multicast_group_id;
+drop index idx_fuota_session_dev_eui;
+drop index idx_fuota_session_organization_id;
+drop table fuota_session;
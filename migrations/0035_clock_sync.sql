@@ -0,0 +1,18 @@
+-- +migrate Up
+alter table application
+    add column clock_sync_enabled boolean not null default false,
+    add column clock_sync_resync_interval bigint not null default 0;
+
+create table device_clock_sync (
+    dev_eui bytea primary key references device on delete cascade,
+    synced_at timestamp with time zone,
+    time_correction integer not null default 0,
+    forced_at timestamp with time zone
+);
+
+-- +migrate Down
+drop table device_clock_sync;
+
+alter table application
+    drop column clock_sync_resync_interval,
+    drop column clock_sync_enabled;
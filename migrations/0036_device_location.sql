@@ -0,0 +1,23 @@
+-- +migrate Up
+alter table device
+    add column latitude double precision,
+    add column longitude double precision,
+    add column altitude double precision,
+    add column location_accuracy double precision,
+    add column location_source varchar(20) not null default '',
+    add column location_updated_at timestamp with time zone;
+
+alter table gateway
+    add column fine_timestamp boolean not null default false;
+
+-- +migrate Down
+alter table gateway
+    drop column fine_timestamp;
+
+alter table device
+    drop column location_updated_at,
+    drop column location_source,
+    drop column location_accuracy,
+    drop column altitude,
+    drop column longitude,
+    drop column latitude;
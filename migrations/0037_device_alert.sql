@@ -0,0 +1,41 @@
+-- +migrate Up
+alter table application
+    add column alert_uplink_interval bigint not null default 0,
+    add column alert_battery_threshold integer not null default 0,
+    add column alert_margin_threshold integer;
+
+alter table device_profile
+    add column alert_uplink_interval bigint not null default 0,
+    add column alert_battery_threshold integer not null default 0,
+    add column alert_margin_threshold integer;
+
+create table device_alert (
+    id uuid primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    dev_eui bytea not null references device on delete cascade,
+    type varchar(20) not null,
+    state varchar(10) not null,
+    message text not null default '',
+    resolved_at timestamp with time zone
+);
+
+create index idx_device_alert_dev_eui on device_alert(dev_eui);
+create index idx_device_alert_created_at on device_alert(created_at);
+create unique index idx_device_alert_dev_eui_type_open on device_alert(dev_eui, type) where state = 'OPEN';
+
+-- +migrate Down
+drop index idx_device_alert_dev_eui_type_open;
+drop index idx_device_alert_created_at;
+drop index idx_device_alert_dev_eui;
+drop table device_alert;
+
+alter table device_profile
+    drop column alert_margin_threshold,
+    drop column alert_battery_threshold,
+    drop column alert_uplink_interval;
+
+alter table application
+    drop column alert_margin_threshold,
+    drop column alert_battery_threshold,
+    drop column alert_uplink_interval;
@@ -0,0 +1,27 @@
+-- +migrate Up
+alter table gateway
+    add column last_seen_at timestamp with time zone,
+    add column status varchar(10) not null default 'UNKNOWN',
+    add column status_changed_at timestamp with time zone;
+
+create table organization_integration (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    organization_id bigint not null references organization on delete cascade,
+    kind varchar(20) not null,
+    settings jsonb,
+
+    constraint organization_integration_kind_organization_id unique (kind, organization_id)
+);
+
+create index idx_organization_integration_organization_id on organization_integration(organization_id);
+
+-- +migrate Down
+drop index idx_organization_integration_organization_id;
+drop table organization_integration;
+
+alter table gateway
+    drop column status_changed_at,
+    drop column status,
+    drop column last_seen_at;
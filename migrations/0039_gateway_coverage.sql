@@ -0,0 +1,21 @@
+-- +migrate Up
+create table gateway_coverage (
+    geohash varchar(12) not null,
+    gateway_mac bytea not null references gateway on delete cascade,
+    updated_at timestamp with time zone not null,
+    count bigint not null,
+    rssi_min integer not null,
+    rssi_max integer not null,
+    rssi_sum bigint not null,
+    lora_snr_min decimal(3,1) not null,
+    lora_snr_max decimal(3,1) not null,
+    lora_snr_sum double precision not null,
+
+    primary key (geohash, gateway_mac)
+);
+
+create index idx_gateway_coverage_gateway_mac on gateway_coverage(gateway_mac);
+
+-- +migrate Down
+drop index idx_gateway_coverage_gateway_mac;
+drop table gateway_coverage;
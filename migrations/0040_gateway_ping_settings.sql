@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table gateway
+    add column ping_interval bigint not null default 0,
+    add column ping_frequency integer not null default 0,
+    add column ping_dr integer;
+
+-- +migrate Down
+alter table gateway
+    drop column ping_dr,
+    drop column ping_frequency,
+    drop column ping_interval;
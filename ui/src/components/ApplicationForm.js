@@ -86,7 +86,9 @@ class ApplicationForm extends Component {
     const payloadCodecOptions = [
       {value: "", label: "None"},
       {value: "CAYENNE_LPP", label: "Cayenne LPP"},
+      {value: "CAYENNE_LPP_LENIENT", label: "Cayenne LPP (lenient)"},
       {value: "CUSTOM_JS", label: "Custom JavaScript codec functions"},
+      {value: "SCHEMA", label: "Binary schema"},
     ];
 
     const codeMirrorOptions = {
@@ -157,6 +159,18 @@ function Decode(fPort, bytes) {
             By defining a payload codec, LoRa App Server can encode and decode the binary device payload for you.
           </p>
         </div>
+        <div className={"form-group " + (this.state.application.payloadCodec === "SCHEMA" ? "" : "hidden")}>
+          <label className="control-label" htmlFor="payloadSchema">Payload schema</label>
+          <CodeMirror
+            value={this.state.application.payloadDecoderScript || ''}
+            options={Object.assign({}, codeMirrorOptions, {mode: {name: "javascript", json: true}})}
+            onBeforeChange={this.onCodeChange.bind(this, 'payloadDecoderScript')}
+          />
+          <p className="help-block">
+            The JSON schema describing the payload fields by offset, length, endianness, signedness, scaling and bit-fields,
+            optionally per fPort. The schema is used for both decoding and encoding.
+          </p>
+        </div>
         <div className={"form-group " + (this.state.application.payloadCodec === "CUSTOM_JS" ? "" : "hidden")}>
           <label className="control-label" htmlFor="payloadDecoderScript">Payload decoder function</label>
           <CodeMirror
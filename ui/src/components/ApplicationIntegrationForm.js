@@ -120,6 +120,10 @@ class ApplicationHTTPIntegrationForm extends Component {
             <label className="control-label" htmlFor="errorNotificationURL">Error notification URL</label>
             <input className="form-control" id="errorNotificationURL" name="errorNotificationURL" type="text" placeholder="http://example.com/error" value={this.props.integration.errorNotificationURL || ''} onChange={this.onChange.bind(this, 'errorNotificationURL')} />
           </div>
+          <div className="form-group">
+            <label className="control-label" htmlFor="alertNotificationURL">Alert notification URL</label>
+            <input className="form-control" id="alertNotificationURL" name="alertNotificationURL" type="text" placeholder="http://example.com/alert" value={this.props.integration.alertNotificationURL || ''} onChange={this.onChange.bind(this, 'alertNotificationURL')} />
+          </div>
         </fieldset>
       </div>
     );
@@ -2,10 +2,12 @@ import React, { Component } from 'react';
 import { Link, withRouter } from 'react-router-dom';
 
 import Select from "react-select";
+import {Controlled as CodeMirror} from "react-codemirror2";
 
 import Loaded from "./Loaded.js";
 import NetworkServerStore from "../stores/NetworkServerStore";
 import SessionStore from "../stores/SessionStore";
+import "codemirror/mode/javascript/javascript";
 
 
 class DeviceProfileForm extends Component {
@@ -102,6 +104,14 @@ class DeviceProfileForm extends Component {
     });
   }
 
+  onCodeChange(field, editor, data, newCode) {
+    let deviceProfile = this.state.deviceProfile;
+    deviceProfile[field] = newCode;
+    this.setState({
+      deviceProfile: deviceProfile,
+    });
+  }
+
   changeTab(e) {
     e.preventDefault();
     this.setState({
@@ -129,6 +139,43 @@ class DeviceProfileForm extends Component {
       {value: "B", label: "B"},
     ];
 
+    const payloadCodecOptions = [
+      {value: "", label: "None (use application codec)"},
+      {value: "CAYENNE_LPP", label: "Cayenne LPP"},
+      {value: "CAYENNE_LPP_LENIENT", label: "Cayenne LPP (lenient)"},
+      {value: "CUSTOM_JS", label: "Custom JavaScript codec functions"},
+      {value: "SCHEMA", label: "Binary schema"},
+    ];
+
+    const codeMirrorOptions = {
+      lineNumbers: true,
+      mode: "javascript",
+      theme: 'base16-light',
+    };
+
+    let payloadEncoderScript = this.state.deviceProfile.payloadEncoderScript;
+    let payloadDecoderScript = this.state.deviceProfile.payloadDecoderScript;
+
+    if (payloadEncoderScript === "" || payloadEncoderScript === undefined) {
+      payloadEncoderScript = `// Encode encodes the given object into an array of bytes.
+//  - fPort contains the LoRaWAN fPort number
+//  - obj is an object, e.g. {"temperature": 22.5}
+// The function must return an array of bytes, e.g. [225, 230, 255, 0]
+function Encode(fPort, obj) {
+  return [];
+}`;
+    }
+
+    if (payloadDecoderScript === "" || payloadDecoderScript === undefined) {
+      payloadDecoderScript = `// Decode decodes an array of bytes into an object.
+//  - fPort contains the LoRaWAN fPort number
+//  - bytes is an array of bytes, e.g. [225, 230, 255, 0]
+// The function must return an object, e.g. {"temperature": 22.5}
+function Decode(fPort, bytes) {
+  return {};
+}`;
+    }
+
     return(
       <Loaded loaded={this.state.loaded}>
         <div>
@@ -136,6 +183,7 @@ class DeviceProfileForm extends Component {
             <li role="presentation" className={(this.state.activeTab === "general" ? "active" : "")}><a onClick={this.changeTab} href="#general" aria-controls="general">General</a></li>
             <li role="presentation" className={(this.state.activeTab === "join" ? "active" : "")}><a onClick={this.changeTab} href="#join" aria-controls="join">Join (OTAA / ABP)</a></li>
             <li role="presentation" className={(this.state.activeTab === "classC" ? "active" : "")}><a onClick={this.changeTab} href="#classC" aria-controls="classC">Class-C</a></li>
+            <li role="presentation" className={(this.state.activeTab === "codec" ? "active" : "")}><a onClick={this.changeTab} href="#codec" aria-controls="codec">Codec</a></li>
           </ul>
           <hr />
           <form onSubmit={this.handleSubmit}>
@@ -264,6 +312,56 @@ class DeviceProfileForm extends Component {
                   </p>
                 </div>
               </div>
+              <div className={(this.state.activeTab === "codec" ? "" : "hidden")}>
+                <div className="form-group">
+                  <label className="control-label" htmlFor="payloadCodec">Payload codec</label>
+                  <Select
+                    name="payloadCodec"
+                    options={payloadCodecOptions}
+                    value={this.state.deviceProfile.payloadCodec || ""}
+                    onChange={this.onSelectChange.bind(this, 'payloadCodec')}
+                  />
+                  <p className="help-block">
+                    When set, this codec is used for the devices using this device-profile instead of the codec of the application.
+                  </p>
+                </div>
+                <div className={"form-group " + (this.state.deviceProfile.payloadCodec === "SCHEMA" ? "" : "hidden")}>
+                  <label className="control-label" htmlFor="payloadSchema">Payload schema</label>
+                  <CodeMirror
+                    value={this.state.deviceProfile.payloadDecoderScript || ''}
+                    options={Object.assign({}, codeMirrorOptions, {mode: {name: "javascript", json: true}})}
+                    onBeforeChange={this.onCodeChange.bind(this, 'payloadDecoderScript')}
+                  />
+                  <p className="help-block">
+                    The JSON schema describing the payload fields by offset, length, endianness, signedness, scaling and bit-fields,
+                    optionally per fPort. The schema is used for both decoding and encoding.
+                  </p>
+                </div>
+                <div className={"form-group " + (this.state.deviceProfile.payloadCodec === "CUSTOM_JS" ? "" : "hidden")}>
+                  <label className="control-label" htmlFor="payloadDecoderScript">Payload decoder function</label>
+                  <CodeMirror
+                    value={payloadDecoderScript}
+                    options={codeMirrorOptions}
+                    onBeforeChange={this.onCodeChange.bind(this, 'payloadDecoderScript')}
+                  />
+                  <p className="help-block">
+                    The function must have the signature <strong>function Decode(fPort, bytes)</strong> and must return an object.
+                    LoRa App Server will convert this object to JSON.
+                  </p>
+                </div>
+                <div className={"form-group " + (this.state.deviceProfile.payloadCodec === "CUSTOM_JS" ? "" : "hidden")}>
+                  <label className="control-label" htmlFor="payloadEncoderScript">Payload encoder function</label>
+                  <CodeMirror
+                    value={payloadEncoderScript}
+                    options={codeMirrorOptions}
+                    onBeforeChange={this.onCodeChange.bind(this, 'payloadEncoderScript')}
+                  />
+                  <p className="help-block">
+                    The function must have the signature <strong>function Encode(fPort, obj)</strong> and must return an array
+                    of bytes.
+                  </p>
+                </div>
+              </div>
             </fieldset>
             <hr />
             <div className={"btn-toolbar pull-right " + (this.state.isAdmin ? "" : "hidden")}>
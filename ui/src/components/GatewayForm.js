@@ -222,6 +222,33 @@ class GatewayForm extends Component {
             </label>
             <p className="help-block">When enabled (and LoRa App Server is configured with the gateway discover feature enabled), the gateway will send out periodical pings to test its coverage by other gateways in the same network.</p>
           </div>
+          <div className={"form-group " + (this.state.gateway.ping ? "" : "hidden")}>
+            <label className="control-label" htmlFor="pingInterval">Ping interval (seconds)</label>
+            <input className="form-control" id="pingInterval" type="number" min="0" value={this.state.gateway.pingInterval || 0} onChange={this.onChange.bind(this, 'pingInterval')} />
+            <p className="help-block">The interval in which the gateway sends out a ping. When set to 0, the globally configured interval is used.</p>
+          </div>
+          <div className={"form-group " + (this.state.gateway.ping ? "" : "hidden")}>
+            <label className="control-label" htmlFor="pingFrequency">Ping frequency (Hz)</label>
+            <input className="form-control" id="pingFrequency" type="number" min="0" value={this.state.gateway.pingFrequency || 0} onChange={this.onChange.bind(this, 'pingFrequency')} />
+            <p className="help-block">The frequency used for transmitting the ping. When set to 0, the globally configured frequency is used.</p>
+          </div>
+          <div className={"form-group " + (this.state.gateway.ping ? "" : "hidden")}>
+            <label className="control-label" htmlFor="customPingDR">
+              <input type="checkbox" name="customPingDR" id="customPingDR" checked={!!this.state.gateway.customPingDR} onChange={this.onChange.bind(this, 'customPingDR')} /> Custom ping data-rate
+            </label>
+            <p className="help-block">When not checked, the globally configured data-rate is used.</p>
+          </div>
+          <div className={"form-group " + (this.state.gateway.ping && this.state.gateway.customPingDR ? "" : "hidden")}>
+            <label className="control-label" htmlFor="pingDR">Ping data-rate</label>
+            <input className="form-control" id="pingDR" type="number" min="0" value={this.state.gateway.pingDR || 0} onChange={this.onChange.bind(this, 'pingDR')} />
+            <p className="help-block">The data-rate used for transmitting the ping.</p>
+          </div>
+          <div className="form-group">
+            <label className="control-label" htmlFor="fineTimestamp">
+              <input type="checkbox" name="fineTimestamp" id="fineTimestamp" checked={!!this.state.gateway.fineTimestamp} onChange={this.onChange.bind(this, 'fineTimestamp')} /> Fine timestamp
+            </label>
+            <p className="help-block">Check when the gateway reports fine (GPS synchronized) timestamps. Only the timestamps of these gateways are used for TDOA geolocation.</p>
+          </div>
           <div className="form-group">
             <label className="control-label" htmlFor="altitude">Gateway altitude (meters)</label>
             <input className="form-control" id="altitude" type="number" value={this.state.gateway.altitude || 0} onChange={this.onChange.bind(this, 'altitude')} />
@@ -53,6 +53,7 @@ class GatewayRow extends Component {
       <tr>
         <td><Link to={`/organizations/${this.props.gateway.organizationID}/gateways/${this.props.gateway.mac}`}>{this.props.gateway.name}</Link></td>
         <td>{this.props.gateway.mac}</td>
+        <td>{this.props.gateway.status}</td>
         <td>
           <Bar width="380" height="23" data={this.state.stats} options={this.state.options} />
         </td>
@@ -126,6 +127,7 @@ class ListGateways extends Component {
               <tr>
                 <th className="col-md-3">Name</th>
                 <th>MAC</th>
+                <th>Status</th>
                 <th className="col-md-4">Gateway activity (30d)</th>
               </tr>
             </thead>